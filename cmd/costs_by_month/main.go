@@ -5,12 +5,12 @@ import (
 	"flag"
 	"fmt"
 	"log"
-	"net/url"
 	"os"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/alphagov/paas-s3-broker/cmd/internal/cloudfoundry"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
@@ -28,11 +28,11 @@ func main() {
 
 	// Trim off any trailing whitespace, in case user has specified cf-api-token as the last command-line argument.
 	cfApiToken = strings.TrimSpace(cfApiToken)
-	cf, err := cfClient(cfApiUrl, cfApiToken)
+	cf, err := cloudfoundry.NewClient(cfApiUrl, cfApiToken)
 	if err != nil {
 		log.Fatalln(err)
 	}
-	serviceInstances, err := cfGetServiceInstancesByServiceLabel(cf, "aws-s3-bucket")
+	serviceInstances, err := cloudfoundry.GetServiceInstancesByServiceLabel(cf, "aws-s3-bucket")
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -156,60 +156,6 @@ func main() {
 	table.Render()
 }
 
-func cfClient(apiUrl, token string) (*cfclient.Client, error) {
-	// Workaround an undocumented expectation of `go-cfclient`: it doesn't
-	// work if `bearer ` is still in the OAuth2 token
-	// https://github.com/cloudfoundry-community/go-cfclient/blob/0b4a58fd/client.go#L200
-	token = strings.TrimPrefix(token, "bearer ")
-	c := &cfclient.Config{
-		ApiAddress: apiUrl,
-		Token:      token,
-	}
-	return cfclient.NewClient(c)
-}
-
-func cfGetServiceInstancesByServiceLabel(cf *cfclient.Client, serviceLabel string) (map[string]cfclient.ServiceInstance, error) {
-	// Get the S3 service
-	v := url.Values{}
-	v.Set("q", fmt.Sprintf("label:%s", serviceLabel))
-	services, err := cf.ListServicesByQuery(v)
-	if err != nil {
-		return nil, err
-	}
-	if len(services) != 1 {
-		return nil, fmt.Errorf("expected one 'aws-s3-bucket' services: %#v", services)
-	}
-	service := services[0]
-
-	// Get every service plan for the S3 service
-	//log.Printf("fetching service plans of service '%s' '%s'\n", service.Label, service.Guid)
-	v = url.Values{}
-	v.Set("q", fmt.Sprintf("service_guid:%s", service.Guid))
-	servicePlans, err := cf.ListServicePlansByQuery(v)
-	if err != nil {
-		return nil, err
-	}
-
-	// Get the service instances for every S3 service plan
-	serviceInstancesSlice := []cfclient.ServiceInstance{}
-	for _, servicePlan := range servicePlans {
-		//log.Printf("fetching service instances of service plan '%s' '%s'\n", servicePlan.Name, servicePlan.Guid)
-		v = url.Values{}
-		v.Set("q", fmt.Sprintf("service_plan_guid:%s", servicePlan.Guid))
-		servicePlanInstances, err := cf.ListServiceInstancesByQuery(v)
-		if err != nil {
-			return nil, err
-		}
-		serviceInstancesSlice = append(serviceInstancesSlice, servicePlanInstances...)
-	}
-
-	serviceInstances := map[string]cfclient.ServiceInstance{}
-	for _, serviceInstance := range serviceInstancesSlice {
-		serviceInstances[serviceInstance.Guid] = serviceInstance
-	}
-	return serviceInstances, nil
-}
-
 func cfGetSpacesAndOrgsFromServiceInstances(cf *cfclient.Client, serviceInstances map[string]cfclient.ServiceInstance) (map[string]cfclient.Org, map[string]cfclient.Space, error) {
 	spaces := map[string]cfclient.Space{}
 	for _, serviceInstance := range serviceInstances {
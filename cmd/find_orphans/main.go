@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/cmd/internal/cloudfoundry"
+	"github.com/alphagov/paas-s3-broker/s3"
+	"github.com/alphagov/paas-service-broker-base/broker"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	aws_s3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/olekukonko/tablewriter"
+)
+
+type result struct {
+	s3.Orphan
+	Deleted     bool   `json:"deleted"`
+	DeleteError string `json:"delete_error,omitempty"`
+}
+
+func main() {
+	var configFilePath, cfApiUrl, cfApiToken string
+	var useJSON, deleteOrphans bool
+	flag.StringVar(&configFilePath, "config", "", "Location of the broker's config file")
+	flag.StringVar(&cfApiUrl, "cf-api-url", "", "URL of Cloud Controller API")
+	flag.StringVar(&cfApiToken, "cf-api-token", "", "OAuth2 Token for the Cloud Controller API")
+	flag.BoolVar(&useJSON, "json", false, "Whether to output as JSON")
+	flag.BoolVar(&deleteOrphans, "delete", false, "Delete the orphaned resources rather than just listing them")
+	flag.Parse()
+
+	file, err := os.Open(configFilePath)
+	if err != nil {
+		log.Fatalf("Error opening config file %s: %s\n", configFilePath, err)
+	}
+	defer file.Close()
+
+	config, err := broker.NewConfig(file)
+	if err != nil {
+		log.Fatalf("Error validating config file: %v\n", err)
+	}
+	s3ClientConfig, err := s3.NewS3ClientConfig(config.Provider)
+	if err != nil {
+		log.Fatalf("Error parsing configuration: %v\n", err)
+	}
+
+	// Trim off any trailing whitespace, in case user has specified cf-api-token as the last command-line argument.
+	cfApiToken = strings.TrimSpace(cfApiToken)
+	cf, err := cloudfoundry.NewClient(cfApiUrl, cfApiToken)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	serviceInstances, err := cloudfoundry.GetServiceInstancesByServiceLabel(cf, "aws-s3-bucket")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	liveBindingIDs, err := cloudfoundry.GetBindingGUIDs(cf, serviceInstances)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	liveInstanceIDs := map[string]bool{}
+	for serviceInstanceGuid := range serviceInstances {
+		liveInstanceIDs[serviceInstanceGuid] = true
+	}
+
+	logger := lager.NewLogger("s3-find-orphans")
+	logger.RegisterSink(lager.NewWriterSink(os.Stderr, config.API.LagerLogLevel))
+
+	sess, err := s3.NewSession(s3ClientConfig)
+	if err != nil {
+		log.Fatalf("Error creating AWS session: %v\n", err)
+	}
+	s3Client := s3.NewS3Client(s3ClientConfig, aws_s3.New(sess, s3ClientConfig.S3ServiceConfig()), iam.New(sess, s3ClientConfig.IAMServiceConfig()), kms.New(sess), logger)
+	if config.API.Locket != nil {
+		// Take the same lock on bucket policies as the broker.
+		bucketLocker, err := s3.DialLocketBucketLocker(config.API.Locket, logger)
+		if err != nil {
+			log.Fatalf("Error connecting to Locket: %v\n", err)
+		}
+		s3Client.SetBucketLocker(bucketLocker)
+	}
+	ctx := context.Background()
+
+	orphans, err := s3Client.FindOrphans(ctx, liveInstanceIDs, liveBindingIDs)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	results := []result{}
+	failures := 0
+	for _, orphan := range orphans {
+		r := result{Orphan: orphan}
+		if deleteOrphans {
+			err := s3Client.DeleteOrphan(ctx, orphan, func(int) {})
+			if err != nil {
+				r.DeleteError = err.Error()
+				failures++
+			} else {
+				r.Deleted = true
+			}
+		}
+		results = append(results, r)
+	}
+
+	if useJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err := encoder.Encode(results)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	} else {
+		if !deleteOrphans && len(results) > 0 {
+			fmt.Printf("\nNothing has been deleted. Run again with --delete to delete these.\n\n")
+		}
+		headers := []string{"Kind", "Bucket", "Principal", "Detail"}
+		if deleteOrphans {
+			headers = append(headers, "Deleted")
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader(headers)
+		for _, r := range results {
+			row := []string{string(r.Kind), r.Bucket, r.Principal, r.Detail}
+			if deleteOrphans {
+				deleted := "true"
+				if r.DeleteError != "" {
+					deleted = r.DeleteError
+				}
+				row = append(row, deleted)
+			}
+			table.Append(row)
+		}
+		table.Render()
+	}
+
+	if failures > 0 {
+		log.Fatalf("Failed to delete %d orphaned resources\n", failures)
+	}
+}
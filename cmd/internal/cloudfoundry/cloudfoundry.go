@@ -0,0 +1,90 @@
+// Package cloudfoundry has the Cloud Controller lookups shared by the
+// utilities in cmd.
+package cloudfoundry
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	cfclient "github.com/cloudfoundry-community/go-cfclient"
+)
+
+func NewClient(apiUrl, token string) (*cfclient.Client, error) {
+	// Workaround an undocumented expectation of `go-cfclient`: it doesn't
+	// work if `bearer ` is still in the OAuth2 token
+	// https://github.com/cloudfoundry-community/go-cfclient/blob/0b4a58fd/client.go#L200
+	token = strings.TrimPrefix(token, "bearer ")
+	c := &cfclient.Config{
+		ApiAddress: apiUrl,
+		Token:      token,
+	}
+	return cfclient.NewClient(c)
+}
+
+func GetServiceInstancesByServiceLabel(cf *cfclient.Client, serviceLabel string) (map[string]cfclient.ServiceInstance, error) {
+	// Get the S3 service
+	v := url.Values{}
+	v.Set("q", fmt.Sprintf("label:%s", serviceLabel))
+	services, err := cf.ListServicesByQuery(v)
+	if err != nil {
+		return nil, err
+	}
+	if len(services) != 1 {
+		return nil, fmt.Errorf("expected one '%s' services: %#v", serviceLabel, services)
+	}
+	service := services[0]
+
+	// Get every service plan for the S3 service
+	v = url.Values{}
+	v.Set("q", fmt.Sprintf("service_guid:%s", service.Guid))
+	servicePlans, err := cf.ListServicePlansByQuery(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the service instances for every S3 service plan
+	serviceInstancesSlice := []cfclient.ServiceInstance{}
+	for _, servicePlan := range servicePlans {
+		v = url.Values{}
+		v.Set("q", fmt.Sprintf("service_plan_guid:%s", servicePlan.Guid))
+		servicePlanInstances, err := cf.ListServiceInstancesByQuery(v)
+		if err != nil {
+			return nil, err
+		}
+		serviceInstancesSlice = append(serviceInstancesSlice, servicePlanInstances...)
+	}
+
+	serviceInstances := map[string]cfclient.ServiceInstance{}
+	for _, serviceInstance := range serviceInstancesSlice {
+		serviceInstances[serviceInstance.Guid] = serviceInstance
+	}
+	return serviceInstances, nil
+}
+
+// GetBindingGUIDs returns the GUIDs of every service binding and service key
+// of the service instances. The broker treats both as bindings.
+func GetBindingGUIDs(cf *cfclient.Client, serviceInstances map[string]cfclient.ServiceInstance) (map[string]bool, error) {
+	bindingGUIDs := map[string]bool{}
+	for serviceInstanceGuid := range serviceInstances {
+		v := url.Values{}
+		v.Set("q", fmt.Sprintf("service_instance_guid:%s", serviceInstanceGuid))
+
+		serviceBindings, err := cf.ListServiceBindingsByQuery(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, serviceBinding := range serviceBindings {
+			bindingGUIDs[serviceBinding.Guid] = true
+		}
+
+		serviceKeys, err := cf.ListServiceKeysByQuery(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, serviceKey := range serviceKeys {
+			bindingGUIDs[serviceKey.Guid] = true
+		}
+	}
+	return bindingGUIDs, nil
+}
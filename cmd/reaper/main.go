@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	"github.com/alphagov/paas-service-broker-base/broker"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	aws_s3 "github.com/aws/aws-sdk-go/service/s3"
+)
+
+func main() {
+	var configFilePath string
+	var dryRun bool
+	flag.StringVar(&configFilePath, "config", "", "Location of the broker's config file")
+	flag.BoolVar(&dryRun, "dry-run", false, "List the buckets which would be deleted without deleting them")
+	flag.Parse()
+
+	file, err := os.Open(configFilePath)
+	if err != nil {
+		log.Fatalf("Error opening config file %s: %s\n", configFilePath, err)
+	}
+	defer file.Close()
+
+	config, err := broker.NewConfig(file)
+	if err != nil {
+		log.Fatalf("Error validating config file: %v\n", err)
+	}
+	s3ClientConfig, err := s3.NewS3ClientConfig(config.Provider)
+	if err != nil {
+		log.Fatalf("Error parsing configuration: %v\n", err)
+	}
+	if s3ClientConfig.SoftDeleteRetentionDays <= 0 {
+		log.Fatalln("soft_delete_retention_days is not set, so there is nothing to reap")
+	}
+
+	logger := lager.NewLogger("s3-bucket-reaper")
+	logger.RegisterSink(lager.NewWriterSink(os.Stderr, config.API.LagerLogLevel))
+
+	sess, err := s3.NewSession(s3ClientConfig)
+	if err != nil {
+		log.Fatalf("Error creating AWS session: %v\n", err)
+	}
+	s3Client := s3.NewS3Client(s3ClientConfig, aws_s3.New(sess, s3ClientConfig.S3ServiceConfig()), iam.New(sess, s3ClientConfig.IAMServiceConfig()), kms.New(sess), logger)
+	if config.API.Locket != nil {
+		// Take the same lock on bucket policies as the broker.
+		bucketLocker, err := s3.DialLocketBucketLocker(config.API.Locket, logger)
+		if err != nil {
+			log.Fatalf("Error connecting to Locket: %v\n", err)
+		}
+		s3Client.SetBucketLocker(bucketLocker)
+	}
+	ctx := context.Background()
+
+	softDeletedBuckets, err := s3Client.ListSoftDeletedBuckets(ctx)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	now := time.Now()
+	failures := 0
+	for _, bucket := range softDeletedBuckets {
+		if !bucket.Expired(now) {
+			fmt.Printf("Keeping %s until %s\n", bucket.BucketName, bucket.ExpiresAt.Format(time.RFC3339))
+			continue
+		}
+		if dryRun {
+			fmt.Printf("Would delete %s, which was deprovisioned at %s\n", bucket.BucketName, bucket.DeletedAt.Format(time.RFC3339))
+			continue
+		}
+
+		fmt.Printf("Deleting %s, which was deprovisioned at %s\n", bucket.BucketName, bucket.DeletedAt.Format(time.RFC3339))
+		objectsDeleted := 0
+		err := s3Client.ReapBucket(ctx, bucket.InstanceID, func(n int) {
+			objectsDeleted = n
+		})
+		if err != nil {
+			fmt.Printf("Failed to delete %s after deleting %d objects: %s\n", bucket.BucketName, objectsDeleted, err)
+			failures++
+			continue
+		}
+		fmt.Printf("Deleted %s and %d objects\n", bucket.BucketName, objectsDeleted)
+	}
+
+	if failures > 0 {
+		log.Fatalf("Failed to delete %d buckets\n", failures)
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"strconv"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	"github.com/alphagov/paas-service-broker-base/broker"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	aws_s3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/olekukonko/tablewriter"
+)
+
+type result struct {
+	s3.Drift
+	Repaired    bool   `json:"repaired"`
+	RepairError string `json:"repair_error,omitempty"`
+}
+
+func main() {
+	var configFilePath string
+	var useJSON bool
+	var repair bool
+	flag.StringVar(&configFilePath, "config", "", "Location of the broker's config file")
+	flag.BoolVar(&useJSON, "json", false, "Whether to output as JSON")
+	flag.BoolVar(&repair, "repair", false, "Repair the drift which can be repaired automatically")
+	flag.Parse()
+
+	file, err := os.Open(configFilePath)
+	if err != nil {
+		log.Fatalf("Error opening config file %s: %s\n", configFilePath, err)
+	}
+	defer file.Close()
+
+	config, err := broker.NewConfig(file)
+	if err != nil {
+		log.Fatalf("Error validating config file: %v\n", err)
+	}
+	s3ClientConfig, err := s3.NewS3ClientConfig(config.Provider)
+	if err != nil {
+		log.Fatalf("Error parsing configuration: %v\n", err)
+	}
+
+	logger := lager.NewLogger("s3-reconcile")
+	logger.RegisterSink(lager.NewWriterSink(os.Stderr, config.API.LagerLogLevel))
+
+	sess, err := s3.NewSession(s3ClientConfig)
+	if err != nil {
+		log.Fatalf("Error creating AWS session: %v\n", err)
+	}
+	s3Client := s3.NewS3Client(s3ClientConfig, aws_s3.New(sess, s3ClientConfig.S3ServiceConfig()), iam.New(sess, s3ClientConfig.IAMServiceConfig()), kms.New(sess), logger)
+	if config.API.Locket != nil {
+		// Take the same lock on bucket policies as the broker.
+		bucketLocker, err := s3.DialLocketBucketLocker(config.API.Locket, logger)
+		if err != nil {
+			log.Fatalf("Error connecting to Locket: %v\n", err)
+		}
+		s3Client.SetBucketLocker(bucketLocker)
+	}
+	ctx := context.Background()
+
+	drifts, err := s3Client.FindDrift(ctx)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	results := []result{}
+	remaining := 0
+	for _, drift := range drifts {
+		r := result{Drift: drift}
+		if repair && drift.Repairable {
+			err := s3Client.RepairDrift(ctx, drift)
+			if err != nil {
+				r.RepairError = err.Error()
+			} else {
+				r.Repaired = true
+			}
+		}
+		if !r.Repaired {
+			remaining++
+		}
+		results = append(results, r)
+	}
+
+	if useJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err := encoder.Encode(results)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	} else {
+		headers := []string{"Kind", "Bucket", "Principal", "Detail", "Repairable"}
+		if repair {
+			headers = append(headers, "Repaired")
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader(headers)
+		for _, r := range results {
+			row := []string{string(r.Kind), r.Bucket, r.Principal, r.Detail, strconv.FormatBool(r.Repairable)}
+			if repair {
+				repaired := strconv.FormatBool(r.Repaired)
+				if r.RepairError != "" {
+					repaired = r.RepairError
+				}
+				row = append(row, repaired)
+			}
+			table.Append(row)
+		}
+		table.Render()
+	}
+
+	if remaining > 0 {
+		log.Fatalf("%d of %d differences remain\n", remaining, len(results))
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	"github.com/alphagov/paas-service-broker-base/broker"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	aws_s3 "github.com/aws/aws-sdk-go/service/s3"
+)
+
+func main() {
+	var configFilePath, instanceID string
+	flag.StringVar(&configFilePath, "config", "", "Location of the broker's config file")
+	flag.StringVar(&instanceID, "instance-id", "", "GUID of the deleted service instance whose bucket should be restored")
+	flag.Parse()
+
+	if instanceID == "" {
+		log.Fatalln("-instance-id is required")
+	}
+
+	file, err := os.Open(configFilePath)
+	if err != nil {
+		log.Fatalf("Error opening config file %s: %s\n", configFilePath, err)
+	}
+	defer file.Close()
+
+	config, err := broker.NewConfig(file)
+	if err != nil {
+		log.Fatalf("Error validating config file: %v\n", err)
+	}
+	s3ClientConfig, err := s3.NewS3ClientConfig(config.Provider)
+	if err != nil {
+		log.Fatalf("Error parsing configuration: %v\n", err)
+	}
+
+	logger := lager.NewLogger("s3-bucket-restore")
+	logger.RegisterSink(lager.NewWriterSink(os.Stderr, config.API.LagerLogLevel))
+
+	sess, err := s3.NewSession(s3ClientConfig)
+	if err != nil {
+		log.Fatalf("Error creating AWS session: %v\n", err)
+	}
+	s3Client := s3.NewS3Client(s3ClientConfig, aws_s3.New(sess, s3ClientConfig.S3ServiceConfig()), iam.New(sess, s3ClientConfig.IAMServiceConfig()), kms.New(sess), logger)
+	if config.API.Locket != nil {
+		// Take the same lock on bucket policies as the broker.
+		bucketLocker, err := s3.DialLocketBucketLocker(config.API.Locket, logger)
+		if err != nil {
+			log.Fatalf("Error connecting to Locket: %v\n", err)
+		}
+		s3Client.SetBucketLocker(bucketLocker)
+	}
+	ctx := context.Background()
+
+	err = s3Client.RestoreBucket(ctx, instanceID)
+	if err != nil {
+		log.Fatalf("Error restoring bucket for %s: %s\n", instanceID, err)
+	}
+	fmt.Printf("Restored bucket for %s. It is no longer managed by Cloud Foundry.\n", instanceID)
+}
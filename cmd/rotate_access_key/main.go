@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/credstore"
+	"github.com/alphagov/paas-s3-broker/s3"
+	"github.com/alphagov/paas-service-broker-base/broker"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	aws_s3 "github.com/aws/aws-sdk-go/service/s3"
+)
+
+func main() {
+	var configFilePath, bindingID string
+	var expireOnly bool
+	flag.StringVar(&configFilePath, "config", "", "Location of the broker's config file")
+	flag.StringVar(&bindingID, "binding-id", "", "GUID of the service binding whose access key should be rotated")
+	flag.BoolVar(&expireOnly, "expire-only", false, "Only delete the previous access key if its overlap is over, without creating a new one")
+	flag.Parse()
+
+	if bindingID == "" {
+		log.Fatalln("-binding-id is required")
+	}
+
+	file, err := os.Open(configFilePath)
+	if err != nil {
+		log.Fatalf("Error opening config file %s: %s\n", configFilePath, err)
+	}
+	defer file.Close()
+
+	config, err := broker.NewConfig(file)
+	if err != nil {
+		log.Fatalf("Error validating config file: %v\n", err)
+	}
+	s3ClientConfig, err := s3.NewS3ClientConfig(config.Provider)
+	if err != nil {
+		log.Fatalf("Error parsing configuration: %v\n", err)
+	}
+
+	storeConfig, err := credstore.NewConfig(config.Provider)
+	if err != nil {
+		log.Fatalf("Error parsing configuration: %v\n", err)
+	}
+	store, err := credstore.New(storeConfig)
+	if err != nil {
+		log.Fatalf("Error creating credential store: %v\n", err)
+	}
+
+	logger := lager.NewLogger("s3-rotate-access-key")
+	logger.RegisterSink(lager.NewWriterSink(os.Stderr, config.API.LagerLogLevel))
+
+	sess, err := s3.NewSession(s3ClientConfig)
+	if err != nil {
+		log.Fatalf("Error creating AWS session: %v\n", err)
+	}
+	s3Client := s3.NewS3Client(s3ClientConfig, aws_s3.New(sess, s3ClientConfig.S3ServiceConfig()), iam.New(sess, s3ClientConfig.IAMServiceConfig()), kms.New(sess), logger)
+	ctx := context.Background()
+
+	if expireOnly {
+		err = s3Client.ExpireRotatedAccessKey(ctx, bindingID)
+		if err != nil {
+			log.Fatalf("Error expiring previous access key for %s: %s\n", bindingID, err)
+		}
+		return
+	}
+
+	credentials, err := s3Client.RotateAccessKey(ctx, bindingID)
+	if err != nil {
+		log.Fatalf("Error rotating access key for %s: %s\n", bindingID, err)
+	}
+	if store != nil {
+		err = updateStoredCredentials(ctx, store, bindingID, credentials)
+		if err != nil {
+			// Nothing would ever give out the new key, so do not let the
+			// previous one expire in its favour.
+			undoErr := s3Client.UndoAccessKeyRotation(ctx, bindingID, credentials.AWSAccessKeyID)
+			if undoErr != nil {
+				log.Fatalf("Error updating stored credentials for %s: %s, and error deleting the new access key %s: %s\n", bindingID, err, credentials.AWSAccessKeyID, undoErr)
+			}
+			log.Fatalf("Error updating stored credentials for %s: %s\n", bindingID, err)
+		}
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(credentials)
+	if err != nil {
+		log.Fatalf("Error writing credentials: %s\n", err)
+	}
+	fmt.Fprintf(os.Stderr, "The previous access key for %s keeps working for %.0f hours.\n", bindingID, s3ClientConfig.AccessKeyRotationOverlap().Hours())
+}
+
+// updateStoredCredentials replaces the access key in the binding's stored
+// credentials, keeping the rest of them, such as its prefix, as they were.
+func updateStoredCredentials(ctx context.Context, store credstore.CredentialStore, bindingID string, rotated s3.BucketCredentials) error {
+	credentials := s3.BucketCredentials{}
+	stored, err := store.Get(ctx, bindingID)
+	if err == credstore.ErrNotFound {
+		// The binding was made before there was a credential store.
+		credentials = rotated
+	} else if err != nil {
+		return err
+	} else {
+		err = json.Unmarshal(stored, &credentials)
+		if err != nil {
+			return err
+		}
+		credentials.AWSAccessKeyID = rotated.AWSAccessKeyID
+		credentials.AWSSecretAccessKey = rotated.AWSSecretAccessKey
+	}
+
+	credentialsJSON, err := json.Marshal(credentials)
+	if err != nil {
+		return err
+	}
+	return store.Put(ctx, bindingID, credentialsJSON)
+}
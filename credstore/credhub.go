@@ -0,0 +1,336 @@
+package credstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultCredHubNamePrefix = "/c/paas-s3-broker"
+
+	credHubRequestTimeout = 30 * time.Second
+	// uaaTokenExpiryMargin is how long before a UAA token expires that a new
+	// one is fetched, so that a request does not start with a token which
+	// runs out before it reaches CredHub.
+	uaaTokenExpiryMargin = 30 * time.Second
+)
+
+// CredHubStore keeps credentials in CredHub, as JSON credentials named
+// `<name prefix>/<binding ID>/credentials`, alongside the app allowed to
+// read them in `<name prefix>/<binding ID>/app`. It authenticates with a UAA
+// client which must be allowed to write under the name prefix.
+type CredHubStore struct {
+	credHubURL   string
+	uaaURL       string
+	clientID     string
+	clientSecret string
+	namePrefix   string
+	httpClient   *http.Client
+
+	mu             sync.Mutex
+	token          string
+	tokenExpiresAt time.Time
+}
+
+var _ ReferenceStore = &CredHubStore{}
+
+func NewCredHubStore(config Config) (*CredHubStore, error) {
+	var missing []string
+	required := []struct{ field, value string }{
+		{"credhub_url", config.CredHubURL},
+		{"uaa_url", config.UAAURL},
+		{"client_id", config.ClientID},
+		{"client_secret", config.ClientSecret},
+	}
+	for _, r := range required {
+		if r.value == "" {
+			missing = append(missing, "credential_store."+r.field)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%s must be set", strings.Join(missing, ", "))
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.CACert)) {
+			return nil, errors.New("credential_store.ca_cert does not contain any PEM certificates")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	namePrefix := config.NamePrefix
+	if namePrefix == "" {
+		namePrefix = DefaultCredHubNamePrefix
+	}
+	return &CredHubStore{
+		credHubURL:   strings.TrimSuffix(config.CredHubURL, "/"),
+		uaaURL:       strings.TrimSuffix(config.UAAURL, "/"),
+		clientID:     config.ClientID,
+		clientSecret: config.ClientSecret,
+		namePrefix:   "/" + strings.Trim(namePrefix, "/"),
+		httpClient:   &http.Client{Transport: transport, Timeout: credHubRequestTimeout},
+	}, nil
+}
+
+func (c *CredHubStore) name(bindingID string) string {
+	return fmt.Sprintf("%s/%s/credentials", c.namePrefix, bindingID)
+}
+
+// appName is where AllowRead records the app, so that Readable does not
+// need to know it.
+func (c *CredHubStore) appName(bindingID string) string {
+	return fmt.Sprintf("%s/%s/app", c.namePrefix, bindingID)
+}
+
+func (c *CredHubStore) Put(ctx context.Context, bindingID string, credentials json.RawMessage) error {
+	return c.put(ctx, c.name(bindingID), credentials)
+}
+
+func (c *CredHubStore) Get(ctx context.Context, bindingID string) (json.RawMessage, error) {
+	return c.get(ctx, c.name(bindingID))
+}
+
+// Delete also takes away the app's permission to read the credentials, so
+// that it cannot read whatever is stored under the same name later.
+func (c *CredHubStore) Delete(ctx context.Context, bindingID string) error {
+	err := c.revokeRead(ctx, bindingID)
+	if err != nil {
+		return err
+	}
+	err = c.delete(ctx, c.name(bindingID))
+	if err != nil {
+		return err
+	}
+	return c.delete(ctx, c.appName(bindingID))
+}
+
+func (c *CredHubStore) put(ctx context.Context, name string, value json.RawMessage) error {
+	body := map[string]interface{}{
+		"name":  name,
+		"type":  "json",
+		"value": value,
+	}
+	_, err := c.do(ctx, http.MethodPut, "/api/v1/data", nil, body)
+	return err
+}
+
+func (c *CredHubStore) get(ctx context.Context, name string) (json.RawMessage, error) {
+	query := url.Values{"name": {name}, "current": {"true"}}
+	responseBody, err := c.do(ctx, http.MethodGet, "/api/v1/data", query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data []struct {
+			Value json.RawMessage `json:"value"`
+		} `json:"data"`
+	}{}
+	err = json.Unmarshal(responseBody, &response)
+	if err != nil {
+		return nil, fmt.Errorf("credhub: reading %s: %w", name, err)
+	}
+	if len(response.Data) == 0 {
+		return nil, ErrNotFound
+	}
+	return response.Data[0].Value, nil
+}
+
+// delete does nothing if there is no credential with the name.
+func (c *CredHubStore) delete(ctx context.Context, name string) error {
+	query := url.Values{"name": {name}}
+	_, err := c.do(ctx, http.MethodDelete, "/api/v1/data", query, nil)
+	if err == ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// Reference is what Cloud Foundry puts in an app's VCAP_SERVICES in place
+// of its credentials, and replaces with them when the app starts.
+func (c *CredHubStore) Reference(bindingID string) interface{} {
+	return map[string]string{"credhub-ref": c.name(bindingID)}
+}
+
+// AllowRead lets the app read its credentials, using the identity Diego
+// gives its containers, and records that it may.
+func (c *CredHubStore) AllowRead(ctx context.Context, bindingID, appGUID string) error {
+	body := map[string]interface{}{
+		"path":       c.name(bindingID),
+		"actor":      "mtls-app:" + appGUID,
+		"operations": []string{"read"},
+	}
+	_, err := c.do(ctx, http.MethodPost, "/api/v2/permissions", nil, body)
+	if err != nil && !errors.Is(err, errConflict) {
+		// A conflict means the app was allowed already, for instance
+		// because Cloud Controller retried the bind.
+		return err
+	}
+
+	app, err := json.Marshal(map[string]string{"app_guid": appGUID})
+	if err != nil {
+		return err
+	}
+	return c.put(ctx, c.appName(bindingID), app)
+}
+
+// revokeRead deletes the permission AllowRead gave the app recorded for the
+// binding, if there is one.
+func (c *CredHubStore) revokeRead(ctx context.Context, bindingID string) error {
+	appJSON, err := c.get(ctx, c.appName(bindingID))
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	app := struct {
+		AppGUID string `json:"app_guid"`
+	}{}
+	err = json.Unmarshal(appJSON, &app)
+	if err != nil {
+		return fmt.Errorf("credhub: reading %s: %w", c.appName(bindingID), err)
+	}
+
+	query := url.Values{"path": {c.name(bindingID)}, "actor": {"mtls-app:" + app.AppGUID}}
+	responseBody, err := c.do(ctx, http.MethodGet, "/api/v2/permissions", query, nil)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	permission := struct {
+		UUID string `json:"uuid"`
+	}{}
+	err = json.Unmarshal(responseBody, &permission)
+	if err != nil {
+		return fmt.Errorf("credhub: reading the permission on %s: %w", c.name(bindingID), err)
+	}
+	_, err = c.do(ctx, http.MethodDelete, "/api/v2/permissions/"+url.PathEscape(permission.UUID), nil, nil)
+	if err == ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+func (c *CredHubStore) Readable(ctx context.Context, bindingID string) (bool, error) {
+	_, err := c.get(ctx, c.appName(bindingID))
+	if err == ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+var errConflict = errors.New("conflict")
+
+// do makes a request to CredHub and returns the response body. It returns
+// ErrNotFound for a 404.
+func (c *CredHubStore) do(ctx context.Context, method, path string, query url.Values, body interface{}) ([]byte, error) {
+	token, err := c.getToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestBody io.Reader
+	if body != nil {
+		bodyJSON, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		requestBody = bytes.NewReader(bodyJSON)
+	}
+	requestURL := c.credHubURL + path
+	if query != nil {
+		requestURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("credhub: %s %s: %w", method, path, err)
+	}
+	defer res.Body.Close()
+	responseBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("credhub: %s %s: %w", method, path, err)
+	}
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return nil, ErrNotFound
+	case res.StatusCode == http.StatusConflict:
+		return nil, fmt.Errorf("credhub: %s %s: %w: %s", method, path, errConflict, responseBody)
+	case res.StatusCode >= 300:
+		return nil, fmt.Errorf("credhub: %s %s: %s: %s", method, path, res.Status, responseBody)
+	}
+	return responseBody, nil
+}
+
+// getToken returns a UAA access token for the client, fetching a new one if
+// the last has expired.
+func (c *CredHubStore) getToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && time.Now().Before(c.tokenExpiresAt) {
+		return c.token, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.uaaURL+"/oauth/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(url.QueryEscape(c.clientID), url.QueryEscape(c.clientSecret))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("uaa: fetching token: %w", err)
+	}
+	defer res.Body.Close()
+	responseBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("uaa: fetching token: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("uaa: fetching token: %s: %s", res.Status, responseBody)
+	}
+
+	response := struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}{}
+	err = json.Unmarshal(responseBody, &response)
+	if err != nil {
+		return "", fmt.Errorf("uaa: fetching token: %w", err)
+	}
+	if response.AccessToken == "" {
+		return "", errors.New("uaa: fetching token: no access token in the response")
+	}
+
+	c.token = response.AccessToken
+	c.tokenExpiresAt = time.Now().Add(time.Duration(response.ExpiresIn)*time.Second - uaaTokenExpiryMargin)
+	return c.token, nil
+}
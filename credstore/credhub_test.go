@@ -0,0 +1,273 @@
+package credstore_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/alphagov/paas-s3-broker/credstore"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeCredHub is a stand-in for the parts of CredHub and UAA which
+// CredHubStore uses.
+type fakeCredHub struct {
+	mu          sync.Mutex
+	credentials map[string]json.RawMessage
+	permissions map[string][]string
+	tokens      int
+}
+
+// permissionUUID stands in for the UUID CredHub gives each permission.
+func permissionUUID(path, actor string) string {
+	return strings.NewReplacer("/", "_", ":", "_").Replace(path + "_" + actor)
+}
+
+func (f *fakeCredHub) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if req.URL.Path == "/oauth/token" {
+		clientID, clientSecret, ok := req.BasicAuth()
+		if !ok || clientID != "credhub-client" || clientSecret != "credhub-secret" || req.FormValue("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.tokens++
+		json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "test-token", "expires_in": 3600})
+		return
+	}
+
+	if req.Header.Get("Authorization") != "Bearer test-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case req.URL.Path == "/api/v1/data" && req.Method == http.MethodPut:
+		body := struct {
+			Name  string          `json:"name"`
+			Type  string          `json:"type"`
+			Value json.RawMessage `json:"value"`
+		}{}
+		if json.NewDecoder(req.Body).Decode(&body) != nil || body.Type != "json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.credentials[body.Name] = body.Value
+		json.NewEncoder(w).Encode(body)
+	case req.URL.Path == "/api/v1/data" && req.Method == http.MethodGet:
+		value, ok := f.credentials[req.URL.Query().Get("name")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]interface{}{{"type": "json", "value": value}},
+		})
+	case req.URL.Path == "/api/v1/data" && req.Method == http.MethodDelete:
+		name := req.URL.Query().Get("name")
+		if _, ok := f.credentials[name]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.credentials, name)
+		w.WriteHeader(http.StatusNoContent)
+	case req.URL.Path == "/api/v2/permissions" && req.Method == http.MethodPost:
+		body := struct {
+			Path       string   `json:"path"`
+			Actor      string   `json:"actor"`
+			Operations []string `json:"operations"`
+		}{}
+		if json.NewDecoder(req.Body).Decode(&body) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, actor := range f.permissions[body.Path] {
+			if actor == body.Actor {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+		}
+		f.permissions[body.Path] = append(f.permissions[body.Path], body.Actor)
+		w.WriteHeader(http.StatusCreated)
+	case req.URL.Path == "/api/v2/permissions" && req.Method == http.MethodGet:
+		path, actor := req.URL.Query().Get("path"), req.URL.Query().Get("actor")
+		if !slices.Contains(f.permissions[path], actor) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"uuid":       permissionUUID(path, actor),
+			"path":       path,
+			"actor":      actor,
+			"operations": []string{"read"},
+		})
+	case strings.HasPrefix(req.URL.Path, "/api/v2/permissions/") && req.Method == http.MethodDelete:
+		uuid := strings.TrimPrefix(req.URL.Path, "/api/v2/permissions/")
+		for path, actors := range f.permissions {
+			for i, actor := range actors {
+				if permissionUUID(path, actor) == uuid {
+					f.permissions[path] = slices.Delete(actors, i, i+1)
+					if len(f.permissions[path]) == 0 {
+						delete(f.permissions, path)
+					}
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+var _ = Describe("CredHubStore", func() {
+	var (
+		fake   *fakeCredHub
+		server *httptest.Server
+		config credstore.Config
+		store  *credstore.CredHubStore
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		fake = &fakeCredHub{
+			credentials: map[string]json.RawMessage{},
+			permissions: map[string][]string{},
+		}
+		server = httptest.NewTLSServer(fake)
+		DeferCleanup(server.Close)
+
+		config = credstore.Config{
+			Type:         credstore.TypeCredHub,
+			CredHubURL:   server.URL,
+			UAAURL:       server.URL,
+			ClientID:     "credhub-client",
+			ClientSecret: "credhub-secret",
+			CACert:       string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+		}
+		ctx = context.Background()
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		store, err = credstore.NewCredHubStore(config)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("keeps credentials in CredHub, named after the binding", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{"aws_secret_access_key":"secret-1"}`))).To(Succeed())
+
+		Expect(fake.credentials).To(HaveKeyWithValue("/c/paas-s3-broker/binding-1/credentials", MatchJSON(`{"aws_secret_access_key":"secret-1"}`)))
+		Expect(store.Get(ctx, "binding-1")).To(MatchJSON(`{"aws_secret_access_key":"secret-1"}`))
+	})
+
+	It("deletes credentials, and ignores ones which are gone already", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{}`))).To(Succeed())
+		Expect(store.Delete(ctx, "binding-1")).To(Succeed())
+		Expect(fake.credentials).To(BeEmpty())
+
+		Expect(store.Delete(ctx, "binding-1")).To(Succeed())
+	})
+
+	It("reports credentials which were never put as not found", func() {
+		_, err := store.Get(ctx, "binding-1")
+		Expect(err).To(Equal(credstore.ErrNotFound))
+	})
+
+	It("lets the app read its credentials", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{}`))).To(Succeed())
+		Expect(store.AllowRead(ctx, "binding-1", "app-guid")).To(Succeed())
+		Expect(fake.permissions).To(HaveKeyWithValue("/c/paas-s3-broker/binding-1/credentials", ConsistOf("mtls-app:app-guid")))
+
+		By("not minding if the app could read them already")
+		Expect(store.AllowRead(ctx, "binding-1", "app-guid")).To(Succeed())
+	})
+
+	It("remembers which bindings an app may read", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{}`))).To(Succeed())
+		Expect(store.Put(ctx, "binding-2", json.RawMessage(`{}`))).To(Succeed())
+		Expect(store.AllowRead(ctx, "binding-1", "app-guid")).To(Succeed())
+
+		Expect(store.Readable(ctx, "binding-1")).To(BeTrue())
+		Expect(store.Readable(ctx, "binding-2")).To(BeFalse())
+
+		By("forgetting when the credentials are deleted")
+		Expect(store.Delete(ctx, "binding-1")).To(Succeed())
+		Expect(store.Readable(ctx, "binding-1")).To(BeFalse())
+		Expect(fake.credentials).NotTo(HaveKey(ContainSubstring("binding-1")))
+	})
+
+	It("takes away the app's permission when the credentials are deleted", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{}`))).To(Succeed())
+		Expect(store.AllowRead(ctx, "binding-1", "app-guid")).To(Succeed())
+		Expect(store.Put(ctx, "binding-2", json.RawMessage(`{}`))).To(Succeed())
+		Expect(store.AllowRead(ctx, "binding-2", "app-guid")).To(Succeed())
+
+		Expect(store.Delete(ctx, "binding-1")).To(Succeed())
+		Expect(fake.permissions).NotTo(HaveKey("/c/paas-s3-broker/binding-1/credentials"))
+		Expect(fake.permissions).To(HaveKeyWithValue("/c/paas-s3-broker/binding-2/credentials", ConsistOf("mtls-app:app-guid")))
+
+		By("not minding if the permission has gone already")
+		fake.permissions = map[string][]string{}
+		Expect(store.Delete(ctx, "binding-2")).To(Succeed())
+		Expect(fake.credentials).To(BeEmpty())
+	})
+
+	It("refers to the credentials as Cloud Foundry expects", func() {
+		Expect(store.Reference("binding-1")).To(Equal(map[string]string{
+			"credhub-ref": "/c/paas-s3-broker/binding-1/credentials",
+		}))
+	})
+
+	It("reuses its UAA token", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{}`))).To(Succeed())
+		Expect(store.Put(ctx, "binding-2", json.RawMessage(`{}`))).To(Succeed())
+		Expect(fake.tokens).To(Equal(1))
+	})
+
+	Context("with a name prefix", func() {
+		BeforeEach(func() {
+			config.NamePrefix = "/c/s3-broker-client/service-guid/"
+		})
+
+		It("names credentials under it", func() {
+			Expect(store.Put(ctx, "binding-1", json.RawMessage(`{}`))).To(Succeed())
+			Expect(fake.credentials).To(HaveKey("/c/s3-broker-client/service-guid/binding-1/credentials"))
+		})
+	})
+
+	Context("when the client's secret is wrong", func() {
+		BeforeEach(func() {
+			config.ClientSecret = "wrong"
+		})
+
+		It("reports that it could not get a token", func() {
+			err := store.Put(ctx, "binding-1", json.RawMessage(`{}`))
+			Expect(err).To(MatchError(ContainSubstring("uaa: fetching token: 401")))
+		})
+	})
+
+	Context("when the server's certificate is not trusted", func() {
+		BeforeEach(func() {
+			config.CACert = ""
+		})
+
+		It("refuses to talk to it", func() {
+			err := store.Put(ctx, "binding-1", json.RawMessage(`{}`))
+			Expect(err).To(MatchError(ContainSubstring("certificate")))
+		})
+	})
+
+	It("requires the URLs and client", func() {
+		_, err := credstore.NewCredHubStore(credstore.Config{Type: credstore.TypeCredHub, ClientID: "credhub-client"})
+		Expect(err).To(MatchError("credential_store.credhub_url, credential_store.uaa_url, credential_store.client_secret must be set"))
+	})
+})
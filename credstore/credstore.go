@@ -0,0 +1,99 @@
+// Package credstore keeps binding credentials, so that they can be fetched
+// again after the bind request has returned them.
+package credstore
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	TypeFile    = "file"
+	TypeCredHub = "credhub"
+)
+
+var ErrNotFound = errors.New("credentials not found")
+
+// CredentialStore keeps each binding's credentials, as JSON, under its
+// binding ID.
+type CredentialStore interface {
+	Put(ctx context.Context, bindingID string, credentials json.RawMessage) error
+	// Get returns ErrNotFound if there are no credentials for the binding.
+	Get(ctx context.Context, bindingID string) (json.RawMessage, error)
+	// Delete does nothing if there are no credentials for the binding.
+	Delete(ctx context.Context, bindingID string) error
+}
+
+// ReferenceStore is a CredentialStore which apps can read their own
+// credentials from, so that the broker can give them a reference to their
+// credentials instead of the credentials themselves.
+type ReferenceStore interface {
+	CredentialStore
+	Reference(bindingID string) interface{}
+	AllowRead(ctx context.Context, bindingID, appGUID string) error
+	// Readable reports whether AllowRead has let an app read the binding's
+	// credentials, and so whether it was given a reference to them.
+	Readable(ctx context.Context, bindingID string) (bool, error)
+}
+
+type Config struct {
+	Type string `json:"type"`
+
+	// For TypeFile.
+	Path          string `json:"path"`
+	EncryptionKey string `json:"encryption_key"`
+
+	// For TypeCredHub.
+	CredHubURL   string `json:"credhub_url"`
+	UAAURL       string `json:"uaa_url"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	CACert       string `json:"ca_cert"`
+	NamePrefix   string `json:"name_prefix"`
+}
+
+// NewConfig reads the `credential_store` section of the provider
+// configuration. Its Type is empty if there is none.
+func NewConfig(configJSON []byte) (Config, error) {
+	config := struct {
+		CredentialStore Config `json:"credential_store"`
+	}{}
+	err := json.Unmarshal(configJSON, &config)
+	if err != nil {
+		return Config{}, err
+	}
+	return config.CredentialStore, nil
+}
+
+// New returns the store the configuration describes, or nil if it does not
+// describe one.
+func New(config Config) (CredentialStore, error) {
+	switch config.Type {
+	case "":
+		return nil, nil
+	case TypeFile:
+		if config.Path == "" {
+			return nil, errors.New("credential_store.path must be set")
+		}
+		key, err := base64.StdEncoding.DecodeString(config.EncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("credential_store.encryption_key must be base64 encoded: %w", err)
+		}
+		store, err := NewFileStore(config.Path, key)
+		if err != nil {
+			return nil, fmt.Errorf("credential_store.encryption_key: %w", err)
+		}
+		return store, nil
+	case TypeCredHub:
+		store, err := NewCredHubStore(config)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unknown credential_store.type %q: must be one of %q or %q", config.Type, TypeFile, TypeCredHub)
+	}
+}
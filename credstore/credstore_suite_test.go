@@ -0,0 +1,13 @@
+package credstore_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCredstore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Credstore Suite")
+}
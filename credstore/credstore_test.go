@@ -0,0 +1,44 @@
+package credstore_test
+
+import (
+	"encoding/base64"
+	"path/filepath"
+
+	"github.com/alphagov/paas-s3-broker/credstore"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credstore", func() {
+	Describe("New", func() {
+		It("builds a file store from the configuration", func() {
+			fileStore, err := credstore.New(credstore.Config{
+				Type:          credstore.TypeFile,
+				Path:          filepath.Join(GinkgoT().TempDir(), "credentials.json"),
+				EncryptionKey: base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fileStore).To(BeAssignableToTypeOf(&credstore.FileStore{}))
+		})
+
+		It("builds no store if none is configured", func() {
+			noStore, err := credstore.New(credstore.Config{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(noStore).To(BeNil())
+		})
+
+		It("refuses unknown types", func() {
+			_, err := credstore.New(credstore.Config{Type: "vault"})
+			Expect(err).To(MatchError(ContainSubstring(`unknown credential_store.type "vault"`)))
+		})
+	})
+
+	Describe("NewConfig", func() {
+		It("reads the credential_store section of the provider configuration", func() {
+			config, err := credstore.NewConfig([]byte(`{"aws_region": "eu-west-2", "credential_store": {"type": "file", "path": "/var/vcap/store/credentials.json"}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Type).To(Equal(credstore.TypeFile))
+			Expect(config.Path).To(Equal("/var/vcap/store/credentials.json"))
+		})
+	})
+})
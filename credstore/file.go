@@ -0,0 +1,180 @@
+package credstore
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const fileStoreKeyLength = 32
+
+// FileStore keeps credentials in a local file, each encrypted with
+// AES-256-GCM. The file is rewritten on every change, which is fine for the
+// number of bindings one broker has, but it cannot be shared between broker
+// instances. Changes are made holding a lock on `<path>.lock`, so that the
+// utilities in cmd can use the file while the broker is running.
+type FileStore struct {
+	path string
+	aead cipher.AEAD
+
+	mu sync.Mutex
+}
+
+var _ CredentialStore = &FileStore{}
+
+// NewFileStore returns a store kept in the file at path, which is created
+// when the first credentials are put. key must be 32 bytes long.
+func NewFileStore(path string, key []byte) (*FileStore, error) {
+	if len(key) != fileStoreKeyLength {
+		return nil, fmt.Errorf("the encryption key must be %d bytes long, but is %d", fileStoreKeyLength, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStore{path: path, aead: aead}, nil
+}
+
+func (f *FileStore) Put(ctx context.Context, bindingID string, credentials json.RawMessage) error {
+	unlock, err := f.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	entries, err := f.read()
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, f.aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return err
+	}
+	// The binding ID is authenticated along with the credentials, so that
+	// one binding's entry cannot be swapped for another's.
+	entries[bindingID] = f.aead.Seal(nonce, nonce, credentials, []byte(bindingID))
+	return f.write(entries)
+}
+
+func (f *FileStore) Get(ctx context.Context, bindingID string) (json.RawMessage, error) {
+	unlock, err := f.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	entries, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	sealed, ok := entries[bindingID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	nonceSize := f.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, fmt.Errorf("the credentials for %s are corrupt", bindingID)
+	}
+	credentials, err := f.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte(bindingID))
+	if err != nil {
+		return nil, fmt.Errorf("decrypting the credentials for %s: %w", bindingID, err)
+	}
+	return credentials, nil
+}
+
+func (f *FileStore) Delete(ctx context.Context, bindingID string) error {
+	unlock, err := f.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	entries, err := f.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := entries[bindingID]; !ok {
+		return nil
+	}
+	delete(entries, bindingID)
+	return f.write(entries)
+}
+
+// lock keeps other goroutines and processes from changing the file until
+// unlock is called. The lock is on a file of its own, as write replaces the
+// file the credentials are in.
+func (f *FileStore) lock() (unlock func(), err error) {
+	f.mu.Lock()
+	file, err := os.OpenFile(f.path+".lock", os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		f.mu.Unlock()
+		return nil, err
+	}
+	err = lockFile(file)
+	if err != nil {
+		file.Close()
+		f.mu.Unlock()
+		return nil, fmt.Errorf("locking %s: %w", file.Name(), err)
+	}
+	return func() {
+		unlockFile(file)
+		file.Close()
+		f.mu.Unlock()
+	}, nil
+}
+
+// read returns the encrypted entries, which are base64 encoded in the file
+// by encoding/json.
+func (f *FileStore) read() (map[string][]byte, error) {
+	entries := map[string][]byte{}
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return entries, nil
+		}
+		return nil, err
+	}
+	err = json.Unmarshal(data, &entries)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", f.path, err)
+	}
+	return entries, nil
+}
+
+// write replaces the file in one go, so that a crash part way through
+// cannot leave it half written.
+func (f *FileStore) write(entries map[string][]byte) error {
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path)
+}
@@ -0,0 +1,16 @@
+//go:build !unix
+
+package credstore
+
+import (
+	"errors"
+	"os"
+)
+
+func lockFile(file *os.File) error {
+	return errors.New("the file credential store can only be locked on Unix systems")
+}
+
+func unlockFile(file *os.File) error {
+	return nil
+}
@@ -0,0 +1,18 @@
+//go:build unix
+
+package credstore
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile waits for an exclusive lock on file, which is shared with other
+// processes, such as rotate_access_key running alongside the broker.
+func lockFile(file *os.File) error {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_EX)
+}
+
+func unlockFile(file *os.File) error {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+}
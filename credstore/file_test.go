@@ -0,0 +1,144 @@
+package credstore_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/alphagov/paas-s3-broker/credstore"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileStore", func() {
+	var (
+		path  string
+		key   []byte
+		store *credstore.FileStore
+		ctx   context.Context
+	)
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "credentials.json")
+		key = []byte("0123456789abcdef0123456789abcdef")
+		ctx = context.Background()
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		store, err = credstore.NewFileStore(path, key)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("keeps credentials", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{"aws_secret_access_key":"secret-1"}`))).To(Succeed())
+		Expect(store.Put(ctx, "binding-2", json.RawMessage(`{"aws_secret_access_key":"secret-2"}`))).To(Succeed())
+
+		Expect(store.Get(ctx, "binding-1")).To(MatchJSON(`{"aws_secret_access_key":"secret-1"}`))
+		Expect(store.Get(ctx, "binding-2")).To(MatchJSON(`{"aws_secret_access_key":"secret-2"}`))
+	})
+
+	It("replaces credentials", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{"aws_access_key_id":"old"}`))).To(Succeed())
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{"aws_access_key_id":"new"}`))).To(Succeed())
+
+		Expect(store.Get(ctx, "binding-1")).To(MatchJSON(`{"aws_access_key_id":"new"}`))
+	})
+
+	It("deletes credentials", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{}`))).To(Succeed())
+		Expect(store.Delete(ctx, "binding-1")).To(Succeed())
+
+		_, err := store.Get(ctx, "binding-1")
+		Expect(err).To(Equal(credstore.ErrNotFound))
+		Expect(store.Delete(ctx, "binding-1")).To(Succeed())
+	})
+
+	It("reports credentials which were never put as not found", func() {
+		_, err := store.Get(ctx, "binding-1")
+		Expect(err).To(Equal(credstore.ErrNotFound))
+	})
+
+	It("encrypts the credentials in the file, and only lets the owner read it", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{"aws_secret_access_key":"secret-1"}`))).To(Succeed())
+
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring("binding-1"))
+		Expect(string(data)).NotTo(ContainSubstring("secret-1"))
+
+		info, err := os.Stat(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	It("keeps the credentials after a restart", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{"aws_secret_access_key":"secret-1"}`))).To(Succeed())
+
+		restarted, err := credstore.NewFileStore(path, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restarted.Get(ctx, "binding-1")).To(MatchJSON(`{"aws_secret_access_key":"secret-1"}`))
+	})
+
+	It("does not lose changes made through another store on the same file", func() {
+		// Each store has its own lock file handle, as a second process
+		// such as rotate_access_key would.
+		other, err := credstore.NewFileStore(path, key)
+		Expect(err).NotTo(HaveOccurred())
+
+		var wg sync.WaitGroup
+		for i, s := range []*credstore.FileStore{store, other} {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				for j := 0; j < 20; j++ {
+					Expect(s.Put(ctx, fmt.Sprintf("binding-%d-%d", i, j), json.RawMessage(`{}`))).To(Succeed())
+				}
+			}()
+		}
+		wg.Wait()
+
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 20; j++ {
+				Expect(store.Get(ctx, fmt.Sprintf("binding-%d-%d", i, j))).To(MatchJSON(`{}`))
+			}
+		}
+	})
+
+	It("cannot decrypt the credentials with another key", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{}`))).To(Succeed())
+
+		otherStore, err := credstore.NewFileStore(path, []byte("fedcba9876543210fedcba9876543210"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = otherStore.Get(ctx, "binding-1")
+		Expect(err).To(MatchError(ContainSubstring("decrypting the credentials for binding-1")))
+	})
+
+	It("does not accept one binding's credentials in place of another's", func() {
+		Expect(store.Put(ctx, "binding-1", json.RawMessage(`{}`))).To(Succeed())
+		Expect(store.Put(ctx, "binding-2", json.RawMessage(`{}`))).To(Succeed())
+
+		entries := map[string]string{}
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(json.Unmarshal(data, &entries)).To(Succeed())
+		entries["binding-2"] = entries["binding-1"]
+		data, err = json.Marshal(entries)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.WriteFile(path, data, 0600)).To(Succeed())
+
+		_, err = store.Get(ctx, "binding-2")
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("when the key is the wrong length", func() {
+		It("refuses to start", func() {
+			_, err := credstore.NewFileStore(path, []byte("too-short"))
+			Expect(err).To(MatchError(ContainSubstring("must be 32 bytes long")))
+		})
+	})
+})
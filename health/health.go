@@ -0,0 +1,89 @@
+// Package health serves the broker's liveness and readiness endpoints.
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+const (
+	DefaultCacheFor     = 30 * time.Second
+	DefaultCheckTimeout = 10 * time.Second
+)
+
+// LivenessHandler reports that the process is up and serving requests.
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte("ok\n"))
+	})
+}
+
+// Readiness runs a check, such as S3Client.CheckReadiness, when asked
+// whether the broker is ready, and remembers the result for a while so that
+// frequent health checks do not turn into a stream of AWS calls.
+type Readiness struct {
+	check        func(ctx context.Context) error
+	cacheFor     time.Duration
+	checkTimeout time.Duration
+	logger       lager.Logger
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	lastErr   error
+}
+
+func NewReadiness(check func(ctx context.Context) error, cacheFor, checkTimeout time.Duration, logger lager.Logger) *Readiness {
+	return &Readiness{
+		check:        check,
+		cacheFor:     cacheFor,
+		checkTimeout: checkTimeout,
+		logger:       logger.Session("readiness"),
+	}
+}
+
+// Check returns the result of the last check if it is recent enough, and
+// otherwise checks again. Callers arriving while a check is running wait for
+// it rather than starting their own.
+func (r *Readiness) Check(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.checkedAt.IsZero() && time.Now().Sub(r.checkedAt) < r.cacheFor {
+		return r.lastErr
+	}
+
+	// The check outlives a health checker which gives up on the request, so
+	// that waiting callers get a real result.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.checkTimeout)
+	defer cancel()
+	err := r.check(ctx)
+	if err != nil {
+		r.logger.Error("check", err)
+	} else if r.lastErr != nil || r.checkedAt.IsZero() {
+		r.logger.Info("ready")
+	}
+	r.checkedAt = time.Now()
+	r.lastErr = err
+	return err
+}
+
+// Handler responds 200 if the broker is ready, and 503 if it is not. The
+// endpoint needs no auth, so the problems found, which name AWS resources,
+// are only logged.
+func (r *Readiness) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		err := r.Check(req.Context())
+		if err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("not ready\n"))
+			return
+		}
+		w.Write([]byte("ok\n"))
+	})
+}
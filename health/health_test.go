@@ -0,0 +1,131 @@
+package health_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/health"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Health", func() {
+	get := func(handler http.Handler, path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder
+	}
+
+	It("is live", func() {
+		recorder := get(health.LivenessHandler(), "/healthz")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(Equal("ok\n"))
+	})
+
+	Describe("Readiness", func() {
+		var (
+			mu       sync.Mutex
+			checks   int
+			checkErr error
+			cacheFor time.Duration
+		)
+
+		check := func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			checks++
+			return checkErr
+		}
+
+		checkCount := func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			return checks
+		}
+
+		var logs *bytes.Buffer
+
+		newReadiness := func() *health.Readiness {
+			logger := lager.NewLogger("health-test")
+			logger.RegisterSink(lager.NewWriterSink(logs, lager.INFO))
+			return health.NewReadiness(check, cacheFor, time.Second, logger)
+		}
+
+		BeforeEach(func() {
+			checks = 0
+			checkErr = nil
+			cacheFor = time.Hour
+			logs = &bytes.Buffer{}
+		})
+
+		It("is ready when the check passes", func() {
+			recorder := get(newReadiness().Handler(), "/readyz")
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(Equal("ok\n"))
+		})
+
+		It("is not ready when the check fails, and logs why without saying", func() {
+			checkErr = errors.New("listing S3 buckets: AccessDenied")
+
+			recorder := get(newReadiness().Handler(), "/readyz")
+			Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+			Expect(recorder.Body.String()).To(Equal("not ready\n"))
+			Expect(logs.String()).To(ContainSubstring("listing S3 buckets: AccessDenied"))
+		})
+
+		It("remembers the result of a recent check", func() {
+			readiness := newReadiness()
+			Expect(readiness.Check(context.Background())).To(Succeed())
+
+			checkErr = errors.New("failed")
+			Expect(readiness.Check(context.Background())).To(Succeed())
+			Expect(checkCount()).To(Equal(1))
+		})
+
+		It("checks only once for callers arriving together", func() {
+			readiness := newReadiness()
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					Expect(readiness.Check(context.Background())).To(Succeed())
+				}()
+			}
+			wg.Wait()
+			Expect(checkCount()).To(Equal(1))
+		})
+
+		Context("when the last result is too old", func() {
+			BeforeEach(func() {
+				cacheFor = 0
+			})
+
+			It("checks again", func() {
+				readiness := newReadiness()
+				Expect(readiness.Check(context.Background())).To(Succeed())
+
+				checkErr = errors.New("failed")
+				Expect(readiness.Check(context.Background())).To(MatchError("failed"))
+				Expect(checkCount()).To(Equal(2))
+			})
+		})
+
+		It("checks even if the caller has given up", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			readiness := health.NewReadiness(func(ctx context.Context) error {
+				return ctx.Err()
+			}, cacheFor, time.Second, lager.NewLogger("health-test"))
+			Expect(readiness.Check(ctx)).To(Succeed())
+		})
+	})
+})
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"context"
 	"crypto/tls"
 	"encoding/json"
 	"flag"
@@ -13,13 +12,16 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/credstore"
+	"github.com/alphagov/paas-s3-broker/health"
+	"github.com/alphagov/paas-s3-broker/metrics"
 	"github.com/alphagov/paas-s3-broker/provider"
 	"github.com/alphagov/paas-s3-broker/s3"
 	"github.com/alphagov/paas-service-broker-base/broker"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	aws_s3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 var configFilePath string
@@ -48,24 +50,56 @@ func main() {
 	if err != nil {
 		log.Fatalf("Error parsing configuration: %v\n", err)
 	}
+	err = s3ClientConfig.Validate()
+	if err != nil {
+		log.Fatalf("Invalid configuration:\n%v\n", err)
+	}
+
+	storeConfig, err := credstore.NewConfig(config.Provider)
+	if err != nil {
+		log.Fatalf("Error parsing configuration: %v\n", err)
+	}
+	store, err := credstore.New(storeConfig)
+	if err != nil {
+		log.Fatalf("Error creating credential store: %v\n", err)
+	}
 
 	logger := lager.NewLogger("s3-service-broker")
 	logger.RegisterSink(lager.NewWriterSink(os.Stdout, config.API.LagerLogLevel))
 
-	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(s3ClientConfig.AWSRegion)}))
-	s3Client := s3.NewS3Client(s3ClientConfig, aws_s3.New(sess), iam.New(sess), logger, context.Background())
+	sess, err := s3.NewSession(s3ClientConfig)
+	if err != nil {
+		log.Fatalf("Error creating AWS session: %v\n", err)
+	}
+	metrics.InstrumentAWSHandlers(&sess.Handlers)
+	s3Client := s3.NewS3Client(s3ClientConfig, aws_s3.New(sess, s3ClientConfig.S3ServiceConfig()), iam.New(sess, s3ClientConfig.IAMServiceConfig()), kms.New(sess), logger)
 
 	s3Provider := provider.NewS3Provider(s3Client)
 	if err != nil {
 		log.Fatalf("Error creating S3 Provider: %v\n", err)
 	}
+	if store != nil {
+		s3Provider.SetCredentialStore(store)
+	}
 
 	serviceBroker, err := broker.New(config, s3Provider, logger)
 	if err != nil {
 		log.Fatalf("Error creating service broker: %s", err)
 	}
+	if config.API.Locket != nil {
+		// Without Locket the broker's lock is a single in-process mutex,
+		// already held while bind and unbind run, so keep our own.
+		s3Client.SetBucketLocker(s3.NewLocketBucketLocker(serviceBroker.LocketClient, logger))
+	}
+
+	brokerAPI := broker.NewAPI(provider.NewBroker(serviceBroker, s3Provider, config, logger), logger, config)
 
-	brokerAPI := broker.NewAPI(serviceBroker, logger, config)
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/healthz", health.LivenessHandler())
+	readiness := health.NewReadiness(s3Client.CheckReadiness, health.DefaultCacheFor, health.DefaultCheckTimeout, logger)
+	mux.Handle("/readyz", readiness.Handler())
+	mux.Handle("/", brokerAPI)
 
 	listenAddress := fmt.Sprintf("%s:%s", config.API.Host, config.API.Port)
 	listener, err := net.Listen("tcp", listenAddress)
@@ -82,5 +116,5 @@ func main() {
 	} else {
 		fmt.Printf("S3 Service Broker started http://%s...\n", listenAddress)
 	}
-	http.Serve(listener, brokerAPI)
+	http.Serve(listener, mux)
 }
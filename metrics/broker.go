@@ -0,0 +1,93 @@
+// Package metrics defines the broker's Prometheus metrics. They are
+// registered with the Prometheus client's default registry, which main.go
+// serves on /metrics.
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+var (
+	BrokerOperations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "s3_broker_operations_total",
+		Help: "Broker operations which have finished, such as provision and bind, by outcome.",
+	}, []string{"operation", "outcome"})
+	BrokerOperationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "s3_broker_operation_duration_seconds",
+		Help:    "How long broker operations took, including any work done in the background.",
+		Buckets: []float64{0.1, 0.5, 1, 2.5, 5, 10, 20, 30, 60, 120, 300, 600, 1800, 3600},
+	}, []string{"operation"})
+	BrokerOperationsInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "s3_broker_operations_in_flight",
+		Help: "Broker operations which have started but not finished.",
+	}, []string{"operation"})
+
+	AWSRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "s3_broker_aws_requests_total",
+		Help: "Requests made to AWS APIs. Each retry by the broker is a new request.",
+	}, []string{"service", "operation"})
+	AWSRequestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "s3_broker_aws_request_errors_total",
+		Help: "Requests to AWS APIs which failed, by error code.",
+	}, []string{"service", "operation", "code"})
+	AWSRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "s3_broker_aws_retries_total",
+		Help: "AWS calls retried by the broker, by the code of the error which was retried.",
+	}, []string{"code"})
+)
+
+// StartOperation counts a broker operation as in flight. Call the function
+// it returns with the operation's error when it has finished.
+func StartOperation(operation string) func(err error) {
+	startedAt := time.Now()
+	BrokerOperationsInFlight.WithLabelValues(operation).Inc()
+	return func(err error) {
+		BrokerOperationsInFlight.WithLabelValues(operation).Dec()
+		BrokerOperationDuration.WithLabelValues(operation).Observe(time.Since(startedAt).Seconds())
+		outcome := OutcomeSuccess
+		if err != nil {
+			outcome = OutcomeFailure
+		}
+		BrokerOperations.WithLabelValues(operation, outcome).Inc()
+	}
+}
+
+// ErrorCode is the AWS error code of err, or "unknown" if it is not an AWS
+// error.
+func ErrorCode(err error) string {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code()
+	}
+	return "unknown"
+}
+
+// InstrumentAWSHandlers counts every request made with the handlers, such as
+// those of a session, and its error code if it failed.
+func InstrumentAWSHandlers(handlers *request.Handlers) {
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "paas-s3-broker.metrics",
+		Fn: func(r *request.Request) {
+			service := r.ClientInfo.ServiceName
+			operation := ""
+			if r.Operation != nil {
+				operation = r.Operation.Name
+			}
+			AWSRequests.WithLabelValues(service, operation).Inc()
+			if r.Error != nil {
+				AWSRequestErrors.WithLabelValues(service, operation, ErrorCode(r.Error)).Inc()
+			}
+		},
+	})
+}
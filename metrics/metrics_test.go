@@ -0,0 +1,54 @@
+package metrics_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/alphagov/paas-s3-broker/metrics"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var _ = Describe("the broker's metrics", func() {
+	render := func() string {
+		recorder := httptest.NewRecorder()
+		promhttp.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		return recorder.Body.String()
+	}
+
+	It("records the outcome of operations, and whether they are in flight", func() {
+		done := metrics.StartOperation("test-operation")
+		Expect(render()).To(ContainSubstring(`s3_broker_operations_in_flight{operation="test-operation"} 1`))
+
+		done(errors.New("failed"))
+		output := render()
+		Expect(output).To(ContainSubstring(`s3_broker_operations_in_flight{operation="test-operation"} 0`))
+		Expect(output).To(ContainSubstring(`s3_broker_operations_total{operation="test-operation",outcome="failure"} 1`))
+		Expect(output).To(ContainSubstring(`s3_broker_operation_duration_seconds_count{operation="test-operation"} 1`))
+	})
+
+	It("counts AWS requests and their error codes", func() {
+		handlers := request.Handlers{}
+		metrics.InstrumentAWSHandlers(&handlers)
+
+		handlers.Complete.Run(&request.Request{
+			ClientInfo: metadata.ClientInfo{ServiceName: "test-service"},
+			Operation:  &request.Operation{Name: "TestOperation"},
+		})
+		handlers.Complete.Run(&request.Request{
+			ClientInfo: metadata.ClientInfo{ServiceName: "test-service"},
+			Operation:  &request.Operation{Name: "TestOperation"},
+			Error:      awserr.New("Throttling", "Rate exceeded", nil),
+		})
+
+		output := render()
+		Expect(output).To(ContainSubstring(`s3_broker_aws_request_errors_total{code="Throttling",operation="TestOperation",service="test-service"} 1`))
+		Expect(output).To(ContainSubstring(`s3_broker_aws_requests_total{operation="TestOperation",service="test-service"} 2`))
+	})
+})
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-service-broker-base/broker"
+	"github.com/pivotal-cf/brokerapi/v10/domain"
+)
+
+// InstanceFetcher is implemented by providers which can report the current
+// configuration of a service instance.
+type InstanceFetcher interface {
+	GetInstance(ctx context.Context, instanceID string, details domain.FetchInstanceDetails) (domain.GetInstanceDetailsSpec, error)
+}
+
+// Broker is the base broker with fetching instances added, which the base
+// broker does not support. Services are marked `instances_retrievable` so
+// that Cloud Controller asks.
+type Broker struct {
+	*broker.Broker
+	fetcher        InstanceFetcher
+	contextTimeout time.Duration
+	logger         lager.Logger
+}
+
+var _ domain.ServiceBroker = &Broker{}
+
+func NewBroker(base *broker.Broker, fetcher InstanceFetcher, config broker.Config, logger lager.Logger) *Broker {
+	return &Broker{
+		Broker:         base,
+		fetcher:        fetcher,
+		contextTimeout: config.API.ContextTimeout(),
+		logger:         logger,
+	}
+}
+
+func (b *Broker) Services(ctx context.Context) ([]domain.Service, error) {
+	services, err := b.Broker.Services(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range services {
+		services[i].InstancesRetrievable = true
+	}
+	return services, nil
+}
+
+func (b *Broker) GetInstance(ctx context.Context, instanceID string, details domain.FetchInstanceDetails) (domain.GetInstanceDetailsSpec, error) {
+	b.logger.Debug("get-instance-start", lager.Data{
+		"instance-id": instanceID,
+	})
+
+	providerCtx, cancelFunc := context.WithTimeout(ctx, b.contextTimeout)
+	defer cancelFunc()
+
+	spec, err := b.fetcher.GetInstance(providerCtx, instanceID, details)
+	if err != nil {
+		return domain.GetInstanceDetailsSpec{}, err
+	}
+
+	b.logger.Debug("get-instance-success", lager.Data{
+		"instance-id": instanceID,
+	})
+	return spec, nil
+}
@@ -0,0 +1,65 @@
+package provider_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/provider"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-service-broker-base/broker"
+	"github.com/pivotal-cf/brokerapi/v10/domain"
+	"github.com/pivotal-cf/brokerapi/v10/domain/apiresponses"
+)
+
+var _ = Describe("Broker", func() {
+	var (
+		fakeS3Client  *fakeClient.FakeClient
+		serviceBroker *provider.Broker
+	)
+
+	BeforeEach(func() {
+		fakeS3Client = &fakeClient.FakeClient{}
+		s3Provider := provider.NewS3Provider(fakeS3Client)
+		config := broker.Config{
+			API: broker.API{ContextTimeoutSeconds: 5},
+			Catalog: broker.Catalog{
+				Catalog: apiresponses.CatalogResponse{
+					Services: []domain.Service{{ID: "service-id", Name: "aws-s3-bucket"}},
+				},
+			},
+		}
+		logger := lager.NewLogger("s3-service-broker-test")
+		base, err := broker.New(config, s3Provider, logger)
+		Expect(err).NotTo(HaveOccurred())
+		serviceBroker = provider.NewBroker(base, s3Provider, config, logger)
+	})
+
+	It("marks services as instances_retrievable and bindings_retrievable", func() {
+		services, err := serviceBroker.Services(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services).To(HaveLen(1))
+		Expect(services[0].ID).To(Equal("service-id"))
+		Expect(services[0].InstancesRetrievable).To(BeTrue())
+		Expect(services[0].BindingsRetrievable).To(BeTrue())
+	})
+
+	It("fetches instances from the provider, with the configured timeout", func() {
+		fakeS3Client.GetBucketDetailsReturns(s3.BucketDetails{AWSRegion: "eu-west-2"}, nil)
+
+		spec, err := serviceBroker.GetInstance(context.Background(), "instance-id", domain.FetchInstanceDetails{ServiceID: "service-id"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.ServiceID).To(Equal("service-id"))
+		Expect(spec.Parameters).To(Equal(s3.BucketDetails{AWSRegion: "eu-west-2"}))
+
+		ctx, instanceID := fakeS3Client.GetBucketDetailsArgsForCall(0)
+		Expect(instanceID).To(Equal("instance-id"))
+		deadline, ok := ctx.Deadline()
+		Expect(ok).To(BeTrue())
+		Expect(deadline).To(BeTemporally("~", time.Now().Add(5*time.Second), time.Second))
+	})
+})
@@ -0,0 +1,261 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/alphagov/paas-s3-broker/credstore"
+	"github.com/alphagov/paas-s3-broker/provider"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	provideriface "github.com/alphagov/paas-service-broker-base/provider"
+	"github.com/pivotal-cf/brokerapi/v10/domain"
+)
+
+// memoryStore is a credstore.CredentialStore which keeps credentials in a map.
+type memoryStore struct {
+	credentials map[string]json.RawMessage
+	err         error
+}
+
+func (m *memoryStore) Put(ctx context.Context, bindingID string, credentials json.RawMessage) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.credentials[bindingID] = credentials
+	return nil
+}
+
+func (m *memoryStore) Get(ctx context.Context, bindingID string) (json.RawMessage, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	credentials, ok := m.credentials[bindingID]
+	if !ok {
+		return nil, credstore.ErrNotFound
+	}
+	return credentials, nil
+}
+
+func (m *memoryStore) Delete(ctx context.Context, bindingID string) error {
+	if m.err != nil {
+		return m.err
+	}
+	delete(m.credentials, bindingID)
+	return nil
+}
+
+// memoryReferenceStore is a memoryStore which apps can read from.
+type memoryReferenceStore struct {
+	memoryStore
+	readers  map[string]string
+	allowErr error
+}
+
+func (m *memoryReferenceStore) Reference(bindingID string) interface{} {
+	return map[string]string{"ref": bindingID}
+}
+
+func (m *memoryReferenceStore) AllowRead(ctx context.Context, bindingID, appGUID string) error {
+	if m.allowErr != nil {
+		return m.allowErr
+	}
+	m.readers[bindingID] = appGUID
+	return nil
+}
+
+func (m *memoryReferenceStore) Readable(ctx context.Context, bindingID string) (bool, error) {
+	_, ok := m.readers[bindingID]
+	return ok, nil
+}
+
+var _ = Describe("Provider with a credential store", func() {
+	var (
+		fakeS3Client      *fakeClient.FakeClient
+		s3Provider        *provider.S3Provider
+		bucketCredentials s3.BucketCredentials
+		bindData          provideriface.BindData
+		ctx               context.Context
+	)
+
+	BeforeEach(func() {
+		fakeS3Client = &fakeClient.FakeClient{}
+		s3Provider = provider.NewS3Provider(fakeS3Client)
+		bucketCredentials = s3.BucketCredentials{
+			BucketName:         "bucket-name",
+			AWSAccessKeyID:     "access-key-id",
+			AWSSecretAccessKey: "secret-access-key",
+			AWSRegion:          "eu-west-2",
+		}
+		fakeS3Client.AddUserToBucketReturns(bucketCredentials, nil)
+		bindData = provideriface.BindData{
+			InstanceID: "instance-id",
+			BindingID:  "binding-id",
+			Details:    domain.BindDetails{AppGUID: "app-guid"},
+		}
+		ctx = context.Background()
+	})
+
+	Context("which apps cannot read from", func() {
+		var store *memoryStore
+
+		BeforeEach(func() {
+			store = &memoryStore{credentials: map[string]json.RawMessage{}}
+			s3Provider.SetCredentialStore(store)
+		})
+
+		It("keeps the credentials and still returns them from Bind", func() {
+			binding, err := s3Provider.Bind(ctx, bindData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(binding.Credentials).To(Equal(bucketCredentials))
+
+			credentialsJSON, _ := json.Marshal(bucketCredentials)
+			Expect(store.credentials).To(HaveKeyWithValue("binding-id", MatchJSON(credentialsJSON)))
+		})
+
+		It("fails the bind if the credentials cannot be kept", func() {
+			store.err = errors.New("disk full")
+
+			_, err := s3Provider.Bind(ctx, bindData)
+			Expect(err).To(MatchError(ContainSubstring("disk full")))
+
+			By("deleting the user it made")
+			Expect(fakeS3Client.RemoveUserFromBucketAndDeleteUserCallCount()).To(Equal(1))
+			_, bindingID, instanceID := fakeS3Client.RemoveUserFromBucketAndDeleteUserArgsForCall(0)
+			Expect(bindingID).To(Equal("binding-id"))
+			Expect(instanceID).To(Equal("instance-id"))
+		})
+
+		It("reports it if the user of a failed bind cannot be deleted either", func() {
+			store.err = errors.New("disk full")
+			fakeS3Client.RemoveUserFromBucketAndDeleteUserReturns(errors.New("error-removing-user"))
+
+			_, err := s3Provider.Bind(ctx, bindData)
+			Expect(err).To(MatchError(ContainSubstring("disk full")))
+			Expect(err).To(MatchError(ContainSubstring("removing the binding's user: error-removing-user")))
+		})
+
+		It("only deletes the new access key if a rotation cannot be kept", func() {
+			bindData.Details.RawParameters = json.RawMessage(`{"rotate_access_key": true}`)
+			store.err = errors.New("disk full")
+
+			_, err := s3Provider.Bind(ctx, bindData)
+			Expect(err).To(MatchError(ContainSubstring("disk full")))
+			Expect(fakeS3Client.RemoveUserFromBucketAndDeleteUserCallCount()).To(Equal(0))
+			Expect(fakeS3Client.UndoAccessKeyRotationCallCount()).To(Equal(1))
+			_, bindingID, accessKeyID := fakeS3Client.UndoAccessKeyRotationArgsForCall(0)
+			Expect(bindingID).To(Equal("binding-id"))
+			Expect(accessKeyID).To(Equal("access-key-id"))
+		})
+
+		It("returns the kept credentials, secret included, from GetBinding", func() {
+			_, err := s3Provider.Bind(ctx, bindData)
+			Expect(err).NotTo(HaveOccurred())
+
+			spec, err := s3Provider.GetBinding(ctx, provideriface.GetBindData{InstanceID: "instance-id", BindingID: "binding-id"})
+			Expect(err).NotTo(HaveOccurred())
+			credentialsJSON, _ := json.Marshal(bucketCredentials)
+			Expect(spec.Credentials).To(MatchJSON(credentialsJSON))
+			Expect(fakeS3Client.GetBindingCredentialsCallCount()).To(Equal(0))
+		})
+
+		It("works out the credentials of bindings made before it was set up", func() {
+			fakeS3Client.GetBindingCredentialsReturns(bucketCredentials, nil)
+
+			spec, err := s3Provider.GetBinding(ctx, provideriface.GetBindData{InstanceID: "instance-id", BindingID: "binding-id"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.Credentials).To(Equal(bucketCredentials))
+		})
+
+		It("deletes the credentials on unbind", func() {
+			_, err := s3Provider.Bind(ctx, bindData)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = s3Provider.Unbind(ctx, provideriface.UnbindData{InstanceID: "instance-id", BindingID: "binding-id"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(store.credentials).To(BeEmpty())
+		})
+
+		It("keeps the credentials if the user could not be deleted", func() {
+			_, err := s3Provider.Bind(ctx, bindData)
+			Expect(err).NotTo(HaveOccurred())
+			fakeS3Client.RemoveUserFromBucketAndDeleteUserReturns(errors.New("error-removing-user"))
+
+			_, err = s3Provider.Unbind(ctx, provideriface.UnbindData{InstanceID: "instance-id", BindingID: "binding-id"})
+			Expect(err).To(HaveOccurred())
+			Expect(store.credentials).To(HaveKey("binding-id"))
+		})
+	})
+
+	Context("which apps can read from", func() {
+		var store *memoryReferenceStore
+
+		BeforeEach(func() {
+			store = &memoryReferenceStore{
+				memoryStore: memoryStore{credentials: map[string]json.RawMessage{}},
+				readers:     map[string]string{},
+			}
+			s3Provider.SetCredentialStore(store)
+		})
+
+		It("gives apps a reference to their credentials", func() {
+			binding, err := s3Provider.Bind(ctx, bindData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(binding.Credentials).To(Equal(map[string]string{"ref": "binding-id"}))
+			Expect(store.credentials).To(HaveKey("binding-id"))
+			Expect(store.readers).To(HaveKeyWithValue("binding-id", "app-guid"))
+
+			spec, err := s3Provider.GetBinding(ctx, provideriface.GetBindData{InstanceID: "instance-id", BindingID: "binding-id"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.Credentials).To(Equal(map[string]string{"ref": "binding-id"}))
+		})
+
+		It("uses the app from the bind resource", func() {
+			bindData.Details = domain.BindDetails{BindResource: &domain.BindResource{AppGuid: "resource-app-guid"}}
+
+			_, err := s3Provider.Bind(ctx, bindData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(store.readers).To(HaveKeyWithValue("binding-id", "resource-app-guid"))
+		})
+
+		It("gives service keys the credentials themselves", func() {
+			bindData.Details = domain.BindDetails{}
+
+			binding, err := s3Provider.Bind(ctx, bindData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(binding.Credentials).To(Equal(bucketCredentials))
+			Expect(store.credentials).To(HaveKey("binding-id"))
+			Expect(store.readers).To(BeEmpty())
+
+			By("giving them the credentials from GetBinding too")
+			spec, err := s3Provider.GetBinding(ctx, provideriface.GetBindData{InstanceID: "instance-id", BindingID: "binding-id"})
+			Expect(err).NotTo(HaveOccurred())
+			credentialsJSON, _ := json.Marshal(bucketCredentials)
+			Expect(spec.Credentials).To(MatchJSON(credentialsJSON))
+		})
+
+		It("undoes the bind if the app cannot be allowed to read its credentials", func() {
+			store.allowErr = errors.New("credhub down")
+
+			_, err := s3Provider.Bind(ctx, bindData)
+			Expect(err).To(MatchError(ContainSubstring("credhub down")))
+			Expect(fakeS3Client.RemoveUserFromBucketAndDeleteUserCallCount()).To(Equal(1))
+			Expect(store.credentials).To(BeEmpty())
+		})
+
+		It("keeps a rotated access key which was stored before the bind failed", func() {
+			bindData.Details.RawParameters = json.RawMessage(`{"rotate_access_key": true}`)
+			store.allowErr = errors.New("credhub down")
+
+			_, err := s3Provider.Bind(ctx, bindData)
+			Expect(err).To(MatchError(ContainSubstring("credhub down")))
+			Expect(fakeS3Client.UndoAccessKeyRotationCallCount()).To(Equal(0))
+			Expect(fakeS3Client.RemoveUserFromBucketAndDeleteUserCallCount()).To(Equal(0))
+			Expect(store.credentials).To(HaveKey("binding-id"))
+		})
+	})
+})
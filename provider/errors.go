@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/alphagov/paas-s3-broker/s3"
+	"github.com/pivotal-cf/brokerapi/v10/domain/apiresponses"
+)
+
+// deprovisionFailure turns errors from the S3 client into responses which
+// tell the developer running `cf delete-service` what they can do about it,
+// rather than an opaque 500.
+func deprovisionFailure(err error) error {
+	var bucketNotEmptyErr *s3.BucketNotEmptyError
+
+	switch {
+	case err == s3.ErrNoSuchResources:
+		return apiresponses.ErrInstanceDoesNotExist
+	case errors.As(err, &bucketNotEmptyErr):
+		return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, "bucket-not-empty")
+	case errors.Is(err, s3.ErrAccessDenied):
+		return apiresponses.NewFailureResponse(
+			fmt.Errorf("the broker was denied access to the bucket, so it cannot be deleted; please contact support (%s)", err),
+			http.StatusForbidden, "access-denied",
+		)
+	case errors.Is(err, s3.ErrThrottled):
+		return apiresponses.NewFailureResponse(
+			fmt.Errorf("AWS is busy, so the bucket could not be deleted; please try again in a few minutes (%s)", err),
+			http.StatusServiceUnavailable, "throttled",
+		)
+	}
+	return err
+}
+
+// errInstanceNotFound is the 404 the Open Service Broker API asks for when
+// fetching an instance which does not exist. brokerapi only has a 410 Gone,
+// which is meant for deprovisioning.
+var errInstanceNotFound = apiresponses.NewFailureResponse(
+	errors.New("instance not found"),
+	http.StatusNotFound, "instance-not-found",
+)
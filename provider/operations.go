@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	ActionProvision   = "provision"
+	ActionDeprovision = "deprovision"
+)
+
+// Operation is what we hand Cloud Controller as `operation` data when
+// returning 202 Accepted. It is passed back to us on every LastOperation
+// poll, possibly to a different broker instance from the one which started
+// the work, so it must carry everything needed to interpret the bucket state.
+type Operation struct {
+	Action    string    `json:"action"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+func (o Operation) String() string {
+	data, err := json.Marshal(o)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
+
+func ParseOperation(operationData string) (Operation, error) {
+	operation := Operation{}
+	err := json.Unmarshal([]byte(operationData), &operation)
+	if err != nil {
+		return Operation{}, fmt.Errorf("invalid operation data %q: %v", operationData, err)
+	}
+	return operation, nil
+}
+
+type operationResult struct {
+	done           bool
+	err            error
+	objectsDeleted int
+}
+
+// operationTracker records the outcome of operations running in the
+// background on this broker instance. It is only an optimisation: the
+// bucket itself is the source of truth when we have no record.
+type operationTracker struct {
+	mu      sync.Mutex
+	results map[string]operationResult
+}
+
+func newOperationTracker() *operationTracker {
+	return &operationTracker{
+		results: map[string]operationResult{},
+	}
+}
+
+func (t *operationTracker) start(instanceID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.results[instanceID] = operationResult{}
+}
+
+func (t *operationTracker) objectsDeleted(instanceID string, objectsDeleted int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	result := t.results[instanceID]
+	result.objectsDeleted = objectsDeleted
+	t.results[instanceID] = result
+}
+
+func (t *operationTracker) finish(instanceID string, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	result := t.results[instanceID]
+	result.done = true
+	result.err = err
+	t.results[instanceID] = result
+}
+
+func (t *operationTracker) get(instanceID string) (operationResult, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	result, ok := t.results[instanceID]
+	return result, ok
+}
+
+func (t *operationTracker) forget(instanceID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.results, instanceID)
+}
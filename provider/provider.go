@@ -2,61 +2,270 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/alphagov/paas-s3-broker/credstore"
+	"github.com/alphagov/paas-s3-broker/metrics"
 	"github.com/alphagov/paas-s3-broker/s3"
 	provideriface "github.com/alphagov/paas-service-broker-base/provider"
 	"github.com/pivotal-cf/brokerapi/v10/domain"
 	"github.com/pivotal-cf/brokerapi/v10/domain/apiresponses"
 )
 
+var (
+	// ProvisionTimeout is how long LastOperation waits for a bucket to be
+	// created and configured before reporting that provisioning has failed.
+	ProvisionTimeout = 10 * time.Minute
+
+	// DeprovisionTimeout is how long LastOperation waits for a
+	// force_delete bucket to be emptied and deleted.
+	DeprovisionTimeout = 6 * time.Hour
+)
+
+// Names of the synchronous operations in metrics. Provision and deprovision
+// use ActionProvision and ActionDeprovision.
+const (
+	operationBind   = "bind"
+	operationUnbind = "unbind"
+	operationUpdate = "update"
+)
+
 type S3Provider struct {
-	client s3.Client
+	client     s3.Client
+	operations *operationTracker
+	store      credstore.CredentialStore
 }
 
 func NewS3Provider(s3Client s3.Client) *S3Provider {
 	return &S3Provider{
-		client: s3Client,
+		client:     s3Client,
+		operations: newOperationTracker(),
 	}
 }
 
+// SetCredentialStore makes the provider keep each binding's credentials in
+// store. If store is a credstore.ReferenceStore, apps are given a reference
+// to their credentials rather than the credentials themselves.
+func (s *S3Provider) SetCredentialStore(store credstore.CredentialStore) {
+	s.store = store
+}
+
 func (s *S3Provider) Provision(ctx context.Context, provisionData provideriface.ProvisionData) (
 	res *domain.ProvisionedServiceSpec, err error) {
 
-	err = s.client.CreateBucket(provisionData)
-	res = &domain.ProvisionedServiceSpec{IsAsync: false, AlreadyExists: false, DashboardURL: "", OperationData: ""}
-	return res, err
+	err = s.client.ValidateProvisionParams(provisionData)
+	if err != nil {
+		return &domain.ProvisionedServiceSpec{}, err
+	}
+
+	operation := Operation{Action: ActionProvision, StartedAt: time.Now().UTC()}
+
+	// Creating and configuring a bucket can take longer than Cloud
+	// Controller is prepared to wait, so it carries on in the background
+	// after the request has returned, and must not be cancelled with it.
+	backgroundCtx := context.WithoutCancel(ctx)
+	s.operations.start(provisionData.InstanceID)
+	done := metrics.StartOperation(ActionProvision)
+	go func() {
+		err := s.client.CreateBucket(backgroundCtx, provisionData)
+		s.operations.finish(provisionData.InstanceID, err)
+		done(err)
+	}()
+
+	res = &domain.ProvisionedServiceSpec{IsAsync: true, AlreadyExists: false, DashboardURL: "", OperationData: operation.String()}
+	return res, nil
 }
 
 func (s *S3Provider) Deprovision(ctx context.Context, deprovisionData provideriface.DeprovisionData) (
 	res *domain.DeprovisionServiceSpec, err error) {
 
-	err = s.client.DeleteBucket(deprovisionData.InstanceID)
+	s.operations.forget(deprovisionData.InstanceID)
+
+	done := metrics.StartOperation(ActionDeprovision)
+
+	// With soft delete turned on, the bucket and everything in it is kept
+	// until the reaper deletes it, so force_delete makes no difference.
+	if s.client.SoftDeleteEnabled() {
+		err = s.client.SoftDeleteBucket(ctx, deprovisionData.InstanceID)
+		done(err)
+		res = &domain.DeprovisionServiceSpec{IsAsync: false, OperationData: ""}
+		if err != nil {
+			return res, deprovisionFailure(err)
+		}
+		return res, nil
+	}
+
+	forceDelete, err := s.client.ForceDeleteEnabled(ctx, deprovisionData.InstanceID)
+	if err != nil {
+		done(err)
+		return &domain.DeprovisionServiceSpec{}, deprovisionFailure(err)
+	}
+	if forceDelete {
+		return s.emptyAndDeleteBucket(ctx, deprovisionData.InstanceID, done), nil
+	}
+
+	err = s.client.DeleteBucket(ctx, deprovisionData.InstanceID)
+	done(err)
 	res = &domain.DeprovisionServiceSpec{IsAsync: false, OperationData: ""}
-	if err == s3.ErrNoSuchResources {
-		return res, apiresponses.ErrInstanceDoesNotExist
+	if err != nil {
+		return res, deprovisionFailure(err)
 	}
-	return res, err
+	return res, nil
+}
+
+// emptyAndDeleteBucket deletes everything in the bucket, then the bucket,
+// in the background. There may be far too many objects to delete before
+// Cloud Controller gives up on the request.
+func (s *S3Provider) emptyAndDeleteBucket(ctx context.Context, instanceID string, done func(err error)) *domain.DeprovisionServiceSpec {
+	operation := Operation{Action: ActionDeprovision, StartedAt: time.Now().UTC()}
+
+	backgroundCtx := context.WithoutCancel(ctx)
+	s.operations.start(instanceID)
+	go func() {
+		err := s.client.EmptyBucket(backgroundCtx, instanceID, func(objectsDeleted int) {
+			s.operations.objectsDeleted(instanceID, objectsDeleted)
+		})
+		if err == nil {
+			err = s.client.DeleteBucket(backgroundCtx, instanceID)
+		}
+		s.operations.finish(instanceID, err)
+		done(err)
+	}()
+
+	return &domain.DeprovisionServiceSpec{IsAsync: true, OperationData: operation.String()}
 }
 
 func (s *S3Provider) Bind(ctx context.Context, bindData provideriface.BindData) (
 	binding *domain.Binding, err error) {
 
-	bucketCredentials, err := s.client.AddUserToBucket(bindData)
+	done := metrics.StartOperation(operationBind)
+	bucketCredentials, err := s.client.AddUserToBucket(ctx, bindData)
+	if err != nil {
+		done(err)
+		return &domain.Binding{}, err
+	}
+
+	credentials, err := s.storeCredentials(ctx, bindData, bucketCredentials)
+	if err != nil {
+		if isAccessKeyRotation(bindData.Details) {
+			err = s.undoRotation(ctx, bindData, bucketCredentials, err)
+		} else {
+			err = s.undoBind(ctx, bindData, err)
+		}
+	}
+	done(err)
 	if err != nil {
 		return &domain.Binding{}, err
 	}
 
 	return &domain.Binding{
 		IsAsync:     false,
-		Credentials: bucketCredentials,
+		Credentials: credentials,
 	}, nil
 }
 
+// storeCredentials keeps the binding's credentials in the credential store,
+// if there is one, and returns what to give Cloud Controller for them.
+func (s *S3Provider) storeCredentials(ctx context.Context, bindData provideriface.BindData, bucketCredentials s3.BucketCredentials) (interface{}, error) {
+	if s.store == nil {
+		return bucketCredentials, nil
+	}
+
+	credentialsJSON, err := json.Marshal(bucketCredentials)
+	if err != nil {
+		return nil, err
+	}
+	err = s.store.Put(ctx, bindData.BindingID, credentialsJSON)
+	if err != nil {
+		return nil, fmt.Errorf("storing credentials: %w", err)
+	}
+
+	// Only apps can read from CredHub, so service keys are always given
+	// the credentials themselves.
+	referenceStore, ok := s.store.(credstore.ReferenceStore)
+	appGUID := bindAppGUID(bindData.Details)
+	if !ok || appGUID == "" {
+		return bucketCredentials, nil
+	}
+	err = referenceStore.AllowRead(ctx, bindData.BindingID, appGUID)
+	if err != nil {
+		return nil, fmt.Errorf("allowing the app to read its credentials: %w", err)
+	}
+	return referenceStore.Reference(bindData.BindingID), nil
+}
+
+// undoBind deletes the user or role, and anything stored, of a bind which
+// failed after they were created, rather than leaving them for an unbind
+// which Cloud Controller may never send.
+func (s *S3Provider) undoBind(ctx context.Context, bindData provideriface.BindData, bindErr error) error {
+	cleanupCtx := context.WithoutCancel(ctx)
+	errs := []error{bindErr}
+	err := s.client.RemoveUserFromBucketAndDeleteUser(cleanupCtx, bindData.BindingID, bindData.InstanceID)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("removing the binding's user: %w", err))
+	}
+	err = s.store.Delete(cleanupCtx, bindData.BindingID)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("deleting stored credentials: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// undoRotation deletes the access key a `rotate_access_key` bind created if
+// it could not be stored, so that the binding keeps its previous key rather
+// than having it expire in favour of one nobody has.
+func (s *S3Provider) undoRotation(ctx context.Context, bindData provideriface.BindData, bucketCredentials s3.BucketCredentials, bindErr error) error {
+	cleanupCtx := context.WithoutCancel(ctx)
+	storedJSON, err := s.store.Get(cleanupCtx, bindData.BindingID)
+	if err == nil {
+		stored := s3.BucketCredentials{}
+		if json.Unmarshal(storedJSON, &stored) == nil && stored.AWSAccessKeyID == bucketCredentials.AWSAccessKeyID {
+			// The new key was stored, so GetBinding can still hand it out.
+			return bindErr
+		}
+	}
+	err = s.client.UndoAccessKeyRotation(cleanupCtx, bindData.BindingID, bucketCredentials.AWSAccessKeyID)
+	if err != nil {
+		return errors.Join(bindErr, fmt.Errorf("deleting the new access key: %w", err))
+	}
+	return bindErr
+}
+
+func isAccessKeyRotation(details domain.BindDetails) bool {
+	bindParams := s3.BindParams{}
+	if details.RawParameters == nil || json.Unmarshal(details.RawParameters, &bindParams) != nil {
+		return false
+	}
+	return bindParams.RotateAccessKey
+}
+
+func bindAppGUID(details domain.BindDetails) string {
+	if details.BindResource != nil && details.BindResource.AppGuid != "" {
+		return details.BindResource.AppGuid
+	}
+	return details.AppGUID
+}
+
 func (s *S3Provider) Unbind(ctx context.Context, unbindData provideriface.UnbindData) (
 	unbinding *domain.UnbindSpec, err error) {
 
-	err = s.client.RemoveUserFromBucketAndDeleteUser(unbindData.BindingID, unbindData.InstanceID)
+	done := metrics.StartOperation(operationUnbind)
+	err = s.client.RemoveUserFromBucketAndDeleteUser(ctx, unbindData.BindingID, unbindData.InstanceID)
+	if (err == nil || err == s3.ErrNoSuchResources) && s.store != nil {
+		storeErr := s.store.Delete(ctx, unbindData.BindingID)
+		if storeErr != nil {
+			err = fmt.Errorf("deleting stored credentials: %w", storeErr)
+		}
+	}
+	if err == s3.ErrNoSuchResources {
+		// Cloud Controller retrying an unbind which worked is not a failure.
+		done(nil)
+	} else {
+		done(err)
+	}
 	if err != nil {
 		if err == s3.ErrNoSuchResources {
 			return &domain.UnbindSpec{}, apiresponses.ErrBindingDoesNotExist
@@ -68,14 +277,182 @@ func (s *S3Provider) Unbind(ctx context.Context, unbindData provideriface.Unbind
 	}, nil
 }
 
-var ErrUpdateNotSupported = errors.New("Updating the S3 bucket is currently not supported")
-
 func (s *S3Provider) Update(ctx context.Context, updateData provideriface.UpdateData) (
 	res *domain.UpdateServiceSpec, err error) {
-		return &domain.UpdateServiceSpec{IsAsync: false,DashboardURL: "", OperationData: ""}, ErrUpdateNotSupported
+
+	done := metrics.StartOperation(operationUpdate)
+	err = s.client.UpdateBucket(ctx, updateData)
+	done(err)
+	res = &domain.UpdateServiceSpec{IsAsync: false, DashboardURL: "", OperationData: ""}
+	return res, err
+}
+
+// GetBinding lets Cloud Controller fetch a binding's credentials again.
+// They come from the credential store if there is one. Otherwise, or for
+// bindings made before there was one, they are worked out from AWS, which
+// cannot give out binding users' secret access keys, so they are left out.
+func (s *S3Provider) GetBinding(ctx context.Context, getBindData provideriface.GetBindData) (
+	*domain.GetBindingSpec, error) {
+
+	if s.store != nil {
+		credentials, err := s.store.Get(ctx, getBindData.BindingID)
+		if err == nil {
+			return s.storedBinding(ctx, getBindData.BindingID, credentials)
+		}
+		if err != credstore.ErrNotFound {
+			return &domain.GetBindingSpec{}, fmt.Errorf("fetching stored credentials: %w", err)
+		}
+	}
+
+	bucketCredentials, err := s.client.GetBindingCredentials(ctx, getBindData.InstanceID, getBindData.BindingID)
+	if err != nil {
+		if err == s3.ErrNoSuchResources {
+			return &domain.GetBindingSpec{}, apiresponses.ErrBindingNotFound
+		}
+		return &domain.GetBindingSpec{}, err
+	}
+	return &domain.GetBindingSpec{
+		Credentials: bucketCredentials,
+	}, nil
+}
+
+// storedBinding gives back what Bind gave for the binding: a reference to
+// its credentials if an app was allowed to read them, or else the
+// credentials themselves.
+func (s *S3Provider) storedBinding(ctx context.Context, bindingID string, credentials json.RawMessage) (*domain.GetBindingSpec, error) {
+	referenceStore, ok := s.store.(credstore.ReferenceStore)
+	if !ok {
+		return &domain.GetBindingSpec{Credentials: credentials}, nil
+	}
+	readable, err := referenceStore.Readable(ctx, bindingID)
+	if err != nil {
+		return &domain.GetBindingSpec{}, fmt.Errorf("fetching stored credentials: %w", err)
+	}
+	if readable {
+		return &domain.GetBindingSpec{Credentials: referenceStore.Reference(bindingID)}, nil
+	}
+	return &domain.GetBindingSpec{Credentials: credentials}, nil
+}
+
+// LastBindingOperation is only here to make bindings retrievable: binding is
+// synchronous, so there is never an operation to poll.
+func (s *S3Provider) LastBindingOperation(ctx context.Context, lastBindingOperationData provideriface.LastBindingOperationData) (
+	*domain.LastOperation, error) {
+
+	return &domain.LastOperation{State: domain.Succeeded, Description: "Last operation polling not required for synchronous operations."}, nil
 }
 
 func (s *S3Provider) LastOperation(ctx context.Context, lastOperationData provideriface.LastOperationData) (
 	state *domain.LastOperation, err error) {
-	return &domain.LastOperation{State: domain.Succeeded, Description: "Last operation polling not required. All operations are synchronous."}, nil
+
+	if lastOperationData.PollDetails.OperationData == "" {
+		return &domain.LastOperation{State: domain.Succeeded, Description: "Last operation polling not required for synchronous operations."}, nil
+	}
+
+	operation, err := ParseOperation(lastOperationData.PollDetails.OperationData)
+	if err != nil {
+		return nil, err
+	}
+
+	switch operation.Action {
+	case ActionProvision:
+		return s.lastProvisionOperation(ctx, lastOperationData.InstanceID, operation)
+	case ActionDeprovision:
+		return s.lastDeprovisionOperation(ctx, lastOperationData.InstanceID, operation)
+	default:
+		return nil, fmt.Errorf("unknown operation %q", operation.Action)
+	}
+}
+
+func (s *S3Provider) lastProvisionOperation(ctx context.Context, instanceID string, operation Operation) (*domain.LastOperation, error) {
+	if result, ok := s.operations.get(instanceID); ok {
+		if !result.done {
+			return &domain.LastOperation{State: domain.InProgress, Description: "Creating bucket"}, nil
+		}
+		// Cloud Controller stops polling once it has been told the
+		// outcome, so there is no reason to hold on to it after this.
+		s.operations.forget(instanceID)
+		if result.err != nil {
+			return &domain.LastOperation{State: domain.Failed, Description: fmt.Sprintf("Creating bucket failed: %s", result.err)}, nil
+		}
+		return &domain.LastOperation{State: domain.Succeeded, Description: "Bucket is ready"}, nil
+	}
+
+	// The bucket is being provisioned by another broker instance, or by
+	// one which has since restarted, so all we have to go on is the bucket.
+	bucketState, err := s.client.GetBucketState(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	timedOut := time.Since(operation.StartedAt) > ProvisionTimeout
+
+	switch bucketState {
+	case s3.BucketStateReady:
+		return &domain.LastOperation{State: domain.Succeeded, Description: "Bucket is ready"}, nil
+	case s3.BucketStateMissing:
+		if timedOut {
+			return &domain.LastOperation{State: domain.Failed, Description: "Creating bucket failed: the bucket was never created"}, nil
+		}
+		return &domain.LastOperation{State: domain.InProgress, Description: "Creating bucket"}, nil
+	default:
+		if timedOut {
+			return &domain.LastOperation{State: domain.Failed, Description: "Creating bucket failed: the bucket was not configured in time"}, nil
+		}
+		return &domain.LastOperation{State: domain.InProgress, Description: "Configuring bucket"}, nil
+	}
+}
+
+func (s *S3Provider) lastDeprovisionOperation(ctx context.Context, instanceID string, operation Operation) (*domain.LastOperation, error) {
+	if result, ok := s.operations.get(instanceID); ok {
+		if !result.done {
+			return &domain.LastOperation{
+				State:       domain.InProgress,
+				Description: fmt.Sprintf("Emptying bucket: %d objects deleted so far", result.objectsDeleted),
+			}, nil
+		}
+		s.operations.forget(instanceID)
+		if result.err != nil {
+			return &domain.LastOperation{
+				State:       domain.Failed,
+				Description: fmt.Sprintf("Deleting bucket failed after deleting %d objects: %s", result.objectsDeleted, result.err),
+			}, nil
+		}
+		return &domain.LastOperation{State: domain.Succeeded, Description: "Bucket deleted"}, nil
+	}
+
+	bucketState, err := s.client.GetBucketState(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if bucketState == s3.BucketStateMissing {
+		return &domain.LastOperation{State: domain.Succeeded, Description: "Bucket deleted"}, nil
+	}
+	if time.Since(operation.StartedAt) > DeprovisionTimeout {
+		return &domain.LastOperation{State: domain.Failed, Description: "Deleting bucket failed: the bucket was not emptied in time"}, nil
+	}
+	return &domain.LastOperation{State: domain.InProgress, Description: "Emptying bucket"}, nil
+}
+
+// GetInstance reports the bucket's configuration as it is in AWS as the
+// instance's parameters, so that `cf service` shows them.
+func (s *S3Provider) GetInstance(ctx context.Context, instanceID string, details domain.FetchInstanceDetails) (
+	spec domain.GetInstanceDetailsSpec, err error) {
+
+	bucketDetails, err := s.client.GetBucketDetails(ctx, instanceID)
+	if err != nil {
+		if err == s3.ErrNoSuchResources {
+			return domain.GetInstanceDetailsSpec{}, errInstanceNotFound
+		}
+		return domain.GetInstanceDetailsSpec{}, err
+	}
+
+	planID := bucketDetails.Tags["plan_guid"]
+	if planID == "" {
+		planID = details.PlanID
+	}
+	return domain.GetInstanceDetailsSpec{
+		ServiceID:  details.ServiceID,
+		PlanID:     planID,
+		Parameters: bucketDetails,
+	}, nil
 }
@@ -8,6 +8,9 @@ import (
 
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/alphagov/paas-s3-broker/provider"
 	"github.com/alphagov/paas-s3-broker/s3"
@@ -29,26 +32,34 @@ var _ = Describe("Provider", func() {
 	})
 
 	Describe("Provision", func() {
-		It("passes the correct parameters to the client", func() {
+		It("creates the bucket in the background", func() {
 			provisionData := provideriface.ProvisionData{
 				InstanceID: "09E1993E-62E2-4040-ADF2-4D3EC741EFE6",
 			}
 			fakeS3Client.CreateBucketReturns(nil)
 
-			_, err := s3Provider.Provision(context.Background(), provisionData)
+			res, err := s3Provider.Provision(context.Background(), provisionData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.IsAsync).To(BeTrue())
+
+			operation, err := provider.ParseOperation(res.OperationData)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(fakeS3Client.CreateBucketArgsForCall(0)).To(Equal(provisionData))
+			Expect(operation.Action).To(Equal(provider.ActionProvision))
+			Expect(operation.StartedAt).To(BeTemporally("~", time.Now(), time.Minute))
+
+			Expect(fakeS3Client.ValidateProvisionParamsArgsForCall(0)).To(Equal(provisionData))
+			Eventually(fakeS3Client.CreateBucketCallCount).Should(Equal(1))
+			_, createdData := fakeS3Client.CreateBucketArgsForCall(0)
+			Expect(createdData).To(Equal(provisionData))
 		})
 
-		It("errors if the client errors", func() {
-			provisionData := provideriface.ProvisionData{
-				InstanceID: "09E1993E-62E2-4040-ADF2-4D3EC741EFE6",
-			}
-			errProvisioning := errors.New("error provisioning")
-			fakeS3Client.CreateBucketReturns(errProvisioning)
+		It("errors straight away if the parameters are invalid", func() {
+			errInvalid := errors.New("invalid parameters")
+			fakeS3Client.ValidateProvisionParamsReturns(errInvalid)
 
-			_, err := s3Provider.Provision(context.Background(), provisionData)
-			Expect(err).To(MatchError(errProvisioning))
+			_, err := s3Provider.Provision(context.Background(), provideriface.ProvisionData{})
+			Expect(err).To(MatchError(errInvalid))
+			Consistently(fakeS3Client.CreateBucketCallCount).Should(Equal(0))
 		})
 	})
 
@@ -61,7 +72,8 @@ var _ = Describe("Provider", func() {
 
 			_, err := s3Provider.Deprovision(context.Background(), deprovisionData)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(fakeS3Client.DeleteBucketArgsForCall(0)).To(Equal(deprovisionData.InstanceID))
+			_, deletedInstanceID := fakeS3Client.DeleteBucketArgsForCall(0)
+			Expect(deletedInstanceID).To(Equal(deprovisionData.InstanceID))
 		})
 
 		It("returns a specific error if the bucket does not exist", func() {
@@ -70,6 +82,27 @@ var _ = Describe("Provider", func() {
 			Expect(err).To(MatchError(apiresponses.ErrInstanceDoesNotExist))
 		})
 
+		DescribeTable("explains why the bucket could not be deleted",
+			func(clientErr error, expectedStatus int, expectedMessage string) {
+				fakeS3Client.DeleteBucketReturns(clientErr)
+
+				_, err := s3Provider.Deprovision(context.Background(), provideriface.DeprovisionData{})
+				var failureResponse *apiresponses.FailureResponse
+				Expect(errors.As(err, &failureResponse)).To(BeTrue())
+				Expect(failureResponse.ValidatedStatusCode(nil)).To(Equal(expectedStatus))
+				Expect(err).To(MatchError(ContainSubstring(expectedMessage)))
+			},
+			Entry("not empty",
+				&s3.BucketNotEmptyError{ObjectCount: 12},
+				http.StatusUnprocessableEntity, "bucket still contains 12 objects"),
+			Entry("access denied",
+				fmt.Errorf("%w: Access Denied", s3.ErrAccessDenied),
+				http.StatusForbidden, "please contact support"),
+			Entry("throttled",
+				fmt.Errorf("%w: Please reduce your request rate.", s3.ErrThrottled),
+				http.StatusServiceUnavailable, "please try again in a few minutes"),
+		)
+
 		It("errors if the client errors", func() {
 			deprovisionData := provideriface.DeprovisionData{
 				InstanceID: "09E1993E-62E2-4040-ADF2-4D3EC741EFE6",
@@ -82,6 +115,127 @@ var _ = Describe("Provider", func() {
 		})
 	})
 
+	Describe("Deprovision with soft delete", func() {
+		const instanceID = "09E1993E-62E2-4040-ADF2-4D3EC741EFE6"
+
+		BeforeEach(func() {
+			fakeS3Client.SoftDeleteEnabledReturns(true)
+			fakeS3Client.ForceDeleteEnabledReturns(true, nil)
+		})
+
+		It("keeps the bucket instead of deleting it", func() {
+			res, err := s3Provider.Deprovision(context.Background(), provideriface.DeprovisionData{InstanceID: instanceID})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.IsAsync).To(BeFalse())
+
+			Expect(fakeS3Client.SoftDeleteBucketCallCount()).To(Equal(1))
+			_, softDeletedInstanceID := fakeS3Client.SoftDeleteBucketArgsForCall(0)
+			Expect(softDeletedInstanceID).To(Equal(instanceID))
+			Expect(fakeS3Client.EmptyBucketCallCount()).To(Equal(0))
+			Expect(fakeS3Client.DeleteBucketCallCount()).To(Equal(0))
+		})
+
+		It("returns a specific error if the bucket does not exist", func() {
+			fakeS3Client.SoftDeleteBucketReturns(s3.ErrNoSuchResources)
+			_, err := s3Provider.Deprovision(context.Background(), provideriface.DeprovisionData{InstanceID: instanceID})
+			Expect(err).To(MatchError(apiresponses.ErrInstanceDoesNotExist))
+		})
+	})
+
+	Describe("Deprovision with force_delete", func() {
+		const instanceID = "09E1993E-62E2-4040-ADF2-4D3EC741EFE6"
+
+		lastOperation := func(operationData string) *domain.LastOperation {
+			state, err := s3Provider.LastOperation(context.Background(), provideriface.LastOperationData{
+				InstanceID:  instanceID,
+				PollDetails: domain.PollDetails{OperationData: operationData},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return state
+		}
+
+		BeforeEach(func() {
+			fakeS3Client.ForceDeleteEnabledReturns(true, nil)
+		})
+
+		It("empties and deletes the bucket in the background", func() {
+			res, err := s3Provider.Deprovision(context.Background(), provideriface.DeprovisionData{InstanceID: instanceID})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.IsAsync).To(BeTrue())
+
+			operation, err := provider.ParseOperation(res.OperationData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(operation.Action).To(Equal(provider.ActionDeprovision))
+
+			Eventually(fakeS3Client.DeleteBucketCallCount).Should(Equal(1))
+			_, checkedInstanceID := fakeS3Client.ForceDeleteEnabledArgsForCall(0)
+			Expect(checkedInstanceID).To(Equal(instanceID))
+			Expect(fakeS3Client.EmptyBucketCallCount()).To(Equal(1))
+			_, emptiedInstanceID, _ := fakeS3Client.EmptyBucketArgsForCall(0)
+			Expect(emptiedInstanceID).To(Equal(instanceID))
+			_, deletedInstanceID := fakeS3Client.DeleteBucketArgsForCall(0)
+			Expect(deletedInstanceID).To(Equal(instanceID))
+
+			Eventually(func() domain.LastOperationState {
+				return lastOperation(res.OperationData).State
+			}).Should(Equal(domain.Succeeded))
+		})
+
+		It("reports how many objects have been deleted so far", func() {
+			emptied := make(chan struct{})
+			fakeS3Client.EmptyBucketStub = func(_ context.Context, _ string, progress func(int)) error {
+				progress(1000)
+				progress(2000)
+				<-emptied
+				return nil
+			}
+
+			res, err := s3Provider.Deprovision(context.Background(), provideriface.DeprovisionData{InstanceID: instanceID})
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() string {
+				return lastOperation(res.OperationData).Description
+			}).Should(ContainSubstring("2000 objects deleted"))
+			Expect(lastOperation(res.OperationData).State).To(Equal(domain.InProgress))
+			close(emptied)
+		})
+
+		It("does not delete the bucket if emptying it fails", func() {
+			fakeS3Client.EmptyBucketReturns(errors.New("AccessDenied"))
+
+			res, err := s3Provider.Deprovision(context.Background(), provideriface.DeprovisionData{InstanceID: instanceID})
+			Expect(err).NotTo(HaveOccurred())
+
+			var state *domain.LastOperation
+			Eventually(func() domain.LastOperationState {
+				state = lastOperation(res.OperationData)
+				return state.State
+			}).Should(Equal(domain.Failed))
+			Expect(state.Description).To(ContainSubstring("AccessDenied"))
+			Expect(fakeS3Client.DeleteBucketCallCount()).To(Equal(0))
+		})
+
+		It("returns a specific error if the bucket does not exist", func() {
+			fakeS3Client.ForceDeleteEnabledReturns(false, s3.ErrNoSuchResources)
+			_, err := s3Provider.Deprovision(context.Background(), provideriface.DeprovisionData{})
+			Expect(err).To(MatchError(apiresponses.ErrInstanceDoesNotExist))
+		})
+
+		Context("when another broker is deleting the bucket", func() {
+			operationData := provider.Operation{Action: provider.ActionDeprovision, StartedAt: time.Now()}.String()
+
+			It("is in progress while the bucket exists", func() {
+				fakeS3Client.GetBucketStateReturns(s3.BucketStateReady, nil)
+				Expect(lastOperation(operationData).State).To(Equal(domain.InProgress))
+			})
+
+			It("succeeds once the bucket has gone", func() {
+				fakeS3Client.GetBucketStateReturns(s3.BucketStateMissing, nil)
+				Expect(lastOperation(operationData).State).To(Equal(domain.Succeeded))
+			})
+		})
+	})
+
 	Describe("Bind", func() {
 		It("passes the correct parameters to the client", func() {
 			instanceID := "09E1993E-62E2-4040-ADF2-4D3EC741EFE6"
@@ -105,7 +259,7 @@ var _ = Describe("Provider", func() {
 
 			binding, err := s3Provider.Bind(context.Background(), bindData)
 			Expect(err).NotTo(HaveOccurred())
-			actualBindData := fakeS3Client.AddUserToBucketArgsForCall(0)
+			_, actualBindData := fakeS3Client.AddUserToBucketArgsForCall(0)
 			Expect(actualBindData).To(Equal(bindData))
 
 			Expect(binding.Credentials).To(Equal(returnedBucketCredentials))
@@ -134,7 +288,7 @@ var _ = Describe("Provider", func() {
 
 			_, err := s3Provider.Unbind(context.Background(), unbindData)
 			Expect(err).NotTo(HaveOccurred())
-			actualUsername, actualBucketName := fakeS3Client.RemoveUserFromBucketAndDeleteUserArgsForCall(0)
+			_, actualUsername, actualBucketName := fakeS3Client.RemoveUserFromBucketAndDeleteUserArgsForCall(0)
 			Expect(actualUsername).To(Equal(bindingID))
 			Expect(actualBucketName).To(Equal(instanceID))
 		})
@@ -157,23 +311,235 @@ var _ = Describe("Provider", func() {
 		})
 	})
 
+	Describe("GetBinding", func() {
+		It("returns the binding's credentials", func() {
+			bucketCredentials := s3.BucketCredentials{
+				BucketName:     "bucket-name",
+				AWSAccessKeyID: "access-key-id",
+				AWSRegion:      "eu-west-2",
+			}
+			fakeS3Client.GetBindingCredentialsReturns(bucketCredentials, nil)
+
+			spec, err := s3Provider.GetBinding(context.Background(), provideriface.GetBindData{
+				InstanceID: "instance-id",
+				BindingID:  "binding-id",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.Credentials).To(Equal(bucketCredentials))
+
+			_, instanceID, bindingID := fakeS3Client.GetBindingCredentialsArgsForCall(0)
+			Expect(instanceID).To(Equal("instance-id"))
+			Expect(bindingID).To(Equal("binding-id"))
+		})
+
+		It("returns a 404 if the binding does not exist", func() {
+			fakeS3Client.GetBindingCredentialsReturns(s3.BucketCredentials{}, s3.ErrNoSuchResources)
+
+			_, err := s3Provider.GetBinding(context.Background(), provideriface.GetBindData{})
+			Expect(err).To(Equal(apiresponses.ErrBindingNotFound))
+		})
+
+		It("errors if the client errors", func() {
+			errGetting := errors.New("error getting binding")
+			fakeS3Client.GetBindingCredentialsReturns(s3.BucketCredentials{}, errGetting)
+
+			_, err := s3Provider.GetBinding(context.Background(), provideriface.GetBindData{})
+			Expect(err).To(MatchError(errGetting))
+		})
+	})
+
+	Describe("LastBindingOperation", func() {
+		It("reports success, as binding is synchronous", func() {
+			state, err := s3Provider.LastBindingOperation(context.Background(), provideriface.LastBindingOperationData{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.State).To(Equal(domain.Succeeded))
+		})
+	})
+
 	Describe("Update", func() {
-		It("does not support updating a bucket", func() {
+		It("passes the correct parameters to the client", func() {
 			updateData := provideriface.UpdateData{
 				InstanceID: "09E1993E-62E2-4040-ADF2-4D3EC741EFE6",
+				Details: domain.UpdateDetails{
+					RawParameters: json.RawMessage(`{"public_bucket":true}`),
+				},
 			}
+			fakeS3Client.UpdateBucketReturns(nil)
 
-			_, err := s3Provider.Update(context.Background(), updateData)
-			Expect(err).To(MatchError(provider.ErrUpdateNotSupported))
+			res, err := s3Provider.Update(context.Background(), updateData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.IsAsync).To(BeFalse())
+			_, updatedData := fakeS3Client.UpdateBucketArgsForCall(0)
+			Expect(updatedData).To(Equal(updateData))
+		})
+
+		It("errors if the client errors", func() {
+			errUpdating := errors.New("error updating")
+			fakeS3Client.UpdateBucketReturns(errUpdating)
+
+			_, err := s3Provider.Update(context.Background(), provideriface.UpdateData{})
+			Expect(err).To(MatchError(errUpdating))
 		})
 	})
 
 	Describe("LastOperation", func() {
-		It("returns success unconditionally", func() {
-			state, err := s3Provider.LastOperation(context.Background(), provideriface.LastOperationData{})
+		const instanceID = "09E1993E-62E2-4040-ADF2-4D3EC741EFE6"
+
+		lastOperation := func(operationData string) *domain.LastOperation {
+			state, err := s3Provider.LastOperation(context.Background(), provideriface.LastOperationData{
+				InstanceID:  instanceID,
+				PollDetails: domain.PollDetails{OperationData: operationData},
+			})
 			Expect(err).NotTo(HaveOccurred())
-			Expect(state.Description).To(Equal("Last operation polling not required. All operations are synchronous."))
+			return state
+		}
+
+		It("returns success for synchronous operations", func() {
+			state := lastOperation("")
 			Expect(state.State).To(Equal(domain.Succeeded))
 		})
+
+		It("errors if the operation data is not recognised", func() {
+			_, err := s3Provider.LastOperation(context.Background(), provideriface.LastOperationData{
+				PollDetails: domain.PollDetails{OperationData: `{"action": "dance"}`},
+			})
+			Expect(err).To(MatchError(ContainSubstring(`unknown operation "dance"`)))
+		})
+
+		Context("when this broker is provisioning the bucket", func() {
+			var (
+				operationData string
+				createBucket  chan error
+			)
+
+			BeforeEach(func() {
+				createBucket = make(chan error)
+				fakeS3Client.CreateBucketStub = func(context.Context, provideriface.ProvisionData) error {
+					return <-createBucket
+				}
+
+				res, err := s3Provider.Provision(context.Background(), provideriface.ProvisionData{InstanceID: instanceID})
+				Expect(err).NotTo(HaveOccurred())
+				operationData = res.OperationData
+			})
+
+			It("reports progress until the bucket has been created", func() {
+				Expect(lastOperation(operationData).State).To(Equal(domain.InProgress))
+
+				createBucket <- nil
+				Eventually(func() domain.LastOperationState {
+					return lastOperation(operationData).State
+				}).Should(Equal(domain.Succeeded))
+				Expect(fakeS3Client.GetBucketStateCallCount()).To(Equal(0))
+			})
+
+			It("reports why creating the bucket failed", func() {
+				createBucket <- errors.New("BucketAlreadyExists")
+				var state *domain.LastOperation
+				Eventually(func() domain.LastOperationState {
+					state = lastOperation(operationData)
+					return state.State
+				}).Should(Equal(domain.Failed))
+				Expect(state.Description).To(ContainSubstring("BucketAlreadyExists"))
+			})
+
+			It("forgets the outcome once it has been reported", func() {
+				createBucket <- nil
+				Eventually(func() domain.LastOperationState {
+					return lastOperation(operationData).State
+				}).Should(Equal(domain.Succeeded))
+				Expect(fakeS3Client.GetBucketStateCallCount()).To(Equal(0))
+
+				fakeS3Client.GetBucketStateReturns(s3.BucketStateReady, nil)
+				Expect(lastOperation(operationData).State).To(Equal(domain.Succeeded))
+				Expect(fakeS3Client.GetBucketStateCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when another broker is provisioning the bucket", func() {
+			operationStartedAgo := func(ago time.Duration) string {
+				return provider.Operation{
+					Action:    provider.ActionProvision,
+					StartedAt: time.Now().Add(-ago),
+				}.String()
+			}
+
+			DescribeTable("derives the state from the bucket",
+				func(bucketState s3.BucketState, startedAgo time.Duration, expectedState domain.LastOperationState) {
+					fakeS3Client.GetBucketStateReturns(bucketState, nil)
+
+					state := lastOperation(operationStartedAgo(startedAgo))
+					Expect(state.State).To(Equal(expectedState))
+					_, checkedInstanceID := fakeS3Client.GetBucketStateArgsForCall(0)
+					Expect(checkedInstanceID).To(Equal(instanceID))
+				},
+				Entry("ready", s3.BucketStateReady, time.Minute, domain.Succeeded),
+				Entry("not created yet", s3.BucketStateMissing, time.Minute, domain.InProgress),
+				Entry("never created", s3.BucketStateMissing, time.Hour, domain.Failed),
+				Entry("being configured", s3.BucketStateConfiguring, time.Minute, domain.InProgress),
+				Entry("never finished being configured", s3.BucketStateConfiguring, time.Hour, domain.Failed),
+			)
+
+			It("errors if the bucket state cannot be found", func() {
+				fakeS3Client.GetBucketStateReturns("", errors.New("throttled"))
+
+				_, err := s3Provider.LastOperation(context.Background(), provideriface.LastOperationData{
+					PollDetails: domain.PollDetails{OperationData: operationStartedAgo(time.Minute)},
+				})
+				Expect(err).To(MatchError("throttled"))
+			})
+		})
+	})
+
+	Describe("GetInstance", func() {
+		const instanceID = "09E1993E-62E2-4040-ADF2-4D3EC741EFE6"
+
+		It("reports the bucket's configuration as the instance's parameters", func() {
+			bucketDetails := s3.BucketDetails{
+				BucketName: "paas-s3-broker-" + instanceID,
+				AWSRegion:  "eu-west-2",
+				Encryption: s3.EncryptionAES256,
+				Tags:       map[string]string{"plan_guid": "plan-from-tags"},
+				Bindings:   3,
+			}
+			fakeS3Client.GetBucketDetailsReturns(bucketDetails, nil)
+
+			spec, err := s3Provider.GetInstance(context.Background(), instanceID, domain.FetchInstanceDetails{
+				ServiceID: "service-id",
+				PlanID:    "plan-from-request",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.ServiceID).To(Equal("service-id"))
+			Expect(spec.PlanID).To(Equal("plan-from-tags"))
+			Expect(spec.Parameters).To(Equal(bucketDetails))
+
+			_, fetchedInstanceID := fakeS3Client.GetBucketDetailsArgsForCall(0)
+			Expect(fetchedInstanceID).To(Equal(instanceID))
+		})
+
+		It("falls back to the requested plan if the bucket is not tagged with one", func() {
+			fakeS3Client.GetBucketDetailsReturns(s3.BucketDetails{}, nil)
+
+			spec, err := s3Provider.GetInstance(context.Background(), instanceID, domain.FetchInstanceDetails{PlanID: "plan-from-request"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.PlanID).To(Equal("plan-from-request"))
+		})
+
+		It("returns a 404 if the bucket does not exist", func() {
+			fakeS3Client.GetBucketDetailsReturns(s3.BucketDetails{}, s3.ErrNoSuchResources)
+
+			_, err := s3Provider.GetInstance(context.Background(), instanceID, domain.FetchInstanceDetails{})
+			var failureResponse *apiresponses.FailureResponse
+			Expect(errors.As(err, &failureResponse)).To(BeTrue())
+			Expect(failureResponse.ValidatedStatusCode(nil)).To(Equal(http.StatusNotFound))
+		})
+
+		It("errors if the client errors", func() {
+			errGetting := errors.New("error getting bucket")
+			fakeS3Client.GetBucketDetailsReturns(s3.BucketDetails{}, errGetting)
+
+			_, err := s3Provider.GetInstance(context.Background(), instanceID, domain.FetchInstanceDetails{})
+			Expect(err).To(MatchError(errGetting))
+		})
 	})
 })
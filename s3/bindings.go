@@ -0,0 +1,119 @@
+package s3
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+// GetBindingCredentials works out a binding's credentials again from its IAM
+// user or role and the bucket policy. AWS does not give out secret access
+// keys after they are created, so AWSSecretAccessKey is empty for bindings
+// with their own user. Role bindings use the operator's shared access key,
+// so their credentials are complete. It returns ErrNoSuchResources if the
+// binding does not exist or belongs to another instance.
+func (s *S3Client) GetBindingCredentials(ctx context.Context, instanceID, bindingID string) (BucketCredentials, error) {
+	logger := s.logger.Session("get-binding-credentials", lager.Data{"instance": instanceID, "binding": bindingID})
+	bucketName := s.buildBucketName(instanceID)
+	name := s.buildBindingUsername(bindingID)
+
+	credentials, err := s.getUserBindingCredentials(ctx, logger, instanceID, name)
+	if err == ErrNoSuchResources && s.assumeRoleEnabled() {
+		credentials, err = s.getRoleBindingCredentials(ctx, logger, instanceID, name)
+	}
+	if err != nil {
+		return BucketCredentials{}, err
+	}
+
+	bucketPolicy, err := s.getBucketPolicy(ctx, logger, bucketName)
+	if err != nil {
+		return BucketCredentials{}, err
+	}
+	credentials.Prefix, err = policy.PrefixForUser(bucketPolicy, "/"+name)
+	if err != nil {
+		logger.Error("find-prefix", err)
+		return BucketCredentials{}, err
+	}
+
+	location := s.bucketCredentials(bucketName)
+	credentials.BucketName = location.BucketName
+	credentials.AWSRegion = location.AWSRegion
+	credentials.AWSPartition = location.AWSPartition
+	credentials.Endpoint = location.Endpoint
+	credentials.ForcePathStyle = location.ForcePathStyle
+	return credentials, nil
+}
+
+// getUserBindingCredentials returns the ID of the binding user's current
+// access key: the newest, as the other is the previous key during rotation.
+func (s *S3Client) getUserBindingCredentials(ctx context.Context, logger lager.Logger, instanceID, username string) (BucketCredentials, error) {
+	tags, err := s.listUserTags(ctx, logger, username)
+	if err != nil {
+		return BucketCredentials{}, err
+	}
+	if tagValue(tags, "service_instance_guid") != instanceID {
+		logger.Info("binding-not-for-instance", lager.Data{"user": username})
+		return BucketCredentials{}, ErrNoSuchResources
+	}
+
+	logger.Info("list-access-keys", lager.Data{"user": username})
+	listAccessKeysOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.ListAccessKeysOutput, error) {
+		return s.iamClient.ListAccessKeysWithContext(ctx, &iam.ListAccessKeysInput{
+			UserName: aws.String(username),
+		})
+	})
+	if err != nil {
+		logger.Error("list-access-keys", err)
+		return BucketCredentials{}, err
+	}
+
+	var (
+		accessKeyID string
+		createdAt   time.Time
+	)
+	for _, key := range listAccessKeysOutput.AccessKeyMetadata {
+		if aws.StringValue(key.Status) != iam.StatusTypeActive {
+			continue
+		}
+		if accessKeyID == "" || aws.TimeValue(key.CreateDate).After(createdAt) {
+			accessKeyID = aws.StringValue(key.AccessKeyId)
+			createdAt = aws.TimeValue(key.CreateDate)
+		}
+	}
+	return BucketCredentials{AWSAccessKeyID: accessKeyID}, nil
+}
+
+func (s *S3Client) getRoleBindingCredentials(ctx context.Context, logger lager.Logger, instanceID, roleName string) (BucketCredentials, error) {
+	logger.Info("get-role", lager.Data{"role": roleName})
+	getRoleOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.GetRoleOutput, error) {
+		return s.iamClient.GetRoleWithContext(ctx, &iam.GetRoleInput{
+			RoleName: aws.String(roleName),
+		})
+	})
+	if err != nil {
+		if !isIAMUserNotFound(err) {
+			return BucketCredentials{}, ErrNoSuchResources
+		}
+		logger.Error("get-role", err)
+		return BucketCredentials{}, err
+	}
+	role := getRoleOutput.Role
+	if tagValue(role.Tags, "service_instance_guid") != instanceID {
+		logger.Info("binding-not-for-instance", lager.Data{"role": roleName})
+		return BucketCredentials{}, ErrNoSuchResources
+	}
+
+	externalID, err := policy.ExternalIDFromAssumeRolePolicy(aws.StringValue(role.AssumeRolePolicyDocument))
+	if err != nil {
+		logger.Error("find-external-id", err)
+		return BucketCredentials{}, err
+	}
+	return BucketCredentials{
+		RoleARN:    aws.StringValue(role.Arn),
+		ExternalID: externalID,
+	}, nil
+}
@@ -0,0 +1,140 @@
+package s3_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetBindingCredentials", func() {
+	const (
+		bucketName = "test-bucket-prefix-test-instance-id"
+		userARN    = "arn:aws:iam::123456789012:user/test-iam-path/test-bucket-prefix-test-binding-id"
+		roleARN    = "arn:aws:iam::123456789012:role/test-iam-path/test-bucket-prefix-test-binding-id"
+	)
+
+	var (
+		s3API          *fakeClient.FakeS3API
+		iamAPI         *fakeClient.FakeIAMAPI
+		s3Client       *s3.S3Client
+		s3ClientConfig *s3.Config
+	)
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		s3ClientConfig = &s3.Config{
+			AWSRegion:      "eu-west-2",
+			ResourcePrefix: "test-bucket-prefix-",
+			IAMUserPath:    "/test-iam-path/",
+		}
+
+		iamAPI.ListUserTagsWithContextReturns(&iam.ListUserTagsOutput{
+			Tags: []*iam.Tag{{Key: aws.String("service_instance_guid"), Value: aws.String("test-instance-id")}},
+		}, nil)
+		now := time.Now()
+		iamAPI.ListAccessKeysWithContextReturns(&iam.ListAccessKeysOutput{
+			AccessKeyMetadata: []*iam.AccessKeyMetadata{
+				{AccessKeyId: aws.String("previous-key-id"), Status: aws.String(iam.StatusTypeActive), CreateDate: aws.Time(now.Add(-time.Hour))},
+				{AccessKeyId: aws.String("current-key-id"), Status: aws.String(iam.StatusTypeActive), CreateDate: aws.Time(now)},
+			},
+		}, nil)
+		s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			s3ClientConfig,
+			s3API,
+			iamAPI,
+			&fakeClient.FakeKMSAPI{},
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	It("returns the binding user's current access key, but not its secret", func() {
+		credentials, err := s3Client.GetBindingCredentials(context.Background(), "test-instance-id", "test-binding-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(credentials).To(Equal(s3.BucketCredentials{
+			BucketName:     bucketName,
+			AWSAccessKeyID: "current-key-id",
+			AWSRegion:      "eu-west-2",
+			AWSPartition:   "aws",
+		}))
+
+		Expect(aws.StringValue(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0)).UserName)).To(Equal("test-bucket-prefix-test-binding-id"))
+	})
+
+	It("finds the binding's prefix in the bucket policy", func() {
+		policyDoc, err := policy.BuildPolicy("", policy.BuildPrefixStatements("aws", bucketName, iam.User{Arn: aws.String(userARN)}, policy.ReadWritePermissions{}, "app/")...)
+		Expect(err).NotTo(HaveOccurred())
+		policyJSON, err := json.Marshal(policyDoc)
+		Expect(err).NotTo(HaveOccurred())
+		s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{Policy: aws.String(string(policyJSON))}, nil)
+
+		credentials, err := s3Client.GetBindingCredentials(context.Background(), "test-instance-id", "test-binding-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(credentials.Prefix).To(Equal("app/"))
+	})
+
+	It("returns ErrNoSuchResources if the binding belongs to another instance", func() {
+		_, err := s3Client.GetBindingCredentials(context.Background(), "other-instance-id", "test-binding-id")
+		Expect(err).To(Equal(s3.ErrNoSuchResources))
+	})
+
+	It("returns ErrNoSuchResources if there is no binding user", func() {
+		iamAPI.ListUserTagsWithContextReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+
+		_, err := s3Client.GetBindingCredentials(context.Background(), "test-instance-id", "test-binding-id")
+		Expect(err).To(Equal(s3.ErrNoSuchResources))
+		Expect(iamAPI.GetRoleWithContextCallCount()).To(Equal(0))
+	})
+
+	Context("when role bindings are enabled", func() {
+		BeforeEach(func() {
+			s3ClientConfig.AssumeRolePrincipalARN = "arn:aws:iam::123456789012:user/assumer"
+
+			iamAPI.ListUserTagsWithContextReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+			assumeRolePolicy, err := policy.BuildAssumeRolePolicy(s3ClientConfig.AssumeRolePrincipalARN, "test-external-id")
+			Expect(err).NotTo(HaveOccurred())
+			iamAPI.GetRoleWithContextReturns(&iam.GetRoleOutput{
+				Role: &iam.Role{
+					Arn:                      aws.String(roleARN),
+					AssumeRolePolicyDocument: aws.String(url.PathEscape(assumeRolePolicy)),
+					Tags:                     []*iam.Tag{{Key: aws.String("service_instance_guid"), Value: aws.String("test-instance-id")}},
+				},
+			}, nil)
+		})
+
+		It("returns the complete credentials of a role binding", func() {
+			credentials, err := s3Client.GetBindingCredentials(context.Background(), "test-instance-id", "test-binding-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials).To(Equal(s3.BucketCredentials{
+				BucketName:   bucketName,
+				AWSRegion:    "eu-west-2",
+				AWSPartition: "aws",
+				RoleARN:      roleARN,
+				ExternalID:   "test-external-id",
+			}))
+		})
+
+		It("returns ErrNoSuchResources if there is no role either", func() {
+			iamAPI.GetRoleWithContextReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+
+			_, err := s3Client.GetBindingCredentials(context.Background(), "test-instance-id", "test-binding-id")
+			Expect(err).To(Equal(s3.ErrNoSuchResources))
+		})
+	})
+})
@@ -0,0 +1,177 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/locket"
+	locket_models "code.cloudfoundry.org/locket/models"
+	"github.com/alphagov/paas-service-broker-base/broker"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	bucketPolicyLockKeyPrefix = "s3-bucket-policy/"
+
+	defaultBucketPolicyLockTTL           = 30
+	defaultBucketPolicyLockRetryInterval = time.Second
+)
+
+// BucketLocker serialises changes to a bucket's policy. S3 only lets us
+// replace the whole policy, so two binds to the same bucket at once would
+// otherwise each write back the policy they read, without the other's
+// statement.
+type BucketLocker interface {
+	LockBucket(ctx context.Context, bucketName string) (unlock func(), err error)
+}
+
+// InProcessBucketLocker is enough when there is a single broker.
+type InProcessBucketLocker struct {
+	mu    sync.Mutex
+	locks map[string]*bucketMutex
+}
+
+// bucketMutex is a one slot semaphore rather than a sync.Mutex, so that
+// waiting for it can be given up when the request is cancelled or times out.
+type bucketMutex struct {
+	sem     chan struct{}
+	waiters int
+}
+
+func NewInProcessBucketLocker() *InProcessBucketLocker {
+	return &InProcessBucketLocker{locks: map[string]*bucketMutex{}}
+}
+
+func (l *InProcessBucketLocker) LockBucket(ctx context.Context, bucketName string) (func(), error) {
+	l.mu.Lock()
+	m, ok := l.locks[bucketName]
+	if !ok {
+		m = &bucketMutex{sem: make(chan struct{}, 1)}
+		l.locks[bucketName] = m
+	}
+	m.waiters++
+	l.mu.Unlock()
+
+	select {
+	case m.sem <- struct{}{}:
+	case <-ctx.Done():
+		l.release(bucketName, m)
+		return nil, ctx.Err()
+	}
+	return func() {
+		<-m.sem
+		l.release(bucketName, m)
+	}, nil
+}
+
+// release stops tracking the bucket's lock once nothing holds or waits for
+// it.
+func (l *InProcessBucketLocker) release(bucketName string, m *bucketMutex) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	m.waiters--
+	if m.waiters == 0 {
+		delete(l.locks, bucketName)
+	}
+}
+
+// LocketBucketLocker also takes a Locket lock, so that brokers running side
+// by side, and the utilities in cmd, do not change the same bucket policy at
+// once. Its keys are kept apart from the `broker/<instance-id>` locks taken
+// by the broker API, which are held for the whole of each request. Those
+// already keep binds to one instance apart, but the utilities do not take
+// them, so the broker takes this lock too.
+type LocketBucketLocker struct {
+	client        locket_models.LocketClient
+	local         *InProcessBucketLocker
+	logger        lager.Logger
+	ttl           int64
+	retryInterval time.Duration
+}
+
+func NewLocketBucketLocker(client locket_models.LocketClient, logger lager.Logger) *LocketBucketLocker {
+	return &LocketBucketLocker{
+		client:        client,
+		local:         NewInProcessBucketLocker(),
+		logger:        logger.Session("bucket-policy-lock"),
+		ttl:           defaultBucketPolicyLockTTL,
+		retryInterval: defaultBucketPolicyLockRetryInterval,
+	}
+}
+
+// DialLocketBucketLocker connects to Locket the way the broker does, for the
+// utilities in cmd, which have no broker to borrow a Locket client from.
+func DialLocketBucketLocker(config *broker.LocketConfig, logger lager.Logger) (*LocketBucketLocker, error) {
+	locketConfig := locket.ClientLocketConfig{
+		LocketAddress:        config.Address,
+		LocketCACertFile:     config.CACertFile,
+		LocketClientCertFile: config.ClientCertFile,
+		LocketClientKeyFile:  config.ClientKeyFile,
+	}
+	var client locket_models.LocketClient
+	var err error
+	if config.SkipVerify {
+		client, err = locket.NewClientSkipCertVerify(logger.Session("locket"), locketConfig)
+	} else {
+		client, err = locket.NewClient(logger.Session("locket"), locketConfig)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewLocketBucketLocker(client, logger), nil
+}
+
+func (l *LocketBucketLocker) LockBucket(ctx context.Context, bucketName string) (func(), error) {
+	// Queue up locally first, so that a busy broker does not poll Locket
+	// once for every request waiting on the same bucket.
+	unlockLocal, err := l.local.LockBucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := &locket_models.Resource{
+		Key:      bucketPolicyLockKeyPrefix + bucketName,
+		Owner:    fmt.Sprintf("%s%s", bucketPolicyLockKeyPrefix, uuid.NewV4().String()),
+		TypeCode: locket_models.LOCK,
+	}
+	logger := l.logger.WithData(lager.Data{"key": resource.Key, "owner": resource.Owner})
+
+	// Locket locks expire after their TTL, so give up waiting after as long
+	// as the current holder could keep it.
+	for attempts := int64(0); ; attempts++ {
+		_, err = l.client.Lock(ctx, &locket_models.LockRequest{
+			Resource:     resource,
+			TtlInSeconds: l.ttl,
+		})
+		if err == nil {
+			break
+		}
+		if attempts >= l.ttl {
+			logger.Error("lock", err)
+			unlockLocal()
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			logger.Error("lock", ctx.Err())
+			unlockLocal()
+			return nil, ctx.Err()
+		case <-time.After(l.retryInterval):
+		}
+	}
+	logger.Debug("locked")
+
+	return func() {
+		_, err := l.client.Release(context.Background(), &locket_models.ReleaseRequest{
+			Resource: resource,
+		})
+		if err != nil {
+			// The lock expires after its TTL anyway.
+			logger.Error("release", err)
+		}
+		unlockLocal()
+	}, nil
+}
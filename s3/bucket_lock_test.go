@@ -0,0 +1,142 @@
+package s3_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	locket_models "code.cloudfoundry.org/locket/models"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bucket locks", func() {
+	Describe("InProcessBucketLocker", func() {
+		var locker *s3.InProcessBucketLocker
+
+		BeforeEach(func() {
+			locker = s3.NewInProcessBucketLocker()
+		})
+
+		It("makes a second lock on the same bucket wait for the first", func() {
+			unlock, err := locker.LockBucket(context.Background(), "bucket-a")
+			Expect(err).NotTo(HaveOccurred())
+
+			locked := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				unlockAgain, err := locker.LockBucket(context.Background(), "bucket-a")
+				Expect(err).NotTo(HaveOccurred())
+				close(locked)
+				unlockAgain()
+			}()
+
+			Consistently(locked, 100*time.Millisecond).ShouldNot(BeClosed())
+			unlock()
+			Eventually(locked).Should(BeClosed())
+		})
+
+		It("does not make locks on different buckets wait", func() {
+			unlock, err := locker.LockBucket(context.Background(), "bucket-a")
+			Expect(err).NotTo(HaveOccurred())
+			defer unlock()
+
+			unlockOther, err := locker.LockBucket(context.Background(), "bucket-b")
+			Expect(err).NotTo(HaveOccurred())
+			unlockOther()
+		})
+
+		It("gives up waiting when the context is done", func() {
+			unlock, err := locker.LockBucket(context.Background(), "bucket-a")
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			_, err = locker.LockBucket(ctx, "bucket-a")
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+
+			By("still letting the next caller in once the lock is released")
+			unlock()
+			unlockAgain, err := locker.LockBucket(context.Background(), "bucket-a")
+			Expect(err).NotTo(HaveOccurred())
+			unlockAgain()
+		})
+	})
+
+	Describe("LocketBucketLocker", func() {
+		var (
+			locketClient *fakeClient.FakeLocketClient
+			locker       *s3.LocketBucketLocker
+		)
+
+		BeforeEach(func() {
+			locketClient = &fakeClient.FakeLocketClient{}
+			locker = s3.NewLocketBucketLocker(locketClient, lager.NewLogger("s3-service-broker-test"))
+		})
+
+		It("takes and releases a Locket lock kept apart from the broker's instance locks", func() {
+			unlock, err := locker.LockBucket(context.Background(), "bucket-a")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(locketClient.LockCallCount()).To(Equal(1))
+			_, lockRequest, _ := locketClient.LockArgsForCall(0)
+			Expect(lockRequest.Resource.Key).To(Equal("s3-bucket-policy/bucket-a"))
+			Expect(lockRequest.Resource.TypeCode).To(Equal(locket_models.LOCK))
+			Expect(lockRequest.TtlInSeconds).To(BeNumerically(">", 0))
+
+			unlock()
+			Expect(locketClient.ReleaseCallCount()).To(Equal(1))
+			_, releaseRequest, _ := locketClient.ReleaseArgsForCall(0)
+			Expect(releaseRequest.Resource).To(Equal(lockRequest.Resource))
+		})
+
+		It("uses a different owner for each lock", func() {
+			unlock, err := locker.LockBucket(context.Background(), "bucket-a")
+			Expect(err).NotTo(HaveOccurred())
+			unlock()
+			unlock, err = locker.LockBucket(context.Background(), "bucket-a")
+			Expect(err).NotTo(HaveOccurred())
+			unlock()
+
+			_, first, _ := locketClient.LockArgsForCall(0)
+			_, second, _ := locketClient.LockArgsForCall(1)
+			Expect(first.Resource.Owner).NotTo(Equal(second.Resource.Owner))
+		})
+
+		It("retries while someone else holds the lock", func() {
+			locketClient.LockReturnsOnCall(0, nil, errors.New("lock-collision"))
+
+			unlock, err := locker.LockBucket(context.Background(), "bucket-a")
+			Expect(err).NotTo(HaveOccurred())
+			unlock()
+			Expect(locketClient.LockCallCount()).To(Equal(2))
+		})
+
+		It("gives up when the context is cancelled", func() {
+			locketClient.LockReturns(nil, errors.New("lock-collision"))
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := locker.LockBucket(ctx, "bucket-a")
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(locketClient.ReleaseCallCount()).To(Equal(0))
+
+			By("not keeping the bucket locked in this process")
+			locketClient.LockReturns(nil, nil)
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				unlock, err := locker.LockBucket(context.Background(), "bucket-a")
+				Expect(err).NotTo(HaveOccurred())
+				unlock()
+			}()
+			wg.Wait()
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package s3
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+const bucketPolicyUpdateAttempts = 3
+
+var ErrBucketPolicyConflict = errors.New("the bucket policy kept being changed by something else while it was being updated")
+
+// updateBucketPolicy makes a read-modify-write change to a bucket's policy
+// while holding the bucket's lock. change is given the current policy, and
+// reports whether it wrote a new one. If it did, the policy is read back and
+// checked with verify: anything changing the policy without holding the lock,
+// such as an operator in the console, could have overwritten our change, in
+// which case it is made again from the fresh policy.
+func (s *S3Client) updateBucketPolicy(
+	ctx context.Context,
+	logger lager.Logger,
+	bucketName string,
+	change func(currentBucketPolicy string) (changed bool, err error),
+	verify func(updatedBucketPolicy string) (ok bool, err error),
+) error {
+	logger.Info("lock-bucket-policy", lager.Data{"bucket": bucketName})
+	unlock, err := s.bucketLocker.LockBucket(ctx, bucketName)
+	if err != nil {
+		logger.Error("lock-bucket-policy", err)
+		return err
+	}
+	defer unlock()
+
+	for attempt := 1; ; attempt++ {
+		currentBucketPolicy, err := s.getBucketPolicy(ctx, logger, bucketName)
+		if err != nil {
+			return err
+		}
+		changed, err := change(currentBucketPolicy)
+		if err != nil || !changed {
+			return err
+		}
+
+		updatedBucketPolicy, err := s.getBucketPolicy(ctx, logger, bucketName)
+		if err != nil {
+			return err
+		}
+		ok, err := verify(updatedBucketPolicy)
+		if err != nil {
+			logger.Error("verify-bucket-policy", err)
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if attempt == bucketPolicyUpdateAttempts {
+			logger.Error("verify-bucket-policy", ErrBucketPolicyConflict, lager.Data{"bucket": bucketName, "attempts": attempt})
+			return ErrBucketPolicyConflict
+		}
+		logger.Info("retry-bucket-policy-update", lager.Data{"bucket": bucketName, "attempt": attempt})
+	}
+}
@@ -0,0 +1,163 @@
+package s3_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/alphagov/paas-service-broker-base/provider"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bucket policy updates", func() {
+	const otherPolicy = `{"Version": "2012-10-17", "Statement": [{
+		"Effect": "Allow",
+		"Principal": {"AWS": "arn:aws:iam::123456789012:user/someone-else"},
+		"Action": ["s3:GetObject"],
+		"Resource": ["arn:aws:s3:::test-bucket-prefix-test-instance-id/*"]
+	}]}`
+
+	var (
+		s3API    *fakeClient.FakeS3API
+		iamAPI   *fakeClient.FakeIAMAPI
+		kmsAPI   *fakeClient.FakeKMSAPI
+		s3Client *s3.S3Client
+		bindData provider.BindData
+	)
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
+		storeBucketPolicies(s3API)
+
+		s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+		s3API.GetBucketEncryptionWithContextReturns(&awsS3.GetBucketEncryptionOutput{}, nil)
+		kmsAPI.DescribeKeyWithContextReturns(nil, awserr.New(kms.ErrCodeNotFoundException, "Alias not found", nil))
+		iamAPI.CreateUserWithContextStub = func(_ context.Context, input *iam.CreateUserInput, _ ...request.Option) (*iam.CreateUserOutput, error) {
+			return &iam.CreateUserOutput{
+				User: &iam.User{Arn: aws.String("arn:aws:iam::123456789012:user/" + aws.StringValue(input.UserName))},
+			}, nil
+		}
+		iamAPI.CreateAccessKeyWithContextReturns(&iam.CreateAccessKeyOutput{
+			AccessKey: &iam.AccessKey{
+				AccessKeyId:     aws.String("access-key-id"),
+				SecretAccessKey: aws.String("secret-access-key"),
+			},
+		}, nil)
+		iamAPI.ListAccessKeysWithContextReturns(&iam.ListAccessKeysOutput{}, nil)
+		iamAPI.ListAttachedUserPoliciesWithContextReturns(&iam.ListAttachedUserPoliciesOutput{}, nil)
+		iamAPI.ListUserPoliciesWithContextReturns(&iam.ListUserPoliciesOutput{}, nil)
+
+		bindData = provider.BindData{
+			InstanceID: "test-instance-id",
+			BindingID:  "test-binding-id",
+		}
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			&s3.Config{
+				AWSRegion:      "eu-west-2",
+				ResourcePrefix: "test-bucket-prefix-",
+				IAMUserPath:    "/test-iam-path/",
+			},
+			s3API,
+			iamAPI,
+			kmsAPI,
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	It("keeps both statements when two apps are bound at once", func() {
+		var wg sync.WaitGroup
+		for _, bindingID := range []string{"binding-1", "binding-2"} {
+			wg.Add(1)
+			go func(bindingID string) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				_, err := s3Client.AddUserToBucket(context.Background(), provider.BindData{
+					InstanceID: "test-instance-id",
+					BindingID:  bindingID,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}(bindingID)
+		}
+		wg.Wait()
+
+		Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(2))
+		finalPolicy := policy.PolicyDocument{}
+		Expect(json.Unmarshal([]byte(*inputOf(s3API.PutBucketPolicyWithContextArgsForCall(1)).Policy), &finalPolicy)).To(Succeed())
+		principals := []string{}
+		for _, stmt := range finalPolicy.Statement {
+			principals = append(principals, stmt.Principal.AWS)
+		}
+		Expect(principals).To(ConsistOf(
+			"arn:aws:iam::123456789012:user/test-bucket-prefix-binding-1",
+			"arn:aws:iam::123456789012:user/test-bucket-prefix-binding-2",
+		))
+	})
+
+	It("makes the change again if something else overwrote it", func() {
+		s3API.PutBucketPolicyWithContextStub = func(_ context.Context, input *awsS3.PutBucketPolicyInput, _ ...request.Option) (*awsS3.PutBucketPolicyOutput, error) {
+			if s3API.PutBucketPolicyWithContextCallCount() == 1 {
+				s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{Policy: aws.String(otherPolicy)}, nil)
+			} else {
+				s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{Policy: input.Policy}, nil)
+			}
+			return &awsS3.PutBucketPolicyOutput{}, nil
+		}
+
+		_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(2))
+		By("building the second attempt on the policy it found")
+		finalPolicy := policy.PolicyDocument{}
+		Expect(json.Unmarshal([]byte(*inputOf(s3API.PutBucketPolicyWithContextArgsForCall(1)).Policy), &finalPolicy)).To(Succeed())
+		Expect(finalPolicy.Statement).To(HaveLen(2))
+		Expect(finalPolicy.Statement[0].Principal.AWS).To(Equal("arn:aws:iam::123456789012:user/someone-else"))
+	})
+
+	It("gives up if the change keeps being overwritten", func() {
+		s3API.PutBucketPolicyWithContextStub = func(_ context.Context, input *awsS3.PutBucketPolicyInput, _ ...request.Option) (*awsS3.PutBucketPolicyOutput, error) {
+			s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{Policy: aws.String(otherPolicy)}, nil)
+			return &awsS3.PutBucketPolicyOutput{}, nil
+		}
+
+		_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+		Expect(err).To(MatchError(s3.ErrBucketPolicyConflict))
+		Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(3))
+
+		By("not leaving the user behind")
+		Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+	})
+
+	It("checks that a removed user's statements have gone", func() {
+		s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{Policy: aws.String(`{"Version": "2012-10-17", "Statement": [{
+			"Effect": "Allow",
+			"Principal": {"AWS": "arn:aws:iam::123456789012:user/test-bucket-prefix-test-binding-id"},
+			"Action": ["s3:GetObject"],
+			"Resource": ["arn:aws:s3:::test-bucket-prefix-test-instance-id/*"]
+		}]}`)}, nil)
+		s3API.DeleteBucketPolicyWithContextStub = func(context.Context, *awsS3.DeleteBucketPolicyInput, ...request.Option) (*awsS3.DeleteBucketPolicyOutput, error) {
+			return &awsS3.DeleteBucketPolicyOutput{}, nil
+		}
+
+		err := s3Client.RemoveUserFromBucketAndDeleteUser(context.Background(), "test-binding-id", "test-instance-id")
+		Expect(err).To(MatchError(s3.ErrBucketPolicyConflict))
+		Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(3))
+		Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(0))
+	})
+})
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 )
@@ -32,29 +33,68 @@ var (
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o fakes/fake_s3_client.go . Client
 type Client interface {
-	CreateBucket(provisionData provider.ProvisionData) error
-	DeleteBucket(name string) error
-	AddUserToBucket(bindData provider.BindData) (BucketCredentials, error)
-	RemoveUserFromBucketAndDeleteUser(bindingID, bucketName string) error
+	ValidateProvisionParams(provisionData provider.ProvisionData) error
+	CreateBucket(ctx context.Context, provisionData provider.ProvisionData) error
+	GetBucketState(ctx context.Context, instanceID string) (BucketState, error)
+	GetBucketDetails(ctx context.Context, instanceID string) (BucketDetails, error)
+	UpdateBucket(ctx context.Context, updateData provider.UpdateData) error
+	DeleteBucket(ctx context.Context, name string) error
+	ForceDeleteEnabled(ctx context.Context, instanceID string) (bool, error)
+	EmptyBucket(ctx context.Context, instanceID string, progress func(objectsDeleted int)) error
+	SoftDeleteEnabled() bool
+	SoftDeleteBucket(ctx context.Context, instanceID string) error
+	AddUserToBucket(ctx context.Context, bindData provider.BindData) (BucketCredentials, error)
+	GetBindingCredentials(ctx context.Context, instanceID, bindingID string) (BucketCredentials, error)
+	RemoveUserFromBucketAndDeleteUser(ctx context.Context, bindingID, bucketName string) error
+	UndoAccessKeyRotation(ctx context.Context, bindingID, accessKeyID string) error
 }
 
+// BucketState describes how far through provisioning a bucket is. Tagging is
+// the last step of CreateBucket, so a bucket which exists but has not been
+// tagged is still being set up (or setting it up failed).
+type BucketState string
+
+const (
+	BucketStateMissing     BucketState = "missing"
+	BucketStateConfiguring BucketState = "configuring"
+	BucketStateReady       BucketState = "ready"
+)
+
 type BucketCredentials struct {
 	BucketName         string `json:"bucket_name"`
-	AWSAccessKeyID     string `json:"aws_access_key_id"`
-	AWSSecretAccessKey string `json:"aws_secret_access_key"`
+	AWSAccessKeyID     string `json:"aws_access_key_id,omitempty"`
+	AWSSecretAccessKey string `json:"aws_secret_access_key,omitempty"`
 	AWSRegion          string `json:"aws_region"`
+	AWSPartition       string `json:"aws_partition"`
 	DeployEnvironment  string `json:"deploy_env"`
+	Prefix             string `json:"prefix,omitempty"`
+	RoleARN            string `json:"role_arn,omitempty"`
+	ExternalID         string `json:"external_id,omitempty"`
+	Endpoint           string `json:"endpoint,omitempty"`
+	ForcePathStyle     bool   `json:"force_path_style,omitempty"`
 }
 
 type Config struct {
-	AWSRegion              string `json:"aws_region"`
-	ResourcePrefix         string `json:"resource_prefix"`
-	IAMUserPath            string `json:"iam_user_path"`
-	DeployEnvironment      string `json:"deploy_env"`
-	IpRestrictionPolicyARN string `json:"iam_ip_restriction_policy_arn"`
-	CommonUserPolicyARN    string `json:"iam_common_user_policy_arn"`
-	PermissionsBoundaryARN string `json:"iam_user_permissions_boundary_arn"`
-	Timeout                time.Duration
+	AWSRegion                     string   `json:"aws_region"`
+	AWSPartition                  string   `json:"aws_partition"`
+	ResourcePrefix                string   `json:"resource_prefix"`
+	IAMUserPath                   string   `json:"iam_user_path"`
+	DeployEnvironment             string   `json:"deploy_env"`
+	IpRestrictionPolicyARN        string   `json:"iam_ip_restriction_policy_arn"`
+	CommonUserPolicyARN           string   `json:"iam_common_user_policy_arn"`
+	PermissionsBoundaryARN        string   `json:"iam_user_permissions_boundary_arn"`
+	AllowedStorageClasses         []string `json:"lifecycle_allowed_storage_classes"`
+	KMSKeyARN                     string   `json:"kms_key_arn"`
+	KMSPlanIDs                    []string `json:"kms_plan_ids"`
+	AllowForceDelete              bool     `json:"allow_force_delete"`
+	SoftDeleteRetentionDays       int      `json:"soft_delete_retention_days"`
+	AssumeRolePrincipalARN        string   `json:"assume_role_principal_arn"`
+	AccessKeyRotationOverlapHours int      `json:"access_key_rotation_overlap_hours"`
+	S3Endpoint                    string   `json:"s3_endpoint"`
+	IAMEndpoint                   string   `json:"iam_endpoint"`
+	S3ForcePathStyle              bool     `json:"s3_force_path_style"`
+	AWSCACert                     string   `json:"aws_ca_cert"`
+	Timeout                       time.Duration
 }
 
 func NewS3ClientConfig(configJSON []byte) (*Config, error) {
@@ -68,224 +108,632 @@ func NewS3ClientConfig(configJSON []byte) (*Config, error) {
 }
 
 type S3Client struct {
-	bucketPrefix           string
-	iamUserPath            string
-	ipRestrictionPolicyArn string
-	commonUserPolicyArn    string
-	permissionsBoundaryArn string
-	awsRegion              string
-	deployEnvironment      string
-	timeout                time.Duration
-	s3Client               s3iface.S3API
-	iamClient              iamiface.IAMAPI
-	logger                 lager.Logger
-	context                context.Context
+	bucketPrefix             string
+	iamUserPath              string
+	ipRestrictionPolicyArn   string
+	commonUserPolicyArn      string
+	permissionsBoundaryArn   string
+	awsRegion                string
+	partition                string
+	s3Endpoint               string
+	s3ForcePathStyle         bool
+	deployEnvironment        string
+	allowedStorageClasses    []string
+	kmsKeyARN                string
+	kmsPlanIDs               []string
+	allowForceDelete         bool
+	softDeleteRetention      time.Duration
+	assumeRolePrincipalARN   string
+	accessKeyRotationOverlap time.Duration
+	bucketLocker             BucketLocker
+	timeout                  time.Duration
+	s3Client                 s3iface.S3API
+	iamClient                iamiface.IAMAPI
+	kmsClient                kmsiface.KMSAPI
+	logger                   lager.Logger
 }
 
 type BindParams struct {
 	Permissions         string `json:"permissions"`
 	AllowExternalAccess bool   `json:"allow_external_access"`
+	Prefix              string `json:"prefix"`
+	CredentialType      string `json:"credential_type"`
+	RotateAccessKey     bool   `json:"rotate_access_key"`
 }
 
+const (
+	VersioningEnabled   = "enabled"
+	VersioningSuspended = "suspended"
+)
+
 type ProvisionParams struct {
-	PublicBucket bool `json:"public_bucket"`
+	PublicBucket   bool            `json:"public_bucket"`
+	Versioning     string          `json:"versioning"`
+	LifecycleRules []LifecycleRule `json:"lifecycle_rules"`
+	Encryption     string          `json:"encryption"`
+	ForceDelete    bool            `json:"force_delete"`
+}
+
+// UpdateParams mirrors ProvisionParams, but every field is optional so that
+// only the settings explicitly passed to `cf update-service` are changed.
+type UpdateParams struct {
+	PublicBucket   *bool            `json:"public_bucket"`
+	Versioning     *string          `json:"versioning"`
+	LifecycleRules *[]LifecycleRule `json:"lifecycle_rules"`
+	ForceDelete    *bool            `json:"force_delete"`
 }
 
 func NewS3Client(
 	config *Config,
 	s3Client s3iface.S3API,
 	iamClient iamiface.IAMAPI,
+	kmsClient kmsiface.KMSAPI,
 	logger lager.Logger,
-	ctx context.Context,
 ) *S3Client {
 	timeout := config.Timeout
 	if timeout == time.Duration(0) {
 		timeout = 30 * time.Second
 	}
-
 	return &S3Client{
-		bucketPrefix:           config.ResourcePrefix,
-		iamUserPath:            fmt.Sprintf("/%s/", strings.Trim(config.IAMUserPath, "/")),
-		ipRestrictionPolicyArn: config.IpRestrictionPolicyARN,
-		commonUserPolicyArn:    config.CommonUserPolicyARN,
-		permissionsBoundaryArn: config.PermissionsBoundaryARN,
-		awsRegion:              config.AWSRegion,
-		deployEnvironment:      config.DeployEnvironment,
-		timeout:                timeout,
-		s3Client:               s3Client,
-		iamClient:              iamClient,
-		logger:                 logger,
-		context:                ctx,
-	}
-}
-
-func (s *S3Client) CreateBucket(provisionData provider.ProvisionData) error {
+		bucketPrefix:             config.ResourcePrefix,
+		iamUserPath:              fmt.Sprintf("/%s/", strings.Trim(config.IAMUserPath, "/")),
+		ipRestrictionPolicyArn:   config.IpRestrictionPolicyARN,
+		commonUserPolicyArn:      config.CommonUserPolicyARN,
+		permissionsBoundaryArn:   config.PermissionsBoundaryARN,
+		awsRegion:                config.AWSRegion,
+		partition:                config.Partition(),
+		s3Endpoint:               config.S3Endpoint,
+		s3ForcePathStyle:         config.S3ForcePathStyle,
+		deployEnvironment:        config.DeployEnvironment,
+		allowedStorageClasses:    config.AllowedStorageClasses,
+		kmsKeyARN:                config.KMSKeyARN,
+		kmsPlanIDs:               config.KMSPlanIDs,
+		allowForceDelete:         config.AllowForceDelete,
+		softDeleteRetention:      time.Duration(config.SoftDeleteRetentionDays) * 24 * time.Hour,
+		assumeRolePrincipalARN:   config.AssumeRolePrincipalARN,
+		accessKeyRotationOverlap: config.AccessKeyRotationOverlap(),
+		bucketLocker:             NewInProcessBucketLocker(),
+		timeout:                  timeout,
+		s3Client:                 s3Client,
+		iamClient:                iamClient,
+		kmsClient:                kmsClient,
+		logger:                   logger,
+	}
+}
+
+// SetBucketLocker replaces the in-process lock on bucket policy changes, for
+// instance with a LocketBucketLocker when several brokers are deployed.
+func (s *S3Client) SetBucketLocker(bucketLocker BucketLocker) {
+	s.bucketLocker = bucketLocker
+}
+
+// ValidateProvisionParams checks the provision parameters without touching
+// AWS, so that mistakes can be reported before provisioning carries on in
+// the background.
+func (s *S3Client) ValidateProvisionParams(provisionData provider.ProvisionData) error {
+	logger := s.logger.Session("validate-provision-params")
+	_, _, _, err := s.parseProvisionParams(logger, provisionData)
+	return err
+}
+
+func (s *S3Client) parseProvisionParams(logger lager.Logger, provisionData provider.ProvisionData) (
+	provisionParams ProvisionParams, versioningStatus string, useKMS bool, err error) {
+
+	provisionParams = ProvisionParams{
+		PublicBucket: false,
+	}
+	if provisionData.Details.RawParameters != nil {
+		err = json.Unmarshal(provisionData.Details.RawParameters, &provisionParams)
+		if err != nil {
+			return provisionParams, "", false, err
+		}
+	}
+	versioningStatus, err = parseVersioning(provisionParams.Versioning)
+	if err != nil {
+		logger.Error("invalid-versioning", err)
+		return provisionParams, "", false, err
+	}
+	err = validateLifecycleRules(provisionParams.LifecycleRules, s.allowedStorageClasses)
+	if err != nil {
+		logger.Error("invalid-lifecycle-rules", err)
+		return provisionParams, "", false, err
+	}
+	useKMS, err = s.useKMS(provisionData.Plan.ID, provisionParams.Encryption)
+	if err != nil {
+		logger.Error("invalid-encryption", err)
+		return provisionParams, "", false, err
+	}
+	err = s.validateForceDelete(provisionParams.ForceDelete)
+	if err != nil {
+		logger.Error("invalid-force-delete", err)
+		return provisionParams, "", false, err
+	}
+	return provisionParams, versioningStatus, useKMS, nil
+}
+
+func (s *S3Client) CreateBucket(ctx context.Context, provisionData provider.ProvisionData) error {
 	logger := s.logger.Session("create-bucket")
 	bucketName := s.buildBucketName(provisionData.InstanceID)
 
-	logger.Info("create-bucket", lager.Data{"bucket": bucketName})
-	_, err := s.s3Client.CreateBucket(&s3.CreateBucketInput{
-		Bucket: aws.String(bucketName),
-	})
-
+	provisionParams, versioningStatus, useKMS, err := s.parseProvisionParams(logger, provisionData)
 	if err != nil {
-		logger.Error("create-bucket", err)
 		return err
 	}
 
-	err = s.s3Client.WaitUntilBucketExistsWithContext(
-		s.context,
-		&s3.HeadBucketInput{Bucket: aws.String(bucketName)},
-
-		request.WithWaiterDelay(request.ConstantWaiterDelay(awsWaitDelay)),
-		request.WithWaiterMaxAttempts(awsMaxWaitAttempts),
+	tags := s.buildBucketTags(
+		provisionData.InstanceID,
+		provisionData.Details.OrganizationGUID,
+		provisionData.Details.SpaceGUID,
+		provisionData.Plan.ID,
 	)
+	if provisionParams.ForceDelete {
+		tags = append(tags, forceDeleteTag(true))
+	}
 
-	if err != nil {
-		logger.Error("wait-until-bucket-exists", err)
-		return err
+	cleanupCtx := context.WithoutCancel(ctx)
+
+	steps := []step{
+		{
+			name: "create-bucket",
+			do: func() error {
+				logger.Info("create-bucket", lager.Data{"bucket": bucketName})
+				_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.CreateBucketOutput, error) {
+					return s.s3Client.CreateBucketWithContext(ctx, &s3.CreateBucketInput{
+						Bucket: aws.String(bucketName),
+					})
+				})
+				return err
+			},
+			// Deleting the bucket also removes everything the later steps
+			// configure on it.
+			undo: func() error {
+				logger.Info("delete-bucket", lager.Data{"bucket": bucketName})
+				_, err := retryAWS(cleanupCtx, s, logger, func(ctx context.Context) (*s3.DeleteBucketOutput, error) {
+					return s.s3Client.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+						Bucket: aws.String(bucketName),
+					})
+				})
+				return err
+			},
+			markForCleanup: func() error {
+				return s.markBucketForCleanup(cleanupCtx, logger, provisionData.InstanceID)
+			},
+		},
+		{
+			name: "wait-until-bucket-exists",
+			do: func() error {
+				return s.s3Client.WaitUntilBucketExistsWithContext(
+					ctx,
+					&s3.HeadBucketInput{Bucket: aws.String(bucketName)},
+
+					request.WithWaiterDelay(request.ConstantWaiterDelay(awsWaitDelay)),
+					request.WithWaiterMaxAttempts(awsMaxWaitAttempts),
+				)
+			},
+		},
+		{
+			name: "put-public-access-block",
+			do: func() error {
+				logger.Info("put-public-access-block", lager.Data{"bucket": bucketName})
+				return s.putPublicAccessBlock(ctx, bucketName)
+			},
+		},
 	}
 
-	logger.Info("put-public-access-block", lager.Data{"bucket": bucketName})
-	_, err = s.s3Client.PutPublicAccessBlock(&s3.PutPublicAccessBlockInput{
-		Bucket: aws.String(bucketName),
-		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
-			BlockPublicAcls:       aws.Bool(true),
-			BlockPublicPolicy:     aws.Bool(true),
-			IgnorePublicAcls:      aws.Bool(true),
-			RestrictPublicBuckets: aws.Bool(true),
+	var keyARN string
+	if useKMS {
+		steps = append(steps, step{
+			name: "create-kms-key",
+			do: func() (err error) {
+				keyARN, err = s.getOrCreateKMSKey(ctx, logger, bucketName, tags)
+				return err
+			},
+			undo: func() error {
+				return s.deleteKMSKey(cleanupCtx, logger, bucketName)
+			},
+			markForCleanup: func() error {
+				return s.markKMSKeyForCleanup(cleanupCtx, logger, keyARN)
+			},
+		})
+	}
+
+	steps = append(steps, step{
+		name: "put-bucket-encryption",
+		do: func() error {
+			return s.putBucketEncryption(ctx, logger, bucketName, keyARN)
 		},
 	})
-	if err != nil {
-		logger.Error("put-public-access-block", err)
-		return err
+
+	if versioningStatus != "" {
+		steps = append(steps, step{
+			name: "put-bucket-versioning",
+			do: func() error {
+				return s.putBucketVersioning(ctx, logger, bucketName, versioningStatus)
+			},
+		})
 	}
 
-	logger.Info("put-bucket-encryption", lager.Data{"bucket": bucketName, "sse-algorithm": s3.ServerSideEncryptionAes256})
-	_, err = s.s3Client.PutBucketEncryption(&s3.PutBucketEncryptionInput{
-		Bucket: aws.String(bucketName),
-		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
-			Rules: []*s3.ServerSideEncryptionRule{
-				{
-					ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
-						SSEAlgorithm: aws.String(s3.ServerSideEncryptionAes256),
-					},
-				},
+	if len(provisionParams.LifecycleRules) > 0 {
+		steps = append(steps, step{
+			name: "put-bucket-lifecycle-configuration",
+			do: func() error {
+				return s.putBucketLifecycleConfiguration(ctx, logger, bucketName, provisionParams.LifecycleRules)
 			},
+		})
+	}
+
+	if provisionParams.PublicBucket {
+		steps = append(steps, step{
+			name: "make-bucket-public",
+			do: func() error {
+				_, err := s.makeBucketPublic(ctx, logger, bucketName, "")
+				return err
+			},
+		})
+	}
+
+	// Tagging is last, as it is what marks the bucket as ready.
+	steps = append(steps, step{
+		name: "tag-bucket",
+		do: func() error {
+			logger.Info("tag-bucket", lager.Data{"bucket": bucketName, "tags": tags})
+			_, err := s.tagBucket(ctx, provisionData.InstanceID, tags)
+			return err
 		},
 	})
-	if err != nil {
-		logger.Error("put-bucket-encryption", err)
-		return err
+
+	return runSteps(logger, steps)
+}
+
+func (s *S3Client) UpdateBucket(ctx context.Context, updateData provider.UpdateData) error {
+	logger := s.logger.Session("update-bucket")
+	bucketName := s.buildBucketName(updateData.InstanceID)
+
+	updateParams := UpdateParams{}
+	if updateData.Details.RawParameters != nil {
+		logger.Info("parse-raw-params")
+		err := json.Unmarshal(updateData.Details.RawParameters, &updateParams)
+		if err != nil {
+			logger.Error("parse-raw-params", err)
+			return err
+		}
 	}
 
-	provisionParams := ProvisionParams{
-		PublicBucket: false,
+	versioningStatus := ""
+	if updateParams.Versioning != nil {
+		var err error
+		versioningStatus, err = parseVersioning(*updateParams.Versioning)
+		if err == nil && versioningStatus == "" {
+			err = fmt.Errorf("versioning must be one of %q or %q", VersioningEnabled, VersioningSuspended)
+		}
+		if err != nil {
+			logger.Error("invalid-versioning", err)
+			return err
+		}
 	}
-	if provisionData.Details.RawParameters != nil {
-		err := json.Unmarshal(provisionData.Details.RawParameters, &provisionParams)
+	if updateParams.LifecycleRules != nil {
+		err := validateLifecycleRules(*updateParams.LifecycleRules, s.allowedStorageClasses)
 		if err != nil {
+			logger.Error("invalid-lifecycle-rules", err)
 			return err
 		}
 	}
-	if provisionParams.PublicBucket {
-		logger.Info("delete-public-access-block", lager.Data{"bucket": bucketName})
-		_, err = s.s3Client.DeletePublicAccessBlock(&s3.DeletePublicAccessBlockInput{
-			Bucket: aws.String(bucketName),
-		})
+	if updateParams.ForceDelete != nil {
+		err := s.validateForceDelete(*updateParams.ForceDelete)
 		if err != nil {
-			logger.Error("delete-public-access-block", err)
+			logger.Error("invalid-force-delete", err)
 			return err
 		}
+	}
 
-		logger.Info("make-bucket-public", lager.Data{"bucket": bucketName})
-		var permissions policy.Permissions = policy.PublicBucketPermissions{}
-		stmt := policy.BuildStatement(bucketName, iam.User{Arn: aws.String("*")}, permissions)
-		initialBucketPolicy, err := policy.BuildPolicy("", stmt)
+	if updateParams.PublicBucket != nil {
+		publicBucket := *updateParams.PublicBucket
+		err := s.updateBucketPolicy(ctx, logger, bucketName,
+			func(currentBucketPolicy string) (bool, error) {
+				if publicBucket {
+					return s.makeBucketPublic(ctx, logger, bucketName, currentBucketPolicy)
+				}
+				return s.makeBucketPrivate(ctx, logger, bucketName, currentBucketPolicy)
+			},
+			func(updatedBucketPolicy string) (bool, error) {
+				isPublic, err := policy.HasPublicStatement(updatedBucketPolicy)
+				return isPublic == publicBucket, err
+			},
+		)
 		if err != nil {
 			return err
 		}
-		initialPolicyJSON, err := json.Marshal(initialBucketPolicy)
+	}
+
+	if versioningStatus != "" {
+		err := s.putBucketVersioning(ctx, logger, bucketName, versioningStatus)
 		if err != nil {
 			return err
 		}
+	}
 
-		err = s.putBucketPolicyWithTimeout(s.buildBucketName(provisionData.InstanceID), string(initialPolicyJSON))
+	if updateParams.LifecycleRules != nil {
+		var err error
+		if len(*updateParams.LifecycleRules) > 0 {
+			err = s.putBucketLifecycleConfiguration(ctx, logger, bucketName, *updateParams.LifecycleRules)
+		} else {
+			logger.Info("delete-bucket-lifecycle", lager.Data{"bucket": bucketName})
+			_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.DeleteBucketLifecycleOutput, error) {
+				return s.s3Client.DeleteBucketLifecycleWithContext(ctx, &s3.DeleteBucketLifecycleInput{
+					Bucket: aws.String(bucketName),
+				})
+			})
+			if err != nil {
+				logger.Error("delete-bucket-lifecycle", err)
+			}
+		}
 		if err != nil {
-			logger.Error("make-bucket-public", err)
 			return err
 		}
 	}
 
-	tags := []*s3.Tag{
-		{
-			Key:   aws.String("service_instance_guid"),
-			Value: aws.String(provisionData.InstanceID),
-		},
-		{
-			Key:   aws.String("org_guid"),
-			Value: aws.String(provisionData.Details.OrganizationGUID),
-		},
-		{
-			Key:   aws.String("space_guid"),
-			Value: aws.String(provisionData.Details.SpaceGUID),
-		},
-		{
-			Key:   aws.String("created_by"),
-			Value: aws.String("paas-s3-broker"),
-		},
+	logger.Info("get-bucket-tagging", lager.Data{"bucket": bucketName})
+	getBucketTaggingOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.GetBucketTaggingOutput, error) {
+		return s.s3Client.GetBucketTaggingWithContext(ctx, &s3.GetBucketTaggingInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		logger.Error("get-bucket-tagging", err)
+		return err
+	}
+
+	tagOverrides := []*s3.Tag{
 		{
 			Key:   aws.String("plan_guid"),
-			Value: aws.String(provisionData.Plan.ID),
-		},
-		{
-			Key:   aws.String("deploy_env"),
-			Value: aws.String(s.deployEnvironment),
-		},
-		{
-			Key:   aws.String("tenant"),
-			Value: aws.String(provisionData.Details.OrganizationGUID),
-		},
-		{
-			Key:   aws.String("chargeable_entity"),
-			Value: aws.String(provisionData.InstanceID),
+			Value: aws.String(updateData.Plan.ID),
 		},
 	}
+	if updateParams.ForceDelete != nil {
+		tagOverrides = append(tagOverrides, forceDeleteTag(*updateParams.ForceDelete))
+	}
+	tags := mergeTags(getBucketTaggingOutput.TagSet, tagOverrides)
 	logger.Info("tag-bucket", lager.Data{"bucket": bucketName, "tags": tags})
-	_, err = s.tagBucket(provisionData.InstanceID, tags)
+	_, err = s.tagBucket(ctx, updateData.InstanceID, tags)
 	if err != nil {
 		logger.Error("tag-bucket", err)
-		logger.Info("delete-bucket", lager.Data{"bucket": bucketName})
-		deleteErr := s.DeleteBucket(provisionData.InstanceID)
-		if deleteErr != nil {
-			return fmt.Errorf(
-				"error while tagging S3 Bucket %s: %v.\nadditional error while deleting %s: %v",
-				provisionData.InstanceID, err, provisionData.InstanceID, deleteErr,
-			)
+		return err
+	}
+	return nil
+}
+
+func (s *S3Client) putBucketVersioning(ctx context.Context, logger lager.Logger, bucketName, status string) error {
+	logger.Info("put-bucket-versioning", lager.Data{"bucket": bucketName, "status": status})
+	_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.PutBucketVersioningOutput, error) {
+		return s.s3Client.PutBucketVersioningWithContext(ctx, &s3.PutBucketVersioningInput{
+			Bucket: aws.String(bucketName),
+			VersioningConfiguration: &s3.VersioningConfiguration{
+				Status: aws.String(status),
+			},
+		})
+	})
+	if err != nil {
+		logger.Error("put-bucket-versioning", err)
+		return err
+	}
+	return nil
+}
+
+func (s *S3Client) putBucketLifecycleConfiguration(ctx context.Context, logger lager.Logger, bucketName string, rules []LifecycleRule) error {
+	logger.Info("put-bucket-lifecycle-configuration", lager.Data{"bucket": bucketName, "rules": rules})
+	_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.PutBucketLifecycleConfigurationOutput, error) {
+		return s.s3Client.PutBucketLifecycleConfigurationWithContext(ctx, &s3.PutBucketLifecycleConfigurationInput{
+			Bucket:                 aws.String(bucketName),
+			LifecycleConfiguration: buildLifecycleConfiguration(rules),
+		})
+	})
+	if err != nil {
+		logger.Error("put-bucket-lifecycle-configuration", err)
+		return err
+	}
+	return nil
+}
+
+// parseVersioning maps the `versioning` parameter onto the S3 versioning
+// status. An empty parameter leaves versioning untouched.
+func parseVersioning(versioning string) (string, error) {
+	switch versioning {
+	case "":
+		return "", nil
+	case VersioningEnabled:
+		return s3.BucketVersioningStatusEnabled, nil
+	case VersioningSuspended:
+		return s3.BucketVersioningStatusSuspended, nil
+	default:
+		return "", fmt.Errorf("unknown versioning %q: must be one of %q or %q", versioning, VersioningEnabled, VersioningSuspended)
+	}
+}
+
+// makeBucketPublic removes the public access block and grants anonymous
+// read access to objects. Any existing binding statements in
+// currentBucketPolicy are preserved. It reports whether the policy was
+// changed.
+func (s *S3Client) makeBucketPublic(ctx context.Context, logger lager.Logger, bucketName, currentBucketPolicy string) (bool, error) {
+	logger.Info("delete-public-access-block", lager.Data{"bucket": bucketName})
+	_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.DeletePublicAccessBlockOutput, error) {
+		return s.s3Client.DeletePublicAccessBlockWithContext(ctx, &s3.DeletePublicAccessBlockInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		logger.Error("delete-public-access-block", err)
+		return false, err
+	}
+
+	isPublic, err := policy.HasPublicStatement(currentBucketPolicy)
+	if err != nil {
+		logger.Error("make-bucket-public", err)
+		return false, err
+	}
+	if isPublic {
+		logger.Info("bucket-already-public", lager.Data{"bucket": bucketName})
+		return false, nil
+	}
+
+	logger.Info("make-bucket-public", lager.Data{"bucket": bucketName})
+	var permissions policy.Permissions = policy.PublicBucketPermissions{}
+	stmt := policy.BuildStatement(s.partition, bucketName, iam.User{Arn: aws.String("*")}, permissions)
+	updatedBucketPolicy, err := policy.BuildPolicy(currentBucketPolicy, stmt)
+	if err != nil {
+		return false, err
+	}
+	updatedPolicyJSON, err := json.Marshal(updatedBucketPolicy)
+	if err != nil {
+		return false, err
+	}
+
+	err = s.putBucketPolicy(ctx, logger, bucketName, string(updatedPolicyJSON))
+	if err != nil {
+		logger.Error("make-bucket-public", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// makeBucketPrivate strips the anonymous read statement from the bucket
+// policy, leaving binding statements in place, and restores the public
+// access block. It reports whether the policy was changed.
+func (s *S3Client) makeBucketPrivate(ctx context.Context, logger lager.Logger, bucketName, currentBucketPolicy string) (bool, error) {
+	changed := false
+	if currentBucketPolicy != "" {
+		logger.Info("remove-public-statement", lager.Data{"bucket": bucketName})
+		updatedPolicy, err := policy.RemovePublicAccessFromPolicy(currentBucketPolicy)
+		if err != nil && err != policy.ErrNoPublicStatement {
+			logger.Error("remove-public-statement", err)
+			return false, err
+		}
+
+		if err == nil {
+			err = s.putOrDeleteBucketPolicy(ctx, logger, bucketName, updatedPolicy)
+			if err != nil {
+				return false, err
+			}
+			changed = true
 		}
-		return fmt.Errorf("error while tagging S3 Bucket %s: %v. Bucket has been deleted", provisionData.InstanceID, err)
 	}
+
+	logger.Info("put-public-access-block", lager.Data{"bucket": bucketName})
+	err := s.putPublicAccessBlock(ctx, bucketName)
+	if err != nil {
+		logger.Error("put-public-access-block", err)
+		return false, err
+	}
+	return changed, nil
+}
+
+func (s *S3Client) putPublicAccessBlock(ctx context.Context, bucketName string) error {
+	_, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*s3.PutPublicAccessBlockOutput, error) {
+		return s.s3Client.PutPublicAccessBlockWithContext(ctx, &s3.PutPublicAccessBlockInput{
+			Bucket: aws.String(bucketName),
+			PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(true),
+				BlockPublicPolicy:     aws.Bool(true),
+				IgnorePublicAcls:      aws.Bool(true),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+		})
+	})
 	return err
 }
 
-func (s *S3Client) DeleteBucket(name string) error {
+// getBucketPolicy returns the current bucket policy, or an empty string if
+// the bucket does not have one yet.
+func (s *S3Client) getBucketPolicy(ctx context.Context, logger lager.Logger, bucketName string) (string, error) {
+	logger.Info("get-bucket-policy", lager.Data{"bucket": bucketName})
+	getBucketPolicyOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.GetBucketPolicyOutput, error) {
+		return s.s3Client.GetBucketPolicyWithContext(ctx, &s3.GetBucketPolicyInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucketPolicy" {
+			return "", nil
+		}
+		logger.Error("get-bucket-policy", err)
+		return "", err
+	}
+	return aws.StringValue(getBucketPolicyOutput.Policy), nil
+}
+
+func (s *S3Client) DeleteBucket(ctx context.Context, name string) error {
 	logger := s.logger.Session("delete-bucket")
 	fullBucketName := s.buildBucketName(name)
 
+	// Find out whether the bucket has a key of its own while it still
+	// exists, so that deployments which never use SSE-KMS do not need any
+	// KMS permissions to deprovision. If the bucket has gone already,
+	// deleting it reports that.
+	kmsKeyARN, err := s.getBucketKMSKey(ctx, logger, fullBucketName)
+	if err != nil {
+		awsErr, ok := err.(awserr.Error)
+		switch {
+		case ok && awsErr.Code() == "NoSuchBucket":
+		case ok && awsErr.Code() == "AccessDenied" && !s.kmsConfigured():
+			// Deployments set up before SSE-KMS may not allow
+			// s3:GetEncryptionConfiguration. Without it, the keys of
+			// buckets whose tenants asked for `encryption` are left
+			// behind, so the README lists it as required.
+			logger.Info("skip-kms-key-check", lager.Data{"bucket": fullBucketName})
+		default:
+			return classifyAWSError(err)
+		}
+	}
+
 	logger.Info("delete-bucket", lager.Data{"bucket": fullBucketName})
-	_, err := s.s3Client.DeleteBucket(&s3.DeleteBucketInput{
-		Bucket: aws.String(fullBucketName),
+	_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.DeleteBucketOutput, error) {
+		return s.s3Client.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+			Bucket: aws.String(fullBucketName),
+		})
 	})
 	if err != nil {
 		logger.Error("delete-bucket", err)
-		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucket" {
-			return ErrNoSuchResources
+		return s.classifyDeleteBucketError(ctx, logger, fullBucketName, err)
+	}
+
+	// Operator-supplied keys are shared between buckets and never deleted.
+	if kmsKeyARN == "" || kmsKeyARN == s.kmsKeyARN {
+		return nil
+	}
+	return s.deleteKMSKey(ctx, logger, fullBucketName)
+}
+
+func (s *S3Client) GetBucketState(ctx context.Context, instanceID string) (BucketState, error) {
+	logger := s.logger.Session("get-bucket-state")
+	bucketName := s.buildBucketName(instanceID)
+
+	logger.Info("get-bucket-tagging", lager.Data{"bucket": bucketName})
+	getBucketTaggingOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.GetBucketTaggingOutput, error) {
+		return s.s3Client.GetBucketTaggingWithContext(ctx, &s3.GetBucketTaggingInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			switch awsErr.Code() {
+			case "NoSuchBucket":
+				return BucketStateMissing, nil
+			case "NoSuchTagSet":
+				return BucketStateConfiguring, nil
+			}
 		}
+		logger.Error("get-bucket-tagging", err)
+		return "", err
 	}
-	return err
+
+	for _, tag := range getBucketTaggingOutput.TagSet {
+		if aws.StringValue(tag.Key) == "service_instance_guid" && aws.StringValue(tag.Value) == instanceID {
+			return BucketStateReady, nil
+		}
+	}
+	return BucketStateConfiguring, nil
 }
 
-func (s *S3Client) AddUserToBucket(bindData provider.BindData) (BucketCredentials, error) {
+func (s *S3Client) AddUserToBucket(ctx context.Context, bindData provider.BindData) (BucketCredentials, error) {
 	logger := s.logger.Session("add-user-to-bucket")
 	var permissions policy.Permissions = policy.ReadWritePermissions{}
 
@@ -306,6 +754,33 @@ func (s *S3Client) AddUserToBucket(bindData provider.BindData) (BucketCredential
 			logger.Error("invalid-permissions", err)
 			return BucketCredentials{}, err
 		}
+
+		if bindParams.Prefix != "" {
+			bindParams.Prefix, err = policy.NormalisePrefix(bindParams.Prefix)
+			if err != nil {
+				logger.Error("invalid-prefix", err)
+				return BucketCredentials{}, err
+			}
+		}
+
+		err = s.validateCredentialType(bindParams.CredentialType)
+		if err != nil {
+			logger.Error("invalid-credential-type", err)
+			return BucketCredentials{}, err
+		}
+	}
+
+	if bindParams.RotateAccessKey {
+		if bindParams.CredentialType == CredentialTypeAssumeRole {
+			err := errors.New("rotate_access_key cannot be used with assume_role credentials, which are short-lived already")
+			logger.Error("invalid-rotate-access-key", err)
+			return BucketCredentials{}, err
+		}
+		return s.rotateBindingAccessKeyForBind(ctx, logger, bindData.InstanceID, bindData.BindingID)
+	}
+
+	if bindParams.CredentialType == CredentialTypeAssumeRole {
+		return s.addRoleToBucket(ctx, logger, bindData, bindParams, permissions)
 	}
 
 	fullBucketName := s.buildBucketName(bindData.InstanceID)
@@ -333,145 +808,226 @@ func (s *S3Client) AddUserToBucket(bindData provider.BindData) (BucketCredential
 	if s.permissionsBoundaryArn != "" {
 		user.PermissionsBoundary = aws.String(s.permissionsBoundaryArn)
 	}
-	logger.Info("create-user", lager.Data{"bucket": fullBucketName, "user": user})
-	createUserOutput, err := s.iamClient.CreateUser(user)
-	if err != nil {
-		logger.Error("create-user", err)
-		return BucketCredentials{}, err
-	}
 
-	err = s.iamClient.WaitUntilUserExistsWithContext(
-		s.context,
-		&iam.GetUserInput{UserName: aws.String(username)},
+	// Rolling back tidies up after a failure, which may be the request
+	// being cancelled, so it must not be cancelled along with it.
+	cleanupCtx := context.WithoutCancel(ctx)
 
-		request.WithWaiterDelay(request.ConstantWaiterDelay(awsWaitDelay)),
-		request.WithWaiterMaxAttempts(awsMaxWaitAttempts),
+	var (
+		createUserOutput      *iam.CreateUserOutput
+		createAccessKeyOutput *iam.CreateAccessKeyOutput
 	)
-
-	if err != nil {
-		logger.Error("wait-for-user-exist", err)
-		return BucketCredentials{}, err
+	steps := []step{
+		{
+			name: "create-user",
+			do: func() (err error) {
+				logger.Info("create-user", lager.Data{"bucket": fullBucketName, "user": user})
+				createUserOutput, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.CreateUserOutput, error) {
+					return s.iamClient.CreateUserWithContext(ctx, user)
+				})
+				return err
+			},
+			// Deleting the user also removes everything the later
+			// steps attach to it.
+			undo: func() error {
+				return ignoreNoSuchResources(s.deleteUser(cleanupCtx, username))
+			},
+			markForCleanup: func() error {
+				return s.markUserForCleanup(cleanupCtx, logger, username)
+			},
+		},
+		{
+			name: "wait-for-user-exist",
+			do: func() error {
+				return s.iamClient.WaitUntilUserExistsWithContext(
+					ctx,
+					&iam.GetUserInput{UserName: aws.String(username)},
+
+					request.WithWaiterDelay(request.ConstantWaiterDelay(awsWaitDelay)),
+					request.WithWaiterMaxAttempts(awsMaxWaitAttempts),
+				)
+			},
+		},
 	}
 
 	if s.commonUserPolicyArn != "" {
-		logger.Info("add-common-user-policy", lager.Data{
-			"bucket": fullBucketName,
-			"user": username,
-		})
-		_, err = s.iamClient.AttachUserPolicy(&iam.AttachUserPolicyInput{
-			PolicyArn: aws.String(s.commonUserPolicyArn),
-			UserName:  aws.String(username),
+		steps = append(steps, step{
+			name: "add-common-user-policy",
+			do: func() error {
+				logger.Info("add-common-user-policy", lager.Data{
+					"bucket": fullBucketName,
+					"user":   username,
+				})
+				_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.AttachUserPolicyOutput, error) {
+					return s.iamClient.AttachUserPolicyWithContext(ctx, &iam.AttachUserPolicyInput{
+						PolicyArn: aws.String(s.commonUserPolicyArn),
+						UserName:  aws.String(username),
+					})
+				})
+				return err
+			},
 		})
-		if err != nil {
-			logger.Error("add-common-user-policy", err)
-			s.deleteUserWithoutError(username)
-			return BucketCredentials{}, err
-		}
 	}
 
 	if !bindParams.AllowExternalAccess {
-		logger.Info("disallow-external-access", lager.Data{
-			"bucket": fullBucketName,
-			"user": username,
-		})
-		_, err = s.iamClient.AttachUserPolicy(&iam.AttachUserPolicyInput{
-			PolicyArn: aws.String(s.ipRestrictionPolicyArn),
-			UserName:  aws.String(username),
+		steps = append(steps, step{
+			name: "disallow-external-access",
+			do: func() error {
+				logger.Info("disallow-external-access", lager.Data{
+					"bucket": fullBucketName,
+					"user":   username,
+				})
+				_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.AttachUserPolicyOutput, error) {
+					return s.iamClient.AttachUserPolicyWithContext(ctx, &iam.AttachUserPolicyInput{
+						PolicyArn: aws.String(s.ipRestrictionPolicyArn),
+						UserName:  aws.String(username),
+					})
+				})
+				return err
+			},
 		})
-		if err != nil {
-			logger.Error("disallow-external-access", err)
-			s.deleteUserWithoutError(username)
-			return BucketCredentials{}, err
-		}
 	}
 
-	logger.Info("create-access-key", lager.Data{"bucket": fullBucketName, "username": username})
-	createAccessKeyOutput, err := s.iamClient.CreateAccessKey(&iam.CreateAccessKeyInput{
-		UserName: aws.String(username),
-	})
-	if err != nil {
-		logger.Error("create-access-key", err)
-		s.deleteUserWithoutError(username)
-		return BucketCredentials{}, err
-	}
+	steps = append(steps,
+		step{
+			name: "put-kms-key-user-policy",
+			do: func() error {
+				kmsKeyARN, err := s.getBucketKMSKey(ctx, logger, fullBucketName)
+				if err != nil || kmsKeyARN == "" {
+					return err
+				}
+				logger.Info("put-kms-key-user-policy", lager.Data{
+					"bucket": fullBucketName,
+					"user":   username,
+					"key":    kmsKeyARN,
+				})
+				kmsPolicy, err := policy.BuildKMSKeyUserPolicy(kmsKeyARN, permissions)
+				if err != nil {
+					return err
+				}
+				_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.PutUserPolicyOutput, error) {
+					return s.iamClient.PutUserPolicyWithContext(ctx, &iam.PutUserPolicyInput{
+						PolicyDocument: aws.String(kmsPolicy),
+						PolicyName:     aws.String(kmsKeyUserPolicyName),
+						UserName:       aws.String(username),
+					})
+				})
+				return err
+			},
+		},
+		step{
+			name: "create-access-key",
+			do: func() (err error) {
+				logger.Info("create-access-key", lager.Data{"bucket": fullBucketName, "username": username})
+				createAccessKeyOutput, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.CreateAccessKeyOutput, error) {
+					return s.iamClient.CreateAccessKeyWithContext(ctx, &iam.CreateAccessKeyInput{
+						UserName: aws.String(username),
+					})
+				})
+				return err
+			},
+		},
+		s.grantBucketAccessStep(ctx, cleanupCtx, logger, bindData.InstanceID, func() iam.User {
+			return *createUserOutput.User
+		}, username, permissions, bindParams.Prefix),
+	)
 
-	logger.Info("get-bucket-policy", lager.Data{"bucket": fullBucketName})
-	getBucketPolicyOutput, err := s.s3Client.GetBucketPolicy(&s3.GetBucketPolicyInput{
-		Bucket: aws.String(fullBucketName),
-	})
-	currentBucketPolicy := ""
+	err := runSteps(logger, steps)
 	if err != nil {
-		if !strings.Contains(err.Error(), "NoSuchBucketPolicy: The bucket policy does not exist") {
-			logger.Error("get-bucket-policy", err)
-			s.deleteUserWithoutError(username)
-			return BucketCredentials{}, err
-		}
-	} else {
-		currentBucketPolicy = *getBucketPolicyOutput.Policy
+		return BucketCredentials{}, err
 	}
 
-	stmt := policy.BuildStatement(fullBucketName, *createUserOutput.User, permissions)
+	credentials := s.bucketCredentials(fullBucketName)
+	credentials.AWSAccessKeyID = *createAccessKeyOutput.AccessKey.AccessKeyId
+	credentials.AWSSecretAccessKey = *createAccessKeyOutput.AccessKey.SecretAccessKey
+	credentials.Prefix = bindParams.Prefix
+	return credentials, nil
+}
 
-	logger.Info("update-bucket-policy", lager.Data{"bucket": fullBucketName})
-	updatedBucketPolicy, err := policy.BuildPolicy(currentBucketPolicy, stmt)
-	if err != nil {
-		s.deleteUserWithoutError(username)
-		return BucketCredentials{}, err
+// bucketCredentials returns the parts of a binding's credentials which say
+// where its bucket is.
+func (s *S3Client) bucketCredentials(bucketName string) BucketCredentials {
+	return BucketCredentials{
+		BucketName:     bucketName,
+		AWSRegion:      s.awsRegion,
+		AWSPartition:   s.partition,
+		Endpoint:       s.s3Endpoint,
+		ForcePathStyle: s.s3ForcePathStyle,
 	}
+}
 
-	updatedPolicyJSON, err := json.Marshal(updatedBucketPolicy)
-	if err != nil {
-		logger.Error("update-bucket-policy", err)
-		s.deleteUserWithoutError(username)
-		return BucketCredentials{}, err
+// grantBucketAccessStep is the last step of binding. Its policy change can
+// be made and then fail to verify, so it is undone even when it fails.
+func (s *S3Client) grantBucketAccessStep(
+	ctx, cleanupCtx context.Context,
+	logger lager.Logger,
+	instanceID string,
+	principal func() iam.User,
+	principalName string,
+	permissions policy.Permissions,
+	prefix string,
+) step {
+	fullBucketName := s.buildBucketName(instanceID)
+	return step{
+		name: "grant-bucket-access",
+		do: func() error {
+			return s.grantBucketAccess(ctx, logger, fullBucketName, principal(), permissions, prefix)
+		},
+		undo: func() error {
+			_, err := s.revokeBucketAccess(cleanupCtx, logger, fullBucketName, principalName)
+			return err
+		},
+		partial: true,
+		markForCleanup: func() error {
+			return s.markBucketForCleanup(cleanupCtx, logger, instanceID)
+		},
 	}
+}
 
-	err = s.putBucketPolicyWithTimeout(fullBucketName, string(updatedPolicyJSON))
-	if err != nil {
-		logger.Error("update-bucket-policy", err)
-		s.deleteUserWithoutError(username)
-		return BucketCredentials{}, err
+// grantBucketAccess adds statements for the principal to the bucket policy.
+func (s *S3Client) grantBucketAccess(ctx context.Context, logger lager.Logger, fullBucketName string, principal iam.User, permissions policy.Permissions, prefix string) error {
+	stmts := []policy.Statement{policy.BuildStatement(s.partition, fullBucketName, principal, permissions)}
+	if prefix != "" {
+		stmts = policy.BuildPrefixStatements(s.partition, fullBucketName, principal, permissions, prefix)
 	}
 
-	return BucketCredentials{
-		BucketName:         fullBucketName,
-		AWSAccessKeyID:     *createAccessKeyOutput.AccessKey.AccessKeyId,
-		AWSSecretAccessKey: *createAccessKeyOutput.AccessKey.SecretAccessKey,
-		AWSRegion:          s.awsRegion,
-	}, nil
-}
-
-func (s *S3Client) putBucketPolicyWithTimeout(fullBucketName, updatedPolicyJSON string) error {
-	var apiErr error
-	timeoutChannel := make(chan bool)
-	go func() {
-		time.Sleep(s.timeout)
-		timeoutChannel <- true
-	}()
-	for {
-		select {
-		case <-timeoutChannel:
-			return apiErr
-		default:
-			_, apiErr = s.s3Client.PutBucketPolicy(&s3.PutBucketPolicyInput{
-				Bucket: aws.String(fullBucketName),
-				Policy: aws.String(updatedPolicyJSON),
-			})
+	return s.updateBucketPolicy(ctx, logger, fullBucketName,
+		func(currentBucketPolicy string) (bool, error) {
+			logger.Info("update-bucket-policy", lager.Data{"bucket": fullBucketName, "prefix": prefix})
+			updatedBucketPolicy, err := policy.BuildPolicy(currentBucketPolicy, stmts...)
+			if err != nil {
+				return false, err
+			}
 
-			time.Sleep(2 * time.Second)
+			updatedPolicyJSON, err := json.Marshal(updatedBucketPolicy)
+			if err != nil {
+				logger.Error("update-bucket-policy", err)
+				return false, err
+			}
 
-			if apiErr == nil {
-				return apiErr
+			err = s.putBucketPolicy(ctx, logger, fullBucketName, string(updatedPolicyJSON))
+			if err != nil {
+				logger.Error("update-bucket-policy", err)
+				return false, err
 			}
-		}
-	}
+			return true, nil
+		},
+		func(updatedBucketPolicy string) (bool, error) {
+			return policy.ContainsStatements(updatedBucketPolicy, stmts...)
+		},
+	)
 }
 
-func (s *S3Client) deleteUserWithoutError(username string) {
-	err := s.deleteUser(username)
-	if err != nil {
-		s.logger.Error(fmt.Sprintf("Deleted User %s, and suppressed error", username), err)
-	}
+// putBucketPolicy retries, amongst other things, the MalformedPolicy error
+// S3 returns while a user or role it names is still being created.
+func (s *S3Client) putBucketPolicy(ctx context.Context, logger lager.Logger, fullBucketName, updatedPolicyJSON string) error {
+	_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.PutBucketPolicyOutput, error) {
+		return s.s3Client.PutBucketPolicyWithContext(ctx, &s3.PutBucketPolicyInput{
+			Bucket: aws.String(fullBucketName),
+			Policy: aws.String(updatedPolicyJSON),
+		})
+	})
+	return err
 }
 
 func isIAMUserNotFound(err error) bool {
@@ -483,16 +1039,19 @@ func isIAMUserNotFound(err error) bool {
 	return (!ok) || (awsErr.Code() != iam.ErrCodeNoSuchEntityException && awsErr.Code() != "AccessDenied")
 }
 
-func (s *S3Client) deleteUser(username string) error {
+func (s *S3Client) deleteUser(ctx context.Context, username string) error {
 	hadEffect := false
 
 	var (
-		keys     []*iam.AccessKeyMetadata
-		policies []*iam.AttachedPolicy
+		keys           []*iam.AccessKeyMetadata
+		policies       []*iam.AttachedPolicy
+		inlinePolicies []*string
 	)
 
-	keysOutput, err := s.iamClient.ListAccessKeys(&iam.ListAccessKeysInput{
-		UserName: aws.String(username),
+	keysOutput, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*iam.ListAccessKeysOutput, error) {
+		return s.iamClient.ListAccessKeysWithContext(ctx, &iam.ListAccessKeysInput{
+			UserName: aws.String(username),
+		})
 	})
 	if err != nil {
 		s.logger.Error("list-access-keys", err)
@@ -503,8 +1062,10 @@ func (s *S3Client) deleteUser(username string) error {
 		keys = keysOutput.AccessKeyMetadata
 	}
 
-	policiesOutput, err := s.iamClient.ListAttachedUserPolicies(&iam.ListAttachedUserPoliciesInput{
-		UserName: aws.String(username),
+	policiesOutput, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*iam.ListAttachedUserPoliciesOutput, error) {
+		return s.iamClient.ListAttachedUserPoliciesWithContext(ctx, &iam.ListAttachedUserPoliciesInput{
+			UserName: aws.String(username),
+		})
 	})
 	if err != nil {
 		s.logger.Error("list-attached-user-policies", err)
@@ -515,10 +1076,26 @@ func (s *S3Client) deleteUser(username string) error {
 		policies = policiesOutput.AttachedPolicies
 	}
 
+	inlinePoliciesOutput, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*iam.ListUserPoliciesOutput, error) {
+		return s.iamClient.ListUserPoliciesWithContext(ctx, &iam.ListUserPoliciesInput{
+			UserName: aws.String(username),
+		})
+	})
+	if err != nil {
+		s.logger.Error("list-user-policies", err)
+		if isIAMUserNotFound(err) {
+			return err
+		}
+	} else {
+		inlinePolicies = inlinePoliciesOutput.PolicyNames
+	}
+
 	for _, k := range keys {
-		_, err := s.iamClient.DeleteAccessKey(&iam.DeleteAccessKeyInput{
-			UserName:    aws.String(username),
-			AccessKeyId: k.AccessKeyId,
+		_, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*iam.DeleteAccessKeyOutput, error) {
+			return s.iamClient.DeleteAccessKeyWithContext(ctx, &iam.DeleteAccessKeyInput{
+				UserName:    aws.String(username),
+				AccessKeyId: k.AccessKeyId,
+			})
 		})
 		if err != nil {
 			s.logger.Error("delete-access-key", err)
@@ -528,9 +1105,11 @@ func (s *S3Client) deleteUser(username string) error {
 		hadEffect = true
 	}
 	for _, p := range policies {
-		_, err := s.iamClient.DetachUserPolicy(&iam.DetachUserPolicyInput{
-			UserName:  aws.String(username),
-			PolicyArn: p.PolicyArn,
+		_, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*iam.DetachUserPolicyOutput, error) {
+			return s.iamClient.DetachUserPolicyWithContext(ctx, &iam.DetachUserPolicyInput{
+				UserName:  aws.String(username),
+				PolicyArn: p.PolicyArn,
+			})
 		})
 		if err != nil {
 			s.logger.Error("detach-user-policy", err)
@@ -539,9 +1118,25 @@ func (s *S3Client) deleteUser(username string) error {
 
 		hadEffect = true
 	}
+	for _, p := range inlinePolicies {
+		_, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*iam.DeleteUserPolicyOutput, error) {
+			return s.iamClient.DeleteUserPolicyWithContext(ctx, &iam.DeleteUserPolicyInput{
+				UserName:   aws.String(username),
+				PolicyName: p,
+			})
+		})
+		if err != nil {
+			s.logger.Error("delete-user-policy", err)
+			return err
+		}
 
-	_, err = s.iamClient.DeleteUser(&iam.DeleteUserInput{
-		UserName: aws.String(username),
+		hadEffect = true
+	}
+
+	_, err = retryAWS(ctx, s, s.logger, func(ctx context.Context) (*iam.DeleteUserOutput, error) {
+		return s.iamClient.DeleteUserWithContext(ctx, &iam.DeleteUserInput{
+			UserName: aws.String(username),
+		})
 	})
 	if err != nil {
 		s.logger.Error("delete-user", err)
@@ -559,12 +1154,74 @@ func (s *S3Client) deleteUser(username string) error {
 	return nil
 }
 
-func (s *S3Client) tagBucket(instanceID string, tags []*s3.Tag) (output *s3.PutBucketTaggingOutput, err error) {
+func (s *S3Client) buildBucketTags(instanceID, orgGUID, spaceGUID, planID string) []*s3.Tag {
+	return []*s3.Tag{
+		{
+			Key:   aws.String("service_instance_guid"),
+			Value: aws.String(instanceID),
+		},
+		{
+			Key:   aws.String("org_guid"),
+			Value: aws.String(orgGUID),
+		},
+		{
+			Key:   aws.String("space_guid"),
+			Value: aws.String(spaceGUID),
+		},
+		{
+			Key:   aws.String("created_by"),
+			Value: aws.String("paas-s3-broker"),
+		},
+		{
+			Key:   aws.String("plan_guid"),
+			Value: aws.String(planID),
+		},
+		{
+			Key:   aws.String("deploy_env"),
+			Value: aws.String(s.deployEnvironment),
+		},
+		{
+			Key:   aws.String("tenant"),
+			Value: aws.String(orgGUID),
+		},
+		{
+			Key:   aws.String("chargeable_entity"),
+			Value: aws.String(instanceID),
+		},
+	}
+}
+
+// mergeTags returns existing with the values of any matching keys in
+// overrides replaced, and any new keys appended.
+func mergeTags(existing, overrides []*s3.Tag) []*s3.Tag {
+	merged := []*s3.Tag{}
+	overridden := map[string]bool{}
+	for _, tag := range existing {
+		for _, override := range overrides {
+			if aws.StringValue(tag.Key) == aws.StringValue(override.Key) {
+				tag = override
+				overridden[aws.StringValue(override.Key)] = true
+				break
+			}
+		}
+		merged = append(merged, tag)
+	}
+	for _, override := range overrides {
+		if !overridden[aws.StringValue(override.Key)] {
+			merged = append(merged, override)
+		}
+	}
+	return merged
+}
+
+func (s *S3Client) tagBucket(ctx context.Context, instanceID string, tags []*s3.Tag) (output *s3.PutBucketTaggingOutput, err error) {
 	createTagsInput := s3.PutBucketTaggingInput{
 		Bucket:  aws.String(s.buildBucketName(instanceID)),
 		Tagging: &s3.Tagging{TagSet: tags},
 	}
-	result, err := s.s3Client.PutBucketTagging(&createTagsInput)
+	result, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*s3.PutBucketTaggingOutput, error) {
+		return s.s3Client.PutBucketTaggingWithContext(ctx, &createTagsInput)
+	})
 	return result, err
 }
 
@@ -576,42 +1233,32 @@ func (s *S3Client) buildBindingUsername(bindingID string) string {
 	return fmt.Sprintf("%s%s", s.bucketPrefix, bindingID)
 }
 
-func (s *S3Client) RemoveUserFromBucketAndDeleteUser(bindingID, bucketName string) error {
-	logger := s.logger.Session("remove-user-from-bucket")
-
-	hadEffect := false
-
-	username := s.buildBindingUsername(bindingID)
-	fullBucketName := s.buildBucketName(bucketName)
+// revokeBucketAccess removes a binding's statements from the bucket policy,
+// reporting whether there were any.
+func (s *S3Client) revokeBucketAccess(ctx context.Context, logger lager.Logger, fullBucketName, username string) (revoked bool, err error) {
+	err = s.updateBucketPolicy(ctx, logger, fullBucketName,
+		func(currentBucketPolicy string) (bool, error) {
+			if currentBucketPolicy == "" {
+				return false, nil
+			}
 
-	logger.Info("get-bucket-policy", lager.Data{"bucket": fullBucketName})
-	getBucketPolicyOutput, err := s.s3Client.GetBucketPolicy(&s3.GetBucketPolicyInput{
-		Bucket: aws.String(fullBucketName),
-	})
-	if err != nil {
-		logger.Error("get-bucket-policy", err)
-		if awsErr, ok := err.(awserr.Error); (!ok) || awsErr.Code() != "NoSuchBucketPolicy" {
-			return err
-		}
-	} else {
-		logger.Info(
-			"remove-user-from-policy",
-			lager.Data{
-				"bucket":   fullBucketName,
-				"username": username,
-			},
-		)
-		updatedPolicy, err := policy.RemoveUserFromPolicy(
-			*getBucketPolicyOutput.Policy,
-			username,
-		)
-		if err != nil {
-			logger.Error("remove-user-from-policy", err)
+			logger.Info(
+				"remove-user-from-policy",
+				lager.Data{
+					"bucket":   fullBucketName,
+					"username": username,
+				},
+			)
+			updatedPolicy, err := policy.RemoveUserFromPolicy(currentBucketPolicy, username)
+			if err != nil {
+				logger.Error("remove-user-from-policy", err)
 
-			if !strings.Contains(err.Error(), "could not find a policy statement for user") {
-				return err
+				if !strings.Contains(err.Error(), "could not find a policy statement for user") {
+					return false, err
+				}
+				return false, nil
 			}
-		} else {
+
 			logger.Info(
 				"policy-statements",
 				lager.Data{
@@ -619,38 +1266,38 @@ func (s *S3Client) RemoveUserFromBucketAndDeleteUser(bindingID, bucketName strin
 					"count":  len(updatedPolicy.Statement),
 				},
 			)
-			if len(updatedPolicy.Statement) > 0 {
-				logger.Info("update-policy", lager.Data{"bucket": fullBucketName})
-				updatedPolicyJSON, err := json.Marshal(updatedPolicy)
-				if err != nil {
-					logger.Error("update-policy", err)
-					return err
-				}
-
-				err = s.putBucketPolicyWithTimeout(
-					fullBucketName,
-					string(updatedPolicyJSON),
-				)
-				if err != nil {
-					logger.Error("put-bucket-policy-with-timeout", err)
-					return err
-				}
-			} else {
-				logger.Info("delete-policy", lager.Data{"bucket": fullBucketName})
-				_, err = s.s3Client.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
-					Bucket: aws.String(fullBucketName),
-				})
-				if err != nil {
-					logger.Error("delete-policy", err)
-					return err
-				}
+			err = s.putOrDeleteBucketPolicy(ctx, logger, fullBucketName, updatedPolicy)
+			if err != nil {
+				return false, err
 			}
-			hadEffect = true
-		}
+			revoked = true
+			return true, nil
+		},
+		func(updatedBucketPolicy string) (bool, error) {
+			hasStatements, err := policy.HasStatementsForUser(updatedBucketPolicy, username)
+			return !hasStatements, err
+		},
+	)
+	return revoked, err
+}
+
+func (s *S3Client) RemoveUserFromBucketAndDeleteUser(ctx context.Context, bindingID, bucketName string) error {
+	logger := s.logger.Session("remove-user-from-bucket")
+
+	username := s.buildBindingUsername(bindingID)
+	fullBucketName := s.buildBucketName(bucketName)
+
+	hadEffect, err := s.revokeBucketAccess(ctx, logger, fullBucketName, username)
+	if err != nil {
+		return err
 	}
 
 	logger.Info("delete-user", lager.Data{"username": username})
-	err = s.deleteUser(username)
+	err = s.deleteUser(ctx, username)
+	if err == ErrNoSuchResources && s.assumeRoleEnabled() {
+		logger.Info("delete-role", lager.Data{"role": username})
+		err = s.deleteRole(ctx, username)
+	}
 	if err != nil {
 		logger.Error("delete-user", err)
 		if err != ErrNoSuchResources {
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	awsS3 "github.com/aws/aws-sdk-go/service/s3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -26,6 +27,7 @@ var _ = Describe("Client", func() {
 	var (
 		s3API          *fakeClient.FakeS3API
 		iamAPI         *fakeClient.FakeIAMAPI
+		kmsAPI         *fakeClient.FakeKMSAPI
 		s3Client       *s3.S3Client
 		s3ClientConfig *s3.Config
 		logger         lager.Logger
@@ -33,7 +35,9 @@ var _ = Describe("Client", func() {
 
 	BeforeEach(func() {
 		s3API = &fakeClient.FakeS3API{}
+		storeBucketPolicies(s3API)
 		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
 		s3ClientConfig = &s3.Config{
 			AWSRegion:              "eu-west-2",
 			ResourcePrefix:         "test-bucket-prefix-",
@@ -42,6 +46,10 @@ var _ = Describe("Client", func() {
 			Timeout:                2 * time.Second,
 			IpRestrictionPolicyARN: "test-ip-restriction-policy-arn",
 		}
+
+		s3API.GetBucketEncryptionWithContextReturns(nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found", nil))
+		iamAPI.ListUserPoliciesWithContextReturns(&iam.ListUserPoliciesOutput{}, nil)
+		kmsAPI.DescribeKeyWithContextReturns(nil, awserr.New(kms.ErrCodeNotFoundException, "Alias not found", nil))
 	})
 
 	JustBeforeEach(func() {
@@ -50,20 +58,20 @@ var _ = Describe("Client", func() {
 			s3ClientConfig,
 			s3API,
 			iamAPI,
+			kmsAPI,
 			logger,
-			context.Background(),
 		)
 	})
 
 	Describe("CreateBucket", func() {
 		It("enables encryption at rest", func() {
 			pd := provider.ProvisionData{}
-			s3Client.CreateBucket(pd)
+			s3Client.CreateBucket(context.Background(), pd)
 
-			Expect(s3API.CreateBucketCallCount()).To(Equal(1))
-			Expect(s3API.PutBucketEncryptionCallCount()).To(Equal(1))
+			Expect(s3API.CreateBucketWithContextCallCount()).To(Equal(1))
+			Expect(s3API.PutBucketEncryptionWithContextCallCount()).To(Equal(1))
 
-			encryptionCallParams := s3API.PutBucketEncryptionArgsForCall(0)
+			encryptionCallParams := inputOf(s3API.PutBucketEncryptionWithContextArgsForCall(0))
 			encryptionCfg := encryptionCallParams.ServerSideEncryptionConfiguration
 			Expect(len(encryptionCfg.Rules)).To(Equal(1))
 
@@ -77,8 +85,8 @@ var _ = Describe("Client", func() {
 					RawParameters: nil,
 				},
 			}
-			s3Client.CreateBucket(pd)
-			Expect(s3API.PutPublicAccessBlockCallCount()).To(Equal(1))
+			s3Client.CreateBucket(context.Background(), pd)
+			Expect(s3API.PutPublicAccessBlockWithContextCallCount()).To(Equal(1))
 		})
 		It("disables the s3 public access block when private", func() {
 			pd := provider.ProvisionData{
@@ -87,8 +95,8 @@ var _ = Describe("Client", func() {
 					RawParameters: json.RawMessage(`{"public_bucket": false}`),
 				},
 			}
-			s3Client.CreateBucket(pd)
-			Expect(s3API.PutPublicAccessBlockCallCount()).To(Equal(1))
+			s3Client.CreateBucket(context.Background(), pd)
+			Expect(s3API.PutPublicAccessBlockWithContextCallCount()).To(Equal(1))
 		})
 		It("deletes the s3 public access block when public", func() {
 			pd := provider.ProvisionData{
@@ -97,9 +105,9 @@ var _ = Describe("Client", func() {
 					RawParameters: json.RawMessage(`{"public_bucket": true}`),
 				},
 			}
-			s3Client.CreateBucket(pd)
-			Expect(s3API.PutPublicAccessBlockCallCount()).To(Equal(1))
-			Expect(s3API.DeletePublicAccessBlockCallCount()).To(Equal(1))
+			s3Client.CreateBucket(context.Background(), pd)
+			Expect(s3API.PutPublicAccessBlockWithContextCallCount()).To(Equal(1))
+			Expect(s3API.DeletePublicAccessBlockWithContextCallCount()).To(Equal(1))
 		})
 		It("creates a public bucket when specified", func() {
 			pd := provider.ProvisionData{
@@ -108,11 +116,11 @@ var _ = Describe("Client", func() {
 					RawParameters: json.RawMessage(`{"public_bucket": true}`),
 				},
 			}
-			s3Client.CreateBucket(pd)
+			s3Client.CreateBucket(context.Background(), pd)
 
-			Expect(s3API.CreateBucketCallCount()).To(Equal(1))
-			Expect(s3API.PutBucketPolicyCallCount()).To(Equal(1))
-			policyInput := s3API.PutBucketPolicyArgsForCall(0)
+			Expect(s3API.CreateBucketWithContextCallCount()).To(Equal(1))
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(1))
+			policyInput := inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0))
 			policyDoc, err := getPolicyFromPolicyCall(policyInput)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(policyDoc.Statement).To(HaveLen(1))
@@ -126,10 +134,10 @@ var _ = Describe("Client", func() {
 					RawParameters: json.RawMessage(`{"public_bucket": false}`),
 				},
 			}
-			s3Client.CreateBucket(pd)
+			s3Client.CreateBucket(context.Background(), pd)
 
-			Expect(s3API.CreateBucketCallCount()).To(Equal(1))
-			Expect(s3API.PutBucketPolicyCallCount()).To(Equal(0))
+			Expect(s3API.CreateBucketWithContextCallCount()).To(Equal(1))
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(0))
 		})
 		It("creates a private bucket by default", func() {
 			pd := provider.ProvisionData{
@@ -138,10 +146,43 @@ var _ = Describe("Client", func() {
 					RawParameters: nil,
 				},
 			}
-			s3Client.CreateBucket(pd)
+			s3Client.CreateBucket(context.Background(), pd)
 
-			Expect(s3API.CreateBucketCallCount()).To(Equal(1))
-			Expect(s3API.PutBucketPolicyCallCount()).To(Equal(0))
+			Expect(s3API.CreateBucketWithContextCallCount()).To(Equal(1))
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(0))
+		})
+		It("does not configure versioning by default", func() {
+			pd := provider.ProvisionData{
+				InstanceID: "test-instance-id",
+			}
+			s3Client.CreateBucket(context.Background(), pd)
+			Expect(s3API.PutBucketVersioningWithContextCallCount()).To(Equal(0))
+		})
+		It("enables versioning when specified", func() {
+			pd := provider.ProvisionData{
+				InstanceID: "test-instance-id",
+				Details: domain.ProvisionDetails{
+					RawParameters: json.RawMessage(`{"versioning": "enabled"}`),
+				},
+			}
+			err := s3Client.CreateBucket(context.Background(), pd)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s3API.PutBucketVersioningWithContextCallCount()).To(Equal(1))
+			versioningInput := inputOf(s3API.PutBucketVersioningWithContextArgsForCall(0))
+			Expect(versioningInput.Bucket).To(HaveValue(Equal("test-bucket-prefix-test-instance-id")))
+			Expect(versioningInput.VersioningConfiguration.Status).To(HaveValue(Equal(awsS3.BucketVersioningStatusEnabled)))
+		})
+		It("rejects an unknown versioning value before creating the bucket", func() {
+			pd := provider.ProvisionData{
+				InstanceID: "test-instance-id",
+				Details: domain.ProvisionDetails{
+					RawParameters: json.RawMessage(`{"versioning": "sometimes"}`),
+				},
+			}
+			err := s3Client.CreateBucket(context.Background(), pd)
+			Expect(err).To(MatchError(ContainSubstring("unknown versioning")))
+			Expect(s3API.CreateBucketWithContextCallCount()).To(Equal(0))
 		})
 		It("tags the bucket appropriately", func() {
 			pd := provider.ProvisionData{
@@ -155,10 +196,10 @@ var _ = Describe("Client", func() {
 					ID: "test-plan-guid",
 				},
 			}
-			s3Client.CreateBucket(pd)
+			s3Client.CreateBucket(context.Background(), pd)
 
-			Expect(s3API.CreateBucketCallCount()).To(Equal(1))
-			taggingArgs := s3API.PutBucketTaggingArgsForCall(0)
+			Expect(s3API.CreateBucketWithContextCallCount()).To(Equal(1))
+			taggingArgs := inputOf(s3API.PutBucketTaggingWithContextArgsForCall(0))
 			Expect(len(taggingArgs.Tagging.TagSet)).To(Equal(8))
 			Expect(hasTag(taggingArgs.Tagging.TagSet, "service_instance_guid", pd.InstanceID)).To(BeTrue())
 			Expect(hasTag(taggingArgs.Tagging.TagSet, "org_guid", pd.Details.OrganizationGUID)).To(BeTrue())
@@ -181,29 +222,400 @@ var _ = Describe("Client", func() {
 					ID: "test-plan-guid",
 				},
 			}
-			s3API.PutBucketTaggingReturns(nil, errors.New("lol"))
-			s3Client.CreateBucket(pd)
+			s3API.PutBucketTaggingWithContextReturns(nil, errors.New("lol"))
+			s3Client.CreateBucket(context.Background(), pd)
+
+			Expect(s3API.CreateBucketWithContextCallCount()).To(Equal(1))
+			Expect(s3API.PutBucketTaggingWithContextCallCount()).To(Equal(1))
+			Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(1))
+		})
+	})
+	Describe("UpdateBucket", func() {
+		var bindingStatement string
+
+		BeforeEach(func() {
+			bindingStatement = `{
+				"Effect": "Allow",
+				"Principal": {"AWS": "arn:aws:iam::account-number:user/s3-broker/test-bucket-prefix-some-binding"},
+				"Action": ["s3:GetObject"],
+				"Resource": ["arn:aws:s3:::test-bucket-prefix-test-instance-id/*"]
+			}`
+			s3API.GetBucketTaggingWithContextReturns(&awsS3.GetBucketTaggingOutput{
+				TagSet: []*awsS3.Tag{
+					{Key: aws.String("service_instance_guid"), Value: aws.String("test-instance-id")},
+					{Key: aws.String("plan_guid"), Value: aws.String("old-plan-guid")},
+				},
+			}, nil)
+		})
+
+		It("does not change public access when the parameter is omitted", func() {
+			err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+				InstanceID: "test-instance-id",
+				Plan:       domain.ServicePlan{ID: "test-plan-guid"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(0))
+			Expect(s3API.PutPublicAccessBlockWithContextCallCount()).To(Equal(0))
+			Expect(s3API.DeletePublicAccessBlockWithContextCallCount()).To(Equal(0))
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(0))
+		})
+
+		It("re-tags the bucket with the new plan, keeping the other tags", func() {
+			err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+				InstanceID: "test-instance-id",
+				Plan:       domain.ServicePlan{ID: "test-plan-guid"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s3API.PutBucketTaggingWithContextCallCount()).To(Equal(1))
+			taggingArgs := inputOf(s3API.PutBucketTaggingWithContextArgsForCall(0))
+			Expect(taggingArgs.Bucket).To(HaveValue(Equal("test-bucket-prefix-test-instance-id")))
+			Expect(taggingArgs.Tagging.TagSet).To(HaveLen(2))
+			Expect(hasTag(taggingArgs.Tagging.TagSet, "service_instance_guid", "test-instance-id")).To(BeTrue())
+			Expect(hasTag(taggingArgs.Tagging.TagSet, "plan_guid", "test-plan-guid")).To(BeTrue())
+		})
+
+		It("returns an error if the parameters are invalid JSON", func() {
+			err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+				InstanceID: "test-instance-id",
+				Details: domain.UpdateDetails{
+					RawParameters: json.RawMessage(`{"public_bucket": "yes please"}`),
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(s3API.Invocations()).To(BeEmpty())
+		})
+
+		It("suspends versioning when requested", func() {
+			err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+				InstanceID: "test-instance-id",
+				Details: domain.UpdateDetails{
+					RawParameters: json.RawMessage(`{"versioning": "suspended"}`),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s3API.PutBucketVersioningWithContextCallCount()).To(Equal(1))
+			versioningInput := inputOf(s3API.PutBucketVersioningWithContextArgsForCall(0))
+			Expect(versioningInput.VersioningConfiguration.Status).To(HaveValue(Equal(awsS3.BucketVersioningStatusSuspended)))
+		})
+
+		It("rejects invalid versioning before changing anything", func() {
+			err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+				InstanceID: "test-instance-id",
+				Details: domain.UpdateDetails{
+					RawParameters: json.RawMessage(`{"public_bucket": true, "versioning": ""}`),
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(s3API.Invocations()).To(BeEmpty())
+		})
+
+		Context("when making a private bucket public", func() {
+			It("removes the public access block and adds a public statement alongside existing bindings", func() {
+				s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
+					Policy: aws.String(`{"Version": "2012-10-17", "Statement": [` + bindingStatement + `]}`),
+				}, nil)
+
+				err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+					InstanceID: "test-instance-id",
+					Details: domain.UpdateDetails{
+						RawParameters: json.RawMessage(`{"public_bucket": true}`),
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(s3API.DeletePublicAccessBlockWithContextCallCount()).To(Equal(1))
+				Expect(s3API.PutPublicAccessBlockWithContextCallCount()).To(Equal(0))
+				Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(1))
+				policyDoc, err := getPolicyFromPolicyCall(inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0)))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policyDoc.Statement).To(HaveLen(2))
+				Expect(policyDoc.Statement[0].Principal.AWS).To(HaveSuffix("some-binding"))
+				Expect(policyDoc.Statement[1].Principal.AWS).To(Equal("*"))
+				Expect(policyDoc.Statement[1].Action).To(ConsistOf("s3:GetObject"))
+			})
+
+			It("creates a policy when the bucket does not have one", func() {
+				s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+
+				err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+					InstanceID: "test-instance-id",
+					Details: domain.UpdateDetails{
+						RawParameters: json.RawMessage(`{"public_bucket": true}`),
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(1))
+				policyDoc, err := getPolicyFromPolicyCall(inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0)))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policyDoc.Statement).To(HaveLen(1))
+				Expect(policyDoc.Statement[0].Principal.AWS).To(Equal("*"))
+			})
+
+			It("does not duplicate the public statement if the bucket is already public", func() {
+				s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
+					Policy: aws.String(`{"Version": "2012-10-17", "Statement": [{
+						"Effect": "Allow",
+						"Principal": {"AWS": "*"},
+						"Action": "s3:GetObject",
+						"Resource": ["arn:aws:s3:::test-bucket-prefix-test-instance-id/*"]
+					}]}`),
+				}, nil)
+
+				err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+					InstanceID: "test-instance-id",
+					Details: domain.UpdateDetails{
+						RawParameters: json.RawMessage(`{"public_bucket": true}`),
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(s3API.DeletePublicAccessBlockWithContextCallCount()).To(Equal(1))
+				Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when making a public bucket private", func() {
+			It("strips the public statement, keeps binding statements and restores the public access block", func() {
+				s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
+					Policy: aws.String(`{"Version": "2012-10-17", "Statement": [
+						{
+							"Effect": "Allow",
+							"Principal": {"AWS": "*"},
+							"Action": "s3:GetObject",
+							"Resource": ["arn:aws:s3:::test-bucket-prefix-test-instance-id/*"]
+						},
+						` + bindingStatement + `
+					]}`),
+				}, nil)
+
+				err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+					InstanceID: "test-instance-id",
+					Details: domain.UpdateDetails{
+						RawParameters: json.RawMessage(`{"public_bucket": false}`),
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(1))
+				policyDoc, err := getPolicyFromPolicyCall(inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0)))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policyDoc.Statement).To(HaveLen(1))
+				Expect(policyDoc.Statement[0].Principal.AWS).To(HaveSuffix("some-binding"))
+				Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(0))
+				Expect(s3API.PutPublicAccessBlockWithContextCallCount()).To(Equal(1))
+			})
+
+			It("deletes the bucket policy if the public statement was the only statement", func() {
+				s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
+					Policy: aws.String(`{"Version": "2012-10-17", "Statement": [{
+						"Effect": "Allow",
+						"Principal": {"AWS": "*"},
+						"Action": "s3:GetObject",
+						"Resource": ["arn:aws:s3:::test-bucket-prefix-test-instance-id/*"]
+					}]}`),
+				}, nil)
+
+				err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+					InstanceID: "test-instance-id",
+					Details: domain.UpdateDetails{
+						RawParameters: json.RawMessage(`{"public_bucket": false}`),
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(0))
+				Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(1))
+				Expect(s3API.PutPublicAccessBlockWithContextCallCount()).To(Equal(1))
+			})
+
+			It("leaves an already private bucket's policy alone", func() {
+				s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
+					Policy: aws.String(`{"Version": "2012-10-17", "Statement": [` + bindingStatement + `]}`),
+				}, nil)
+
+				err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+					InstanceID: "test-instance-id",
+					Details: domain.UpdateDetails{
+						RawParameters: json.RawMessage(`{"public_bucket": false}`),
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(0))
+				Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(0))
+				Expect(s3API.PutPublicAccessBlockWithContextCallCount()).To(Equal(1))
+			})
+		})
+	})
+	Describe("DeleteBucket", func() {
+		It("deletes the bucket", func() {
+			Expect(s3Client.DeleteBucket(context.Background(), "test-instance-id")).To(Succeed())
+			Expect(inputOf(s3API.DeleteBucketWithContextArgsForCall(0)).Bucket).To(HaveValue(Equal("test-bucket-prefix-test-instance-id")))
+		})
+
+		It("returns ErrNoSuchResources if there is no bucket", func() {
+			s3API.DeleteBucketWithContextReturns(nil, awserr.New("NoSuchBucket", "The specified bucket does not exist", nil))
+			Expect(s3Client.DeleteBucket(context.Background(), "test-instance-id")).To(MatchError(s3.ErrNoSuchResources))
+		})
+
+		Context("when the bucket is not empty", func() {
+			BeforeEach(func() {
+				s3API.DeleteBucketWithContextReturns(nil, awserr.New("BucketNotEmpty", "The bucket you tried to delete is not empty", nil))
+			})
+
+			It("says how many objects are left", func() {
+				s3API.ListObjectVersionsWithContextReturns(&awsS3.ListObjectVersionsOutput{
+					Versions:      []*awsS3.ObjectVersion{{Key: aws.String("a")}, {Key: aws.String("b")}},
+					DeleteMarkers: []*awsS3.DeleteMarkerEntry{{Key: aws.String("c")}},
+				}, nil)
+
+				err := s3Client.DeleteBucket(context.Background(), "test-instance-id")
+				var bucketNotEmptyErr *s3.BucketNotEmptyError
+				Expect(errors.As(err, &bucketNotEmptyErr)).To(BeTrue())
+				Expect(bucketNotEmptyErr.ObjectCount).To(Equal(3))
+				Expect(err).To(MatchError(ContainSubstring("bucket still contains 3 objects")))
+
+				Expect(s3API.ListObjectVersionsWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(s3API.ListObjectVersionsWithContextArgsForCall(0)).MaxKeys).To(HaveValue(BeEquivalentTo(1000)))
+			})
+
+			It("only counts the first page of objects", func() {
+				s3API.ListObjectVersionsWithContextReturns(&awsS3.ListObjectVersionsOutput{
+					Versions:    []*awsS3.ObjectVersion{{Key: aws.String("a")}},
+					IsTruncated: aws.Bool(true),
+				}, nil)
+
+				err := s3Client.DeleteBucket(context.Background(), "test-instance-id")
+				Expect(err).To(MatchError(ContainSubstring("bucket still contains more than 1 objects")))
+			})
+
+			It("still explains the problem if the objects cannot be counted", func() {
+				s3API.ListObjectVersionsWithContextReturns(nil, errors.New("throttled"))
 
-			Expect(s3API.CreateBucketCallCount()).To(Equal(1))
-			Expect(s3API.PutBucketTaggingCallCount()).To(Equal(1))
-			Expect(s3API.DeleteBucketCallCount()).To(Equal(1))
+				err := s3Client.DeleteBucket(context.Background(), "test-instance-id")
+				Expect(err).To(MatchError(ContainSubstring("bucket still contains objects;")))
+			})
+
+			Context("when the operator allows force_delete", func() {
+				BeforeEach(func() {
+					s3ClientConfig.AllowForceDelete = true
+					s3API.ListObjectVersionsWithContextReturns(&awsS3.ListObjectVersionsOutput{}, nil)
+				})
+
+				It("suggests using it", func() {
+					err := s3Client.DeleteBucket(context.Background(), "test-instance-id")
+					Expect(err).To(MatchError(ContainSubstring("force_delete")))
+				})
+			})
+		})
+
+		It("wraps access denied errors", func() {
+			s3API.DeleteBucketWithContextReturns(nil, awserr.New("AccessDenied", "Access Denied", nil))
+			Expect(s3Client.DeleteBucket(context.Background(), "test-instance-id")).To(MatchError(s3.ErrAccessDenied))
+		})
+
+		It("wraps throttling errors", func() {
+			s3API.DeleteBucketWithContextReturns(nil, awserr.New("SlowDown", "Please reduce your request rate.", nil))
+			Expect(s3Client.DeleteBucket(context.Background(), "test-instance-id")).To(MatchError(s3.ErrThrottled))
+		})
+
+		It("wraps throttling errors from looking up the bucket's key", func() {
+			s3API.GetBucketEncryptionWithContextReturns(nil, awserr.New("SlowDown", "Please reduce your request rate.", nil))
+			Expect(s3Client.DeleteBucket(context.Background(), "test-instance-id")).To(MatchError(s3.ErrThrottled))
+			Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(0))
+		})
+
+		Context("when the broker may not read the bucket's encryption", func() {
+			BeforeEach(func() {
+				s3API.GetBucketEncryptionWithContextReturns(nil, awserr.New("AccessDenied", "Access Denied", nil))
+			})
+
+			It("deletes the bucket if SSE-KMS is not configured", func() {
+				Expect(s3Client.DeleteBucket(context.Background(), "test-instance-id")).To(Succeed())
+				Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(1))
+				Expect(kmsAPI.Invocations()).To(BeEmpty())
+			})
+
+			Context("and SSE-KMS is configured", func() {
+				BeforeEach(func() {
+					s3ClientConfig.KMSPlanIDs = []string{"kms-plan-id"}
+				})
+
+				It("wraps the access denied error rather than leave a key behind", func() {
+					Expect(s3Client.DeleteBucket(context.Background(), "test-instance-id")).To(MatchError(s3.ErrAccessDenied))
+					Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
+	Describe("GetBucketState", func() {
+		It("is ready once the bucket has been tagged", func() {
+			s3API.GetBucketTaggingWithContextReturns(&awsS3.GetBucketTaggingOutput{
+				TagSet: []*awsS3.Tag{
+					{Key: aws.String("service_instance_guid"), Value: aws.String("test-instance-id")},
+				},
+			}, nil)
+
+			state, err := s3Client.GetBucketState(context.Background(), "test-instance-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal(s3.BucketStateReady))
+			Expect(inputOf(s3API.GetBucketTaggingWithContextArgsForCall(0)).Bucket).To(HaveValue(Equal("test-bucket-prefix-test-instance-id")))
+		})
+
+		It("is configuring while the bucket has no tags", func() {
+			s3API.GetBucketTaggingWithContextReturns(nil, awserr.New("NoSuchTagSet", "The TagSet does not exist", nil))
+
+			state, err := s3Client.GetBucketState(context.Background(), "test-instance-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal(s3.BucketStateConfiguring))
+		})
+
+		It("is missing if there is no bucket", func() {
+			s3API.GetBucketTaggingWithContextReturns(nil, awserr.New("NoSuchBucket", "The specified bucket does not exist", nil))
+
+			state, err := s3Client.GetBucketState(context.Background(), "test-instance-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal(s3.BucketStateMissing))
+		})
+
+		It("returns other errors", func() {
+			s3API.GetBucketTaggingWithContextReturns(nil, errors.New("throttled"))
+
+			_, err := s3Client.GetBucketState(context.Background(), "test-instance-id")
+			Expect(err).To(MatchError("throttled"))
+		})
+
+		It("passes the request's context on to AWS", func() {
+			type contextKey struct{}
+			ctx := context.WithValue(context.Background(), contextKey{}, "request")
+			s3API.GetBucketTaggingWithContextReturns(&awsS3.GetBucketTaggingOutput{}, nil)
+
+			_, err := s3Client.GetBucketState(ctx, "test-instance-id")
+			Expect(err).NotTo(HaveOccurred())
+			awsCtx, _, _ := s3API.GetBucketTaggingWithContextArgsForCall(0)
+			Expect(awsCtx.Value(contextKey{})).To(Equal("request"))
 		})
 	})
+
 	Describe("AddUserToBucket", func() {
 		BeforeEach(func() {
 			// Set up fake API
-			iamAPI.CreateUserReturnsOnCall(0, &iam.CreateUserOutput{
+			iamAPI.CreateUserWithContextReturnsOnCall(0, &iam.CreateUserOutput{
 				User: &iam.User{
 					Arn: aws.String("arn"),
 				},
 			}, nil)
-			iamAPI.CreateAccessKeyReturnsOnCall(0, &iam.CreateAccessKeyOutput{
+			iamAPI.CreateAccessKeyWithContextReturnsOnCall(0, &iam.CreateAccessKeyOutput{
 				AccessKey: &iam.AccessKey{
 					AccessKeyId:     aws.String("access-key-id"),
 					SecretAccessKey: aws.String("secret-access-key"),
 				},
 			}, nil)
-			s3API.GetBucketPolicyReturnsOnCall(0, &awsS3.GetBucketPolicyOutput{
+			s3API.GetBucketPolicyWithContextReturnsOnCall(0, &awsS3.GetBucketPolicyOutput{
 				Policy: aws.String(`{"Version": "2012-10-17", "Statement":[]}`),
 			}, nil)
 
@@ -213,12 +625,12 @@ var _ = Describe("Client", func() {
 				InstanceID: "test-instance-id",
 				BindingID:  "test-binding-id",
 			}
-			bucketCredentials, err := s3Client.AddUserToBucket(bindData)
+			bucketCredentials, err := s3Client.AddUserToBucket(context.Background(), bindData)
 			Expect(err).NotTo(HaveOccurred())
 
 			By("creating a user")
-			Expect(iamAPI.CreateUserCallCount()).To(Equal(1))
-			createUserInput := iamAPI.CreateUserArgsForCall(0)
+			Expect(iamAPI.CreateUserWithContextCallCount()).To(Equal(1))
+			createUserInput := inputOf(iamAPI.CreateUserWithContextArgsForCall(0))
 			Expect(createUserInput.UserName).To(HaveValue(Equal("test-bucket-prefix-test-binding-id")))
 			Expect(createUserInput.Path).To(HaveValue(Equal("/test-iam-path/")))
 			Expect(createUserInput.PermissionsBoundary).To(BeNil())
@@ -238,16 +650,16 @@ var _ = Describe("Client", func() {
 			}))
 
 			By("creating access keys for the user")
-			Expect(iamAPI.CreateAccessKeyCallCount()).To(Equal(1))
+			Expect(iamAPI.CreateAccessKeyWithContextCallCount()).To(Equal(1))
 
-			By("getting the bucket policy")
-			Expect(s3API.GetBucketPolicyCallCount()).To(Equal(1))
+			By("getting the bucket policy, and again to check the update")
+			Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(2))
 
 			By("putting the updated policy")
-			Expect(s3API.PutBucketPolicyCallCount()).To(Equal(1))
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(1))
 
 			By("with the right permissions")
-			updatedPolicyInput := s3API.PutBucketPolicyArgsForCall(0)
+			updatedPolicyInput := inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0))
 			updatedPolicyStr := updatedPolicyInput.Policy
 
 			updatedPolicy := policy.PolicyDocument{}
@@ -263,9 +675,51 @@ var _ = Describe("Client", func() {
 				AWSAccessKeyID:     "access-key-id",
 				AWSSecretAccessKey: "secret-access-key",
 				AWSRegion:          s3ClientConfig.AWSRegion,
+				AWSPartition:       "aws",
 			}))
 		})
 
+		Context("with a custom S3 endpoint", func() {
+			BeforeEach(func() {
+				s3ClientConfig.S3Endpoint = "https://minio.internal:9000"
+				s3ClientConfig.S3ForcePathStyle = true
+			})
+
+			It("tells the binding where to find the bucket", func() {
+				bucketCredentials, err := s3Client.AddUserToBucket(context.Background(), provider.BindData{
+					InstanceID: "test-instance-id",
+					BindingID:  "test-binding-id",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bucketCredentials.Endpoint).To(Equal("https://minio.internal:9000"))
+				Expect(bucketCredentials.ForcePathStyle).To(BeTrue())
+			})
+		})
+
+		Context("in GovCloud", func() {
+			BeforeEach(func() {
+				s3ClientConfig.AWSRegion = "us-gov-west-1"
+			})
+
+			It("grants access to the bucket by its GovCloud ARN", func() {
+				bucketCredentials, err := s3Client.AddUserToBucket(context.Background(), provider.BindData{
+					InstanceID: "test-instance-id",
+					BindingID:  "test-binding-id",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bucketCredentials.AWSRegion).To(Equal("us-gov-west-1"))
+				Expect(bucketCredentials.AWSPartition).To(Equal("aws-us-gov"))
+
+				updatedPolicy := policy.PolicyDocument{}
+				err = json.Unmarshal([]byte(aws.StringValue(inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0)).Policy)), &updatedPolicy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedPolicy.Statement[0].Resource).To(ConsistOf(
+					"arn:aws-us-gov:s3:::test-bucket-prefix-test-instance-id",
+					"arn:aws-us-gov:s3:::test-bucket-prefix-test-instance-id/*",
+				))
+			})
+		})
+
 		It("handles unknown permissions", func() {
 			bindData := provider.BindData{
 				InstanceID: "test-instance-id",
@@ -275,10 +729,10 @@ var _ = Describe("Client", func() {
 				},
 			}
 
-			_, err := s3Client.AddUserToBucket(bindData)
+			_, err := s3Client.AddUserToBucket(context.Background(), bindData)
 
 			By("Not creating a user", func() {
-				Expect(iamAPI.CreateUserCallCount()).To(Equal(0))
+				Expect(iamAPI.CreateUserWithContextCallCount()).To(Equal(0))
 			})
 
 			By("returning an error", func() {
@@ -294,27 +748,27 @@ var _ = Describe("Client", func() {
 					RawParameters: json.RawMessage(`{"permissions": "read-only"}`),
 				},
 			}
-			bucketCredentials, err := s3Client.AddUserToBucket(bindData)
+			bucketCredentials, err := s3Client.AddUserToBucket(context.Background(), bindData)
 			Expect(err).NotTo(HaveOccurred())
 
 			By("creating a user")
-			Expect(iamAPI.CreateUserCallCount()).To(Equal(1))
-			createUserInput := iamAPI.CreateUserArgsForCall(0)
+			Expect(iamAPI.CreateUserWithContextCallCount()).To(Equal(1))
+			createUserInput := inputOf(iamAPI.CreateUserWithContextArgsForCall(0))
 			Expect(createUserInput.UserName).To(HaveValue(Equal("test-bucket-prefix-test-binding-id")))
 			Expect(createUserInput.Path).To(HaveValue(Equal("/test-iam-path/")))
 			Expect(createUserInput.PermissionsBoundary).To(BeNil())
 
 			By("creating access keys for the user")
-			Expect(iamAPI.CreateAccessKeyCallCount()).To(Equal(1))
+			Expect(iamAPI.CreateAccessKeyWithContextCallCount()).To(Equal(1))
 
-			By("getting the bucket policy")
-			Expect(s3API.GetBucketPolicyCallCount()).To(Equal(1))
+			By("getting the bucket policy, and again to check the update")
+			Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(2))
 
 			By("putting the updated policy")
-			Expect(s3API.PutBucketPolicyCallCount()).To(Equal(1))
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(1))
 
 			By("with the right permissions")
-			updatedPolicyInput := s3API.PutBucketPolicyArgsForCall(0)
+			updatedPolicyInput := inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0))
 			updatedPolicyStr := updatedPolicyInput.Policy
 
 			updatedPolicy := policy.PolicyDocument{}
@@ -330,23 +784,24 @@ var _ = Describe("Client", func() {
 				AWSAccessKeyID:     "access-key-id",
 				AWSSecretAccessKey: "secret-access-key",
 				AWSRegion:          s3ClientConfig.AWSRegion,
+				AWSPartition:       "aws",
 			}))
 		})
 
 		It("does not create a bucket policy with the bad permissions", func() {
 			// Set up fake API
-			iamAPI.CreateUserReturnsOnCall(0, &iam.CreateUserOutput{
+			iamAPI.CreateUserWithContextReturnsOnCall(0, &iam.CreateUserOutput{
 				User: &iam.User{
 					Arn: aws.String("arn"),
 				},
 			}, nil)
-			iamAPI.CreateAccessKeyReturnsOnCall(0, &iam.CreateAccessKeyOutput{
+			iamAPI.CreateAccessKeyWithContextReturnsOnCall(0, &iam.CreateAccessKeyOutput{
 				AccessKey: &iam.AccessKey{
 					AccessKeyId:     aws.String("access-key-id"),
 					SecretAccessKey: aws.String("secret-access-key"),
 				},
 			}, nil)
-			s3API.GetBucketPolicyReturnsOnCall(0, &awsS3.GetBucketPolicyOutput{
+			s3API.GetBucketPolicyWithContextReturnsOnCall(0, &awsS3.GetBucketPolicyOutput{
 				Policy: aws.String(`{"Version": "2012-10-17", "Statement":[]}`),
 			}, nil)
 			bindData := provider.BindData{
@@ -356,10 +811,54 @@ var _ = Describe("Client", func() {
 					RawParameters: json.RawMessage(`{"permissions": "invalid-perms"}`),
 				},
 			}
-			_, err := s3Client.AddUserToBucket(bindData)
+			_, err := s3Client.AddUserToBucket(context.Background(), bindData)
 			Expect(err).To(HaveOccurred())
 		})
 
+		It("scopes the binding to a prefix when requested", func() {
+			bindData := provider.BindData{
+				InstanceID: "test-instance-id",
+				BindingID:  "test-binding-id",
+				Details: domain.BindDetails{
+					RawParameters: json.RawMessage(`{"permissions": "read-only", "prefix": "app-1"}`),
+				},
+			}
+			bucketCredentials, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedPolicy := policy.PolicyDocument{}
+			err = json.Unmarshal([]byte(*inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0)).Policy), &updatedPolicy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedPolicy.Statement).To(HaveLen(2))
+
+			By("only allowing the prefix to be listed")
+			Expect(updatedPolicy.Statement[0].Action).To(ConsistOf("s3:ListBucket"))
+			Expect(updatedPolicy.Statement[0].Resource).To(ConsistOf("arn:aws:s3:::test-bucket-prefix-test-instance-id"))
+			Expect(updatedPolicy.Statement[0].Condition).To(Equal(policy.Condition{
+				"StringLike": {"s3:prefix": {"app-1/*"}},
+			}))
+
+			By("only allowing objects under the prefix to be used")
+			Expect(updatedPolicy.Statement[1].Action).To(ConsistOf("s3:GetObject", "s3:GetObjectTagging"))
+			Expect(updatedPolicy.Statement[1].Resource).To(ConsistOf("arn:aws:s3:::test-bucket-prefix-test-instance-id/app-1/*"))
+
+			By("returning the prefix with the credentials")
+			Expect(bucketCredentials.Prefix).To(Equal("app-1/"))
+		})
+
+		It("rejects an invalid prefix before creating a user", func() {
+			bindData := provider.BindData{
+				InstanceID: "test-instance-id",
+				BindingID:  "test-binding-id",
+				Details: domain.BindDetails{
+					RawParameters: json.RawMessage(`{"prefix": "app-*"}`),
+				},
+			}
+			_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			Expect(err).To(MatchError(policy.ErrInvalidPrefix))
+			Expect(iamAPI.CreateUserWithContextCallCount()).To(Equal(0))
+		})
+
 		Context("when a common user policy ARN is configured", func () {
 			BeforeEach(func () {
 				s3ClientConfig.CommonUserPolicyARN = "test-common-user-policy-arn"
@@ -373,13 +872,13 @@ var _ = Describe("Client", func() {
 						RawParameters: nil,
 					},
 				}
-				_, err := s3Client.AddUserToBucket(bindData)
+				_, err := s3Client.AddUserToBucket(context.Background(), bindData)
 				Expect(err).ToNot(HaveOccurred())
 
-				Expect(iamAPI.CreateUserCallCount()).To(Equal(1))
-				createUserInput := iamAPI.CreateUserArgsForCall(0)
-				Expect(iamAPI.AttachUserPolicyCallCount()).To(BeNumerically(">", 0))
-				attachPolicyArgs := iamAPI.AttachUserPolicyArgsForCall(0)
+				Expect(iamAPI.CreateUserWithContextCallCount()).To(Equal(1))
+				createUserInput := inputOf(iamAPI.CreateUserWithContextArgsForCall(0))
+				Expect(iamAPI.AttachUserPolicyWithContextCallCount()).To(BeNumerically(">", 0))
+				attachPolicyArgs := inputOf(iamAPI.AttachUserPolicyWithContextArgsForCall(0))
 
 				Expect(createUserInput.UserName).To(HaveValue(Equal("test-bucket-prefix-test-binding-id")))
 				Expect(createUserInput.Path).To(HaveValue(Equal("/test-iam-path/")))
@@ -394,9 +893,9 @@ var _ = Describe("Client", func() {
 
 				BeforeEach(func () {
 					expectedError = errors.New("attaching user policy failed. lul.")
-					iamAPI.AttachUserPolicyReturnsOnCall(0, &iam.AttachUserPolicyOutput{}, expectedError)
-					iamAPI.ListAccessKeysReturnsOnCall(0, &iam.ListAccessKeysOutput{}, nil)
-					iamAPI.ListAttachedUserPoliciesReturnsOnCall(0, &iam.ListAttachedUserPoliciesOutput{}, nil)
+					iamAPI.AttachUserPolicyWithContextReturnsOnCall(0, &iam.AttachUserPolicyOutput{}, expectedError)
+					iamAPI.ListAccessKeysWithContextReturnsOnCall(0, &iam.ListAccessKeysOutput{}, nil)
+					iamAPI.ListAttachedUserPoliciesWithContextReturnsOnCall(0, &iam.ListAttachedUserPoliciesOutput{}, nil)
 				})
 
 				It("deletes the user", func() {
@@ -404,14 +903,14 @@ var _ = Describe("Client", func() {
 						InstanceID: "test-instance-id",
 						BindingID:  "test-binding-id",
 					}
-					_, err := s3Client.AddUserToBucket(bindData)
+					_, err := s3Client.AddUserToBucket(context.Background(), bindData)
 					Expect(err).To(HaveOccurred())
 					Expect(err).To(Equal(expectedError))
 
-					Expect(iamAPI.AttachUserPolicyCallCount()).To(Equal(1))
-					Expect(iamAPI.DeleteAccessKeyCallCount()).To(Equal(0))
-					Expect(iamAPI.DetachUserPolicyCallCount()).To(Equal(0))
-					Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
+					Expect(iamAPI.AttachUserPolicyWithContextCallCount()).To(Equal(1))
+					Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(0))
+					Expect(iamAPI.DetachUserPolicyWithContextCallCount()).To(Equal(0))
+					Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
 				})
 			})
 		})
@@ -426,15 +925,15 @@ var _ = Describe("Client", func() {
 					InstanceID: "test-instance-id",
 					BindingID:  "test-binding-id",
 				}
-				_, err := s3Client.AddUserToBucket(bindData)
+				_, err := s3Client.AddUserToBucket(context.Background(), bindData)
 				Expect(err).ToNot(HaveOccurred())
 
-				Expect(iamAPI.CreateUserCallCount()).To(Equal(1))
-				Expect(iamAPI.CreateAccessKeyCallCount()).To(Equal(1))
-				Expect(s3API.GetBucketPolicyCallCount()).To(Equal(1))
-				Expect(s3API.PutBucketPolicyCallCount()).To(Equal(1))
+				Expect(iamAPI.CreateUserWithContextCallCount()).To(Equal(1))
+				Expect(iamAPI.CreateAccessKeyWithContextCallCount()).To(Equal(1))
+				Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(2))
+				Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(1))
 
-				createUserInput := iamAPI.CreateUserArgsForCall(0)
+				createUserInput := inputOf(iamAPI.CreateUserWithContextArgsForCall(0))
 				Expect(createUserInput.UserName).To(HaveValue(Equal("test-bucket-prefix-test-binding-id")))
 				Expect(createUserInput.Path).To(HaveValue(Equal("/test-iam-path/")))
 				Expect(createUserInput.PermissionsBoundary).To(HaveValue(Equal("test-permissions-boundary-arn")))
@@ -448,9 +947,9 @@ var _ = Describe("Client", func() {
 
 				BeforeEach(func () {
 					expectedError = errors.New("attaching user policy failed. lul.")
-					iamAPI.AttachUserPolicyReturnsOnCall(0, &iam.AttachUserPolicyOutput{}, expectedError)
-					iamAPI.ListAccessKeysReturnsOnCall(0, &iam.ListAccessKeysOutput{}, nil)
-					iamAPI.ListAttachedUserPoliciesReturnsOnCall(0, &iam.ListAttachedUserPoliciesOutput{}, nil)
+					iamAPI.AttachUserPolicyWithContextReturnsOnCall(0, &iam.AttachUserPolicyOutput{}, expectedError)
+					iamAPI.ListAccessKeysWithContextReturnsOnCall(0, &iam.ListAccessKeysOutput{}, nil)
+					iamAPI.ListAttachedUserPoliciesWithContextReturnsOnCall(0, &iam.ListAttachedUserPoliciesOutput{}, nil)
 				})
 
 				It("deletes the user", func() {
@@ -458,14 +957,14 @@ var _ = Describe("Client", func() {
 						InstanceID: "test-instance-id",
 						BindingID:  "test-binding-id",
 					}
-					_, err := s3Client.AddUserToBucket(bindData)
+					_, err := s3Client.AddUserToBucket(context.Background(), bindData)
 					Expect(err).To(HaveOccurred())
 					Expect(err).To(Equal(expectedError))
 
-					Expect(iamAPI.AttachUserPolicyCallCount()).To(Equal(1))
-					Expect(iamAPI.DeleteAccessKeyCallCount()).To(Equal(0))
-					Expect(iamAPI.DetachUserPolicyCallCount()).To(Equal(0))
-					Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
+					Expect(iamAPI.AttachUserPolicyWithContextCallCount()).To(Equal(1))
+					Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(0))
+					Expect(iamAPI.DetachUserPolicyWithContextCallCount()).To(Equal(0))
+					Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
 				})
 			})
 
@@ -477,10 +976,10 @@ var _ = Describe("Client", func() {
 							RawParameters: nil,
 						},
 					}
-					s3Client.AddUserToBucket(bindData)
-					createUserInput := iamAPI.CreateUserArgsForCall(0)
-					Expect(iamAPI.AttachUserPolicyCallCount()).To(Equal(1))
-					attachPolicyArgs := iamAPI.AttachUserPolicyArgsForCall(0)
+					s3Client.AddUserToBucket(context.Background(), bindData)
+					createUserInput := inputOf(iamAPI.CreateUserWithContextArgsForCall(0))
+					Expect(iamAPI.AttachUserPolicyWithContextCallCount()).To(Equal(1))
+					attachPolicyArgs := inputOf(iamAPI.AttachUserPolicyWithContextArgsForCall(0))
 
 					Expect(*attachPolicyArgs.PolicyArn).To(Equal(s3ClientConfig.IpRestrictionPolicyARN))
 					Expect(*attachPolicyArgs.UserName).To(Equal(*createUserInput.UserName))
@@ -495,10 +994,10 @@ var _ = Describe("Client", func() {
 							RawParameters: json.RawMessage(`{"allow_external_access": false}`),
 						},
 					}
-					s3Client.AddUserToBucket(bindData)
-					createUserInput := iamAPI.CreateUserArgsForCall(0)
-					Expect(iamAPI.AttachUserPolicyCallCount()).To(Equal(1))
-					attachPolicyArgs := iamAPI.AttachUserPolicyArgsForCall(0)
+					s3Client.AddUserToBucket(context.Background(), bindData)
+					createUserInput := inputOf(iamAPI.CreateUserWithContextArgsForCall(0))
+					Expect(iamAPI.AttachUserPolicyWithContextCallCount()).To(Equal(1))
+					attachPolicyArgs := inputOf(iamAPI.AttachUserPolicyWithContextArgsForCall(0))
 
 					Expect(*attachPolicyArgs.PolicyArn).To(Equal(s3ClientConfig.IpRestrictionPolicyARN))
 					Expect(*attachPolicyArgs.UserName).To(Equal(*createUserInput.UserName))
@@ -515,9 +1014,9 @@ var _ = Describe("Client", func() {
 
 					BeforeEach(func () {
 						expectedError = errors.New("attaching user policy failed. lul.")
-						iamAPI.AttachUserPolicyReturnsOnCall(1, &iam.AttachUserPolicyOutput{}, expectedError)
-						iamAPI.ListAccessKeysReturnsOnCall(0, &iam.ListAccessKeysOutput{}, nil)
-						iamAPI.ListAttachedUserPoliciesReturnsOnCall(0, &iam.ListAttachedUserPoliciesOutput{
+						iamAPI.AttachUserPolicyWithContextReturnsOnCall(1, &iam.AttachUserPolicyOutput{}, expectedError)
+						iamAPI.ListAccessKeysWithContextReturnsOnCall(0, &iam.ListAccessKeysOutput{}, nil)
+						iamAPI.ListAttachedUserPoliciesWithContextReturnsOnCall(0, &iam.ListAttachedUserPoliciesOutput{
 							AttachedPolicies: []*iam.AttachedPolicy{
 								&iam.AttachedPolicy{
 									PolicyArn: aws.String("arn:aws:blah:blah:some-common-iam-policy-arn"),
@@ -533,20 +1032,20 @@ var _ = Describe("Client", func() {
 							InstanceID: "test-instance-id",
 							BindingID:  "test-binding-id",
 						}
-						_, err := s3Client.AddUserToBucket(bindData)
+						_, err := s3Client.AddUserToBucket(context.Background(), bindData)
 						Expect(err).To(HaveOccurred())
 						Expect(err).To(Equal(expectedError))
 
-						Expect(iamAPI.AttachUserPolicyCallCount()).To(Equal(2))
-						attachPolicyArgs := iamAPI.AttachUserPolicyArgsForCall(0)
-						Expect(iamAPI.DeleteAccessKeyCallCount()).To(Equal(0))
-						Expect(iamAPI.DetachUserPolicyCallCount()).To(Equal(1))
+						Expect(iamAPI.AttachUserPolicyWithContextCallCount()).To(Equal(2))
+						attachPolicyArgs := inputOf(iamAPI.AttachUserPolicyWithContextArgsForCall(0))
+						Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(0))
+						Expect(iamAPI.DetachUserPolicyWithContextCallCount()).To(Equal(1))
 
-						detachPolicyArgs := iamAPI.DetachUserPolicyArgsForCall(0)
+						detachPolicyArgs := inputOf(iamAPI.DetachUserPolicyWithContextArgsForCall(0))
 						Expect(*detachPolicyArgs.PolicyArn).To(HaveValue(Equal("arn:aws:blah:blah:some-common-iam-policy-arn")))
 						Expect(*detachPolicyArgs.UserName).To(Equal(*attachPolicyArgs.UserName))
 
-						Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
+						Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
 					})
 				})
 			})
@@ -560,57 +1059,58 @@ var _ = Describe("Client", func() {
 						RawParameters: json.RawMessage(`{"allow_external_access": true}`),
 					},
 				}
-				s3Client.AddUserToBucket(bindData)
-				Expect(iamAPI.AttachUserPolicyCallCount()).To(Equal(0))
+				s3Client.AddUserToBucket(context.Background(), bindData)
+				Expect(iamAPI.AttachUserPolicyWithContextCallCount()).To(Equal(0))
 			})
 		})
 
 		Context("when creating an access key fails", func() {
 			It("deletes the user", func() {
 				// Set up fake API
-				iamAPI.CreateUserReturnsOnCall(0, &iam.CreateUserOutput{
+				iamAPI.CreateUserWithContextReturnsOnCall(0, &iam.CreateUserOutput{
 					User: &iam.User{},
 				}, nil)
-				iamAPI.CreateAccessKeyReturnsOnCall(0, &iam.CreateAccessKeyOutput{}, errors.New("some-error"))
-				iamAPI.ListAccessKeysReturnsOnCall(0, &iam.ListAccessKeysOutput{}, nil)
-				iamAPI.ListAttachedUserPoliciesReturnsOnCall(0, &iam.ListAttachedUserPoliciesOutput{}, nil)
+				iamAPI.CreateAccessKeyWithContextReturnsOnCall(0, &iam.CreateAccessKeyOutput{}, errors.New("some-error"))
+				iamAPI.ListAccessKeysWithContextReturnsOnCall(0, &iam.ListAccessKeysOutput{}, nil)
+				iamAPI.ListAttachedUserPoliciesWithContextReturnsOnCall(0, &iam.ListAttachedUserPoliciesOutput{}, nil)
 				bindData := provider.BindData{
 					InstanceID: "test-instance-id",
 					BindingID:  "test-binding-id",
 				}
-				_, err := s3Client.AddUserToBucket(bindData)
+				_, err := s3Client.AddUserToBucket(context.Background(), bindData)
 				Expect(err).To(HaveOccurred())
-				Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
+				Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
 			})
 		})
 
 		Context("when getting the bucket policy fails because it does not exist", func() {
 			It("proceeds - this is expected for newly created buckets", func() {
 				// Set up fake API
-				iamAPI.CreateUserReturnsOnCall(0, &iam.CreateUserOutput{
+				iamAPI.CreateUserWithContextReturnsOnCall(0, &iam.CreateUserOutput{
 					User: &iam.User{
 						Arn: aws.String("arn"),
 					},
 				}, nil)
-				iamAPI.CreateAccessKeyReturnsOnCall(0, &iam.CreateAccessKeyOutput{
+				iamAPI.CreateAccessKeyWithContextReturnsOnCall(0, &iam.CreateAccessKeyOutput{
 					AccessKey: &iam.AccessKey{
 						AccessKeyId:     aws.String("access-key-id"),
 						SecretAccessKey: aws.String("secret-access-key"),
 					},
 				}, nil)
-				s3API.GetBucketPolicyReturnsOnCall(0, &awsS3.GetBucketPolicyOutput{}, errors.New("NoSuchBucketPolicy: The bucket policy does not exist"))
+				s3API.GetBucketPolicyWithContextReturnsOnCall(0, nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
 
 				bindData := provider.BindData{
 					InstanceID: "test-instance-id",
 					BindingID:  "test-binding-id",
 				}
-				bucketCredentials, err := s3Client.AddUserToBucket(bindData)
+				bucketCredentials, err := s3Client.AddUserToBucket(context.Background(), bindData)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(bucketCredentials).To(Equal(s3.BucketCredentials{
 					BucketName:         s3ClientConfig.ResourcePrefix + bindData.InstanceID,
 					AWSAccessKeyID:     "access-key-id",
 					AWSSecretAccessKey: "secret-access-key",
 					AWSRegion:          s3ClientConfig.AWSRegion,
+					AWSPartition:       "aws",
 				}))
 			})
 		})
@@ -618,22 +1118,23 @@ var _ = Describe("Client", func() {
 		Context("when getting the bucket policy fails for some generic reason", func() {
 			It("deletes the user", func() {
 				// Set up fake API
-				iamAPI.CreateUserReturnsOnCall(0, &iam.CreateUserOutput{
+				iamAPI.CreateUserWithContextReturnsOnCall(0, &iam.CreateUserOutput{
 					User: &iam.User{
 						Arn: aws.String("arn"),
 					},
 				}, nil)
-				iamAPI.CreateAccessKeyReturnsOnCall(0, &iam.CreateAccessKeyOutput{
+				iamAPI.CreateAccessKeyWithContextReturnsOnCall(0, &iam.CreateAccessKeyOutput{
 					AccessKey: &iam.AccessKey{
 						AccessKeyId:     aws.String("access-key-id"),
 						SecretAccessKey: aws.String("secret-access-key"),
 					},
 				}, nil)
-				s3API.GetBucketPolicyReturnsOnCall(0, &awsS3.GetBucketPolicyOutput{}, errors.New("some-error"))
-				iamAPI.ListAccessKeysReturnsOnCall(0, &iam.ListAccessKeysOutput{
+				s3API.GetBucketPolicyWithContextReturnsOnCall(0, &awsS3.GetBucketPolicyOutput{}, errors.New("some-error"))
+				s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+				iamAPI.ListAccessKeysWithContextReturnsOnCall(0, &iam.ListAccessKeysOutput{
 					AccessKeyMetadata: []*iam.AccessKeyMetadata{{AccessKeyId: aws.String("key")}},
 				}, nil)
-				iamAPI.ListAttachedUserPoliciesReturnsOnCall(0, &iam.ListAttachedUserPoliciesOutput{
+				iamAPI.ListAttachedUserPoliciesWithContextReturnsOnCall(0, &iam.ListAttachedUserPoliciesOutput{
 					AttachedPolicies: []*iam.AttachedPolicy{
 						{
 							PolicyArn:  aws.String("foo"),
@@ -641,48 +1142,49 @@ var _ = Describe("Client", func() {
 						},
 					},
 				}, nil)
-				iamAPI.DeleteAccessKeyReturnsOnCall(0, nil, nil)
+				iamAPI.DeleteAccessKeyWithContextReturnsOnCall(0, nil, nil)
 
 				bindData := provider.BindData{
 					InstanceID: "test-instance-id",
 					BindingID:  "test-binding-id",
 				}
-				_, err := s3Client.AddUserToBucket(bindData)
+				_, err := s3Client.AddUserToBucket(context.Background(), bindData)
 				Expect(err).To(HaveOccurred())
-				Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
-				Expect(iamAPI.DeleteAccessKeyCallCount()).To(Equal(1))
+				Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+				Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(1))
 			})
 		})
 
 		Context("when updating the bucket policy fails", func() {
 			It("deletes the user", func() {
 				// Set up fake API
-				iamAPI.CreateUserReturnsOnCall(0, &iam.CreateUserOutput{
+				iamAPI.CreateUserWithContextReturnsOnCall(0, &iam.CreateUserOutput{
 					User: &iam.User{
 						Arn: aws.String("arn"),
 					},
 				}, nil)
-				iamAPI.CreateAccessKeyReturnsOnCall(0, &iam.CreateAccessKeyOutput{
+				iamAPI.CreateAccessKeyWithContextReturnsOnCall(0, &iam.CreateAccessKeyOutput{
 					AccessKey: &iam.AccessKey{},
 				}, nil)
-				s3API.GetBucketPolicyReturnsOnCall(0, &awsS3.GetBucketPolicyOutput{
+				s3API.GetBucketPolicyWithContextReturnsOnCall(0, &awsS3.GetBucketPolicyOutput{
 					Policy: aws.String(`{""}`),
 				}, nil)
-				s3API.PutBucketPolicyReturns(&awsS3.PutBucketPolicyOutput{}, errors.New("some-error"))
-				iamAPI.ListAccessKeysReturnsOnCall(0, &iam.ListAccessKeysOutput{
+				s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+				s3API.PutBucketPolicyWithContextReturns(&awsS3.PutBucketPolicyOutput{}, errors.New("some-error"))
+				iamAPI.ListAccessKeysWithContextReturnsOnCall(0, &iam.ListAccessKeysOutput{
 					AccessKeyMetadata: []*iam.AccessKeyMetadata{{AccessKeyId: aws.String("key")}},
 				}, nil)
-				iamAPI.ListAttachedUserPoliciesReturnsOnCall(0, &iam.ListAttachedUserPoliciesOutput{}, nil)
-				iamAPI.DeleteAccessKeyReturnsOnCall(0, nil, nil)
+				iamAPI.ListAttachedUserPoliciesWithContextReturnsOnCall(0, &iam.ListAttachedUserPoliciesOutput{}, nil)
+				iamAPI.DeleteAccessKeyWithContextReturnsOnCall(0, nil, nil)
 
 				bindData := provider.BindData{
 					InstanceID: "test-instance-id",
 					BindingID:  "test-binding-id",
 				}
-				_, err := s3Client.AddUserToBucket(bindData)
+				_, err := s3Client.AddUserToBucket(context.Background(), bindData)
 				Expect(err).To(HaveOccurred())
-				Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
-				Expect(iamAPI.DeleteAccessKeyCallCount()).To(Equal(1))
+				Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+				Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(1))
 			})
 		})
 	})
@@ -691,7 +1193,7 @@ var _ = Describe("Client", func() {
 		It("deletes user and bucket policy when it is the only statement in the policy", func() {
 			// Set up fake API
 			userArn := "arn:aws:iam::account-number:user/s3-broker/" + s3ClientConfig.ResourcePrefix + "some-user"
-			s3API.GetBucketPolicyReturns(&awsS3.GetBucketPolicyOutput{
+			s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
 				Policy: aws.String(fmt.Sprintf(`
 					{
 						"Statement": [
@@ -713,63 +1215,64 @@ var _ = Describe("Client", func() {
 						]
 					}`, userArn)),
 			}, nil)
-			s3API.DeleteBucketPolicyReturns(&awsS3.DeleteBucketPolicyOutput{}, nil)
-			iamAPI.ListAccessKeysReturns(&iam.ListAccessKeysOutput{
+			iamAPI.ListAccessKeysWithContextReturns(&iam.ListAccessKeysOutput{
 				AccessKeyMetadata: []*iam.AccessKeyMetadata{{AccessKeyId: aws.String("key")}},
 			}, nil)
-			iamAPI.ListAttachedUserPoliciesReturns(&iam.ListAttachedUserPoliciesOutput{}, nil)
-			iamAPI.DeleteAccessKeyReturns(nil, nil)
+			iamAPI.ListAttachedUserPoliciesWithContextReturns(&iam.ListAttachedUserPoliciesOutput{}, nil)
+			iamAPI.DeleteAccessKeyWithContextReturns(nil, nil)
 
-			err := s3Client.RemoveUserFromBucketAndDeleteUser("some-user", "bucketName")
+			err := s3Client.RemoveUserFromBucketAndDeleteUser(context.Background(), "some-user", "bucketName")
 			Expect(err).NotTo(HaveOccurred())
 
-			By("getting the bucket policy", func() {
-				Expect(s3API.GetBucketPolicyCallCount()).To(Equal(1))
-				Expect(s3API.GetBucketPolicyArgsForCall(0)).ToNot(BeNil())
-				Expect(s3API.GetBucketPolicyArgsForCall(0).Bucket).ToNot(BeNil())
-				Expect(s3API.GetBucketPolicyArgsForCall(0).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
+			By("getting the bucket policy, and again to check the update", func() {
+				Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(2))
+				Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).ToNot(BeNil())
+				Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
 			})
 
 			By("deleting the bucket policy", func() {
-				Expect(s3API.DeleteBucketPolicyCallCount()).To(Equal(1))
-				Expect(s3API.DeleteBucketPolicyArgsForCall(0)).ToNot(BeNil())
-				Expect(s3API.DeleteBucketPolicyArgsForCall(0).Bucket).ToNot(BeNil())
-				Expect(s3API.DeleteBucketPolicyArgsForCall(0).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
+				Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(s3API.DeleteBucketPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(s3API.DeleteBucketPolicyWithContextArgsForCall(0)).Bucket).ToNot(BeNil())
+				Expect(inputOf(s3API.DeleteBucketPolicyWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
 			})
 
 			By("deleting user keys and policies", func() {
-				Expect(iamAPI.ListAccessKeysCallCount()).To(Equal(1))
-				Expect(iamAPI.ListAccessKeysArgsForCall(0)).ToNot(BeNil())
-				Expect(iamAPI.ListAccessKeysArgsForCall(0).UserName).ToNot(BeNil())
-				Expect(iamAPI.ListAccessKeysArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
-
-				Expect(iamAPI.ListAttachedUserPoliciesCallCount()).To(Equal(1))
-				Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0)).ToNot(BeNil())
-				Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0).UserName).ToNot(BeNil())
-				Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
-
-				Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
-				Expect(iamAPI.DeleteUserArgsForCall(0)).ToNot(BeNil())
-				Expect(iamAPI.DeleteUserArgsForCall(0).UserName).ToNot(BeNil())
-				Expect(iamAPI.DeleteUserArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+				Expect(iamAPI.ListAccessKeysWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0)).UserName).ToNot(BeNil())
+				Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+
+				Expect(iamAPI.ListAttachedUserPoliciesWithContextCallCount()).To(Equal(1))
+
+				Expect(iamAPI.ListUserPoliciesWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0)).UserName).ToNot(BeNil())
+				Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+
+				Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).ToNot(BeNil())
+				Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
 			})
 
 			By("deleting the user", func() {
-				Expect(iamAPI.DeleteAccessKeyCallCount()).To(Equal(1))
-				Expect(iamAPI.DeleteAccessKeyArgsForCall(0)).ToNot(BeNil())
-				Expect(iamAPI.DeleteAccessKeyArgsForCall(0).AccessKeyId).To(Equal(aws.String("key")))
-				Expect(iamAPI.DeleteAccessKeyArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+				Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(iamAPI.DeleteAccessKeyWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(iamAPI.DeleteAccessKeyWithContextArgsForCall(0)).AccessKeyId).To(Equal(aws.String("key")))
+				Expect(inputOf(iamAPI.DeleteAccessKeyWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
 			})
 
 			// all calls accounted for
 			Expect(s3API.Invocations()).To(HaveLen(2))
-			Expect(iamAPI.Invocations()).To(HaveLen(4))
+			Expect(iamAPI.Invocations()).To(HaveLen(5))
 		})
 
 		It("deletes the user and removes the associated statement from the bucket policy when it is not the only statement", func() {
 			// Set up fake API
 			userArn := "arn:aws:iam::account-number:user/s3-broker/" + s3ClientConfig.ResourcePrefix + "some-user"
-			s3API.GetBucketPolicyReturns(&awsS3.GetBucketPolicyOutput{
+			s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
 				Policy: aws.String(fmt.Sprintf(`
 					{
 						"Statement": [
@@ -806,11 +1309,10 @@ var _ = Describe("Client", func() {
 						]
 					}`, userArn)),
 			}, nil)
-			s3API.PutBucketPolicyReturns(&awsS3.PutBucketPolicyOutput{}, nil)
-			iamAPI.ListAccessKeysReturns(&iam.ListAccessKeysOutput{
+			iamAPI.ListAccessKeysWithContextReturns(&iam.ListAccessKeysOutput{
 				AccessKeyMetadata: []*iam.AccessKeyMetadata{{AccessKeyId: aws.String("key")}},
 			}, nil)
-			iamAPI.ListAttachedUserPoliciesReturns(&iam.ListAttachedUserPoliciesOutput{
+			iamAPI.ListAttachedUserPoliciesWithContextReturns(&iam.ListAttachedUserPoliciesOutput{
 				AttachedPolicies: []*iam.AttachedPolicy{
 					{
 						PolicyArn:  aws.String("foo"),
@@ -818,24 +1320,24 @@ var _ = Describe("Client", func() {
 					},
 				},
 			}, nil)
-			iamAPI.DeleteAccessKeyReturns(nil, nil)
+			iamAPI.DeleteAccessKeyWithContextReturns(nil, nil)
 
-			err := s3Client.RemoveUserFromBucketAndDeleteUser("some-user", "bucketName")
+			err := s3Client.RemoveUserFromBucketAndDeleteUser(context.Background(), "some-user", "bucketName")
 			Expect(err).NotTo(HaveOccurred())
 
-			By("getting the bucket policy", func() {
-				Expect(s3API.GetBucketPolicyCallCount()).To(Equal(1))
-				Expect(s3API.GetBucketPolicyArgsForCall(0)).ToNot(BeNil())
-				Expect(s3API.GetBucketPolicyArgsForCall(0).Bucket).ToNot(BeNil())
-				Expect(s3API.GetBucketPolicyArgsForCall(0).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
+			By("getting the bucket policy, and again to check the update", func() {
+				Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(2))
+				Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).ToNot(BeNil())
+				Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
 			})
 
 			By("updating the bucket policy", func() {
-				Expect(s3API.PutBucketPolicyCallCount()).To(Equal(1))
-				Expect(s3API.PutBucketPolicyArgsForCall(0)).ToNot(BeNil())
-				Expect(s3API.PutBucketPolicyArgsForCall(0).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
-				Expect(s3API.PutBucketPolicyArgsForCall(0).Policy).ToNot(BeNil())
-				Expect(aws.StringValue(s3API.PutBucketPolicyArgsForCall(0).Policy)).To(MatchJSON(`
+				Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
+				Expect(inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0)).Policy).ToNot(BeNil())
+				Expect(aws.StringValue(inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0)).Policy)).To(MatchJSON(`
 					{
 						"Version": "",
 						"Statement": [
@@ -856,56 +1358,58 @@ var _ = Describe("Client", func() {
 							}
 						]
 					}`))
-				Expect(s3API.DeleteBucketPolicyCallCount()).To(Equal(0))
+				Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(0))
 			})
 
 			By("deleting user keys and policies", func() {
-				Expect(iamAPI.ListAccessKeysCallCount()).To(Equal(1))
-				Expect(iamAPI.ListAccessKeysArgsForCall(0)).ToNot(BeNil())
-				Expect(iamAPI.ListAccessKeysArgsForCall(0).UserName).ToNot(BeNil())
-				Expect(iamAPI.ListAccessKeysArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
-
-				Expect(iamAPI.ListAttachedUserPoliciesCallCount()).To(Equal(1))
-				Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0)).ToNot(BeNil())
-				Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0).UserName).ToNot(BeNil())
-				Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
-
-				Expect(iamAPI.DeleteAccessKeyCallCount()).To(Equal(1))
-				Expect(iamAPI.DeleteAccessKeyArgsForCall(0)).ToNot(BeNil())
-				Expect(iamAPI.DeleteAccessKeyArgsForCall(0).AccessKeyId).To(Equal(aws.String("key")))
-				Expect(iamAPI.DeleteAccessKeyArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
-
-				Expect(iamAPI.DetachUserPolicyCallCount()).To(Equal(1))
-				Expect(iamAPI.DetachUserPolicyArgsForCall(0)).ToNot(BeNil())
-				Expect(iamAPI.DetachUserPolicyArgsForCall(0).PolicyArn).To(Equal(aws.String("foo")))
-				Expect(iamAPI.DetachUserPolicyArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+				Expect(iamAPI.ListAccessKeysWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0)).UserName).ToNot(BeNil())
+				Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+
+				Expect(iamAPI.ListAttachedUserPoliciesWithContextCallCount()).To(Equal(1))
+
+				Expect(iamAPI.ListUserPoliciesWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0)).UserName).ToNot(BeNil())
+				Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+
+				Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(iamAPI.DeleteAccessKeyWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(iamAPI.DeleteAccessKeyWithContextArgsForCall(0)).AccessKeyId).To(Equal(aws.String("key")))
+				Expect(inputOf(iamAPI.DeleteAccessKeyWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+
+				Expect(iamAPI.DetachUserPolicyWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(iamAPI.DetachUserPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(iamAPI.DetachUserPolicyWithContextArgsForCall(0)).PolicyArn).To(Equal(aws.String("foo")))
+				Expect(inputOf(iamAPI.DetachUserPolicyWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
 			})
 
 			By("deleting the user", func() {
-				Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
-				Expect(iamAPI.DeleteUserArgsForCall(0)).ToNot(BeNil())
-				Expect(iamAPI.DeleteUserArgsForCall(0).UserName).ToNot(BeNil())
-				Expect(iamAPI.DeleteUserArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+				Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0))).ToNot(BeNil())
+				Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).ToNot(BeNil())
+				Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
 			})
 
 			// all calls accounted for
 			Expect(s3API.Invocations()).To(HaveLen(2))
-			Expect(iamAPI.Invocations()).To(HaveLen(5))
+			Expect(iamAPI.Invocations()).To(HaveLen(6))
 		})
 
 		Context("when getting the bucket policy fails for an unknown reason", func() {
 			It("passes through the unrecognized error", func() {
 				// Set up fake API
 				errGettingPolicy := errors.New("error-getting-policy")
-				s3API.GetBucketPolicyReturns(&awsS3.GetBucketPolicyOutput{}, errGettingPolicy)
+				s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{}, errGettingPolicy)
 
-				err := s3Client.RemoveUserFromBucketAndDeleteUser("some-user", "bucketName")
+				err := s3Client.RemoveUserFromBucketAndDeleteUser(context.Background(), "some-user", "bucketName")
 				Expect(err).To(MatchError(errGettingPolicy))
 
 				By("attempting to get the bucket policy", func() {
-					Expect(s3API.GetBucketPolicyCallCount()).To(Equal(1))
-					Expect(s3API.GetBucketPolicyArgsForCall(0)).ToNot(BeNil())
-					Expect(s3API.GetBucketPolicyArgsForCall(0).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
+					Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
 				})
 
 				// all calls accounted for
@@ -918,7 +1422,7 @@ var _ = Describe("Client", func() {
 			It("passes through the unrecognized error, having deleted the bucket policy", func() {
 				// Set up fake API
 				userArn := "arn:aws:iam::account-number:user/s3-broker/" + s3ClientConfig.ResourcePrefix + "some-user"
-				s3API.GetBucketPolicyReturns(&awsS3.GetBucketPolicyOutput{
+				s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
 					Policy: aws.String(fmt.Sprintf(`
 					{
 						"Statement": [
@@ -941,52 +1445,53 @@ var _ = Describe("Client", func() {
 					}`, userArn)),
 				}, nil)
 
-				s3API.DeleteBucketPolicyReturns(&awsS3.DeleteBucketPolicyOutput{}, nil)
 
 				errDeletingUser := errors.New("error-deleting-user")
-				iamAPI.ListAccessKeysReturns(&iam.ListAccessKeysOutput{}, nil)
-				iamAPI.ListAttachedUserPoliciesReturns(&iam.ListAttachedUserPoliciesOutput{}, nil)
-				iamAPI.DeleteUserReturns(&iam.DeleteUserOutput{}, errDeletingUser)
+				iamAPI.ListAccessKeysWithContextReturns(&iam.ListAccessKeysOutput{}, nil)
+				iamAPI.ListAttachedUserPoliciesWithContextReturns(&iam.ListAttachedUserPoliciesOutput{}, nil)
+				iamAPI.DeleteUserWithContextReturns(&iam.DeleteUserOutput{}, errDeletingUser)
 
-				err := s3Client.RemoveUserFromBucketAndDeleteUser("some-user", "bucketName")
+				err := s3Client.RemoveUserFromBucketAndDeleteUser(context.Background(), "some-user", "bucketName")
 				Expect(err).To(MatchError(errDeletingUser))
 
-				By("getting the bucket policy", func() {
-					Expect(s3API.GetBucketPolicyCallCount()).To(Equal(1))
-					Expect(s3API.GetBucketPolicyArgsForCall(0)).ToNot(BeNil())
-					Expect(s3API.GetBucketPolicyArgsForCall(0).Bucket).ToNot(BeNil())
-					Expect(s3API.GetBucketPolicyArgsForCall(0).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
+				By("getting the bucket policy, and again to check the update", func() {
+					Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(2))
+					Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).ToNot(BeNil())
+					Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
 				})
 
 				By("deleting the bucket policy", func() {
-					Expect(s3API.DeleteBucketPolicyCallCount()).To(Equal(1))
-					Expect(s3API.DeleteBucketPolicyArgsForCall(0)).ToNot(BeNil())
-					Expect(s3API.DeleteBucketPolicyArgsForCall(0).Bucket).ToNot(BeNil())
-					Expect(s3API.DeleteBucketPolicyArgsForCall(0).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
+					Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(s3API.DeleteBucketPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(s3API.DeleteBucketPolicyWithContextArgsForCall(0)).Bucket).ToNot(BeNil())
+					Expect(inputOf(s3API.DeleteBucketPolicyWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
 				})
 
 				By("checking for user keys and policies", func() {
-					Expect(iamAPI.ListAccessKeysCallCount()).To(Equal(1))
-					Expect(iamAPI.ListAccessKeysArgsForCall(0)).ToNot(BeNil())
-					Expect(iamAPI.ListAccessKeysArgsForCall(0).UserName).ToNot(BeNil())
-					Expect(iamAPI.ListAccessKeysArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
-
-					Expect(iamAPI.ListAttachedUserPoliciesCallCount()).To(Equal(1))
-					Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0)).ToNot(BeNil())
-					Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0).UserName).ToNot(BeNil())
-					Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+					Expect(iamAPI.ListAccessKeysWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0)).UserName).ToNot(BeNil())
+					Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+
+					Expect(iamAPI.ListAttachedUserPoliciesWithContextCallCount()).To(Equal(1))
+
+					Expect(iamAPI.ListUserPoliciesWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0)).UserName).ToNot(BeNil())
+					Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
 				})
 
 				By("attempting to delete the user", func() {
-					Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
-					Expect(iamAPI.DeleteUserArgsForCall(0)).ToNot(BeNil())
-					Expect(iamAPI.DeleteUserArgsForCall(0).UserName).ToNot(BeNil())
-					Expect(iamAPI.DeleteUserArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+					Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).ToNot(BeNil())
+					Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
 				})
 
 				// all calls accounted for
 				Expect(s3API.Invocations()).To(HaveLen(2))
-				Expect(iamAPI.Invocations()).To(HaveLen(3))
+				Expect(iamAPI.Invocations()).To(HaveLen(4))
 			})
 		})
 
@@ -995,7 +1500,7 @@ var _ = Describe("Client", func() {
 				It("deletes the bucket policy statement and returns no error", func() {
 					// Set up fake API
 					userArn := "arn:aws:iam::account-number:user/s3-broker/" + s3ClientConfig.ResourcePrefix + "some-user"
-					s3API.GetBucketPolicyReturns(&awsS3.GetBucketPolicyOutput{
+					s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
 						Policy: aws.String(fmt.Sprintf(`
 						{
 							"Statement": [
@@ -1018,48 +1523,50 @@ var _ = Describe("Client", func() {
 						}`, userArn)),
 					}, nil)
 
-					s3API.DeleteBucketPolicyReturns(&awsS3.DeleteBucketPolicyOutput{}, nil)
 
-					iamAPI.ListAccessKeysReturns(nil, awserr.New(iamErrorCode, "full error message", nil))
-					iamAPI.ListAttachedUserPoliciesReturns(nil, awserr.New(iamErrorCode, "full error message", nil))
-					iamAPI.DeleteUserReturns(nil, awserr.New(iamErrorCode, "full error message", nil))
+					iamAPI.ListAccessKeysWithContextReturns(nil, awserr.New(iamErrorCode, "full error message", nil))
+					iamAPI.ListAttachedUserPoliciesWithContextReturns(nil, awserr.New(iamErrorCode, "full error message", nil))
+					iamAPI.ListUserPoliciesWithContextReturns(nil, awserr.New(iamErrorCode, "full error message", nil))
+					iamAPI.DeleteUserWithContextReturns(nil, awserr.New(iamErrorCode, "full error message", nil))
 
-					err := s3Client.RemoveUserFromBucketAndDeleteUser("some-user", "bucketName")
+					err := s3Client.RemoveUserFromBucketAndDeleteUser(context.Background(), "some-user", "bucketName")
 					Expect(err).ToNot(HaveOccurred())
 
-					By("getting the bucket policy", func() {
-						Expect(s3API.GetBucketPolicyCallCount()).To(Equal(1))
-						Expect(s3API.GetBucketPolicyArgsForCall(0)).ToNot(BeNil())
-						Expect(s3API.GetBucketPolicyArgsForCall(0).Bucket).ToNot(BeNil())
-						Expect(s3API.GetBucketPolicyArgsForCall(0).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
+					By("getting the bucket policy, and again to check the update", func() {
+						Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(2))
+						Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+						Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).ToNot(BeNil())
+						Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
 					})
 
 					By("deleting the bucket policy", func() {
-						Expect(s3API.DeleteBucketPolicyCallCount()).To(Equal(1))
-						Expect(s3API.DeleteBucketPolicyArgsForCall(0)).ToNot(BeNil())
-						Expect(s3API.DeleteBucketPolicyArgsForCall(0).Bucket).ToNot(BeNil())
-						Expect(s3API.DeleteBucketPolicyArgsForCall(0).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
+						Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(1))
+						Expect(inputOf(s3API.DeleteBucketPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+						Expect(inputOf(s3API.DeleteBucketPolicyWithContextArgsForCall(0)).Bucket).ToNot(BeNil())
+						Expect(inputOf(s3API.DeleteBucketPolicyWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
 					})
 
 					By("checking for user keys and policies", func() {
-						Expect(iamAPI.ListAccessKeysCallCount()).To(Equal(1))
-						Expect(iamAPI.ListAccessKeysArgsForCall(0)).ToNot(BeNil())
-						Expect(iamAPI.ListAccessKeysArgsForCall(0).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
+						Expect(iamAPI.ListAccessKeysWithContextCallCount()).To(Equal(1))
+						Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0))).ToNot(BeNil())
+						Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0)).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
+
+						Expect(iamAPI.ListAttachedUserPoliciesWithContextCallCount()).To(Equal(1))
 
-						Expect(iamAPI.ListAttachedUserPoliciesCallCount()).To(Equal(1))
-						Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0)).ToNot(BeNil())
-						Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
+						Expect(iamAPI.ListUserPoliciesWithContextCallCount()).To(Equal(1))
+						Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0))).ToNot(BeNil())
+						Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0)).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
 					})
 
 					By("attempting to delete the user", func() {
-						Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
-						Expect(iamAPI.DeleteUserArgsForCall(0)).ToNot(BeNil())
-						Expect(iamAPI.DeleteUserArgsForCall(0).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
+						Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+						Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0))).ToNot(BeNil())
+						Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
 					})
 
 					// all calls accounted for
 					Expect(s3API.Invocations()).To(HaveLen(2))
-					Expect(iamAPI.Invocations()).To(HaveLen(3))
+					Expect(iamAPI.Invocations()).To(HaveLen(4))
 				})
 			})
 		}
@@ -1069,7 +1576,7 @@ var _ = Describe("Client", func() {
 		Context("when the expected user and policy statement don't exist", func() {
 			It("returns ErrNoSuchResources", func() {
 				// Set up fake API
-				s3API.GetBucketPolicyReturns(&awsS3.GetBucketPolicyOutput{
+				s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
 					Policy: aws.String(`
 						{
 							"Statement": [
@@ -1091,38 +1598,41 @@ var _ = Describe("Client", func() {
 							]
 						}`),
 				}, nil)
-				iamAPI.ListAccessKeysReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "full error message", nil))
-				iamAPI.ListAttachedUserPoliciesReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "full error message", nil))
-				iamAPI.DeleteUserReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "full error message", nil))
+				iamAPI.ListAccessKeysWithContextReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "full error message", nil))
+				iamAPI.ListAttachedUserPoliciesWithContextReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "full error message", nil))
+				iamAPI.ListUserPoliciesWithContextReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "full error message", nil))
+				iamAPI.DeleteUserWithContextReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "full error message", nil))
 
-				err := s3Client.RemoveUserFromBucketAndDeleteUser("some-user", "bucketName")
+				err := s3Client.RemoveUserFromBucketAndDeleteUser(context.Background(), "some-user", "bucketName")
 				Expect(err).To(MatchError(s3.ErrNoSuchResources))
 
 				By("getting the bucket policy", func() {
-					Expect(s3API.GetBucketPolicyCallCount()).To(Equal(1))
-					Expect(s3API.GetBucketPolicyArgsForCall(0)).ToNot(BeNil())
-					Expect(s3API.GetBucketPolicyArgsForCall(0).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
+					Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
 				})
 
 				By("checking for user keys and policies", func() {
-					Expect(iamAPI.ListAccessKeysCallCount()).To(Equal(1))
-					Expect(iamAPI.ListAccessKeysArgsForCall(0)).ToNot(BeNil())
-					Expect(iamAPI.ListAccessKeysArgsForCall(0).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
+					Expect(iamAPI.ListAccessKeysWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0)).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
 
-					Expect(iamAPI.ListAttachedUserPoliciesCallCount()).To(Equal(1))
-					Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0)).ToNot(BeNil())
-					Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
+					Expect(iamAPI.ListAttachedUserPoliciesWithContextCallCount()).To(Equal(1))
+
+					Expect(iamAPI.ListUserPoliciesWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0)).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
 				})
 
 				By("attempting to delete the user", func() {
-					Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
-					Expect(iamAPI.DeleteUserArgsForCall(0)).ToNot(BeNil())
-					Expect(iamAPI.DeleteUserArgsForCall(0).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
+					Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
 				})
 
 				// all calls accounted for
 				Expect(s3API.Invocations()).To(HaveLen(1))
-				Expect(iamAPI.Invocations()).To(HaveLen(3))
+				Expect(iamAPI.Invocations()).To(HaveLen(4))
 			})
 		})
 
@@ -1130,39 +1640,42 @@ var _ = Describe("Client", func() {
 			Context(fmt.Sprintf("when the expected user and the bucket policy don't exist (user %s)", iamErrorCode), func() {
 				It("returns ErrNoSuchResources", func() {
 					// Set up fake API
-					s3API.GetBucketPolicyReturns(nil, awserr.New("NoSuchBucketPolicy", "full error message", nil))
-					iamAPI.ListAccessKeysReturns(nil, awserr.New(iamErrorCode, "full error message", nil))
-					iamAPI.ListAttachedUserPoliciesReturns(nil, awserr.New(iamErrorCode, "full error message", nil))
-					iamAPI.DeleteUserReturns(&iam.DeleteUserOutput{}, awserr.New(iamErrorCode, "full error message", nil))
+					s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "full error message", nil))
+					iamAPI.ListAccessKeysWithContextReturns(nil, awserr.New(iamErrorCode, "full error message", nil))
+					iamAPI.ListAttachedUserPoliciesWithContextReturns(nil, awserr.New(iamErrorCode, "full error message", nil))
+					iamAPI.ListUserPoliciesWithContextReturns(nil, awserr.New(iamErrorCode, "full error message", nil))
+					iamAPI.DeleteUserWithContextReturns(&iam.DeleteUserOutput{}, awserr.New(iamErrorCode, "full error message", nil))
 
-					err := s3Client.RemoveUserFromBucketAndDeleteUser("some-user", "bucketName")
+					err := s3Client.RemoveUserFromBucketAndDeleteUser(context.Background(), "some-user", "bucketName")
 					Expect(err).To(MatchError(s3.ErrNoSuchResources))
 
 					By("attempting to get the bucket policy", func() {
-						Expect(s3API.GetBucketPolicyCallCount()).To(Equal(1))
-						Expect(s3API.GetBucketPolicyArgsForCall(0)).ToNot(BeNil())
-						Expect(s3API.GetBucketPolicyArgsForCall(0).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
+						Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(1))
+						Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+						Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
 					})
 
 					By("checking for user keys and policies", func() {
-						Expect(iamAPI.ListAccessKeysCallCount()).To(Equal(1))
-						Expect(iamAPI.ListAccessKeysArgsForCall(0)).ToNot(BeNil())
-						Expect(iamAPI.ListAccessKeysArgsForCall(0).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
+						Expect(iamAPI.ListAccessKeysWithContextCallCount()).To(Equal(1))
+						Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0))).ToNot(BeNil())
+						Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0)).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
 
-						Expect(iamAPI.ListAttachedUserPoliciesCallCount()).To(Equal(1))
-						Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0)).ToNot(BeNil())
-						Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
+						Expect(iamAPI.ListAttachedUserPoliciesWithContextCallCount()).To(Equal(1))
+
+						Expect(iamAPI.ListUserPoliciesWithContextCallCount()).To(Equal(1))
+						Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0))).ToNot(BeNil())
+						Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0)).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
 					})
 
 					By("attempting to delete the user", func() {
-						Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
-						Expect(iamAPI.DeleteUserArgsForCall(0)).ToNot(BeNil())
-						Expect(iamAPI.DeleteUserArgsForCall(0).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
+						Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+						Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0))).ToNot(BeNil())
+						Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
 					})
 
 					// all calls accounted for
 					Expect(s3API.Invocations()).To(HaveLen(1))
-					Expect(iamAPI.Invocations()).To(HaveLen(3))
+					Expect(iamAPI.Invocations()).To(HaveLen(4))
 				})
 			})
 		}
@@ -1172,9 +1685,9 @@ var _ = Describe("Client", func() {
 		Context("when the bucket policy statement doesn't exist but the user does", func() {
 			It("still deletes the user and returns no error", func() {
 				// Set up fake API
-				s3API.GetBucketPolicyReturns(nil, awserr.New("NoSuchBucketPolicy", "full error message", nil))
-				iamAPI.ListAccessKeysReturns(&iam.ListAccessKeysOutput{}, nil)
-				iamAPI.ListAttachedUserPoliciesReturns(&iam.ListAttachedUserPoliciesOutput{
+				s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "full error message", nil))
+				iamAPI.ListAccessKeysWithContextReturns(&iam.ListAccessKeysOutput{}, nil)
+				iamAPI.ListAttachedUserPoliciesWithContextReturns(&iam.ListAttachedUserPoliciesOutput{
 					AttachedPolicies: []*iam.AttachedPolicy{
 						{
 							PolicyArn:  aws.String("foo"),
@@ -1182,42 +1695,44 @@ var _ = Describe("Client", func() {
 						},
 					},
 				}, nil)
-				iamAPI.DeleteAccessKeyReturns(nil, nil)
+				iamAPI.DeleteAccessKeyWithContextReturns(nil, nil)
 
-				err := s3Client.RemoveUserFromBucketAndDeleteUser("some-user", "bucketName")
+				err := s3Client.RemoveUserFromBucketAndDeleteUser(context.Background(), "some-user", "bucketName")
 				Expect(err).ToNot(HaveOccurred())
 
 				By("attempting to get the bucket policy", func() {
-					Expect(s3API.GetBucketPolicyCallCount()).To(Equal(1))
-					Expect(s3API.GetBucketPolicyArgsForCall(0)).ToNot(BeNil())
-					Expect(s3API.GetBucketPolicyArgsForCall(0).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
+					Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "bucketName")))
 				})
 
 				By("deleting user keys and policies", func() {
-					Expect(iamAPI.ListAccessKeysCallCount()).To(Equal(1))
-					Expect(iamAPI.ListAccessKeysArgsForCall(0)).ToNot(BeNil())
-					Expect(iamAPI.ListAccessKeysArgsForCall(0).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
-
-					Expect(iamAPI.ListAttachedUserPoliciesCallCount()).To(Equal(1))
-					Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0)).ToNot(BeNil())
-					Expect(iamAPI.ListAttachedUserPoliciesArgsForCall(0).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
-
-					Expect(iamAPI.DetachUserPolicyCallCount()).To(Equal(1))
-					Expect(iamAPI.DetachUserPolicyArgsForCall(0)).ToNot(BeNil())
-					Expect(iamAPI.DetachUserPolicyArgsForCall(0).PolicyArn).To(Equal(aws.String("foo")))
-					Expect(iamAPI.DetachUserPolicyArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+					Expect(iamAPI.ListAccessKeysWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(iamAPI.ListAccessKeysWithContextArgsForCall(0)).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
+
+					Expect(iamAPI.ListAttachedUserPoliciesWithContextCallCount()).To(Equal(1))
+
+					Expect(iamAPI.ListUserPoliciesWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(iamAPI.ListAttachedUserPoliciesWithContextArgsForCall(0)).UserName).To(Equal(aws.String("test-bucket-prefix-some-user")))
+
+					Expect(iamAPI.DetachUserPolicyWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(iamAPI.DetachUserPolicyWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(iamAPI.DetachUserPolicyWithContextArgsForCall(0)).PolicyArn).To(Equal(aws.String("foo")))
+					Expect(inputOf(iamAPI.DetachUserPolicyWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
 				})
 
 				By("deleting the user", func() {
-					Expect(iamAPI.DeleteUserCallCount()).To(Equal(1))
-					Expect(iamAPI.DeleteUserArgsForCall(0)).ToNot(BeNil())
-					Expect(iamAPI.DeleteUserArgsForCall(0).UserName).ToNot(BeNil())
-					Expect(iamAPI.DeleteUserArgsForCall(0).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
+					Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+					Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0))).ToNot(BeNil())
+					Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).ToNot(BeNil())
+					Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).To(Equal(aws.String(s3ClientConfig.ResourcePrefix + "some-user")))
 				})
 
 				// all calls accounted for
 				Expect(s3API.Invocations()).To(HaveLen(1))
-				Expect(iamAPI.Invocations()).To(HaveLen(4))
+				Expect(iamAPI.Invocations()).To(HaveLen(5))
 			})
 		})
 	})
@@ -0,0 +1,138 @@
+package s3
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+const (
+	// Bucket names are the resource prefix followed by a 36 character
+	// instance GUID, and may be at most 63 characters long. IAM user and
+	// role names, the prefix and a binding GUID, may be 64.
+	guidLength              = 36
+	maxBucketNameLength     = 63
+	maxResourcePrefixLength = maxBucketNameLength - guidLength
+	maxIAMPathLength        = 512
+)
+
+var (
+	awsRegionPattern      = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+	resourcePrefixPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	iamPathPattern        = regexp.MustCompile(`^[\x21-\x7e]+$`)
+)
+
+// Validate checks the configuration for mistakes which would otherwise only
+// show up when a tenant's provision or bind fails. It returns every problem
+// it finds, not just the first.
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.AWSRegion == "" {
+		errs = append(errs, errors.New("aws_region must be set"))
+	} else if c.S3Endpoint == "" && c.IAMEndpoint == "" && !awsRegionPattern.MatchString(c.AWSRegion) {
+		// S3 compatible backends have their own region names, so only
+		// AWS regions are checked.
+		errs = append(errs, fmt.Errorf("aws_region %q is not an AWS region, such as eu-west-2", c.AWSRegion))
+	}
+	if c.AWSPartition != "" && !containsString(partitions, c.AWSPartition) {
+		errs = append(errs, fmt.Errorf("aws_partition %q must be one of %s", c.AWSPartition, strings.Join(partitions, ", ")))
+	}
+	partition := c.Partition()
+
+	switch {
+	case c.ResourcePrefix == "":
+		errs = append(errs, errors.New("resource_prefix must be set"))
+	case len(c.ResourcePrefix) > maxResourcePrefixLength:
+		errs = append(errs, fmt.Errorf(
+			"resource_prefix %q is %d characters long, but may be at most %d so that bucket names fit in %d characters",
+			c.ResourcePrefix, len(c.ResourcePrefix), maxResourcePrefixLength, maxBucketNameLength,
+		))
+	case !resourcePrefixPattern.MatchString(c.ResourcePrefix):
+		errs = append(errs, fmt.Errorf(
+			"resource_prefix %q must start with a lowercase letter or digit, and contain only lowercase letters, digits and hyphens",
+			c.ResourcePrefix,
+		))
+	case strings.HasPrefix(c.ResourcePrefix, "xn--"):
+		errs = append(errs, fmt.Errorf("resource_prefix %q must not start with \"xn--\"", c.ResourcePrefix))
+	}
+
+	iamPath := strings.Trim(c.IAMUserPath, "/")
+	switch {
+	case iamPath == "":
+		errs = append(errs, errors.New("iam_user_path must be set"))
+	case len(iamPath)+2 > maxIAMPathLength:
+		errs = append(errs, fmt.Errorf("iam_user_path %q may be at most %d characters long", c.IAMUserPath, maxIAMPathLength))
+	case !iamPathPattern.MatchString(iamPath) || strings.Contains(iamPath, "//"):
+		errs = append(errs, fmt.Errorf("iam_user_path %q must be in \"/path/\" format, with only printable ASCII characters and no spaces", c.IAMUserPath))
+	}
+
+	if c.IpRestrictionPolicyARN != "" {
+		errs = append(errs, validateARN("iam_ip_restriction_policy_arn", c.IpRestrictionPolicyARN, partition, "iam", "policy/"))
+	}
+	if c.CommonUserPolicyARN != "" {
+		errs = append(errs, validateARN("iam_common_user_policy_arn", c.CommonUserPolicyARN, partition, "iam", "policy/"))
+	}
+	if c.PermissionsBoundaryARN != "" {
+		errs = append(errs, validateARN("iam_user_permissions_boundary_arn", c.PermissionsBoundaryARN, partition, "iam", "policy/"))
+	}
+	if c.KMSKeyARN != "" {
+		errs = append(errs, validateARN("kms_key_arn", c.KMSKeyARN, partition, "kms", "key/"))
+	}
+	if c.AssumeRolePrincipalARN != "" {
+		errs = append(errs, validateARN("assume_role_principal_arn", c.AssumeRolePrincipalARN, partition, "iam", ""))
+	}
+
+	if c.S3Endpoint != "" {
+		errs = append(errs, validateEndpoint("s3_endpoint", c.S3Endpoint))
+	}
+	if c.IAMEndpoint != "" {
+		errs = append(errs, validateEndpoint("iam_endpoint", c.IAMEndpoint))
+	}
+	if c.AWSCACert != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(c.AWSCACert)) {
+		errs = append(errs, errors.New("aws_ca_cert does not contain any PEM certificates"))
+	}
+
+	if c.SoftDeleteRetentionDays < 0 {
+		errs = append(errs, fmt.Errorf("soft_delete_retention_days must not be negative, but is %d", c.SoftDeleteRetentionDays))
+	}
+	if c.AccessKeyRotationOverlapHours < 0 {
+		errs = append(errs, fmt.Errorf("access_key_rotation_overlap_hours must not be negative, but is %d", c.AccessKeyRotationOverlapHours))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateARN checks that value is an ARN for the given service in the
+// broker's partition, and that its resource starts with resourcePrefix.
+func validateARN(field, value, partition, service, resourcePrefix string) error {
+	parsed, err := arn.Parse(value)
+	if err != nil {
+		return fmt.Errorf("%s %q is not an ARN: %w", field, value, err)
+	}
+	if parsed.Service != service || !strings.HasPrefix(parsed.Resource, resourcePrefix) {
+		return fmt.Errorf("%s %q is not an ARN of the form arn:%s:%s:...:%s...", field, value, partition, service, resourcePrefix)
+	}
+	if parsed.Partition != partition {
+		return fmt.Errorf("%s %q is in the %q partition, but the broker is in %q", field, value, parsed.Partition, partition)
+	}
+	return nil
+}
+
+// validateEndpoint checks that value is the URL of a service, such as
+// https://minio.internal:9000.
+func validateEndpoint(field, value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("%s %q is not a URL: %w", field, value, err)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%s %q must be an http or https URL with a host", field, value)
+	}
+	return nil
+}
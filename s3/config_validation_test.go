@@ -0,0 +1,166 @@
+package s3_test
+
+import (
+	"strings"
+
+	"github.com/alphagov/paas-s3-broker/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config.Validate", func() {
+	var config *s3.Config
+
+	BeforeEach(func() {
+		config = &s3.Config{
+			AWSRegion:              "eu-west-2",
+			ResourcePrefix:         "paas-s3-broker-",
+			IAMUserPath:            "/paas-s3-broker/",
+			IpRestrictionPolicyARN: "arn:aws:iam::123456789012:policy/ip-restriction",
+			CommonUserPolicyARN:    "arn:aws:iam::123456789012:policy/common-user",
+			PermissionsBoundaryARN: "arn:aws:iam::123456789012:policy/boundary",
+			KMSKeyARN:              "arn:aws:kms:eu-west-2:123456789012:key/operator-key-id",
+		}
+	})
+
+	It("accepts a valid configuration", func() {
+		Expect(config.Validate()).To(Succeed())
+	})
+
+	It("accepts regions in other partitions, with ARNs in the same partition", func() {
+		config.AWSRegion = "us-gov-west-1"
+		config.IpRestrictionPolicyARN = "arn:aws-us-gov:iam::123456789012:policy/ip-restriction"
+		config.CommonUserPolicyARN = "arn:aws-us-gov:iam::123456789012:policy/common-user"
+		config.PermissionsBoundaryARN = "arn:aws-us-gov:iam::123456789012:policy/boundary"
+		config.KMSKeyARN = "arn:aws-us-gov:kms:us-gov-west-1:123456789012:key/operator-key-id"
+		Expect(config.Validate()).To(Succeed())
+	})
+
+	It("rejects ARNs in a different partition to the region", func() {
+		config.AWSRegion = "cn-north-1"
+		config.IpRestrictionPolicyARN = "arn:aws-cn:iam::123456789012:policy/ip-restriction"
+		config.CommonUserPolicyARN = "arn:aws-cn:iam::123456789012:policy/common-user"
+		config.PermissionsBoundaryARN = "arn:aws-cn:iam::123456789012:policy/boundary"
+
+		err := config.Validate()
+		Expect(err).To(MatchError(ContainSubstring(`kms_key_arn "arn:aws:kms:eu-west-2:123456789012:key/operator-key-id" is in the "aws" partition, but the broker is in "aws-cn"`)))
+		Expect(err).NotTo(MatchError(ContainSubstring("iam_ip_restriction_policy_arn")))
+	})
+
+	It("checks ARNs against an explicit partition", func() {
+		config.AWSPartition = "aws-us-gov"
+		Expect(config.Validate()).To(MatchError(ContainSubstring(`but the broker is in "aws-us-gov"`)))
+	})
+
+	It("rejects an unknown partition", func() {
+		config.AWSPartition = "aws-moon"
+		Expect(config.Validate()).To(MatchError(ContainSubstring(`aws_partition "aws-moon" must be one of aws, aws-cn, aws-us-gov, aws-iso, aws-iso-b`)))
+	})
+
+	It("reports every problem at once", func() {
+		config.AWSRegion = ""
+		config.IpRestrictionPolicyARN = ""
+		config.IAMUserPath = ""
+
+		err := config.Validate()
+		Expect(err).To(MatchError(ContainSubstring("aws_region must be set")))
+		Expect(err).NotTo(MatchError(ContainSubstring("iam_ip_restriction_policy_arn")))
+		Expect(err).To(MatchError(ContainSubstring("iam_user_path must be set")))
+	})
+
+	It("rejects a region which is not a region", func() {
+		config.AWSRegion = "London"
+		Expect(config.Validate()).To(MatchError(ContainSubstring(`aws_region "London" is not an AWS region`)))
+	})
+
+	It("rejects a resource prefix which would make bucket names too long", func() {
+		config.ResourcePrefix = strings.Repeat("a", 28)
+		Expect(config.Validate()).To(MatchError(ContainSubstring("may be at most 27")))
+
+		config.ResourcePrefix = strings.Repeat("a", 27)
+		Expect(config.Validate()).To(Succeed())
+	})
+
+	DescribeTable("rejects resource prefixes which are not valid in bucket names",
+		func(prefix string) {
+			config.ResourcePrefix = prefix
+			Expect(config.Validate()).To(MatchError(ContainSubstring("resource_prefix")))
+		},
+		Entry("empty", ""),
+		Entry("uppercase", "PaaS-"),
+		Entry("underscore", "paas_s3_"),
+		Entry("full stop", "paas.s3-"),
+		Entry("leading hyphen", "-paas-"),
+		Entry("punycode", "xn--paas-"),
+	)
+
+	DescribeTable("rejects IAM paths which IAM would",
+		func(path string) {
+			config.IAMUserPath = path
+			Expect(config.Validate()).To(MatchError(ContainSubstring("iam_user_path")))
+		},
+		Entry("a space", "/paas s3/"),
+		Entry("an empty segment", "/paas//s3/"),
+		Entry("too long", "/"+strings.Repeat("a", 511)+"/"),
+	)
+
+	It("does not require an IP restriction policy", func() {
+		config.IpRestrictionPolicyARN = ""
+		Expect(config.Validate()).To(Succeed())
+	})
+
+	It("accepts an IAM path without slashes around it", func() {
+		config.IAMUserPath = "paas-s3-broker"
+		Expect(config.Validate()).To(Succeed())
+	})
+
+	It("rejects malformed ARNs", func() {
+		config.IpRestrictionPolicyARN = "something"
+		config.CommonUserPolicyARN = "arn:aws:iam::123456789012:user/common-user"
+		config.KMSKeyARN = "arn:aws:kms:eu-west-2:123456789012:alias/key"
+		config.AssumeRolePrincipalARN = "arn:aws:s3:::bucket"
+
+		err := config.Validate()
+		Expect(err).To(MatchError(ContainSubstring(`iam_ip_restriction_policy_arn "something" is not an ARN`)))
+		Expect(err).To(MatchError(ContainSubstring("iam_common_user_policy_arn")))
+		Expect(err).NotTo(MatchError(ContainSubstring("iam_user_permissions_boundary_arn")))
+		Expect(err).To(MatchError(ContainSubstring("kms_key_arn")))
+		Expect(err).To(MatchError(ContainSubstring("assume_role_principal_arn")))
+	})
+
+	It("accepts custom endpoints", func() {
+		config.S3Endpoint = "https://minio.internal:9000"
+		config.IAMEndpoint = "http://localhost:4566"
+		config.S3ForcePathStyle = true
+		Expect(config.Validate()).To(Succeed())
+	})
+
+	It("accepts any region name with a custom endpoint", func() {
+		config.S3Endpoint = "https://minio.internal:9000"
+		config.AWSRegion = "minio"
+		Expect(config.Validate()).To(Succeed())
+	})
+
+	It("rejects endpoints which are not URLs", func() {
+		config.S3Endpoint = "minio.internal:9000"
+		config.IAMEndpoint = "ftp://iam.internal"
+
+		err := config.Validate()
+		Expect(err).To(MatchError(ContainSubstring(`s3_endpoint "minio.internal:9000"`)))
+		Expect(err).To(MatchError(ContainSubstring(`iam_endpoint "ftp://iam.internal" must be an http or https URL`)))
+	})
+
+	It("rejects a CA certificate which is not PEM", func() {
+		config.AWSCACert = "not a certificate"
+		Expect(config.Validate()).To(MatchError(ContainSubstring("aws_ca_cert does not contain any PEM certificates")))
+	})
+
+	It("rejects negative durations", func() {
+		config.SoftDeleteRetentionDays = -1
+		config.AccessKeyRotationOverlapHours = -1
+
+		err := config.Validate()
+		Expect(err).To(MatchError(ContainSubstring("soft_delete_retention_days")))
+		Expect(err).To(MatchError(ContainSubstring("access_key_rotation_overlap_hours")))
+	})
+})
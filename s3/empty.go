@@ -0,0 +1,191 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	forceDeleteTagKey = "force_delete"
+
+	// maxDeleteObjectsBatch is the most keys S3 accepts in one
+	// DeleteObjects request.
+	maxDeleteObjectsBatch = 1000
+)
+
+var ErrForceDeleteNotAllowed = errors.New("force_delete is not enabled on this broker")
+
+func (s *S3Client) validateForceDelete(forceDelete bool) error {
+	if forceDelete && !s.allowForceDelete {
+		return ErrForceDeleteNotAllowed
+	}
+	return nil
+}
+
+func forceDeleteTag(forceDelete bool) *s3.Tag {
+	return &s3.Tag{
+		Key:   aws.String(forceDeleteTagKey),
+		Value: aws.String(strconv.FormatBool(forceDelete)),
+	}
+}
+
+// ForceDeleteEnabled reports whether the tenant has asked for the bucket to
+// be emptied when the service instance is deleted. The operator can turn
+// this off for all buckets at once with `allow_force_delete`.
+func (s *S3Client) ForceDeleteEnabled(ctx context.Context, instanceID string) (bool, error) {
+	logger := s.logger.Session("force-delete-enabled")
+	bucketName := s.buildBucketName(instanceID)
+
+	if !s.allowForceDelete {
+		return false, nil
+	}
+
+	logger.Info("get-bucket-tagging", lager.Data{"bucket": bucketName})
+	getBucketTaggingOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.GetBucketTaggingOutput, error) {
+		return s.s3Client.GetBucketTaggingWithContext(ctx, &s3.GetBucketTaggingInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			switch awsErr.Code() {
+			case "NoSuchBucket":
+				return false, ErrNoSuchResources
+			case "NoSuchTagSet":
+				return false, nil
+			}
+		}
+		logger.Error("get-bucket-tagging", err)
+		return false, classifyAWSError(err)
+	}
+
+	for _, tag := range getBucketTaggingOutput.TagSet {
+		if aws.StringValue(tag.Key) == forceDeleteTagKey {
+			return aws.StringValue(tag.Value) == "true", nil
+		}
+	}
+	return false, nil
+}
+
+// EmptyBucket deletes every object version, delete marker and incomplete
+// multipart upload in the bucket, so that the bucket itself can be deleted.
+// progress is called with the running total of objects deleted after each
+// batch. It lists one page at a time, as emptying a large bucket takes far
+// longer than the client's timeout for a single call.
+func (s *S3Client) EmptyBucket(ctx context.Context, instanceID string, progress func(objectsDeleted int)) error {
+	logger := s.logger.Session("empty-bucket")
+	bucketName := s.buildBucketName(instanceID)
+
+	logger.Info("abort-multipart-uploads", lager.Data{"bucket": bucketName})
+	var uploadKeyMarker, uploadIDMarker *string
+	for {
+		page, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.ListMultipartUploadsOutput, error) {
+			return s.s3Client.ListMultipartUploadsWithContext(ctx, &s3.ListMultipartUploadsInput{
+				Bucket:         aws.String(bucketName),
+				KeyMarker:      uploadKeyMarker,
+				UploadIdMarker: uploadIDMarker,
+			})
+		})
+		if err != nil {
+			logger.Error("abort-multipart-uploads", err)
+			return err
+		}
+		for _, upload := range page.Uploads {
+			_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.AbortMultipartUploadOutput, error) {
+				return s.s3Client.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+					Bucket:   aws.String(bucketName),
+					Key:      upload.Key,
+					UploadId: upload.UploadId,
+				})
+			})
+			if err != nil {
+				logger.Error("abort-multipart-uploads", err)
+				return err
+			}
+		}
+		if !aws.BoolValue(page.IsTruncated) {
+			break
+		}
+		uploadKeyMarker = page.NextKeyMarker
+		uploadIDMarker = page.NextUploadIdMarker
+	}
+
+	logger.Info("delete-object-versions", lager.Data{"bucket": bucketName})
+	objectsDeleted := 0
+	var keyMarker, versionIDMarker *string
+	for {
+		page, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.ListObjectVersionsOutput, error) {
+			return s.s3Client.ListObjectVersionsWithContext(ctx, &s3.ListObjectVersionsInput{
+				Bucket:          aws.String(bucketName),
+				MaxKeys:         aws.Int64(maxDeleteObjectsBatch),
+				KeyMarker:       keyMarker,
+				VersionIdMarker: versionIDMarker,
+			})
+		})
+		if err != nil {
+			logger.Error("delete-object-versions", err)
+			return err
+		}
+
+		objects := []*s3.ObjectIdentifier{}
+		for _, version := range page.Versions {
+			objects = append(objects, &s3.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
+		}
+		for _, marker := range page.DeleteMarkers {
+			objects = append(objects, &s3.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+		}
+
+		for start := 0; start < len(objects); start += maxDeleteObjectsBatch {
+			end := start + maxDeleteObjectsBatch
+			if end > len(objects) {
+				end = len(objects)
+			}
+			err = s.deleteObjects(ctx, bucketName, objects[start:end])
+			if err != nil {
+				logger.Error("delete-object-versions", err)
+				return err
+			}
+			objectsDeleted += end - start
+			progress(objectsDeleted)
+		}
+
+		if !aws.BoolValue(page.IsTruncated) {
+			break
+		}
+		keyMarker = page.NextKeyMarker
+		versionIDMarker = page.NextVersionIdMarker
+	}
+
+	logger.Info("emptied-bucket", lager.Data{"bucket": bucketName, "objects-deleted": objectsDeleted})
+	return nil
+}
+
+func (s *S3Client) deleteObjects(ctx context.Context, bucketName string, objects []*s3.ObjectIdentifier) error {
+	deleteObjectsOutput, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*s3.DeleteObjectsOutput, error) {
+		return s.s3Client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if len(deleteObjectsOutput.Errors) > 0 {
+		first := deleteObjectsOutput.Errors[0]
+		return fmt.Errorf(
+			"failed to delete %d objects, including %s: %s",
+			len(deleteObjectsOutput.Errors), aws.StringValue(first.Key), aws.StringValue(first.Message),
+		)
+	}
+	return nil
+}
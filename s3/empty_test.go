@@ -0,0 +1,237 @@
+package s3_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-service-broker-base/provider"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/brokerapi/v10/domain"
+)
+
+var _ = Describe("Force delete", func() {
+	var (
+		s3API          *fakeClient.FakeS3API
+		iamAPI         *fakeClient.FakeIAMAPI
+		kmsAPI         *fakeClient.FakeKMSAPI
+		s3Client       *s3.S3Client
+		s3ClientConfig *s3.Config
+	)
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
+		s3ClientConfig = &s3.Config{
+			AWSRegion:        "eu-west-2",
+			ResourcePrefix:   "test-bucket-prefix-",
+			AllowForceDelete: true,
+		}
+		s3API.GetBucketTaggingWithContextReturns(&awsS3.GetBucketTaggingOutput{}, nil)
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			s3ClientConfig,
+			s3API,
+			iamAPI,
+			kmsAPI,
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	forceDeleteTag := func(tags []*awsS3.Tag) *awsS3.Tag {
+		for _, tag := range tags {
+			if aws.StringValue(tag.Key) == "force_delete" {
+				return tag
+			}
+		}
+		return nil
+	}
+
+	Describe("the force_delete parameter", func() {
+		It("tags the bucket when provisioning", func() {
+			err := s3Client.CreateBucket(context.Background(), provider.ProvisionData{
+				InstanceID: "test-instance-id",
+				Details: domain.ProvisionDetails{
+					RawParameters: json.RawMessage(`{"force_delete": true}`),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			tags := inputOf(s3API.PutBucketTaggingWithContextArgsForCall(0)).Tagging.TagSet
+			Expect(forceDeleteTag(tags).Value).To(HaveValue(Equal("true")))
+		})
+
+		It("does not tag the bucket by default", func() {
+			err := s3Client.CreateBucket(context.Background(), provider.ProvisionData{InstanceID: "test-instance-id"})
+			Expect(err).NotTo(HaveOccurred())
+
+			tags := inputOf(s3API.PutBucketTaggingWithContextArgsForCall(0)).Tagging.TagSet
+			Expect(forceDeleteTag(tags)).To(BeNil())
+		})
+
+		It("can be turned off with an update", func() {
+			s3API.GetBucketTaggingWithContextReturns(&awsS3.GetBucketTaggingOutput{
+				TagSet: []*awsS3.Tag{{Key: aws.String("force_delete"), Value: aws.String("true")}},
+			}, nil)
+
+			err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+				InstanceID: "test-instance-id",
+				Details: domain.UpdateDetails{
+					RawParameters: json.RawMessage(`{"force_delete": false}`),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			tags := inputOf(s3API.PutBucketTaggingWithContextArgsForCall(0)).Tagging.TagSet
+			Expect(forceDeleteTag(tags).Value).To(HaveValue(Equal("false")))
+		})
+
+		Context("when the operator has not allowed it", func() {
+			BeforeEach(func() {
+				s3ClientConfig.AllowForceDelete = false
+			})
+
+			It("is rejected when provisioning", func() {
+				err := s3Client.ValidateProvisionParams(provider.ProvisionData{
+					Details: domain.ProvisionDetails{
+						RawParameters: json.RawMessage(`{"force_delete": true}`),
+					},
+				})
+				Expect(err).To(MatchError(s3.ErrForceDeleteNotAllowed))
+			})
+
+			It("is rejected when updating", func() {
+				err := s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+					Details: domain.UpdateDetails{
+						RawParameters: json.RawMessage(`{"force_delete": true}`),
+					},
+				})
+				Expect(err).To(MatchError(s3.ErrForceDeleteNotAllowed))
+				Expect(s3API.Invocations()).To(BeEmpty())
+			})
+
+			It("is ignored on existing buckets", func() {
+				enabled, err := s3Client.ForceDeleteEnabled(context.Background(), "test-instance-id")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(enabled).To(BeFalse())
+				Expect(s3API.Invocations()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("ForceDeleteEnabled", func() {
+		It("is true when the bucket is tagged", func() {
+			s3API.GetBucketTaggingWithContextReturns(&awsS3.GetBucketTaggingOutput{
+				TagSet: []*awsS3.Tag{{Key: aws.String("force_delete"), Value: aws.String("true")}},
+			}, nil)
+
+			enabled, err := s3Client.ForceDeleteEnabled(context.Background(), "test-instance-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+			Expect(inputOf(s3API.GetBucketTaggingWithContextArgsForCall(0)).Bucket).To(HaveValue(Equal("test-bucket-prefix-test-instance-id")))
+		})
+
+		It("is false when the bucket is not tagged", func() {
+			enabled, err := s3Client.ForceDeleteEnabled(context.Background(), "test-instance-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(enabled).To(BeFalse())
+		})
+
+		It("returns ErrNoSuchResources if there is no bucket", func() {
+			s3API.GetBucketTaggingWithContextReturns(nil, awserr.New("NoSuchBucket", "The specified bucket does not exist", nil))
+
+			_, err := s3Client.ForceDeleteEnabled(context.Background(), "test-instance-id")
+			Expect(err).To(MatchError(s3.ErrNoSuchResources))
+		})
+	})
+
+	Describe("EmptyBucket", func() {
+		versions := func(prefix string, n int) []*awsS3.ObjectVersion {
+			versions := []*awsS3.ObjectVersion{}
+			for i := 0; i < n; i++ {
+				versions = append(versions, &awsS3.ObjectVersion{
+					Key:       aws.String(fmt.Sprintf("%s-%d", prefix, i)),
+					VersionId: aws.String("v1"),
+				})
+			}
+			return versions
+		}
+
+		BeforeEach(func() {
+			s3API.DeleteObjectsWithContextReturns(&awsS3.DeleteObjectsOutput{}, nil)
+			s3API.ListMultipartUploadsWithContextReturns(&awsS3.ListMultipartUploadsOutput{
+				Uploads: []*awsS3.MultipartUpload{
+					{Key: aws.String("big-file"), UploadId: aws.String("upload-1")},
+				},
+			}, nil)
+			s3API.ListObjectVersionsWithContextReturnsOnCall(0, &awsS3.ListObjectVersionsOutput{
+				Versions:            versions("object", 1000),
+				DeleteMarkers:       []*awsS3.DeleteMarkerEntry{{Key: aws.String("deleted"), VersionId: aws.String("v2")}},
+				IsTruncated:         aws.Bool(true),
+				NextKeyMarker:       aws.String("object-999"),
+				NextVersionIdMarker: aws.String("v1"),
+			}, nil)
+			s3API.ListObjectVersionsWithContextReturnsOnCall(1, &awsS3.ListObjectVersionsOutput{
+				Versions: versions("other", 5),
+			}, nil)
+		})
+
+		It("deletes everything in the bucket in batches", func() {
+			progress := []int{}
+			err := s3Client.EmptyBucket(context.Background(), "test-instance-id", func(objectsDeleted int) {
+				progress = append(progress, objectsDeleted)
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("aborting incomplete multipart uploads")
+			Expect(s3API.AbortMultipartUploadWithContextCallCount()).To(Equal(1))
+			abortInput := inputOf(s3API.AbortMultipartUploadWithContextArgsForCall(0))
+			Expect(abortInput.Bucket).To(HaveValue(Equal("test-bucket-prefix-test-instance-id")))
+			Expect(abortInput.Key).To(HaveValue(Equal("big-file")))
+			Expect(abortInput.UploadId).To(HaveValue(Equal("upload-1")))
+
+			By("deleting no more than 1000 versions and delete markers at a time")
+			Expect(s3API.DeleteObjectsWithContextCallCount()).To(Equal(3))
+			Expect(inputOf(s3API.DeleteObjectsWithContextArgsForCall(0)).Delete.Objects).To(HaveLen(1000))
+			Expect(inputOf(s3API.DeleteObjectsWithContextArgsForCall(1)).Delete.Objects).To(HaveLen(1))
+			Expect(inputOf(s3API.DeleteObjectsWithContextArgsForCall(1)).Delete.Objects[0].VersionId).To(HaveValue(Equal("v2")))
+			Expect(inputOf(s3API.DeleteObjectsWithContextArgsForCall(2)).Delete.Objects).To(HaveLen(5))
+
+			Expect(progress).To(Equal([]int{1000, 1001, 1006}))
+
+			By("listing one page at a time")
+			Expect(s3API.ListObjectVersionsWithContextCallCount()).To(Equal(2))
+			Expect(inputOf(s3API.ListObjectVersionsWithContextArgsForCall(0)).KeyMarker).To(BeNil())
+			Expect(inputOf(s3API.ListObjectVersionsWithContextArgsForCall(1)).KeyMarker).To(HaveValue(Equal("object-999")))
+			Expect(inputOf(s3API.ListObjectVersionsWithContextArgsForCall(1)).VersionIdMarker).To(HaveValue(Equal("v1")))
+		})
+
+		It("stops if any objects could not be deleted", func() {
+			s3API.DeleteObjectsWithContextReturns(&awsS3.DeleteObjectsOutput{
+				Errors: []*awsS3.Error{{Key: aws.String("object-0"), Message: aws.String("Access Denied")}},
+			}, nil)
+
+			err := s3Client.EmptyBucket(context.Background(), "test-instance-id", func(int) {})
+			Expect(err).To(MatchError(ContainSubstring("object-0: Access Denied")))
+			Expect(s3API.DeleteObjectsWithContextCallCount()).To(Equal(1))
+		})
+
+		It("does not delete anything if the multipart uploads could not be aborted", func() {
+			s3API.AbortMultipartUploadWithContextReturns(nil, awserr.New("AccessDenied", "Access Denied", nil))
+
+			err := s3Client.EmptyBucket(context.Background(), "test-instance-id", func(int) {})
+			Expect(err).To(HaveOccurred())
+			Expect(s3API.DeleteObjectsWithContextCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,116 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// objectCountProbeLimit caps how many objects we count when telling a
+// tenant why their bucket cannot be deleted, so that a huge bucket costs
+// no more than one list request.
+const objectCountProbeLimit = 1000
+
+var (
+	ErrAccessDenied = errors.New("access denied")
+	ErrThrottled    = errors.New("request throttled by AWS")
+)
+
+// BucketNotEmptyError is returned by DeleteBucket when S3 refuses to delete
+// the bucket because it still has objects in it.
+type BucketNotEmptyError struct {
+	// ObjectCount is the number of object versions and delete markers
+	// found, or -1 if they could not be counted.
+	ObjectCount int
+	// Truncated is set when there are more than ObjectCount objects.
+	Truncated bool
+	// ForceDeleteAllowed is set when the tenant could use force_delete to
+	// have the objects deleted for them.
+	ForceDeleteAllowed bool
+}
+
+func (e *BucketNotEmptyError) Error() string {
+	contents := "objects"
+	switch {
+	case e.ObjectCount < 0:
+	case e.Truncated:
+		contents = fmt.Sprintf("more than %d objects", e.ObjectCount)
+	case e.ObjectCount == 1:
+		contents = "1 object"
+	default:
+		contents = fmt.Sprintf("%d objects", e.ObjectCount)
+	}
+
+	if e.ForceDeleteAllowed {
+		return fmt.Sprintf(
+			"bucket still contains %s; delete them, or use `cf update-service -c '{\"force_delete\": true}'` to have them deleted with the bucket",
+			contents,
+		)
+	}
+	return fmt.Sprintf("bucket still contains %s; delete them (including any previous versions) and try again", contents)
+}
+
+// classifyDeleteBucketError turns the errors S3 returns from DeleteBucket
+// into ones the provider knows how to explain.
+func (s *S3Client) classifyDeleteBucketError(ctx context.Context, logger lager.Logger, bucketName string, err error) error {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return err
+	}
+
+	switch awsErr.Code() {
+	case "NoSuchBucket":
+		return ErrNoSuchResources
+	case "BucketNotEmpty":
+		objectCount, truncated, countErr := s.countObjects(ctx, bucketName)
+		if countErr != nil {
+			logger.Error("count-objects", countErr)
+			objectCount = -1
+		}
+		return &BucketNotEmptyError{
+			ObjectCount:        objectCount,
+			Truncated:          truncated,
+			ForceDeleteAllowed: s.allowForceDelete,
+		}
+	}
+	return classifyAWSError(err)
+}
+
+// classifyAWSError wraps AWS errors which mean the same thing whichever API
+// call returned them, so callers can check for them with errors.Is.
+func classifyAWSError(err error) error {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return err
+	}
+
+	switch {
+	case awsErr.Code() == "AccessDenied":
+		return fmt.Errorf("%w: %s", ErrAccessDenied, awsErr.Message())
+	case awsErr.Code() == "SlowDown" || request.IsErrorThrottle(err):
+		return fmt.Errorf("%w: %s", ErrThrottled, awsErr.Message())
+	}
+	return err
+}
+
+// countObjects counts the object versions and delete markers in a bucket,
+// up to objectCountProbeLimit.
+func (s *S3Client) countObjects(ctx context.Context, bucketName string) (count int, truncated bool, err error) {
+	listObjectVersionsOutput, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*s3.ListObjectVersionsOutput, error) {
+		return s.s3Client.ListObjectVersionsWithContext(ctx, &s3.ListObjectVersionsInput{
+			Bucket:  aws.String(bucketName),
+			MaxKeys: aws.Int64(objectCountProbeLimit),
+		})
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	count = len(listObjectVersionsOutput.Versions) + len(listObjectVersionsOutput.DeleteMarkers)
+	return count, aws.BoolValue(listObjectVersionsOutput.IsTruncated), nil
+}
@@ -0,0 +1,371 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/locket/models"
+	"google.golang.org/grpc"
+)
+
+type FakeLocketClient struct {
+	FetchStub        func(context.Context, *models.FetchRequest, ...grpc.CallOption) (*models.FetchResponse, error)
+	fetchMutex       sync.RWMutex
+	fetchArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.FetchRequest
+		arg3 []grpc.CallOption
+	}
+	fetchReturns struct {
+		result1 *models.FetchResponse
+		result2 error
+	}
+	fetchReturnsOnCall map[int]struct {
+		result1 *models.FetchResponse
+		result2 error
+	}
+	FetchAllStub        func(context.Context, *models.FetchAllRequest, ...grpc.CallOption) (*models.FetchAllResponse, error)
+	fetchAllMutex       sync.RWMutex
+	fetchAllArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.FetchAllRequest
+		arg3 []grpc.CallOption
+	}
+	fetchAllReturns struct {
+		result1 *models.FetchAllResponse
+		result2 error
+	}
+	fetchAllReturnsOnCall map[int]struct {
+		result1 *models.FetchAllResponse
+		result2 error
+	}
+	LockStub        func(context.Context, *models.LockRequest, ...grpc.CallOption) (*models.LockResponse, error)
+	lockMutex       sync.RWMutex
+	lockArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.LockRequest
+		arg3 []grpc.CallOption
+	}
+	lockReturns struct {
+		result1 *models.LockResponse
+		result2 error
+	}
+	lockReturnsOnCall map[int]struct {
+		result1 *models.LockResponse
+		result2 error
+	}
+	ReleaseStub        func(context.Context, *models.ReleaseRequest, ...grpc.CallOption) (*models.ReleaseResponse, error)
+	releaseMutex       sync.RWMutex
+	releaseArgsForCall []struct {
+		arg1 context.Context
+		arg2 *models.ReleaseRequest
+		arg3 []grpc.CallOption
+	}
+	releaseReturns struct {
+		result1 *models.ReleaseResponse
+		result2 error
+	}
+	releaseReturnsOnCall map[int]struct {
+		result1 *models.ReleaseResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLocketClient) Fetch(arg1 context.Context, arg2 *models.FetchRequest, arg3 ...grpc.CallOption) (*models.FetchResponse, error) {
+	fake.fetchMutex.Lock()
+	ret, specificReturn := fake.fetchReturnsOnCall[len(fake.fetchArgsForCall)]
+	fake.fetchArgsForCall = append(fake.fetchArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.FetchRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.FetchStub
+	fakeReturns := fake.fetchReturns
+	fake.recordInvocation("Fetch", []interface{}{arg1, arg2, arg3})
+	fake.fetchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLocketClient) FetchCallCount() int {
+	fake.fetchMutex.RLock()
+	defer fake.fetchMutex.RUnlock()
+	return len(fake.fetchArgsForCall)
+}
+
+func (fake *FakeLocketClient) FetchCalls(stub func(context.Context, *models.FetchRequest, ...grpc.CallOption) (*models.FetchResponse, error)) {
+	fake.fetchMutex.Lock()
+	defer fake.fetchMutex.Unlock()
+	fake.FetchStub = stub
+}
+
+func (fake *FakeLocketClient) FetchArgsForCall(i int) (context.Context, *models.FetchRequest, []grpc.CallOption) {
+	fake.fetchMutex.RLock()
+	defer fake.fetchMutex.RUnlock()
+	argsForCall := fake.fetchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLocketClient) FetchReturns(result1 *models.FetchResponse, result2 error) {
+	fake.fetchMutex.Lock()
+	defer fake.fetchMutex.Unlock()
+	fake.FetchStub = nil
+	fake.fetchReturns = struct {
+		result1 *models.FetchResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocketClient) FetchReturnsOnCall(i int, result1 *models.FetchResponse, result2 error) {
+	fake.fetchMutex.Lock()
+	defer fake.fetchMutex.Unlock()
+	fake.FetchStub = nil
+	if fake.fetchReturnsOnCall == nil {
+		fake.fetchReturnsOnCall = make(map[int]struct {
+			result1 *models.FetchResponse
+			result2 error
+		})
+	}
+	fake.fetchReturnsOnCall[i] = struct {
+		result1 *models.FetchResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocketClient) FetchAll(arg1 context.Context, arg2 *models.FetchAllRequest, arg3 ...grpc.CallOption) (*models.FetchAllResponse, error) {
+	fake.fetchAllMutex.Lock()
+	ret, specificReturn := fake.fetchAllReturnsOnCall[len(fake.fetchAllArgsForCall)]
+	fake.fetchAllArgsForCall = append(fake.fetchAllArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.FetchAllRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.FetchAllStub
+	fakeReturns := fake.fetchAllReturns
+	fake.recordInvocation("FetchAll", []interface{}{arg1, arg2, arg3})
+	fake.fetchAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLocketClient) FetchAllCallCount() int {
+	fake.fetchAllMutex.RLock()
+	defer fake.fetchAllMutex.RUnlock()
+	return len(fake.fetchAllArgsForCall)
+}
+
+func (fake *FakeLocketClient) FetchAllCalls(stub func(context.Context, *models.FetchAllRequest, ...grpc.CallOption) (*models.FetchAllResponse, error)) {
+	fake.fetchAllMutex.Lock()
+	defer fake.fetchAllMutex.Unlock()
+	fake.FetchAllStub = stub
+}
+
+func (fake *FakeLocketClient) FetchAllArgsForCall(i int) (context.Context, *models.FetchAllRequest, []grpc.CallOption) {
+	fake.fetchAllMutex.RLock()
+	defer fake.fetchAllMutex.RUnlock()
+	argsForCall := fake.fetchAllArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLocketClient) FetchAllReturns(result1 *models.FetchAllResponse, result2 error) {
+	fake.fetchAllMutex.Lock()
+	defer fake.fetchAllMutex.Unlock()
+	fake.FetchAllStub = nil
+	fake.fetchAllReturns = struct {
+		result1 *models.FetchAllResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocketClient) FetchAllReturnsOnCall(i int, result1 *models.FetchAllResponse, result2 error) {
+	fake.fetchAllMutex.Lock()
+	defer fake.fetchAllMutex.Unlock()
+	fake.FetchAllStub = nil
+	if fake.fetchAllReturnsOnCall == nil {
+		fake.fetchAllReturnsOnCall = make(map[int]struct {
+			result1 *models.FetchAllResponse
+			result2 error
+		})
+	}
+	fake.fetchAllReturnsOnCall[i] = struct {
+		result1 *models.FetchAllResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocketClient) Lock(arg1 context.Context, arg2 *models.LockRequest, arg3 ...grpc.CallOption) (*models.LockResponse, error) {
+	fake.lockMutex.Lock()
+	ret, specificReturn := fake.lockReturnsOnCall[len(fake.lockArgsForCall)]
+	fake.lockArgsForCall = append(fake.lockArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.LockRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.LockStub
+	fakeReturns := fake.lockReturns
+	fake.recordInvocation("Lock", []interface{}{arg1, arg2, arg3})
+	fake.lockMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLocketClient) LockCallCount() int {
+	fake.lockMutex.RLock()
+	defer fake.lockMutex.RUnlock()
+	return len(fake.lockArgsForCall)
+}
+
+func (fake *FakeLocketClient) LockCalls(stub func(context.Context, *models.LockRequest, ...grpc.CallOption) (*models.LockResponse, error)) {
+	fake.lockMutex.Lock()
+	defer fake.lockMutex.Unlock()
+	fake.LockStub = stub
+}
+
+func (fake *FakeLocketClient) LockArgsForCall(i int) (context.Context, *models.LockRequest, []grpc.CallOption) {
+	fake.lockMutex.RLock()
+	defer fake.lockMutex.RUnlock()
+	argsForCall := fake.lockArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLocketClient) LockReturns(result1 *models.LockResponse, result2 error) {
+	fake.lockMutex.Lock()
+	defer fake.lockMutex.Unlock()
+	fake.LockStub = nil
+	fake.lockReturns = struct {
+		result1 *models.LockResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocketClient) LockReturnsOnCall(i int, result1 *models.LockResponse, result2 error) {
+	fake.lockMutex.Lock()
+	defer fake.lockMutex.Unlock()
+	fake.LockStub = nil
+	if fake.lockReturnsOnCall == nil {
+		fake.lockReturnsOnCall = make(map[int]struct {
+			result1 *models.LockResponse
+			result2 error
+		})
+	}
+	fake.lockReturnsOnCall[i] = struct {
+		result1 *models.LockResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocketClient) Release(arg1 context.Context, arg2 *models.ReleaseRequest, arg3 ...grpc.CallOption) (*models.ReleaseResponse, error) {
+	fake.releaseMutex.Lock()
+	ret, specificReturn := fake.releaseReturnsOnCall[len(fake.releaseArgsForCall)]
+	fake.releaseArgsForCall = append(fake.releaseArgsForCall, struct {
+		arg1 context.Context
+		arg2 *models.ReleaseRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.ReleaseStub
+	fakeReturns := fake.releaseReturns
+	fake.recordInvocation("Release", []interface{}{arg1, arg2, arg3})
+	fake.releaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLocketClient) ReleaseCallCount() int {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	return len(fake.releaseArgsForCall)
+}
+
+func (fake *FakeLocketClient) ReleaseCalls(stub func(context.Context, *models.ReleaseRequest, ...grpc.CallOption) (*models.ReleaseResponse, error)) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = stub
+}
+
+func (fake *FakeLocketClient) ReleaseArgsForCall(i int) (context.Context, *models.ReleaseRequest, []grpc.CallOption) {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	argsForCall := fake.releaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLocketClient) ReleaseReturns(result1 *models.ReleaseResponse, result2 error) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = nil
+	fake.releaseReturns = struct {
+		result1 *models.ReleaseResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocketClient) ReleaseReturnsOnCall(i int, result1 *models.ReleaseResponse, result2 error) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = nil
+	if fake.releaseReturnsOnCall == nil {
+		fake.releaseReturnsOnCall = make(map[int]struct {
+			result1 *models.ReleaseResponse
+			result2 error
+		})
+	}
+	fake.releaseReturnsOnCall[i] = struct {
+		result1 *models.ReleaseResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocketClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.fetchMutex.RLock()
+	defer fake.fetchMutex.RUnlock()
+	fake.fetchAllMutex.RLock()
+	defer fake.fetchAllMutex.RUnlock()
+	fake.lockMutex.RLock()
+	defer fake.lockMutex.RUnlock()
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeLocketClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ models.LocketClient = new(FakeLocketClient)
@@ -2,9 +2,9 @@
 package fakes
 
 import (
-	"context"
 	"sync"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
@@ -37,10 +37,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.AbortMultipartUploadOutput
 	}
-	AbortMultipartUploadWithContextStub        func(context.Context, *s3.AbortMultipartUploadInput, ...request.Option) (*s3.AbortMultipartUploadOutput, error)
+	AbortMultipartUploadWithContextStub        func(aws.Context, *s3.AbortMultipartUploadInput, ...request.Option) (*s3.AbortMultipartUploadOutput, error)
 	abortMultipartUploadWithContextMutex       sync.RWMutex
 	abortMultipartUploadWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.AbortMultipartUploadInput
 		arg3 []request.Option
 	}
@@ -78,10 +78,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.CompleteMultipartUploadOutput
 	}
-	CompleteMultipartUploadWithContextStub        func(context.Context, *s3.CompleteMultipartUploadInput, ...request.Option) (*s3.CompleteMultipartUploadOutput, error)
+	CompleteMultipartUploadWithContextStub        func(aws.Context, *s3.CompleteMultipartUploadInput, ...request.Option) (*s3.CompleteMultipartUploadOutput, error)
 	completeMultipartUploadWithContextMutex       sync.RWMutex
 	completeMultipartUploadWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.CompleteMultipartUploadInput
 		arg3 []request.Option
 	}
@@ -119,10 +119,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.CopyObjectOutput
 	}
-	CopyObjectWithContextStub        func(context.Context, *s3.CopyObjectInput, ...request.Option) (*s3.CopyObjectOutput, error)
+	CopyObjectWithContextStub        func(aws.Context, *s3.CopyObjectInput, ...request.Option) (*s3.CopyObjectOutput, error)
 	copyObjectWithContextMutex       sync.RWMutex
 	copyObjectWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.CopyObjectInput
 		arg3 []request.Option
 	}
@@ -160,10 +160,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.CreateBucketOutput
 	}
-	CreateBucketWithContextStub        func(context.Context, *s3.CreateBucketInput, ...request.Option) (*s3.CreateBucketOutput, error)
+	CreateBucketWithContextStub        func(aws.Context, *s3.CreateBucketInput, ...request.Option) (*s3.CreateBucketOutput, error)
 	createBucketWithContextMutex       sync.RWMutex
 	createBucketWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.CreateBucketInput
 		arg3 []request.Option
 	}
@@ -201,10 +201,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.CreateMultipartUploadOutput
 	}
-	CreateMultipartUploadWithContextStub        func(context.Context, *s3.CreateMultipartUploadInput, ...request.Option) (*s3.CreateMultipartUploadOutput, error)
+	CreateMultipartUploadWithContextStub        func(aws.Context, *s3.CreateMultipartUploadInput, ...request.Option) (*s3.CreateMultipartUploadOutput, error)
 	createMultipartUploadWithContextMutex       sync.RWMutex
 	createMultipartUploadWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.CreateMultipartUploadInput
 		arg3 []request.Option
 	}
@@ -216,6 +216,47 @@ type FakeS3API struct {
 		result1 *s3.CreateMultipartUploadOutput
 		result2 error
 	}
+	CreateSessionStub        func(*s3.CreateSessionInput) (*s3.CreateSessionOutput, error)
+	createSessionMutex       sync.RWMutex
+	createSessionArgsForCall []struct {
+		arg1 *s3.CreateSessionInput
+	}
+	createSessionReturns struct {
+		result1 *s3.CreateSessionOutput
+		result2 error
+	}
+	createSessionReturnsOnCall map[int]struct {
+		result1 *s3.CreateSessionOutput
+		result2 error
+	}
+	CreateSessionRequestStub        func(*s3.CreateSessionInput) (*request.Request, *s3.CreateSessionOutput)
+	createSessionRequestMutex       sync.RWMutex
+	createSessionRequestArgsForCall []struct {
+		arg1 *s3.CreateSessionInput
+	}
+	createSessionRequestReturns struct {
+		result1 *request.Request
+		result2 *s3.CreateSessionOutput
+	}
+	createSessionRequestReturnsOnCall map[int]struct {
+		result1 *request.Request
+		result2 *s3.CreateSessionOutput
+	}
+	CreateSessionWithContextStub        func(aws.Context, *s3.CreateSessionInput, ...request.Option) (*s3.CreateSessionOutput, error)
+	createSessionWithContextMutex       sync.RWMutex
+	createSessionWithContextArgsForCall []struct {
+		arg1 aws.Context
+		arg2 *s3.CreateSessionInput
+		arg3 []request.Option
+	}
+	createSessionWithContextReturns struct {
+		result1 *s3.CreateSessionOutput
+		result2 error
+	}
+	createSessionWithContextReturnsOnCall map[int]struct {
+		result1 *s3.CreateSessionOutput
+		result2 error
+	}
 	DeleteBucketStub        func(*s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error)
 	deleteBucketMutex       sync.RWMutex
 	deleteBucketArgsForCall []struct {
@@ -255,10 +296,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeleteBucketAnalyticsConfigurationOutput
 	}
-	DeleteBucketAnalyticsConfigurationWithContextStub        func(context.Context, *s3.DeleteBucketAnalyticsConfigurationInput, ...request.Option) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)
+	DeleteBucketAnalyticsConfigurationWithContextStub        func(aws.Context, *s3.DeleteBucketAnalyticsConfigurationInput, ...request.Option) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)
 	deleteBucketAnalyticsConfigurationWithContextMutex       sync.RWMutex
 	deleteBucketAnalyticsConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketAnalyticsConfigurationInput
 		arg3 []request.Option
 	}
@@ -296,10 +337,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeleteBucketCorsOutput
 	}
-	DeleteBucketCorsWithContextStub        func(context.Context, *s3.DeleteBucketCorsInput, ...request.Option) (*s3.DeleteBucketCorsOutput, error)
+	DeleteBucketCorsWithContextStub        func(aws.Context, *s3.DeleteBucketCorsInput, ...request.Option) (*s3.DeleteBucketCorsOutput, error)
 	deleteBucketCorsWithContextMutex       sync.RWMutex
 	deleteBucketCorsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketCorsInput
 		arg3 []request.Option
 	}
@@ -337,10 +378,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeleteBucketEncryptionOutput
 	}
-	DeleteBucketEncryptionWithContextStub        func(context.Context, *s3.DeleteBucketEncryptionInput, ...request.Option) (*s3.DeleteBucketEncryptionOutput, error)
+	DeleteBucketEncryptionWithContextStub        func(aws.Context, *s3.DeleteBucketEncryptionInput, ...request.Option) (*s3.DeleteBucketEncryptionOutput, error)
 	deleteBucketEncryptionWithContextMutex       sync.RWMutex
 	deleteBucketEncryptionWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketEncryptionInput
 		arg3 []request.Option
 	}
@@ -352,6 +393,47 @@ type FakeS3API struct {
 		result1 *s3.DeleteBucketEncryptionOutput
 		result2 error
 	}
+	DeleteBucketIntelligentTieringConfigurationStub        func(*s3.DeleteBucketIntelligentTieringConfigurationInput) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)
+	deleteBucketIntelligentTieringConfigurationMutex       sync.RWMutex
+	deleteBucketIntelligentTieringConfigurationArgsForCall []struct {
+		arg1 *s3.DeleteBucketIntelligentTieringConfigurationInput
+	}
+	deleteBucketIntelligentTieringConfigurationReturns struct {
+		result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}
+	deleteBucketIntelligentTieringConfigurationReturnsOnCall map[int]struct {
+		result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}
+	DeleteBucketIntelligentTieringConfigurationRequestStub        func(*s3.DeleteBucketIntelligentTieringConfigurationInput) (*request.Request, *s3.DeleteBucketIntelligentTieringConfigurationOutput)
+	deleteBucketIntelligentTieringConfigurationRequestMutex       sync.RWMutex
+	deleteBucketIntelligentTieringConfigurationRequestArgsForCall []struct {
+		arg1 *s3.DeleteBucketIntelligentTieringConfigurationInput
+	}
+	deleteBucketIntelligentTieringConfigurationRequestReturns struct {
+		result1 *request.Request
+		result2 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+	}
+	deleteBucketIntelligentTieringConfigurationRequestReturnsOnCall map[int]struct {
+		result1 *request.Request
+		result2 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+	}
+	DeleteBucketIntelligentTieringConfigurationWithContextStub        func(aws.Context, *s3.DeleteBucketIntelligentTieringConfigurationInput, ...request.Option) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)
+	deleteBucketIntelligentTieringConfigurationWithContextMutex       sync.RWMutex
+	deleteBucketIntelligentTieringConfigurationWithContextArgsForCall []struct {
+		arg1 aws.Context
+		arg2 *s3.DeleteBucketIntelligentTieringConfigurationInput
+		arg3 []request.Option
+	}
+	deleteBucketIntelligentTieringConfigurationWithContextReturns struct {
+		result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}
+	deleteBucketIntelligentTieringConfigurationWithContextReturnsOnCall map[int]struct {
+		result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}
 	DeleteBucketInventoryConfigurationStub        func(*s3.DeleteBucketInventoryConfigurationInput) (*s3.DeleteBucketInventoryConfigurationOutput, error)
 	deleteBucketInventoryConfigurationMutex       sync.RWMutex
 	deleteBucketInventoryConfigurationArgsForCall []struct {
@@ -378,10 +460,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeleteBucketInventoryConfigurationOutput
 	}
-	DeleteBucketInventoryConfigurationWithContextStub        func(context.Context, *s3.DeleteBucketInventoryConfigurationInput, ...request.Option) (*s3.DeleteBucketInventoryConfigurationOutput, error)
+	DeleteBucketInventoryConfigurationWithContextStub        func(aws.Context, *s3.DeleteBucketInventoryConfigurationInput, ...request.Option) (*s3.DeleteBucketInventoryConfigurationOutput, error)
 	deleteBucketInventoryConfigurationWithContextMutex       sync.RWMutex
 	deleteBucketInventoryConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketInventoryConfigurationInput
 		arg3 []request.Option
 	}
@@ -419,10 +501,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeleteBucketLifecycleOutput
 	}
-	DeleteBucketLifecycleWithContextStub        func(context.Context, *s3.DeleteBucketLifecycleInput, ...request.Option) (*s3.DeleteBucketLifecycleOutput, error)
+	DeleteBucketLifecycleWithContextStub        func(aws.Context, *s3.DeleteBucketLifecycleInput, ...request.Option) (*s3.DeleteBucketLifecycleOutput, error)
 	deleteBucketLifecycleWithContextMutex       sync.RWMutex
 	deleteBucketLifecycleWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketLifecycleInput
 		arg3 []request.Option
 	}
@@ -460,10 +542,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeleteBucketMetricsConfigurationOutput
 	}
-	DeleteBucketMetricsConfigurationWithContextStub        func(context.Context, *s3.DeleteBucketMetricsConfigurationInput, ...request.Option) (*s3.DeleteBucketMetricsConfigurationOutput, error)
+	DeleteBucketMetricsConfigurationWithContextStub        func(aws.Context, *s3.DeleteBucketMetricsConfigurationInput, ...request.Option) (*s3.DeleteBucketMetricsConfigurationOutput, error)
 	deleteBucketMetricsConfigurationWithContextMutex       sync.RWMutex
 	deleteBucketMetricsConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketMetricsConfigurationInput
 		arg3 []request.Option
 	}
@@ -475,6 +557,47 @@ type FakeS3API struct {
 		result1 *s3.DeleteBucketMetricsConfigurationOutput
 		result2 error
 	}
+	DeleteBucketOwnershipControlsStub        func(*s3.DeleteBucketOwnershipControlsInput) (*s3.DeleteBucketOwnershipControlsOutput, error)
+	deleteBucketOwnershipControlsMutex       sync.RWMutex
+	deleteBucketOwnershipControlsArgsForCall []struct {
+		arg1 *s3.DeleteBucketOwnershipControlsInput
+	}
+	deleteBucketOwnershipControlsReturns struct {
+		result1 *s3.DeleteBucketOwnershipControlsOutput
+		result2 error
+	}
+	deleteBucketOwnershipControlsReturnsOnCall map[int]struct {
+		result1 *s3.DeleteBucketOwnershipControlsOutput
+		result2 error
+	}
+	DeleteBucketOwnershipControlsRequestStub        func(*s3.DeleteBucketOwnershipControlsInput) (*request.Request, *s3.DeleteBucketOwnershipControlsOutput)
+	deleteBucketOwnershipControlsRequestMutex       sync.RWMutex
+	deleteBucketOwnershipControlsRequestArgsForCall []struct {
+		arg1 *s3.DeleteBucketOwnershipControlsInput
+	}
+	deleteBucketOwnershipControlsRequestReturns struct {
+		result1 *request.Request
+		result2 *s3.DeleteBucketOwnershipControlsOutput
+	}
+	deleteBucketOwnershipControlsRequestReturnsOnCall map[int]struct {
+		result1 *request.Request
+		result2 *s3.DeleteBucketOwnershipControlsOutput
+	}
+	DeleteBucketOwnershipControlsWithContextStub        func(aws.Context, *s3.DeleteBucketOwnershipControlsInput, ...request.Option) (*s3.DeleteBucketOwnershipControlsOutput, error)
+	deleteBucketOwnershipControlsWithContextMutex       sync.RWMutex
+	deleteBucketOwnershipControlsWithContextArgsForCall []struct {
+		arg1 aws.Context
+		arg2 *s3.DeleteBucketOwnershipControlsInput
+		arg3 []request.Option
+	}
+	deleteBucketOwnershipControlsWithContextReturns struct {
+		result1 *s3.DeleteBucketOwnershipControlsOutput
+		result2 error
+	}
+	deleteBucketOwnershipControlsWithContextReturnsOnCall map[int]struct {
+		result1 *s3.DeleteBucketOwnershipControlsOutput
+		result2 error
+	}
 	DeleteBucketPolicyStub        func(*s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error)
 	deleteBucketPolicyMutex       sync.RWMutex
 	deleteBucketPolicyArgsForCall []struct {
@@ -501,10 +624,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeleteBucketPolicyOutput
 	}
-	DeleteBucketPolicyWithContextStub        func(context.Context, *s3.DeleteBucketPolicyInput, ...request.Option) (*s3.DeleteBucketPolicyOutput, error)
+	DeleteBucketPolicyWithContextStub        func(aws.Context, *s3.DeleteBucketPolicyInput, ...request.Option) (*s3.DeleteBucketPolicyOutput, error)
 	deleteBucketPolicyWithContextMutex       sync.RWMutex
 	deleteBucketPolicyWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketPolicyInput
 		arg3 []request.Option
 	}
@@ -542,10 +665,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeleteBucketReplicationOutput
 	}
-	DeleteBucketReplicationWithContextStub        func(context.Context, *s3.DeleteBucketReplicationInput, ...request.Option) (*s3.DeleteBucketReplicationOutput, error)
+	DeleteBucketReplicationWithContextStub        func(aws.Context, *s3.DeleteBucketReplicationInput, ...request.Option) (*s3.DeleteBucketReplicationOutput, error)
 	deleteBucketReplicationWithContextMutex       sync.RWMutex
 	deleteBucketReplicationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketReplicationInput
 		arg3 []request.Option
 	}
@@ -596,10 +719,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeleteBucketTaggingOutput
 	}
-	DeleteBucketTaggingWithContextStub        func(context.Context, *s3.DeleteBucketTaggingInput, ...request.Option) (*s3.DeleteBucketTaggingOutput, error)
+	DeleteBucketTaggingWithContextStub        func(aws.Context, *s3.DeleteBucketTaggingInput, ...request.Option) (*s3.DeleteBucketTaggingOutput, error)
 	deleteBucketTaggingWithContextMutex       sync.RWMutex
 	deleteBucketTaggingWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketTaggingInput
 		arg3 []request.Option
 	}
@@ -637,10 +760,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeleteBucketWebsiteOutput
 	}
-	DeleteBucketWebsiteWithContextStub        func(context.Context, *s3.DeleteBucketWebsiteInput, ...request.Option) (*s3.DeleteBucketWebsiteOutput, error)
+	DeleteBucketWebsiteWithContextStub        func(aws.Context, *s3.DeleteBucketWebsiteInput, ...request.Option) (*s3.DeleteBucketWebsiteOutput, error)
 	deleteBucketWebsiteWithContextMutex       sync.RWMutex
 	deleteBucketWebsiteWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketWebsiteInput
 		arg3 []request.Option
 	}
@@ -652,10 +775,10 @@ type FakeS3API struct {
 		result1 *s3.DeleteBucketWebsiteOutput
 		result2 error
 	}
-	DeleteBucketWithContextStub        func(context.Context, *s3.DeleteBucketInput, ...request.Option) (*s3.DeleteBucketOutput, error)
+	DeleteBucketWithContextStub        func(aws.Context, *s3.DeleteBucketInput, ...request.Option) (*s3.DeleteBucketOutput, error)
 	deleteBucketWithContextMutex       sync.RWMutex
 	deleteBucketWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketInput
 		arg3 []request.Option
 	}
@@ -719,10 +842,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeleteObjectTaggingOutput
 	}
-	DeleteObjectTaggingWithContextStub        func(context.Context, *s3.DeleteObjectTaggingInput, ...request.Option) (*s3.DeleteObjectTaggingOutput, error)
+	DeleteObjectTaggingWithContextStub        func(aws.Context, *s3.DeleteObjectTaggingInput, ...request.Option) (*s3.DeleteObjectTaggingOutput, error)
 	deleteObjectTaggingWithContextMutex       sync.RWMutex
 	deleteObjectTaggingWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteObjectTaggingInput
 		arg3 []request.Option
 	}
@@ -734,10 +857,10 @@ type FakeS3API struct {
 		result1 *s3.DeleteObjectTaggingOutput
 		result2 error
 	}
-	DeleteObjectWithContextStub        func(context.Context, *s3.DeleteObjectInput, ...request.Option) (*s3.DeleteObjectOutput, error)
+	DeleteObjectWithContextStub        func(aws.Context, *s3.DeleteObjectInput, ...request.Option) (*s3.DeleteObjectOutput, error)
 	deleteObjectWithContextMutex       sync.RWMutex
 	deleteObjectWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteObjectInput
 		arg3 []request.Option
 	}
@@ -775,10 +898,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeleteObjectsOutput
 	}
-	DeleteObjectsWithContextStub        func(context.Context, *s3.DeleteObjectsInput, ...request.Option) (*s3.DeleteObjectsOutput, error)
+	DeleteObjectsWithContextStub        func(aws.Context, *s3.DeleteObjectsInput, ...request.Option) (*s3.DeleteObjectsOutput, error)
 	deleteObjectsWithContextMutex       sync.RWMutex
 	deleteObjectsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteObjectsInput
 		arg3 []request.Option
 	}
@@ -816,10 +939,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.DeletePublicAccessBlockOutput
 	}
-	DeletePublicAccessBlockWithContextStub        func(context.Context, *s3.DeletePublicAccessBlockInput, ...request.Option) (*s3.DeletePublicAccessBlockOutput, error)
+	DeletePublicAccessBlockWithContextStub        func(aws.Context, *s3.DeletePublicAccessBlockInput, ...request.Option) (*s3.DeletePublicAccessBlockOutput, error)
 	deletePublicAccessBlockWithContextMutex       sync.RWMutex
 	deletePublicAccessBlockWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeletePublicAccessBlockInput
 		arg3 []request.Option
 	}
@@ -857,10 +980,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketAccelerateConfigurationOutput
 	}
-	GetBucketAccelerateConfigurationWithContextStub        func(context.Context, *s3.GetBucketAccelerateConfigurationInput, ...request.Option) (*s3.GetBucketAccelerateConfigurationOutput, error)
+	GetBucketAccelerateConfigurationWithContextStub        func(aws.Context, *s3.GetBucketAccelerateConfigurationInput, ...request.Option) (*s3.GetBucketAccelerateConfigurationOutput, error)
 	getBucketAccelerateConfigurationWithContextMutex       sync.RWMutex
 	getBucketAccelerateConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketAccelerateConfigurationInput
 		arg3 []request.Option
 	}
@@ -898,10 +1021,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketAclOutput
 	}
-	GetBucketAclWithContextStub        func(context.Context, *s3.GetBucketAclInput, ...request.Option) (*s3.GetBucketAclOutput, error)
+	GetBucketAclWithContextStub        func(aws.Context, *s3.GetBucketAclInput, ...request.Option) (*s3.GetBucketAclOutput, error)
 	getBucketAclWithContextMutex       sync.RWMutex
 	getBucketAclWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketAclInput
 		arg3 []request.Option
 	}
@@ -939,10 +1062,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketAnalyticsConfigurationOutput
 	}
-	GetBucketAnalyticsConfigurationWithContextStub        func(context.Context, *s3.GetBucketAnalyticsConfigurationInput, ...request.Option) (*s3.GetBucketAnalyticsConfigurationOutput, error)
+	GetBucketAnalyticsConfigurationWithContextStub        func(aws.Context, *s3.GetBucketAnalyticsConfigurationInput, ...request.Option) (*s3.GetBucketAnalyticsConfigurationOutput, error)
 	getBucketAnalyticsConfigurationWithContextMutex       sync.RWMutex
 	getBucketAnalyticsConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketAnalyticsConfigurationInput
 		arg3 []request.Option
 	}
@@ -980,10 +1103,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketCorsOutput
 	}
-	GetBucketCorsWithContextStub        func(context.Context, *s3.GetBucketCorsInput, ...request.Option) (*s3.GetBucketCorsOutput, error)
+	GetBucketCorsWithContextStub        func(aws.Context, *s3.GetBucketCorsInput, ...request.Option) (*s3.GetBucketCorsOutput, error)
 	getBucketCorsWithContextMutex       sync.RWMutex
 	getBucketCorsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketCorsInput
 		arg3 []request.Option
 	}
@@ -1021,10 +1144,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketEncryptionOutput
 	}
-	GetBucketEncryptionWithContextStub        func(context.Context, *s3.GetBucketEncryptionInput, ...request.Option) (*s3.GetBucketEncryptionOutput, error)
+	GetBucketEncryptionWithContextStub        func(aws.Context, *s3.GetBucketEncryptionInput, ...request.Option) (*s3.GetBucketEncryptionOutput, error)
 	getBucketEncryptionWithContextMutex       sync.RWMutex
 	getBucketEncryptionWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketEncryptionInput
 		arg3 []request.Option
 	}
@@ -1036,6 +1159,47 @@ type FakeS3API struct {
 		result1 *s3.GetBucketEncryptionOutput
 		result2 error
 	}
+	GetBucketIntelligentTieringConfigurationStub        func(*s3.GetBucketIntelligentTieringConfigurationInput) (*s3.GetBucketIntelligentTieringConfigurationOutput, error)
+	getBucketIntelligentTieringConfigurationMutex       sync.RWMutex
+	getBucketIntelligentTieringConfigurationArgsForCall []struct {
+		arg1 *s3.GetBucketIntelligentTieringConfigurationInput
+	}
+	getBucketIntelligentTieringConfigurationReturns struct {
+		result1 *s3.GetBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}
+	getBucketIntelligentTieringConfigurationReturnsOnCall map[int]struct {
+		result1 *s3.GetBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}
+	GetBucketIntelligentTieringConfigurationRequestStub        func(*s3.GetBucketIntelligentTieringConfigurationInput) (*request.Request, *s3.GetBucketIntelligentTieringConfigurationOutput)
+	getBucketIntelligentTieringConfigurationRequestMutex       sync.RWMutex
+	getBucketIntelligentTieringConfigurationRequestArgsForCall []struct {
+		arg1 *s3.GetBucketIntelligentTieringConfigurationInput
+	}
+	getBucketIntelligentTieringConfigurationRequestReturns struct {
+		result1 *request.Request
+		result2 *s3.GetBucketIntelligentTieringConfigurationOutput
+	}
+	getBucketIntelligentTieringConfigurationRequestReturnsOnCall map[int]struct {
+		result1 *request.Request
+		result2 *s3.GetBucketIntelligentTieringConfigurationOutput
+	}
+	GetBucketIntelligentTieringConfigurationWithContextStub        func(aws.Context, *s3.GetBucketIntelligentTieringConfigurationInput, ...request.Option) (*s3.GetBucketIntelligentTieringConfigurationOutput, error)
+	getBucketIntelligentTieringConfigurationWithContextMutex       sync.RWMutex
+	getBucketIntelligentTieringConfigurationWithContextArgsForCall []struct {
+		arg1 aws.Context
+		arg2 *s3.GetBucketIntelligentTieringConfigurationInput
+		arg3 []request.Option
+	}
+	getBucketIntelligentTieringConfigurationWithContextReturns struct {
+		result1 *s3.GetBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}
+	getBucketIntelligentTieringConfigurationWithContextReturnsOnCall map[int]struct {
+		result1 *s3.GetBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}
 	GetBucketInventoryConfigurationStub        func(*s3.GetBucketInventoryConfigurationInput) (*s3.GetBucketInventoryConfigurationOutput, error)
 	getBucketInventoryConfigurationMutex       sync.RWMutex
 	getBucketInventoryConfigurationArgsForCall []struct {
@@ -1062,10 +1226,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketInventoryConfigurationOutput
 	}
-	GetBucketInventoryConfigurationWithContextStub        func(context.Context, *s3.GetBucketInventoryConfigurationInput, ...request.Option) (*s3.GetBucketInventoryConfigurationOutput, error)
+	GetBucketInventoryConfigurationWithContextStub        func(aws.Context, *s3.GetBucketInventoryConfigurationInput, ...request.Option) (*s3.GetBucketInventoryConfigurationOutput, error)
 	getBucketInventoryConfigurationWithContextMutex       sync.RWMutex
 	getBucketInventoryConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketInventoryConfigurationInput
 		arg3 []request.Option
 	}
@@ -1116,10 +1280,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketLifecycleConfigurationOutput
 	}
-	GetBucketLifecycleConfigurationWithContextStub        func(context.Context, *s3.GetBucketLifecycleConfigurationInput, ...request.Option) (*s3.GetBucketLifecycleConfigurationOutput, error)
+	GetBucketLifecycleConfigurationWithContextStub        func(aws.Context, *s3.GetBucketLifecycleConfigurationInput, ...request.Option) (*s3.GetBucketLifecycleConfigurationOutput, error)
 	getBucketLifecycleConfigurationWithContextMutex       sync.RWMutex
 	getBucketLifecycleConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketLifecycleConfigurationInput
 		arg3 []request.Option
 	}
@@ -1144,10 +1308,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketLifecycleOutput
 	}
-	GetBucketLifecycleWithContextStub        func(context.Context, *s3.GetBucketLifecycleInput, ...request.Option) (*s3.GetBucketLifecycleOutput, error)
+	GetBucketLifecycleWithContextStub        func(aws.Context, *s3.GetBucketLifecycleInput, ...request.Option) (*s3.GetBucketLifecycleOutput, error)
 	getBucketLifecycleWithContextMutex       sync.RWMutex
 	getBucketLifecycleWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketLifecycleInput
 		arg3 []request.Option
 	}
@@ -1185,10 +1349,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketLocationOutput
 	}
-	GetBucketLocationWithContextStub        func(context.Context, *s3.GetBucketLocationInput, ...request.Option) (*s3.GetBucketLocationOutput, error)
+	GetBucketLocationWithContextStub        func(aws.Context, *s3.GetBucketLocationInput, ...request.Option) (*s3.GetBucketLocationOutput, error)
 	getBucketLocationWithContextMutex       sync.RWMutex
 	getBucketLocationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketLocationInput
 		arg3 []request.Option
 	}
@@ -1226,10 +1390,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketLoggingOutput
 	}
-	GetBucketLoggingWithContextStub        func(context.Context, *s3.GetBucketLoggingInput, ...request.Option) (*s3.GetBucketLoggingOutput, error)
+	GetBucketLoggingWithContextStub        func(aws.Context, *s3.GetBucketLoggingInput, ...request.Option) (*s3.GetBucketLoggingOutput, error)
 	getBucketLoggingWithContextMutex       sync.RWMutex
 	getBucketLoggingWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketLoggingInput
 		arg3 []request.Option
 	}
@@ -1267,10 +1431,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketMetricsConfigurationOutput
 	}
-	GetBucketMetricsConfigurationWithContextStub        func(context.Context, *s3.GetBucketMetricsConfigurationInput, ...request.Option) (*s3.GetBucketMetricsConfigurationOutput, error)
+	GetBucketMetricsConfigurationWithContextStub        func(aws.Context, *s3.GetBucketMetricsConfigurationInput, ...request.Option) (*s3.GetBucketMetricsConfigurationOutput, error)
 	getBucketMetricsConfigurationWithContextMutex       sync.RWMutex
 	getBucketMetricsConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketMetricsConfigurationInput
 		arg3 []request.Option
 	}
@@ -1321,10 +1485,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.NotificationConfiguration
 	}
-	GetBucketNotificationConfigurationWithContextStub        func(context.Context, *s3.GetBucketNotificationConfigurationRequest, ...request.Option) (*s3.NotificationConfiguration, error)
+	GetBucketNotificationConfigurationWithContextStub        func(aws.Context, *s3.GetBucketNotificationConfigurationRequest, ...request.Option) (*s3.NotificationConfiguration, error)
 	getBucketNotificationConfigurationWithContextMutex       sync.RWMutex
 	getBucketNotificationConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketNotificationConfigurationRequest
 		arg3 []request.Option
 	}
@@ -1349,10 +1513,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.NotificationConfigurationDeprecated
 	}
-	GetBucketNotificationWithContextStub        func(context.Context, *s3.GetBucketNotificationConfigurationRequest, ...request.Option) (*s3.NotificationConfigurationDeprecated, error)
+	GetBucketNotificationWithContextStub        func(aws.Context, *s3.GetBucketNotificationConfigurationRequest, ...request.Option) (*s3.NotificationConfigurationDeprecated, error)
 	getBucketNotificationWithContextMutex       sync.RWMutex
 	getBucketNotificationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketNotificationConfigurationRequest
 		arg3 []request.Option
 	}
@@ -1364,6 +1528,47 @@ type FakeS3API struct {
 		result1 *s3.NotificationConfigurationDeprecated
 		result2 error
 	}
+	GetBucketOwnershipControlsStub        func(*s3.GetBucketOwnershipControlsInput) (*s3.GetBucketOwnershipControlsOutput, error)
+	getBucketOwnershipControlsMutex       sync.RWMutex
+	getBucketOwnershipControlsArgsForCall []struct {
+		arg1 *s3.GetBucketOwnershipControlsInput
+	}
+	getBucketOwnershipControlsReturns struct {
+		result1 *s3.GetBucketOwnershipControlsOutput
+		result2 error
+	}
+	getBucketOwnershipControlsReturnsOnCall map[int]struct {
+		result1 *s3.GetBucketOwnershipControlsOutput
+		result2 error
+	}
+	GetBucketOwnershipControlsRequestStub        func(*s3.GetBucketOwnershipControlsInput) (*request.Request, *s3.GetBucketOwnershipControlsOutput)
+	getBucketOwnershipControlsRequestMutex       sync.RWMutex
+	getBucketOwnershipControlsRequestArgsForCall []struct {
+		arg1 *s3.GetBucketOwnershipControlsInput
+	}
+	getBucketOwnershipControlsRequestReturns struct {
+		result1 *request.Request
+		result2 *s3.GetBucketOwnershipControlsOutput
+	}
+	getBucketOwnershipControlsRequestReturnsOnCall map[int]struct {
+		result1 *request.Request
+		result2 *s3.GetBucketOwnershipControlsOutput
+	}
+	GetBucketOwnershipControlsWithContextStub        func(aws.Context, *s3.GetBucketOwnershipControlsInput, ...request.Option) (*s3.GetBucketOwnershipControlsOutput, error)
+	getBucketOwnershipControlsWithContextMutex       sync.RWMutex
+	getBucketOwnershipControlsWithContextArgsForCall []struct {
+		arg1 aws.Context
+		arg2 *s3.GetBucketOwnershipControlsInput
+		arg3 []request.Option
+	}
+	getBucketOwnershipControlsWithContextReturns struct {
+		result1 *s3.GetBucketOwnershipControlsOutput
+		result2 error
+	}
+	getBucketOwnershipControlsWithContextReturnsOnCall map[int]struct {
+		result1 *s3.GetBucketOwnershipControlsOutput
+		result2 error
+	}
 	GetBucketPolicyStub        func(*s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error)
 	getBucketPolicyMutex       sync.RWMutex
 	getBucketPolicyArgsForCall []struct {
@@ -1416,10 +1621,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketPolicyStatusOutput
 	}
-	GetBucketPolicyStatusWithContextStub        func(context.Context, *s3.GetBucketPolicyStatusInput, ...request.Option) (*s3.GetBucketPolicyStatusOutput, error)
+	GetBucketPolicyStatusWithContextStub        func(aws.Context, *s3.GetBucketPolicyStatusInput, ...request.Option) (*s3.GetBucketPolicyStatusOutput, error)
 	getBucketPolicyStatusWithContextMutex       sync.RWMutex
 	getBucketPolicyStatusWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketPolicyStatusInput
 		arg3 []request.Option
 	}
@@ -1431,10 +1636,10 @@ type FakeS3API struct {
 		result1 *s3.GetBucketPolicyStatusOutput
 		result2 error
 	}
-	GetBucketPolicyWithContextStub        func(context.Context, *s3.GetBucketPolicyInput, ...request.Option) (*s3.GetBucketPolicyOutput, error)
+	GetBucketPolicyWithContextStub        func(aws.Context, *s3.GetBucketPolicyInput, ...request.Option) (*s3.GetBucketPolicyOutput, error)
 	getBucketPolicyWithContextMutex       sync.RWMutex
 	getBucketPolicyWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketPolicyInput
 		arg3 []request.Option
 	}
@@ -1472,10 +1677,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketReplicationOutput
 	}
-	GetBucketReplicationWithContextStub        func(context.Context, *s3.GetBucketReplicationInput, ...request.Option) (*s3.GetBucketReplicationOutput, error)
+	GetBucketReplicationWithContextStub        func(aws.Context, *s3.GetBucketReplicationInput, ...request.Option) (*s3.GetBucketReplicationOutput, error)
 	getBucketReplicationWithContextMutex       sync.RWMutex
 	getBucketReplicationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketReplicationInput
 		arg3 []request.Option
 	}
@@ -1513,10 +1718,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketRequestPaymentOutput
 	}
-	GetBucketRequestPaymentWithContextStub        func(context.Context, *s3.GetBucketRequestPaymentInput, ...request.Option) (*s3.GetBucketRequestPaymentOutput, error)
+	GetBucketRequestPaymentWithContextStub        func(aws.Context, *s3.GetBucketRequestPaymentInput, ...request.Option) (*s3.GetBucketRequestPaymentOutput, error)
 	getBucketRequestPaymentWithContextMutex       sync.RWMutex
 	getBucketRequestPaymentWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketRequestPaymentInput
 		arg3 []request.Option
 	}
@@ -1554,10 +1759,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketTaggingOutput
 	}
-	GetBucketTaggingWithContextStub        func(context.Context, *s3.GetBucketTaggingInput, ...request.Option) (*s3.GetBucketTaggingOutput, error)
+	GetBucketTaggingWithContextStub        func(aws.Context, *s3.GetBucketTaggingInput, ...request.Option) (*s3.GetBucketTaggingOutput, error)
 	getBucketTaggingWithContextMutex       sync.RWMutex
 	getBucketTaggingWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketTaggingInput
 		arg3 []request.Option
 	}
@@ -1595,10 +1800,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketVersioningOutput
 	}
-	GetBucketVersioningWithContextStub        func(context.Context, *s3.GetBucketVersioningInput, ...request.Option) (*s3.GetBucketVersioningOutput, error)
+	GetBucketVersioningWithContextStub        func(aws.Context, *s3.GetBucketVersioningInput, ...request.Option) (*s3.GetBucketVersioningOutput, error)
 	getBucketVersioningWithContextMutex       sync.RWMutex
 	getBucketVersioningWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketVersioningInput
 		arg3 []request.Option
 	}
@@ -1636,10 +1841,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetBucketWebsiteOutput
 	}
-	GetBucketWebsiteWithContextStub        func(context.Context, *s3.GetBucketWebsiteInput, ...request.Option) (*s3.GetBucketWebsiteOutput, error)
+	GetBucketWebsiteWithContextStub        func(aws.Context, *s3.GetBucketWebsiteInput, ...request.Option) (*s3.GetBucketWebsiteOutput, error)
 	getBucketWebsiteWithContextMutex       sync.RWMutex
 	getBucketWebsiteWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketWebsiteInput
 		arg3 []request.Option
 	}
@@ -1690,10 +1895,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetObjectAclOutput
 	}
-	GetObjectAclWithContextStub        func(context.Context, *s3.GetObjectAclInput, ...request.Option) (*s3.GetObjectAclOutput, error)
+	GetObjectAclWithContextStub        func(aws.Context, *s3.GetObjectAclInput, ...request.Option) (*s3.GetObjectAclOutput, error)
 	getObjectAclWithContextMutex       sync.RWMutex
 	getObjectAclWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectAclInput
 		arg3 []request.Option
 	}
@@ -1705,6 +1910,47 @@ type FakeS3API struct {
 		result1 *s3.GetObjectAclOutput
 		result2 error
 	}
+	GetObjectAttributesStub        func(*s3.GetObjectAttributesInput) (*s3.GetObjectAttributesOutput, error)
+	getObjectAttributesMutex       sync.RWMutex
+	getObjectAttributesArgsForCall []struct {
+		arg1 *s3.GetObjectAttributesInput
+	}
+	getObjectAttributesReturns struct {
+		result1 *s3.GetObjectAttributesOutput
+		result2 error
+	}
+	getObjectAttributesReturnsOnCall map[int]struct {
+		result1 *s3.GetObjectAttributesOutput
+		result2 error
+	}
+	GetObjectAttributesRequestStub        func(*s3.GetObjectAttributesInput) (*request.Request, *s3.GetObjectAttributesOutput)
+	getObjectAttributesRequestMutex       sync.RWMutex
+	getObjectAttributesRequestArgsForCall []struct {
+		arg1 *s3.GetObjectAttributesInput
+	}
+	getObjectAttributesRequestReturns struct {
+		result1 *request.Request
+		result2 *s3.GetObjectAttributesOutput
+	}
+	getObjectAttributesRequestReturnsOnCall map[int]struct {
+		result1 *request.Request
+		result2 *s3.GetObjectAttributesOutput
+	}
+	GetObjectAttributesWithContextStub        func(aws.Context, *s3.GetObjectAttributesInput, ...request.Option) (*s3.GetObjectAttributesOutput, error)
+	getObjectAttributesWithContextMutex       sync.RWMutex
+	getObjectAttributesWithContextArgsForCall []struct {
+		arg1 aws.Context
+		arg2 *s3.GetObjectAttributesInput
+		arg3 []request.Option
+	}
+	getObjectAttributesWithContextReturns struct {
+		result1 *s3.GetObjectAttributesOutput
+		result2 error
+	}
+	getObjectAttributesWithContextReturnsOnCall map[int]struct {
+		result1 *s3.GetObjectAttributesOutput
+		result2 error
+	}
 	GetObjectLegalHoldStub        func(*s3.GetObjectLegalHoldInput) (*s3.GetObjectLegalHoldOutput, error)
 	getObjectLegalHoldMutex       sync.RWMutex
 	getObjectLegalHoldArgsForCall []struct {
@@ -1731,10 +1977,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetObjectLegalHoldOutput
 	}
-	GetObjectLegalHoldWithContextStub        func(context.Context, *s3.GetObjectLegalHoldInput, ...request.Option) (*s3.GetObjectLegalHoldOutput, error)
+	GetObjectLegalHoldWithContextStub        func(aws.Context, *s3.GetObjectLegalHoldInput, ...request.Option) (*s3.GetObjectLegalHoldOutput, error)
 	getObjectLegalHoldWithContextMutex       sync.RWMutex
 	getObjectLegalHoldWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectLegalHoldInput
 		arg3 []request.Option
 	}
@@ -1772,10 +2018,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetObjectLockConfigurationOutput
 	}
-	GetObjectLockConfigurationWithContextStub        func(context.Context, *s3.GetObjectLockConfigurationInput, ...request.Option) (*s3.GetObjectLockConfigurationOutput, error)
+	GetObjectLockConfigurationWithContextStub        func(aws.Context, *s3.GetObjectLockConfigurationInput, ...request.Option) (*s3.GetObjectLockConfigurationOutput, error)
 	getObjectLockConfigurationWithContextMutex       sync.RWMutex
 	getObjectLockConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectLockConfigurationInput
 		arg3 []request.Option
 	}
@@ -1826,10 +2072,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetObjectRetentionOutput
 	}
-	GetObjectRetentionWithContextStub        func(context.Context, *s3.GetObjectRetentionInput, ...request.Option) (*s3.GetObjectRetentionOutput, error)
+	GetObjectRetentionWithContextStub        func(aws.Context, *s3.GetObjectRetentionInput, ...request.Option) (*s3.GetObjectRetentionOutput, error)
 	getObjectRetentionWithContextMutex       sync.RWMutex
 	getObjectRetentionWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectRetentionInput
 		arg3 []request.Option
 	}
@@ -1867,10 +2113,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetObjectTaggingOutput
 	}
-	GetObjectTaggingWithContextStub        func(context.Context, *s3.GetObjectTaggingInput, ...request.Option) (*s3.GetObjectTaggingOutput, error)
+	GetObjectTaggingWithContextStub        func(aws.Context, *s3.GetObjectTaggingInput, ...request.Option) (*s3.GetObjectTaggingOutput, error)
 	getObjectTaggingWithContextMutex       sync.RWMutex
 	getObjectTaggingWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectTaggingInput
 		arg3 []request.Option
 	}
@@ -1908,10 +2154,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetObjectTorrentOutput
 	}
-	GetObjectTorrentWithContextStub        func(context.Context, *s3.GetObjectTorrentInput, ...request.Option) (*s3.GetObjectTorrentOutput, error)
+	GetObjectTorrentWithContextStub        func(aws.Context, *s3.GetObjectTorrentInput, ...request.Option) (*s3.GetObjectTorrentOutput, error)
 	getObjectTorrentWithContextMutex       sync.RWMutex
 	getObjectTorrentWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectTorrentInput
 		arg3 []request.Option
 	}
@@ -1923,10 +2169,10 @@ type FakeS3API struct {
 		result1 *s3.GetObjectTorrentOutput
 		result2 error
 	}
-	GetObjectWithContextStub        func(context.Context, *s3.GetObjectInput, ...request.Option) (*s3.GetObjectOutput, error)
+	GetObjectWithContextStub        func(aws.Context, *s3.GetObjectInput, ...request.Option) (*s3.GetObjectOutput, error)
 	getObjectWithContextMutex       sync.RWMutex
 	getObjectWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectInput
 		arg3 []request.Option
 	}
@@ -1964,10 +2210,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.GetPublicAccessBlockOutput
 	}
-	GetPublicAccessBlockWithContextStub        func(context.Context, *s3.GetPublicAccessBlockInput, ...request.Option) (*s3.GetPublicAccessBlockOutput, error)
+	GetPublicAccessBlockWithContextStub        func(aws.Context, *s3.GetPublicAccessBlockInput, ...request.Option) (*s3.GetPublicAccessBlockOutput, error)
 	getPublicAccessBlockWithContextMutex       sync.RWMutex
 	getPublicAccessBlockWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetPublicAccessBlockInput
 		arg3 []request.Option
 	}
@@ -2005,10 +2251,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.HeadBucketOutput
 	}
-	HeadBucketWithContextStub        func(context.Context, *s3.HeadBucketInput, ...request.Option) (*s3.HeadBucketOutput, error)
+	HeadBucketWithContextStub        func(aws.Context, *s3.HeadBucketInput, ...request.Option) (*s3.HeadBucketOutput, error)
 	headBucketWithContextMutex       sync.RWMutex
 	headBucketWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.HeadBucketInput
 		arg3 []request.Option
 	}
@@ -2046,10 +2292,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.HeadObjectOutput
 	}
-	HeadObjectWithContextStub        func(context.Context, *s3.HeadObjectInput, ...request.Option) (*s3.HeadObjectOutput, error)
+	HeadObjectWithContextStub        func(aws.Context, *s3.HeadObjectInput, ...request.Option) (*s3.HeadObjectOutput, error)
 	headObjectWithContextMutex       sync.RWMutex
 	headObjectWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.HeadObjectInput
 		arg3 []request.Option
 	}
@@ -2087,10 +2333,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.ListBucketAnalyticsConfigurationsOutput
 	}
-	ListBucketAnalyticsConfigurationsWithContextStub        func(context.Context, *s3.ListBucketAnalyticsConfigurationsInput, ...request.Option) (*s3.ListBucketAnalyticsConfigurationsOutput, error)
+	ListBucketAnalyticsConfigurationsWithContextStub        func(aws.Context, *s3.ListBucketAnalyticsConfigurationsInput, ...request.Option) (*s3.ListBucketAnalyticsConfigurationsOutput, error)
 	listBucketAnalyticsConfigurationsWithContextMutex       sync.RWMutex
 	listBucketAnalyticsConfigurationsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListBucketAnalyticsConfigurationsInput
 		arg3 []request.Option
 	}
@@ -2102,6 +2348,47 @@ type FakeS3API struct {
 		result1 *s3.ListBucketAnalyticsConfigurationsOutput
 		result2 error
 	}
+	ListBucketIntelligentTieringConfigurationsStub        func(*s3.ListBucketIntelligentTieringConfigurationsInput) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)
+	listBucketIntelligentTieringConfigurationsMutex       sync.RWMutex
+	listBucketIntelligentTieringConfigurationsArgsForCall []struct {
+		arg1 *s3.ListBucketIntelligentTieringConfigurationsInput
+	}
+	listBucketIntelligentTieringConfigurationsReturns struct {
+		result1 *s3.ListBucketIntelligentTieringConfigurationsOutput
+		result2 error
+	}
+	listBucketIntelligentTieringConfigurationsReturnsOnCall map[int]struct {
+		result1 *s3.ListBucketIntelligentTieringConfigurationsOutput
+		result2 error
+	}
+	ListBucketIntelligentTieringConfigurationsRequestStub        func(*s3.ListBucketIntelligentTieringConfigurationsInput) (*request.Request, *s3.ListBucketIntelligentTieringConfigurationsOutput)
+	listBucketIntelligentTieringConfigurationsRequestMutex       sync.RWMutex
+	listBucketIntelligentTieringConfigurationsRequestArgsForCall []struct {
+		arg1 *s3.ListBucketIntelligentTieringConfigurationsInput
+	}
+	listBucketIntelligentTieringConfigurationsRequestReturns struct {
+		result1 *request.Request
+		result2 *s3.ListBucketIntelligentTieringConfigurationsOutput
+	}
+	listBucketIntelligentTieringConfigurationsRequestReturnsOnCall map[int]struct {
+		result1 *request.Request
+		result2 *s3.ListBucketIntelligentTieringConfigurationsOutput
+	}
+	ListBucketIntelligentTieringConfigurationsWithContextStub        func(aws.Context, *s3.ListBucketIntelligentTieringConfigurationsInput, ...request.Option) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)
+	listBucketIntelligentTieringConfigurationsWithContextMutex       sync.RWMutex
+	listBucketIntelligentTieringConfigurationsWithContextArgsForCall []struct {
+		arg1 aws.Context
+		arg2 *s3.ListBucketIntelligentTieringConfigurationsInput
+		arg3 []request.Option
+	}
+	listBucketIntelligentTieringConfigurationsWithContextReturns struct {
+		result1 *s3.ListBucketIntelligentTieringConfigurationsOutput
+		result2 error
+	}
+	listBucketIntelligentTieringConfigurationsWithContextReturnsOnCall map[int]struct {
+		result1 *s3.ListBucketIntelligentTieringConfigurationsOutput
+		result2 error
+	}
 	ListBucketInventoryConfigurationsStub        func(*s3.ListBucketInventoryConfigurationsInput) (*s3.ListBucketInventoryConfigurationsOutput, error)
 	listBucketInventoryConfigurationsMutex       sync.RWMutex
 	listBucketInventoryConfigurationsArgsForCall []struct {
@@ -2128,10 +2415,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.ListBucketInventoryConfigurationsOutput
 	}
-	ListBucketInventoryConfigurationsWithContextStub        func(context.Context, *s3.ListBucketInventoryConfigurationsInput, ...request.Option) (*s3.ListBucketInventoryConfigurationsOutput, error)
+	ListBucketInventoryConfigurationsWithContextStub        func(aws.Context, *s3.ListBucketInventoryConfigurationsInput, ...request.Option) (*s3.ListBucketInventoryConfigurationsOutput, error)
 	listBucketInventoryConfigurationsWithContextMutex       sync.RWMutex
 	listBucketInventoryConfigurationsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListBucketInventoryConfigurationsInput
 		arg3 []request.Option
 	}
@@ -2169,10 +2456,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.ListBucketMetricsConfigurationsOutput
 	}
-	ListBucketMetricsConfigurationsWithContextStub        func(context.Context, *s3.ListBucketMetricsConfigurationsInput, ...request.Option) (*s3.ListBucketMetricsConfigurationsOutput, error)
+	ListBucketMetricsConfigurationsWithContextStub        func(aws.Context, *s3.ListBucketMetricsConfigurationsInput, ...request.Option) (*s3.ListBucketMetricsConfigurationsOutput, error)
 	listBucketMetricsConfigurationsWithContextMutex       sync.RWMutex
 	listBucketMetricsConfigurationsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListBucketMetricsConfigurationsInput
 		arg3 []request.Option
 	}
@@ -2210,10 +2497,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.ListBucketsOutput
 	}
-	ListBucketsWithContextStub        func(context.Context, *s3.ListBucketsInput, ...request.Option) (*s3.ListBucketsOutput, error)
+	ListBucketsWithContextStub        func(aws.Context, *s3.ListBucketsInput, ...request.Option) (*s3.ListBucketsOutput, error)
 	listBucketsWithContextMutex       sync.RWMutex
 	listBucketsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListBucketsInput
 		arg3 []request.Option
 	}
@@ -2225,6 +2512,73 @@ type FakeS3API struct {
 		result1 *s3.ListBucketsOutput
 		result2 error
 	}
+	ListDirectoryBucketsStub        func(*s3.ListDirectoryBucketsInput) (*s3.ListDirectoryBucketsOutput, error)
+	listDirectoryBucketsMutex       sync.RWMutex
+	listDirectoryBucketsArgsForCall []struct {
+		arg1 *s3.ListDirectoryBucketsInput
+	}
+	listDirectoryBucketsReturns struct {
+		result1 *s3.ListDirectoryBucketsOutput
+		result2 error
+	}
+	listDirectoryBucketsReturnsOnCall map[int]struct {
+		result1 *s3.ListDirectoryBucketsOutput
+		result2 error
+	}
+	ListDirectoryBucketsPagesStub        func(*s3.ListDirectoryBucketsInput, func(*s3.ListDirectoryBucketsOutput, bool) bool) error
+	listDirectoryBucketsPagesMutex       sync.RWMutex
+	listDirectoryBucketsPagesArgsForCall []struct {
+		arg1 *s3.ListDirectoryBucketsInput
+		arg2 func(*s3.ListDirectoryBucketsOutput, bool) bool
+	}
+	listDirectoryBucketsPagesReturns struct {
+		result1 error
+	}
+	listDirectoryBucketsPagesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListDirectoryBucketsPagesWithContextStub        func(aws.Context, *s3.ListDirectoryBucketsInput, func(*s3.ListDirectoryBucketsOutput, bool) bool, ...request.Option) error
+	listDirectoryBucketsPagesWithContextMutex       sync.RWMutex
+	listDirectoryBucketsPagesWithContextArgsForCall []struct {
+		arg1 aws.Context
+		arg2 *s3.ListDirectoryBucketsInput
+		arg3 func(*s3.ListDirectoryBucketsOutput, bool) bool
+		arg4 []request.Option
+	}
+	listDirectoryBucketsPagesWithContextReturns struct {
+		result1 error
+	}
+	listDirectoryBucketsPagesWithContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListDirectoryBucketsRequestStub        func(*s3.ListDirectoryBucketsInput) (*request.Request, *s3.ListDirectoryBucketsOutput)
+	listDirectoryBucketsRequestMutex       sync.RWMutex
+	listDirectoryBucketsRequestArgsForCall []struct {
+		arg1 *s3.ListDirectoryBucketsInput
+	}
+	listDirectoryBucketsRequestReturns struct {
+		result1 *request.Request
+		result2 *s3.ListDirectoryBucketsOutput
+	}
+	listDirectoryBucketsRequestReturnsOnCall map[int]struct {
+		result1 *request.Request
+		result2 *s3.ListDirectoryBucketsOutput
+	}
+	ListDirectoryBucketsWithContextStub        func(aws.Context, *s3.ListDirectoryBucketsInput, ...request.Option) (*s3.ListDirectoryBucketsOutput, error)
+	listDirectoryBucketsWithContextMutex       sync.RWMutex
+	listDirectoryBucketsWithContextArgsForCall []struct {
+		arg1 aws.Context
+		arg2 *s3.ListDirectoryBucketsInput
+		arg3 []request.Option
+	}
+	listDirectoryBucketsWithContextReturns struct {
+		result1 *s3.ListDirectoryBucketsOutput
+		result2 error
+	}
+	listDirectoryBucketsWithContextReturnsOnCall map[int]struct {
+		result1 *s3.ListDirectoryBucketsOutput
+		result2 error
+	}
 	ListMultipartUploadsStub        func(*s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error)
 	listMultipartUploadsMutex       sync.RWMutex
 	listMultipartUploadsArgsForCall []struct {
@@ -2250,10 +2604,10 @@ type FakeS3API struct {
 	listMultipartUploadsPagesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ListMultipartUploadsPagesWithContextStub        func(context.Context, *s3.ListMultipartUploadsInput, func(*s3.ListMultipartUploadsOutput, bool) bool, ...request.Option) error
+	ListMultipartUploadsPagesWithContextStub        func(aws.Context, *s3.ListMultipartUploadsInput, func(*s3.ListMultipartUploadsOutput, bool) bool, ...request.Option) error
 	listMultipartUploadsPagesWithContextMutex       sync.RWMutex
 	listMultipartUploadsPagesWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListMultipartUploadsInput
 		arg3 func(*s3.ListMultipartUploadsOutput, bool) bool
 		arg4 []request.Option
@@ -2277,10 +2631,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.ListMultipartUploadsOutput
 	}
-	ListMultipartUploadsWithContextStub        func(context.Context, *s3.ListMultipartUploadsInput, ...request.Option) (*s3.ListMultipartUploadsOutput, error)
+	ListMultipartUploadsWithContextStub        func(aws.Context, *s3.ListMultipartUploadsInput, ...request.Option) (*s3.ListMultipartUploadsOutput, error)
 	listMultipartUploadsWithContextMutex       sync.RWMutex
 	listMultipartUploadsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListMultipartUploadsInput
 		arg3 []request.Option
 	}
@@ -2317,10 +2671,10 @@ type FakeS3API struct {
 	listObjectVersionsPagesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ListObjectVersionsPagesWithContextStub        func(context.Context, *s3.ListObjectVersionsInput, func(*s3.ListObjectVersionsOutput, bool) bool, ...request.Option) error
+	ListObjectVersionsPagesWithContextStub        func(aws.Context, *s3.ListObjectVersionsInput, func(*s3.ListObjectVersionsOutput, bool) bool, ...request.Option) error
 	listObjectVersionsPagesWithContextMutex       sync.RWMutex
 	listObjectVersionsPagesWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListObjectVersionsInput
 		arg3 func(*s3.ListObjectVersionsOutput, bool) bool
 		arg4 []request.Option
@@ -2344,10 +2698,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.ListObjectVersionsOutput
 	}
-	ListObjectVersionsWithContextStub        func(context.Context, *s3.ListObjectVersionsInput, ...request.Option) (*s3.ListObjectVersionsOutput, error)
+	ListObjectVersionsWithContextStub        func(aws.Context, *s3.ListObjectVersionsInput, ...request.Option) (*s3.ListObjectVersionsOutput, error)
 	listObjectVersionsWithContextMutex       sync.RWMutex
 	listObjectVersionsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListObjectVersionsInput
 		arg3 []request.Option
 	}
@@ -2384,10 +2738,10 @@ type FakeS3API struct {
 	listObjectsPagesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ListObjectsPagesWithContextStub        func(context.Context, *s3.ListObjectsInput, func(*s3.ListObjectsOutput, bool) bool, ...request.Option) error
+	ListObjectsPagesWithContextStub        func(aws.Context, *s3.ListObjectsInput, func(*s3.ListObjectsOutput, bool) bool, ...request.Option) error
 	listObjectsPagesWithContextMutex       sync.RWMutex
 	listObjectsPagesWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListObjectsInput
 		arg3 func(*s3.ListObjectsOutput, bool) bool
 		arg4 []request.Option
@@ -2436,10 +2790,10 @@ type FakeS3API struct {
 	listObjectsV2PagesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ListObjectsV2PagesWithContextStub        func(context.Context, *s3.ListObjectsV2Input, func(*s3.ListObjectsV2Output, bool) bool, ...request.Option) error
+	ListObjectsV2PagesWithContextStub        func(aws.Context, *s3.ListObjectsV2Input, func(*s3.ListObjectsV2Output, bool) bool, ...request.Option) error
 	listObjectsV2PagesWithContextMutex       sync.RWMutex
 	listObjectsV2PagesWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListObjectsV2Input
 		arg3 func(*s3.ListObjectsV2Output, bool) bool
 		arg4 []request.Option
@@ -2463,10 +2817,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.ListObjectsV2Output
 	}
-	ListObjectsV2WithContextStub        func(context.Context, *s3.ListObjectsV2Input, ...request.Option) (*s3.ListObjectsV2Output, error)
+	ListObjectsV2WithContextStub        func(aws.Context, *s3.ListObjectsV2Input, ...request.Option) (*s3.ListObjectsV2Output, error)
 	listObjectsV2WithContextMutex       sync.RWMutex
 	listObjectsV2WithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListObjectsV2Input
 		arg3 []request.Option
 	}
@@ -2478,10 +2832,10 @@ type FakeS3API struct {
 		result1 *s3.ListObjectsV2Output
 		result2 error
 	}
-	ListObjectsWithContextStub        func(context.Context, *s3.ListObjectsInput, ...request.Option) (*s3.ListObjectsOutput, error)
+	ListObjectsWithContextStub        func(aws.Context, *s3.ListObjectsInput, ...request.Option) (*s3.ListObjectsOutput, error)
 	listObjectsWithContextMutex       sync.RWMutex
 	listObjectsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListObjectsInput
 		arg3 []request.Option
 	}
@@ -2518,10 +2872,10 @@ type FakeS3API struct {
 	listPartsPagesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ListPartsPagesWithContextStub        func(context.Context, *s3.ListPartsInput, func(*s3.ListPartsOutput, bool) bool, ...request.Option) error
+	ListPartsPagesWithContextStub        func(aws.Context, *s3.ListPartsInput, func(*s3.ListPartsOutput, bool) bool, ...request.Option) error
 	listPartsPagesWithContextMutex       sync.RWMutex
 	listPartsPagesWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListPartsInput
 		arg3 func(*s3.ListPartsOutput, bool) bool
 		arg4 []request.Option
@@ -2545,10 +2899,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.ListPartsOutput
 	}
-	ListPartsWithContextStub        func(context.Context, *s3.ListPartsInput, ...request.Option) (*s3.ListPartsOutput, error)
+	ListPartsWithContextStub        func(aws.Context, *s3.ListPartsInput, ...request.Option) (*s3.ListPartsOutput, error)
 	listPartsWithContextMutex       sync.RWMutex
 	listPartsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListPartsInput
 		arg3 []request.Option
 	}
@@ -2586,10 +2940,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketAccelerateConfigurationOutput
 	}
-	PutBucketAccelerateConfigurationWithContextStub        func(context.Context, *s3.PutBucketAccelerateConfigurationInput, ...request.Option) (*s3.PutBucketAccelerateConfigurationOutput, error)
+	PutBucketAccelerateConfigurationWithContextStub        func(aws.Context, *s3.PutBucketAccelerateConfigurationInput, ...request.Option) (*s3.PutBucketAccelerateConfigurationOutput, error)
 	putBucketAccelerateConfigurationWithContextMutex       sync.RWMutex
 	putBucketAccelerateConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketAccelerateConfigurationInput
 		arg3 []request.Option
 	}
@@ -2627,10 +2981,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketAclOutput
 	}
-	PutBucketAclWithContextStub        func(context.Context, *s3.PutBucketAclInput, ...request.Option) (*s3.PutBucketAclOutput, error)
+	PutBucketAclWithContextStub        func(aws.Context, *s3.PutBucketAclInput, ...request.Option) (*s3.PutBucketAclOutput, error)
 	putBucketAclWithContextMutex       sync.RWMutex
 	putBucketAclWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketAclInput
 		arg3 []request.Option
 	}
@@ -2668,10 +3022,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketAnalyticsConfigurationOutput
 	}
-	PutBucketAnalyticsConfigurationWithContextStub        func(context.Context, *s3.PutBucketAnalyticsConfigurationInput, ...request.Option) (*s3.PutBucketAnalyticsConfigurationOutput, error)
+	PutBucketAnalyticsConfigurationWithContextStub        func(aws.Context, *s3.PutBucketAnalyticsConfigurationInput, ...request.Option) (*s3.PutBucketAnalyticsConfigurationOutput, error)
 	putBucketAnalyticsConfigurationWithContextMutex       sync.RWMutex
 	putBucketAnalyticsConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketAnalyticsConfigurationInput
 		arg3 []request.Option
 	}
@@ -2709,10 +3063,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketCorsOutput
 	}
-	PutBucketCorsWithContextStub        func(context.Context, *s3.PutBucketCorsInput, ...request.Option) (*s3.PutBucketCorsOutput, error)
+	PutBucketCorsWithContextStub        func(aws.Context, *s3.PutBucketCorsInput, ...request.Option) (*s3.PutBucketCorsOutput, error)
 	putBucketCorsWithContextMutex       sync.RWMutex
 	putBucketCorsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketCorsInput
 		arg3 []request.Option
 	}
@@ -2750,10 +3104,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketEncryptionOutput
 	}
-	PutBucketEncryptionWithContextStub        func(context.Context, *s3.PutBucketEncryptionInput, ...request.Option) (*s3.PutBucketEncryptionOutput, error)
+	PutBucketEncryptionWithContextStub        func(aws.Context, *s3.PutBucketEncryptionInput, ...request.Option) (*s3.PutBucketEncryptionOutput, error)
 	putBucketEncryptionWithContextMutex       sync.RWMutex
 	putBucketEncryptionWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketEncryptionInput
 		arg3 []request.Option
 	}
@@ -2765,6 +3119,47 @@ type FakeS3API struct {
 		result1 *s3.PutBucketEncryptionOutput
 		result2 error
 	}
+	PutBucketIntelligentTieringConfigurationStub        func(*s3.PutBucketIntelligentTieringConfigurationInput) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)
+	putBucketIntelligentTieringConfigurationMutex       sync.RWMutex
+	putBucketIntelligentTieringConfigurationArgsForCall []struct {
+		arg1 *s3.PutBucketIntelligentTieringConfigurationInput
+	}
+	putBucketIntelligentTieringConfigurationReturns struct {
+		result1 *s3.PutBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}
+	putBucketIntelligentTieringConfigurationReturnsOnCall map[int]struct {
+		result1 *s3.PutBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}
+	PutBucketIntelligentTieringConfigurationRequestStub        func(*s3.PutBucketIntelligentTieringConfigurationInput) (*request.Request, *s3.PutBucketIntelligentTieringConfigurationOutput)
+	putBucketIntelligentTieringConfigurationRequestMutex       sync.RWMutex
+	putBucketIntelligentTieringConfigurationRequestArgsForCall []struct {
+		arg1 *s3.PutBucketIntelligentTieringConfigurationInput
+	}
+	putBucketIntelligentTieringConfigurationRequestReturns struct {
+		result1 *request.Request
+		result2 *s3.PutBucketIntelligentTieringConfigurationOutput
+	}
+	putBucketIntelligentTieringConfigurationRequestReturnsOnCall map[int]struct {
+		result1 *request.Request
+		result2 *s3.PutBucketIntelligentTieringConfigurationOutput
+	}
+	PutBucketIntelligentTieringConfigurationWithContextStub        func(aws.Context, *s3.PutBucketIntelligentTieringConfigurationInput, ...request.Option) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)
+	putBucketIntelligentTieringConfigurationWithContextMutex       sync.RWMutex
+	putBucketIntelligentTieringConfigurationWithContextArgsForCall []struct {
+		arg1 aws.Context
+		arg2 *s3.PutBucketIntelligentTieringConfigurationInput
+		arg3 []request.Option
+	}
+	putBucketIntelligentTieringConfigurationWithContextReturns struct {
+		result1 *s3.PutBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}
+	putBucketIntelligentTieringConfigurationWithContextReturnsOnCall map[int]struct {
+		result1 *s3.PutBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}
 	PutBucketInventoryConfigurationStub        func(*s3.PutBucketInventoryConfigurationInput) (*s3.PutBucketInventoryConfigurationOutput, error)
 	putBucketInventoryConfigurationMutex       sync.RWMutex
 	putBucketInventoryConfigurationArgsForCall []struct {
@@ -2791,10 +3186,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketInventoryConfigurationOutput
 	}
-	PutBucketInventoryConfigurationWithContextStub        func(context.Context, *s3.PutBucketInventoryConfigurationInput, ...request.Option) (*s3.PutBucketInventoryConfigurationOutput, error)
+	PutBucketInventoryConfigurationWithContextStub        func(aws.Context, *s3.PutBucketInventoryConfigurationInput, ...request.Option) (*s3.PutBucketInventoryConfigurationOutput, error)
 	putBucketInventoryConfigurationWithContextMutex       sync.RWMutex
 	putBucketInventoryConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketInventoryConfigurationInput
 		arg3 []request.Option
 	}
@@ -2845,10 +3240,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketLifecycleConfigurationOutput
 	}
-	PutBucketLifecycleConfigurationWithContextStub        func(context.Context, *s3.PutBucketLifecycleConfigurationInput, ...request.Option) (*s3.PutBucketLifecycleConfigurationOutput, error)
+	PutBucketLifecycleConfigurationWithContextStub        func(aws.Context, *s3.PutBucketLifecycleConfigurationInput, ...request.Option) (*s3.PutBucketLifecycleConfigurationOutput, error)
 	putBucketLifecycleConfigurationWithContextMutex       sync.RWMutex
 	putBucketLifecycleConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketLifecycleConfigurationInput
 		arg3 []request.Option
 	}
@@ -2873,10 +3268,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketLifecycleOutput
 	}
-	PutBucketLifecycleWithContextStub        func(context.Context, *s3.PutBucketLifecycleInput, ...request.Option) (*s3.PutBucketLifecycleOutput, error)
+	PutBucketLifecycleWithContextStub        func(aws.Context, *s3.PutBucketLifecycleInput, ...request.Option) (*s3.PutBucketLifecycleOutput, error)
 	putBucketLifecycleWithContextMutex       sync.RWMutex
 	putBucketLifecycleWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketLifecycleInput
 		arg3 []request.Option
 	}
@@ -2914,10 +3309,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketLoggingOutput
 	}
-	PutBucketLoggingWithContextStub        func(context.Context, *s3.PutBucketLoggingInput, ...request.Option) (*s3.PutBucketLoggingOutput, error)
+	PutBucketLoggingWithContextStub        func(aws.Context, *s3.PutBucketLoggingInput, ...request.Option) (*s3.PutBucketLoggingOutput, error)
 	putBucketLoggingWithContextMutex       sync.RWMutex
 	putBucketLoggingWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketLoggingInput
 		arg3 []request.Option
 	}
@@ -2955,10 +3350,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketMetricsConfigurationOutput
 	}
-	PutBucketMetricsConfigurationWithContextStub        func(context.Context, *s3.PutBucketMetricsConfigurationInput, ...request.Option) (*s3.PutBucketMetricsConfigurationOutput, error)
+	PutBucketMetricsConfigurationWithContextStub        func(aws.Context, *s3.PutBucketMetricsConfigurationInput, ...request.Option) (*s3.PutBucketMetricsConfigurationOutput, error)
 	putBucketMetricsConfigurationWithContextMutex       sync.RWMutex
 	putBucketMetricsConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketMetricsConfigurationInput
 		arg3 []request.Option
 	}
@@ -3009,10 +3404,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketNotificationConfigurationOutput
 	}
-	PutBucketNotificationConfigurationWithContextStub        func(context.Context, *s3.PutBucketNotificationConfigurationInput, ...request.Option) (*s3.PutBucketNotificationConfigurationOutput, error)
+	PutBucketNotificationConfigurationWithContextStub        func(aws.Context, *s3.PutBucketNotificationConfigurationInput, ...request.Option) (*s3.PutBucketNotificationConfigurationOutput, error)
 	putBucketNotificationConfigurationWithContextMutex       sync.RWMutex
 	putBucketNotificationConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketNotificationConfigurationInput
 		arg3 []request.Option
 	}
@@ -3037,10 +3432,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketNotificationOutput
 	}
-	PutBucketNotificationWithContextStub        func(context.Context, *s3.PutBucketNotificationInput, ...request.Option) (*s3.PutBucketNotificationOutput, error)
+	PutBucketNotificationWithContextStub        func(aws.Context, *s3.PutBucketNotificationInput, ...request.Option) (*s3.PutBucketNotificationOutput, error)
 	putBucketNotificationWithContextMutex       sync.RWMutex
 	putBucketNotificationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketNotificationInput
 		arg3 []request.Option
 	}
@@ -3052,6 +3447,47 @@ type FakeS3API struct {
 		result1 *s3.PutBucketNotificationOutput
 		result2 error
 	}
+	PutBucketOwnershipControlsStub        func(*s3.PutBucketOwnershipControlsInput) (*s3.PutBucketOwnershipControlsOutput, error)
+	putBucketOwnershipControlsMutex       sync.RWMutex
+	putBucketOwnershipControlsArgsForCall []struct {
+		arg1 *s3.PutBucketOwnershipControlsInput
+	}
+	putBucketOwnershipControlsReturns struct {
+		result1 *s3.PutBucketOwnershipControlsOutput
+		result2 error
+	}
+	putBucketOwnershipControlsReturnsOnCall map[int]struct {
+		result1 *s3.PutBucketOwnershipControlsOutput
+		result2 error
+	}
+	PutBucketOwnershipControlsRequestStub        func(*s3.PutBucketOwnershipControlsInput) (*request.Request, *s3.PutBucketOwnershipControlsOutput)
+	putBucketOwnershipControlsRequestMutex       sync.RWMutex
+	putBucketOwnershipControlsRequestArgsForCall []struct {
+		arg1 *s3.PutBucketOwnershipControlsInput
+	}
+	putBucketOwnershipControlsRequestReturns struct {
+		result1 *request.Request
+		result2 *s3.PutBucketOwnershipControlsOutput
+	}
+	putBucketOwnershipControlsRequestReturnsOnCall map[int]struct {
+		result1 *request.Request
+		result2 *s3.PutBucketOwnershipControlsOutput
+	}
+	PutBucketOwnershipControlsWithContextStub        func(aws.Context, *s3.PutBucketOwnershipControlsInput, ...request.Option) (*s3.PutBucketOwnershipControlsOutput, error)
+	putBucketOwnershipControlsWithContextMutex       sync.RWMutex
+	putBucketOwnershipControlsWithContextArgsForCall []struct {
+		arg1 aws.Context
+		arg2 *s3.PutBucketOwnershipControlsInput
+		arg3 []request.Option
+	}
+	putBucketOwnershipControlsWithContextReturns struct {
+		result1 *s3.PutBucketOwnershipControlsOutput
+		result2 error
+	}
+	putBucketOwnershipControlsWithContextReturnsOnCall map[int]struct {
+		result1 *s3.PutBucketOwnershipControlsOutput
+		result2 error
+	}
 	PutBucketPolicyStub        func(*s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error)
 	putBucketPolicyMutex       sync.RWMutex
 	putBucketPolicyArgsForCall []struct {
@@ -3078,10 +3514,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketPolicyOutput
 	}
-	PutBucketPolicyWithContextStub        func(context.Context, *s3.PutBucketPolicyInput, ...request.Option) (*s3.PutBucketPolicyOutput, error)
+	PutBucketPolicyWithContextStub        func(aws.Context, *s3.PutBucketPolicyInput, ...request.Option) (*s3.PutBucketPolicyOutput, error)
 	putBucketPolicyWithContextMutex       sync.RWMutex
 	putBucketPolicyWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketPolicyInput
 		arg3 []request.Option
 	}
@@ -3119,10 +3555,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketReplicationOutput
 	}
-	PutBucketReplicationWithContextStub        func(context.Context, *s3.PutBucketReplicationInput, ...request.Option) (*s3.PutBucketReplicationOutput, error)
+	PutBucketReplicationWithContextStub        func(aws.Context, *s3.PutBucketReplicationInput, ...request.Option) (*s3.PutBucketReplicationOutput, error)
 	putBucketReplicationWithContextMutex       sync.RWMutex
 	putBucketReplicationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketReplicationInput
 		arg3 []request.Option
 	}
@@ -3160,10 +3596,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketRequestPaymentOutput
 	}
-	PutBucketRequestPaymentWithContextStub        func(context.Context, *s3.PutBucketRequestPaymentInput, ...request.Option) (*s3.PutBucketRequestPaymentOutput, error)
+	PutBucketRequestPaymentWithContextStub        func(aws.Context, *s3.PutBucketRequestPaymentInput, ...request.Option) (*s3.PutBucketRequestPaymentOutput, error)
 	putBucketRequestPaymentWithContextMutex       sync.RWMutex
 	putBucketRequestPaymentWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketRequestPaymentInput
 		arg3 []request.Option
 	}
@@ -3201,10 +3637,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketTaggingOutput
 	}
-	PutBucketTaggingWithContextStub        func(context.Context, *s3.PutBucketTaggingInput, ...request.Option) (*s3.PutBucketTaggingOutput, error)
+	PutBucketTaggingWithContextStub        func(aws.Context, *s3.PutBucketTaggingInput, ...request.Option) (*s3.PutBucketTaggingOutput, error)
 	putBucketTaggingWithContextMutex       sync.RWMutex
 	putBucketTaggingWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketTaggingInput
 		arg3 []request.Option
 	}
@@ -3242,10 +3678,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketVersioningOutput
 	}
-	PutBucketVersioningWithContextStub        func(context.Context, *s3.PutBucketVersioningInput, ...request.Option) (*s3.PutBucketVersioningOutput, error)
+	PutBucketVersioningWithContextStub        func(aws.Context, *s3.PutBucketVersioningInput, ...request.Option) (*s3.PutBucketVersioningOutput, error)
 	putBucketVersioningWithContextMutex       sync.RWMutex
 	putBucketVersioningWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketVersioningInput
 		arg3 []request.Option
 	}
@@ -3283,10 +3719,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutBucketWebsiteOutput
 	}
-	PutBucketWebsiteWithContextStub        func(context.Context, *s3.PutBucketWebsiteInput, ...request.Option) (*s3.PutBucketWebsiteOutput, error)
+	PutBucketWebsiteWithContextStub        func(aws.Context, *s3.PutBucketWebsiteInput, ...request.Option) (*s3.PutBucketWebsiteOutput, error)
 	putBucketWebsiteWithContextMutex       sync.RWMutex
 	putBucketWebsiteWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketWebsiteInput
 		arg3 []request.Option
 	}
@@ -3337,10 +3773,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutObjectAclOutput
 	}
-	PutObjectAclWithContextStub        func(context.Context, *s3.PutObjectAclInput, ...request.Option) (*s3.PutObjectAclOutput, error)
+	PutObjectAclWithContextStub        func(aws.Context, *s3.PutObjectAclInput, ...request.Option) (*s3.PutObjectAclOutput, error)
 	putObjectAclWithContextMutex       sync.RWMutex
 	putObjectAclWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutObjectAclInput
 		arg3 []request.Option
 	}
@@ -3378,10 +3814,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutObjectLegalHoldOutput
 	}
-	PutObjectLegalHoldWithContextStub        func(context.Context, *s3.PutObjectLegalHoldInput, ...request.Option) (*s3.PutObjectLegalHoldOutput, error)
+	PutObjectLegalHoldWithContextStub        func(aws.Context, *s3.PutObjectLegalHoldInput, ...request.Option) (*s3.PutObjectLegalHoldOutput, error)
 	putObjectLegalHoldWithContextMutex       sync.RWMutex
 	putObjectLegalHoldWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutObjectLegalHoldInput
 		arg3 []request.Option
 	}
@@ -3419,10 +3855,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutObjectLockConfigurationOutput
 	}
-	PutObjectLockConfigurationWithContextStub        func(context.Context, *s3.PutObjectLockConfigurationInput, ...request.Option) (*s3.PutObjectLockConfigurationOutput, error)
+	PutObjectLockConfigurationWithContextStub        func(aws.Context, *s3.PutObjectLockConfigurationInput, ...request.Option) (*s3.PutObjectLockConfigurationOutput, error)
 	putObjectLockConfigurationWithContextMutex       sync.RWMutex
 	putObjectLockConfigurationWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutObjectLockConfigurationInput
 		arg3 []request.Option
 	}
@@ -3473,10 +3909,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutObjectRetentionOutput
 	}
-	PutObjectRetentionWithContextStub        func(context.Context, *s3.PutObjectRetentionInput, ...request.Option) (*s3.PutObjectRetentionOutput, error)
+	PutObjectRetentionWithContextStub        func(aws.Context, *s3.PutObjectRetentionInput, ...request.Option) (*s3.PutObjectRetentionOutput, error)
 	putObjectRetentionWithContextMutex       sync.RWMutex
 	putObjectRetentionWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutObjectRetentionInput
 		arg3 []request.Option
 	}
@@ -3514,10 +3950,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutObjectTaggingOutput
 	}
-	PutObjectTaggingWithContextStub        func(context.Context, *s3.PutObjectTaggingInput, ...request.Option) (*s3.PutObjectTaggingOutput, error)
+	PutObjectTaggingWithContextStub        func(aws.Context, *s3.PutObjectTaggingInput, ...request.Option) (*s3.PutObjectTaggingOutput, error)
 	putObjectTaggingWithContextMutex       sync.RWMutex
 	putObjectTaggingWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutObjectTaggingInput
 		arg3 []request.Option
 	}
@@ -3529,10 +3965,10 @@ type FakeS3API struct {
 		result1 *s3.PutObjectTaggingOutput
 		result2 error
 	}
-	PutObjectWithContextStub        func(context.Context, *s3.PutObjectInput, ...request.Option) (*s3.PutObjectOutput, error)
+	PutObjectWithContextStub        func(aws.Context, *s3.PutObjectInput, ...request.Option) (*s3.PutObjectOutput, error)
 	putObjectWithContextMutex       sync.RWMutex
 	putObjectWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutObjectInput
 		arg3 []request.Option
 	}
@@ -3570,10 +4006,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.PutPublicAccessBlockOutput
 	}
-	PutPublicAccessBlockWithContextStub        func(context.Context, *s3.PutPublicAccessBlockInput, ...request.Option) (*s3.PutPublicAccessBlockOutput, error)
+	PutPublicAccessBlockWithContextStub        func(aws.Context, *s3.PutPublicAccessBlockInput, ...request.Option) (*s3.PutPublicAccessBlockOutput, error)
 	putPublicAccessBlockWithContextMutex       sync.RWMutex
 	putPublicAccessBlockWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutPublicAccessBlockInput
 		arg3 []request.Option
 	}
@@ -3611,10 +4047,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.RestoreObjectOutput
 	}
-	RestoreObjectWithContextStub        func(context.Context, *s3.RestoreObjectInput, ...request.Option) (*s3.RestoreObjectOutput, error)
+	RestoreObjectWithContextStub        func(aws.Context, *s3.RestoreObjectInput, ...request.Option) (*s3.RestoreObjectOutput, error)
 	restoreObjectWithContextMutex       sync.RWMutex
 	restoreObjectWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.RestoreObjectInput
 		arg3 []request.Option
 	}
@@ -3652,10 +4088,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.SelectObjectContentOutput
 	}
-	SelectObjectContentWithContextStub        func(context.Context, *s3.SelectObjectContentInput, ...request.Option) (*s3.SelectObjectContentOutput, error)
+	SelectObjectContentWithContextStub        func(aws.Context, *s3.SelectObjectContentInput, ...request.Option) (*s3.SelectObjectContentOutput, error)
 	selectObjectContentWithContextMutex       sync.RWMutex
 	selectObjectContentWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.SelectObjectContentInput
 		arg3 []request.Option
 	}
@@ -3706,10 +4142,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.UploadPartCopyOutput
 	}
-	UploadPartCopyWithContextStub        func(context.Context, *s3.UploadPartCopyInput, ...request.Option) (*s3.UploadPartCopyOutput, error)
+	UploadPartCopyWithContextStub        func(aws.Context, *s3.UploadPartCopyInput, ...request.Option) (*s3.UploadPartCopyOutput, error)
 	uploadPartCopyWithContextMutex       sync.RWMutex
 	uploadPartCopyWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.UploadPartCopyInput
 		arg3 []request.Option
 	}
@@ -3734,10 +4170,10 @@ type FakeS3API struct {
 		result1 *request.Request
 		result2 *s3.UploadPartOutput
 	}
-	UploadPartWithContextStub        func(context.Context, *s3.UploadPartInput, ...request.Option) (*s3.UploadPartOutput, error)
+	UploadPartWithContextStub        func(aws.Context, *s3.UploadPartInput, ...request.Option) (*s3.UploadPartOutput, error)
 	uploadPartWithContextMutex       sync.RWMutex
 	uploadPartWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.UploadPartInput
 		arg3 []request.Option
 	}
@@ -3760,10 +4196,10 @@ type FakeS3API struct {
 	waitUntilBucketExistsReturnsOnCall map[int]struct {
 		result1 error
 	}
-	WaitUntilBucketExistsWithContextStub        func(context.Context, *s3.HeadBucketInput, ...request.WaiterOption) error
+	WaitUntilBucketExistsWithContextStub        func(aws.Context, *s3.HeadBucketInput, ...request.WaiterOption) error
 	waitUntilBucketExistsWithContextMutex       sync.RWMutex
 	waitUntilBucketExistsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.HeadBucketInput
 		arg3 []request.WaiterOption
 	}
@@ -3784,10 +4220,10 @@ type FakeS3API struct {
 	waitUntilBucketNotExistsReturnsOnCall map[int]struct {
 		result1 error
 	}
-	WaitUntilBucketNotExistsWithContextStub        func(context.Context, *s3.HeadBucketInput, ...request.WaiterOption) error
+	WaitUntilBucketNotExistsWithContextStub        func(aws.Context, *s3.HeadBucketInput, ...request.WaiterOption) error
 	waitUntilBucketNotExistsWithContextMutex       sync.RWMutex
 	waitUntilBucketNotExistsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.HeadBucketInput
 		arg3 []request.WaiterOption
 	}
@@ -3808,10 +4244,10 @@ type FakeS3API struct {
 	waitUntilObjectExistsReturnsOnCall map[int]struct {
 		result1 error
 	}
-	WaitUntilObjectExistsWithContextStub        func(context.Context, *s3.HeadObjectInput, ...request.WaiterOption) error
+	WaitUntilObjectExistsWithContextStub        func(aws.Context, *s3.HeadObjectInput, ...request.WaiterOption) error
 	waitUntilObjectExistsWithContextMutex       sync.RWMutex
 	waitUntilObjectExistsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.HeadObjectInput
 		arg3 []request.WaiterOption
 	}
@@ -3832,10 +4268,10 @@ type FakeS3API struct {
 	waitUntilObjectNotExistsReturnsOnCall map[int]struct {
 		result1 error
 	}
-	WaitUntilObjectNotExistsWithContextStub        func(context.Context, *s3.HeadObjectInput, ...request.WaiterOption) error
+	WaitUntilObjectNotExistsWithContextStub        func(aws.Context, *s3.HeadObjectInput, ...request.WaiterOption) error
 	waitUntilObjectNotExistsWithContextMutex       sync.RWMutex
 	waitUntilObjectNotExistsWithContextArgsForCall []struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.HeadObjectInput
 		arg3 []request.WaiterOption
 	}
@@ -3845,6 +4281,47 @@ type FakeS3API struct {
 	waitUntilObjectNotExistsWithContextReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WriteGetObjectResponseStub        func(*s3.WriteGetObjectResponseInput) (*s3.WriteGetObjectResponseOutput, error)
+	writeGetObjectResponseMutex       sync.RWMutex
+	writeGetObjectResponseArgsForCall []struct {
+		arg1 *s3.WriteGetObjectResponseInput
+	}
+	writeGetObjectResponseReturns struct {
+		result1 *s3.WriteGetObjectResponseOutput
+		result2 error
+	}
+	writeGetObjectResponseReturnsOnCall map[int]struct {
+		result1 *s3.WriteGetObjectResponseOutput
+		result2 error
+	}
+	WriteGetObjectResponseRequestStub        func(*s3.WriteGetObjectResponseInput) (*request.Request, *s3.WriteGetObjectResponseOutput)
+	writeGetObjectResponseRequestMutex       sync.RWMutex
+	writeGetObjectResponseRequestArgsForCall []struct {
+		arg1 *s3.WriteGetObjectResponseInput
+	}
+	writeGetObjectResponseRequestReturns struct {
+		result1 *request.Request
+		result2 *s3.WriteGetObjectResponseOutput
+	}
+	writeGetObjectResponseRequestReturnsOnCall map[int]struct {
+		result1 *request.Request
+		result2 *s3.WriteGetObjectResponseOutput
+	}
+	WriteGetObjectResponseWithContextStub        func(aws.Context, *s3.WriteGetObjectResponseInput, ...request.Option) (*s3.WriteGetObjectResponseOutput, error)
+	writeGetObjectResponseWithContextMutex       sync.RWMutex
+	writeGetObjectResponseWithContextArgsForCall []struct {
+		arg1 aws.Context
+		arg2 *s3.WriteGetObjectResponseInput
+		arg3 []request.Option
+	}
+	writeGetObjectResponseWithContextReturns struct {
+		result1 *s3.WriteGetObjectResponseOutput
+		result2 error
+	}
+	writeGetObjectResponseWithContextReturnsOnCall map[int]struct {
+		result1 *s3.WriteGetObjectResponseOutput
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -3855,15 +4332,16 @@ func (fake *FakeS3API) AbortMultipartUpload(arg1 *s3.AbortMultipartUploadInput)
 	fake.abortMultipartUploadArgsForCall = append(fake.abortMultipartUploadArgsForCall, struct {
 		arg1 *s3.AbortMultipartUploadInput
 	}{arg1})
+	stub := fake.AbortMultipartUploadStub
+	fakeReturns := fake.abortMultipartUploadReturns
 	fake.recordInvocation("AbortMultipartUpload", []interface{}{arg1})
 	fake.abortMultipartUploadMutex.Unlock()
-	if fake.AbortMultipartUploadStub != nil {
-		return fake.AbortMultipartUploadStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.abortMultipartUploadReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -3918,15 +4396,16 @@ func (fake *FakeS3API) AbortMultipartUploadRequest(arg1 *s3.AbortMultipartUpload
 	fake.abortMultipartUploadRequestArgsForCall = append(fake.abortMultipartUploadRequestArgsForCall, struct {
 		arg1 *s3.AbortMultipartUploadInput
 	}{arg1})
+	stub := fake.AbortMultipartUploadRequestStub
+	fakeReturns := fake.abortMultipartUploadRequestReturns
 	fake.recordInvocation("AbortMultipartUploadRequest", []interface{}{arg1})
 	fake.abortMultipartUploadRequestMutex.Unlock()
-	if fake.AbortMultipartUploadRequestStub != nil {
-		return fake.AbortMultipartUploadRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.abortMultipartUploadRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -3975,23 +4454,24 @@ func (fake *FakeS3API) AbortMultipartUploadRequestReturnsOnCall(i int, result1 *
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) AbortMultipartUploadWithContext(arg1 context.Context, arg2 *s3.AbortMultipartUploadInput, arg3 ...request.Option) (*s3.AbortMultipartUploadOutput, error) {
+func (fake *FakeS3API) AbortMultipartUploadWithContext(arg1 aws.Context, arg2 *s3.AbortMultipartUploadInput, arg3 ...request.Option) (*s3.AbortMultipartUploadOutput, error) {
 	fake.abortMultipartUploadWithContextMutex.Lock()
 	ret, specificReturn := fake.abortMultipartUploadWithContextReturnsOnCall[len(fake.abortMultipartUploadWithContextArgsForCall)]
 	fake.abortMultipartUploadWithContextArgsForCall = append(fake.abortMultipartUploadWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.AbortMultipartUploadInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.AbortMultipartUploadWithContextStub
+	fakeReturns := fake.abortMultipartUploadWithContextReturns
 	fake.recordInvocation("AbortMultipartUploadWithContext", []interface{}{arg1, arg2, arg3})
 	fake.abortMultipartUploadWithContextMutex.Unlock()
-	if fake.AbortMultipartUploadWithContextStub != nil {
-		return fake.AbortMultipartUploadWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.abortMultipartUploadWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4001,13 +4481,13 @@ func (fake *FakeS3API) AbortMultipartUploadWithContextCallCount() int {
 	return len(fake.abortMultipartUploadWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) AbortMultipartUploadWithContextCalls(stub func(context.Context, *s3.AbortMultipartUploadInput, ...request.Option) (*s3.AbortMultipartUploadOutput, error)) {
+func (fake *FakeS3API) AbortMultipartUploadWithContextCalls(stub func(aws.Context, *s3.AbortMultipartUploadInput, ...request.Option) (*s3.AbortMultipartUploadOutput, error)) {
 	fake.abortMultipartUploadWithContextMutex.Lock()
 	defer fake.abortMultipartUploadWithContextMutex.Unlock()
 	fake.AbortMultipartUploadWithContextStub = stub
 }
 
-func (fake *FakeS3API) AbortMultipartUploadWithContextArgsForCall(i int) (context.Context, *s3.AbortMultipartUploadInput, []request.Option) {
+func (fake *FakeS3API) AbortMultipartUploadWithContextArgsForCall(i int) (aws.Context, *s3.AbortMultipartUploadInput, []request.Option) {
 	fake.abortMultipartUploadWithContextMutex.RLock()
 	defer fake.abortMultipartUploadWithContextMutex.RUnlock()
 	argsForCall := fake.abortMultipartUploadWithContextArgsForCall[i]
@@ -4046,15 +4526,16 @@ func (fake *FakeS3API) CompleteMultipartUpload(arg1 *s3.CompleteMultipartUploadI
 	fake.completeMultipartUploadArgsForCall = append(fake.completeMultipartUploadArgsForCall, struct {
 		arg1 *s3.CompleteMultipartUploadInput
 	}{arg1})
+	stub := fake.CompleteMultipartUploadStub
+	fakeReturns := fake.completeMultipartUploadReturns
 	fake.recordInvocation("CompleteMultipartUpload", []interface{}{arg1})
 	fake.completeMultipartUploadMutex.Unlock()
-	if fake.CompleteMultipartUploadStub != nil {
-		return fake.CompleteMultipartUploadStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.completeMultipartUploadReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4109,15 +4590,16 @@ func (fake *FakeS3API) CompleteMultipartUploadRequest(arg1 *s3.CompleteMultipart
 	fake.completeMultipartUploadRequestArgsForCall = append(fake.completeMultipartUploadRequestArgsForCall, struct {
 		arg1 *s3.CompleteMultipartUploadInput
 	}{arg1})
+	stub := fake.CompleteMultipartUploadRequestStub
+	fakeReturns := fake.completeMultipartUploadRequestReturns
 	fake.recordInvocation("CompleteMultipartUploadRequest", []interface{}{arg1})
 	fake.completeMultipartUploadRequestMutex.Unlock()
-	if fake.CompleteMultipartUploadRequestStub != nil {
-		return fake.CompleteMultipartUploadRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.completeMultipartUploadRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4166,23 +4648,24 @@ func (fake *FakeS3API) CompleteMultipartUploadRequestReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) CompleteMultipartUploadWithContext(arg1 context.Context, arg2 *s3.CompleteMultipartUploadInput, arg3 ...request.Option) (*s3.CompleteMultipartUploadOutput, error) {
+func (fake *FakeS3API) CompleteMultipartUploadWithContext(arg1 aws.Context, arg2 *s3.CompleteMultipartUploadInput, arg3 ...request.Option) (*s3.CompleteMultipartUploadOutput, error) {
 	fake.completeMultipartUploadWithContextMutex.Lock()
 	ret, specificReturn := fake.completeMultipartUploadWithContextReturnsOnCall[len(fake.completeMultipartUploadWithContextArgsForCall)]
 	fake.completeMultipartUploadWithContextArgsForCall = append(fake.completeMultipartUploadWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.CompleteMultipartUploadInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.CompleteMultipartUploadWithContextStub
+	fakeReturns := fake.completeMultipartUploadWithContextReturns
 	fake.recordInvocation("CompleteMultipartUploadWithContext", []interface{}{arg1, arg2, arg3})
 	fake.completeMultipartUploadWithContextMutex.Unlock()
-	if fake.CompleteMultipartUploadWithContextStub != nil {
-		return fake.CompleteMultipartUploadWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.completeMultipartUploadWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4192,13 +4675,13 @@ func (fake *FakeS3API) CompleteMultipartUploadWithContextCallCount() int {
 	return len(fake.completeMultipartUploadWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) CompleteMultipartUploadWithContextCalls(stub func(context.Context, *s3.CompleteMultipartUploadInput, ...request.Option) (*s3.CompleteMultipartUploadOutput, error)) {
+func (fake *FakeS3API) CompleteMultipartUploadWithContextCalls(stub func(aws.Context, *s3.CompleteMultipartUploadInput, ...request.Option) (*s3.CompleteMultipartUploadOutput, error)) {
 	fake.completeMultipartUploadWithContextMutex.Lock()
 	defer fake.completeMultipartUploadWithContextMutex.Unlock()
 	fake.CompleteMultipartUploadWithContextStub = stub
 }
 
-func (fake *FakeS3API) CompleteMultipartUploadWithContextArgsForCall(i int) (context.Context, *s3.CompleteMultipartUploadInput, []request.Option) {
+func (fake *FakeS3API) CompleteMultipartUploadWithContextArgsForCall(i int) (aws.Context, *s3.CompleteMultipartUploadInput, []request.Option) {
 	fake.completeMultipartUploadWithContextMutex.RLock()
 	defer fake.completeMultipartUploadWithContextMutex.RUnlock()
 	argsForCall := fake.completeMultipartUploadWithContextArgsForCall[i]
@@ -4237,15 +4720,16 @@ func (fake *FakeS3API) CopyObject(arg1 *s3.CopyObjectInput) (*s3.CopyObjectOutpu
 	fake.copyObjectArgsForCall = append(fake.copyObjectArgsForCall, struct {
 		arg1 *s3.CopyObjectInput
 	}{arg1})
+	stub := fake.CopyObjectStub
+	fakeReturns := fake.copyObjectReturns
 	fake.recordInvocation("CopyObject", []interface{}{arg1})
 	fake.copyObjectMutex.Unlock()
-	if fake.CopyObjectStub != nil {
-		return fake.CopyObjectStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.copyObjectReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4300,15 +4784,16 @@ func (fake *FakeS3API) CopyObjectRequest(arg1 *s3.CopyObjectInput) (*request.Req
 	fake.copyObjectRequestArgsForCall = append(fake.copyObjectRequestArgsForCall, struct {
 		arg1 *s3.CopyObjectInput
 	}{arg1})
+	stub := fake.CopyObjectRequestStub
+	fakeReturns := fake.copyObjectRequestReturns
 	fake.recordInvocation("CopyObjectRequest", []interface{}{arg1})
 	fake.copyObjectRequestMutex.Unlock()
-	if fake.CopyObjectRequestStub != nil {
-		return fake.CopyObjectRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.copyObjectRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4357,23 +4842,24 @@ func (fake *FakeS3API) CopyObjectRequestReturnsOnCall(i int, result1 *request.Re
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) CopyObjectWithContext(arg1 context.Context, arg2 *s3.CopyObjectInput, arg3 ...request.Option) (*s3.CopyObjectOutput, error) {
+func (fake *FakeS3API) CopyObjectWithContext(arg1 aws.Context, arg2 *s3.CopyObjectInput, arg3 ...request.Option) (*s3.CopyObjectOutput, error) {
 	fake.copyObjectWithContextMutex.Lock()
 	ret, specificReturn := fake.copyObjectWithContextReturnsOnCall[len(fake.copyObjectWithContextArgsForCall)]
 	fake.copyObjectWithContextArgsForCall = append(fake.copyObjectWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.CopyObjectInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.CopyObjectWithContextStub
+	fakeReturns := fake.copyObjectWithContextReturns
 	fake.recordInvocation("CopyObjectWithContext", []interface{}{arg1, arg2, arg3})
 	fake.copyObjectWithContextMutex.Unlock()
-	if fake.CopyObjectWithContextStub != nil {
-		return fake.CopyObjectWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.copyObjectWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4383,13 +4869,13 @@ func (fake *FakeS3API) CopyObjectWithContextCallCount() int {
 	return len(fake.copyObjectWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) CopyObjectWithContextCalls(stub func(context.Context, *s3.CopyObjectInput, ...request.Option) (*s3.CopyObjectOutput, error)) {
+func (fake *FakeS3API) CopyObjectWithContextCalls(stub func(aws.Context, *s3.CopyObjectInput, ...request.Option) (*s3.CopyObjectOutput, error)) {
 	fake.copyObjectWithContextMutex.Lock()
 	defer fake.copyObjectWithContextMutex.Unlock()
 	fake.CopyObjectWithContextStub = stub
 }
 
-func (fake *FakeS3API) CopyObjectWithContextArgsForCall(i int) (context.Context, *s3.CopyObjectInput, []request.Option) {
+func (fake *FakeS3API) CopyObjectWithContextArgsForCall(i int) (aws.Context, *s3.CopyObjectInput, []request.Option) {
 	fake.copyObjectWithContextMutex.RLock()
 	defer fake.copyObjectWithContextMutex.RUnlock()
 	argsForCall := fake.copyObjectWithContextArgsForCall[i]
@@ -4428,15 +4914,16 @@ func (fake *FakeS3API) CreateBucket(arg1 *s3.CreateBucketInput) (*s3.CreateBucke
 	fake.createBucketArgsForCall = append(fake.createBucketArgsForCall, struct {
 		arg1 *s3.CreateBucketInput
 	}{arg1})
+	stub := fake.CreateBucketStub
+	fakeReturns := fake.createBucketReturns
 	fake.recordInvocation("CreateBucket", []interface{}{arg1})
 	fake.createBucketMutex.Unlock()
-	if fake.CreateBucketStub != nil {
-		return fake.CreateBucketStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createBucketReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4491,15 +4978,16 @@ func (fake *FakeS3API) CreateBucketRequest(arg1 *s3.CreateBucketInput) (*request
 	fake.createBucketRequestArgsForCall = append(fake.createBucketRequestArgsForCall, struct {
 		arg1 *s3.CreateBucketInput
 	}{arg1})
+	stub := fake.CreateBucketRequestStub
+	fakeReturns := fake.createBucketRequestReturns
 	fake.recordInvocation("CreateBucketRequest", []interface{}{arg1})
 	fake.createBucketRequestMutex.Unlock()
-	if fake.CreateBucketRequestStub != nil {
-		return fake.CreateBucketRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createBucketRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4548,23 +5036,24 @@ func (fake *FakeS3API) CreateBucketRequestReturnsOnCall(i int, result1 *request.
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) CreateBucketWithContext(arg1 context.Context, arg2 *s3.CreateBucketInput, arg3 ...request.Option) (*s3.CreateBucketOutput, error) {
+func (fake *FakeS3API) CreateBucketWithContext(arg1 aws.Context, arg2 *s3.CreateBucketInput, arg3 ...request.Option) (*s3.CreateBucketOutput, error) {
 	fake.createBucketWithContextMutex.Lock()
 	ret, specificReturn := fake.createBucketWithContextReturnsOnCall[len(fake.createBucketWithContextArgsForCall)]
 	fake.createBucketWithContextArgsForCall = append(fake.createBucketWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.CreateBucketInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.CreateBucketWithContextStub
+	fakeReturns := fake.createBucketWithContextReturns
 	fake.recordInvocation("CreateBucketWithContext", []interface{}{arg1, arg2, arg3})
 	fake.createBucketWithContextMutex.Unlock()
-	if fake.CreateBucketWithContextStub != nil {
-		return fake.CreateBucketWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createBucketWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4574,13 +5063,13 @@ func (fake *FakeS3API) CreateBucketWithContextCallCount() int {
 	return len(fake.createBucketWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) CreateBucketWithContextCalls(stub func(context.Context, *s3.CreateBucketInput, ...request.Option) (*s3.CreateBucketOutput, error)) {
+func (fake *FakeS3API) CreateBucketWithContextCalls(stub func(aws.Context, *s3.CreateBucketInput, ...request.Option) (*s3.CreateBucketOutput, error)) {
 	fake.createBucketWithContextMutex.Lock()
 	defer fake.createBucketWithContextMutex.Unlock()
 	fake.CreateBucketWithContextStub = stub
 }
 
-func (fake *FakeS3API) CreateBucketWithContextArgsForCall(i int) (context.Context, *s3.CreateBucketInput, []request.Option) {
+func (fake *FakeS3API) CreateBucketWithContextArgsForCall(i int) (aws.Context, *s3.CreateBucketInput, []request.Option) {
 	fake.createBucketWithContextMutex.RLock()
 	defer fake.createBucketWithContextMutex.RUnlock()
 	argsForCall := fake.createBucketWithContextArgsForCall[i]
@@ -4619,15 +5108,16 @@ func (fake *FakeS3API) CreateMultipartUpload(arg1 *s3.CreateMultipartUploadInput
 	fake.createMultipartUploadArgsForCall = append(fake.createMultipartUploadArgsForCall, struct {
 		arg1 *s3.CreateMultipartUploadInput
 	}{arg1})
+	stub := fake.CreateMultipartUploadStub
+	fakeReturns := fake.createMultipartUploadReturns
 	fake.recordInvocation("CreateMultipartUpload", []interface{}{arg1})
 	fake.createMultipartUploadMutex.Unlock()
-	if fake.CreateMultipartUploadStub != nil {
-		return fake.CreateMultipartUploadStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createMultipartUploadReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4682,15 +5172,16 @@ func (fake *FakeS3API) CreateMultipartUploadRequest(arg1 *s3.CreateMultipartUplo
 	fake.createMultipartUploadRequestArgsForCall = append(fake.createMultipartUploadRequestArgsForCall, struct {
 		arg1 *s3.CreateMultipartUploadInput
 	}{arg1})
+	stub := fake.CreateMultipartUploadRequestStub
+	fakeReturns := fake.createMultipartUploadRequestReturns
 	fake.recordInvocation("CreateMultipartUploadRequest", []interface{}{arg1})
 	fake.createMultipartUploadRequestMutex.Unlock()
-	if fake.CreateMultipartUploadRequestStub != nil {
-		return fake.CreateMultipartUploadRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createMultipartUploadRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4739,23 +5230,24 @@ func (fake *FakeS3API) CreateMultipartUploadRequestReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) CreateMultipartUploadWithContext(arg1 context.Context, arg2 *s3.CreateMultipartUploadInput, arg3 ...request.Option) (*s3.CreateMultipartUploadOutput, error) {
+func (fake *FakeS3API) CreateMultipartUploadWithContext(arg1 aws.Context, arg2 *s3.CreateMultipartUploadInput, arg3 ...request.Option) (*s3.CreateMultipartUploadOutput, error) {
 	fake.createMultipartUploadWithContextMutex.Lock()
 	ret, specificReturn := fake.createMultipartUploadWithContextReturnsOnCall[len(fake.createMultipartUploadWithContextArgsForCall)]
 	fake.createMultipartUploadWithContextArgsForCall = append(fake.createMultipartUploadWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.CreateMultipartUploadInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.CreateMultipartUploadWithContextStub
+	fakeReturns := fake.createMultipartUploadWithContextReturns
 	fake.recordInvocation("CreateMultipartUploadWithContext", []interface{}{arg1, arg2, arg3})
 	fake.createMultipartUploadWithContextMutex.Unlock()
-	if fake.CreateMultipartUploadWithContextStub != nil {
-		return fake.CreateMultipartUploadWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createMultipartUploadWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4765,13 +5257,13 @@ func (fake *FakeS3API) CreateMultipartUploadWithContextCallCount() int {
 	return len(fake.createMultipartUploadWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) CreateMultipartUploadWithContextCalls(stub func(context.Context, *s3.CreateMultipartUploadInput, ...request.Option) (*s3.CreateMultipartUploadOutput, error)) {
+func (fake *FakeS3API) CreateMultipartUploadWithContextCalls(stub func(aws.Context, *s3.CreateMultipartUploadInput, ...request.Option) (*s3.CreateMultipartUploadOutput, error)) {
 	fake.createMultipartUploadWithContextMutex.Lock()
 	defer fake.createMultipartUploadWithContextMutex.Unlock()
 	fake.CreateMultipartUploadWithContextStub = stub
 }
 
-func (fake *FakeS3API) CreateMultipartUploadWithContextArgsForCall(i int) (context.Context, *s3.CreateMultipartUploadInput, []request.Option) {
+func (fake *FakeS3API) CreateMultipartUploadWithContextArgsForCall(i int) (aws.Context, *s3.CreateMultipartUploadInput, []request.Option) {
 	fake.createMultipartUploadWithContextMutex.RLock()
 	defer fake.createMultipartUploadWithContextMutex.RUnlock()
 	argsForCall := fake.createMultipartUploadWithContextArgsForCall[i]
@@ -4804,84 +5296,280 @@ func (fake *FakeS3API) CreateMultipartUploadWithContextReturnsOnCall(i int, resu
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucket(arg1 *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error) {
-	fake.deleteBucketMutex.Lock()
-	ret, specificReturn := fake.deleteBucketReturnsOnCall[len(fake.deleteBucketArgsForCall)]
-	fake.deleteBucketArgsForCall = append(fake.deleteBucketArgsForCall, struct {
-		arg1 *s3.DeleteBucketInput
+func (fake *FakeS3API) CreateSession(arg1 *s3.CreateSessionInput) (*s3.CreateSessionOutput, error) {
+	fake.createSessionMutex.Lock()
+	ret, specificReturn := fake.createSessionReturnsOnCall[len(fake.createSessionArgsForCall)]
+	fake.createSessionArgsForCall = append(fake.createSessionArgsForCall, struct {
+		arg1 *s3.CreateSessionInput
 	}{arg1})
-	fake.recordInvocation("DeleteBucket", []interface{}{arg1})
-	fake.deleteBucketMutex.Unlock()
-	if fake.DeleteBucketStub != nil {
-		return fake.DeleteBucketStub(arg1)
+	stub := fake.CreateSessionStub
+	fakeReturns := fake.createSessionReturns
+	fake.recordInvocation("CreateSession", []interface{}{arg1})
+	fake.createSessionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) DeleteBucketCallCount() int {
-	fake.deleteBucketMutex.RLock()
-	defer fake.deleteBucketMutex.RUnlock()
-	return len(fake.deleteBucketArgsForCall)
+func (fake *FakeS3API) CreateSessionCallCount() int {
+	fake.createSessionMutex.RLock()
+	defer fake.createSessionMutex.RUnlock()
+	return len(fake.createSessionArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketCalls(stub func(*s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error)) {
-	fake.deleteBucketMutex.Lock()
-	defer fake.deleteBucketMutex.Unlock()
-	fake.DeleteBucketStub = stub
+func (fake *FakeS3API) CreateSessionCalls(stub func(*s3.CreateSessionInput) (*s3.CreateSessionOutput, error)) {
+	fake.createSessionMutex.Lock()
+	defer fake.createSessionMutex.Unlock()
+	fake.CreateSessionStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketArgsForCall(i int) *s3.DeleteBucketInput {
-	fake.deleteBucketMutex.RLock()
-	defer fake.deleteBucketMutex.RUnlock()
-	argsForCall := fake.deleteBucketArgsForCall[i]
+func (fake *FakeS3API) CreateSessionArgsForCall(i int) *s3.CreateSessionInput {
+	fake.createSessionMutex.RLock()
+	defer fake.createSessionMutex.RUnlock()
+	argsForCall := fake.createSessionArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeS3API) DeleteBucketReturns(result1 *s3.DeleteBucketOutput, result2 error) {
-	fake.deleteBucketMutex.Lock()
-	defer fake.deleteBucketMutex.Unlock()
-	fake.DeleteBucketStub = nil
-	fake.deleteBucketReturns = struct {
-		result1 *s3.DeleteBucketOutput
+func (fake *FakeS3API) CreateSessionReturns(result1 *s3.CreateSessionOutput, result2 error) {
+	fake.createSessionMutex.Lock()
+	defer fake.createSessionMutex.Unlock()
+	fake.CreateSessionStub = nil
+	fake.createSessionReturns = struct {
+		result1 *s3.CreateSessionOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketReturnsOnCall(i int, result1 *s3.DeleteBucketOutput, result2 error) {
-	fake.deleteBucketMutex.Lock()
-	defer fake.deleteBucketMutex.Unlock()
-	fake.DeleteBucketStub = nil
-	if fake.deleteBucketReturnsOnCall == nil {
-		fake.deleteBucketReturnsOnCall = make(map[int]struct {
-			result1 *s3.DeleteBucketOutput
+func (fake *FakeS3API) CreateSessionReturnsOnCall(i int, result1 *s3.CreateSessionOutput, result2 error) {
+	fake.createSessionMutex.Lock()
+	defer fake.createSessionMutex.Unlock()
+	fake.CreateSessionStub = nil
+	if fake.createSessionReturnsOnCall == nil {
+		fake.createSessionReturnsOnCall = make(map[int]struct {
+			result1 *s3.CreateSessionOutput
 			result2 error
 		})
 	}
-	fake.deleteBucketReturnsOnCall[i] = struct {
-		result1 *s3.DeleteBucketOutput
+	fake.createSessionReturnsOnCall[i] = struct {
+		result1 *s3.CreateSessionOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketAnalyticsConfiguration(arg1 *s3.DeleteBucketAnalyticsConfigurationInput) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
-	fake.deleteBucketAnalyticsConfigurationMutex.Lock()
-	ret, specificReturn := fake.deleteBucketAnalyticsConfigurationReturnsOnCall[len(fake.deleteBucketAnalyticsConfigurationArgsForCall)]
-	fake.deleteBucketAnalyticsConfigurationArgsForCall = append(fake.deleteBucketAnalyticsConfigurationArgsForCall, struct {
+func (fake *FakeS3API) CreateSessionRequest(arg1 *s3.CreateSessionInput) (*request.Request, *s3.CreateSessionOutput) {
+	fake.createSessionRequestMutex.Lock()
+	ret, specificReturn := fake.createSessionRequestReturnsOnCall[len(fake.createSessionRequestArgsForCall)]
+	fake.createSessionRequestArgsForCall = append(fake.createSessionRequestArgsForCall, struct {
+		arg1 *s3.CreateSessionInput
+	}{arg1})
+	stub := fake.CreateSessionRequestStub
+	fakeReturns := fake.createSessionRequestReturns
+	fake.recordInvocation("CreateSessionRequest", []interface{}{arg1})
+	fake.createSessionRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) CreateSessionRequestCallCount() int {
+	fake.createSessionRequestMutex.RLock()
+	defer fake.createSessionRequestMutex.RUnlock()
+	return len(fake.createSessionRequestArgsForCall)
+}
+
+func (fake *FakeS3API) CreateSessionRequestCalls(stub func(*s3.CreateSessionInput) (*request.Request, *s3.CreateSessionOutput)) {
+	fake.createSessionRequestMutex.Lock()
+	defer fake.createSessionRequestMutex.Unlock()
+	fake.CreateSessionRequestStub = stub
+}
+
+func (fake *FakeS3API) CreateSessionRequestArgsForCall(i int) *s3.CreateSessionInput {
+	fake.createSessionRequestMutex.RLock()
+	defer fake.createSessionRequestMutex.RUnlock()
+	argsForCall := fake.createSessionRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) CreateSessionRequestReturns(result1 *request.Request, result2 *s3.CreateSessionOutput) {
+	fake.createSessionRequestMutex.Lock()
+	defer fake.createSessionRequestMutex.Unlock()
+	fake.CreateSessionRequestStub = nil
+	fake.createSessionRequestReturns = struct {
+		result1 *request.Request
+		result2 *s3.CreateSessionOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) CreateSessionRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.CreateSessionOutput) {
+	fake.createSessionRequestMutex.Lock()
+	defer fake.createSessionRequestMutex.Unlock()
+	fake.CreateSessionRequestStub = nil
+	if fake.createSessionRequestReturnsOnCall == nil {
+		fake.createSessionRequestReturnsOnCall = make(map[int]struct {
+			result1 *request.Request
+			result2 *s3.CreateSessionOutput
+		})
+	}
+	fake.createSessionRequestReturnsOnCall[i] = struct {
+		result1 *request.Request
+		result2 *s3.CreateSessionOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) CreateSessionWithContext(arg1 aws.Context, arg2 *s3.CreateSessionInput, arg3 ...request.Option) (*s3.CreateSessionOutput, error) {
+	fake.createSessionWithContextMutex.Lock()
+	ret, specificReturn := fake.createSessionWithContextReturnsOnCall[len(fake.createSessionWithContextArgsForCall)]
+	fake.createSessionWithContextArgsForCall = append(fake.createSessionWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.CreateSessionInput
+		arg3 []request.Option
+	}{arg1, arg2, arg3})
+	stub := fake.CreateSessionWithContextStub
+	fakeReturns := fake.createSessionWithContextReturns
+	fake.recordInvocation("CreateSessionWithContext", []interface{}{arg1, arg2, arg3})
+	fake.createSessionWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) CreateSessionWithContextCallCount() int {
+	fake.createSessionWithContextMutex.RLock()
+	defer fake.createSessionWithContextMutex.RUnlock()
+	return len(fake.createSessionWithContextArgsForCall)
+}
+
+func (fake *FakeS3API) CreateSessionWithContextCalls(stub func(aws.Context, *s3.CreateSessionInput, ...request.Option) (*s3.CreateSessionOutput, error)) {
+	fake.createSessionWithContextMutex.Lock()
+	defer fake.createSessionWithContextMutex.Unlock()
+	fake.CreateSessionWithContextStub = stub
+}
+
+func (fake *FakeS3API) CreateSessionWithContextArgsForCall(i int) (aws.Context, *s3.CreateSessionInput, []request.Option) {
+	fake.createSessionWithContextMutex.RLock()
+	defer fake.createSessionWithContextMutex.RUnlock()
+	argsForCall := fake.createSessionWithContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3API) CreateSessionWithContextReturns(result1 *s3.CreateSessionOutput, result2 error) {
+	fake.createSessionWithContextMutex.Lock()
+	defer fake.createSessionWithContextMutex.Unlock()
+	fake.CreateSessionWithContextStub = nil
+	fake.createSessionWithContextReturns = struct {
+		result1 *s3.CreateSessionOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) CreateSessionWithContextReturnsOnCall(i int, result1 *s3.CreateSessionOutput, result2 error) {
+	fake.createSessionWithContextMutex.Lock()
+	defer fake.createSessionWithContextMutex.Unlock()
+	fake.CreateSessionWithContextStub = nil
+	if fake.createSessionWithContextReturnsOnCall == nil {
+		fake.createSessionWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.CreateSessionOutput
+			result2 error
+		})
+	}
+	fake.createSessionWithContextReturnsOnCall[i] = struct {
+		result1 *s3.CreateSessionOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucket(arg1 *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error) {
+	fake.deleteBucketMutex.Lock()
+	ret, specificReturn := fake.deleteBucketReturnsOnCall[len(fake.deleteBucketArgsForCall)]
+	fake.deleteBucketArgsForCall = append(fake.deleteBucketArgsForCall, struct {
+		arg1 *s3.DeleteBucketInput
+	}{arg1})
+	stub := fake.DeleteBucketStub
+	fakeReturns := fake.deleteBucketReturns
+	fake.recordInvocation("DeleteBucket", []interface{}{arg1})
+	fake.deleteBucketMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) DeleteBucketCallCount() int {
+	fake.deleteBucketMutex.RLock()
+	defer fake.deleteBucketMutex.RUnlock()
+	return len(fake.deleteBucketArgsForCall)
+}
+
+func (fake *FakeS3API) DeleteBucketCalls(stub func(*s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error)) {
+	fake.deleteBucketMutex.Lock()
+	defer fake.deleteBucketMutex.Unlock()
+	fake.DeleteBucketStub = stub
+}
+
+func (fake *FakeS3API) DeleteBucketArgsForCall(i int) *s3.DeleteBucketInput {
+	fake.deleteBucketMutex.RLock()
+	defer fake.deleteBucketMutex.RUnlock()
+	argsForCall := fake.deleteBucketArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) DeleteBucketReturns(result1 *s3.DeleteBucketOutput, result2 error) {
+	fake.deleteBucketMutex.Lock()
+	defer fake.deleteBucketMutex.Unlock()
+	fake.DeleteBucketStub = nil
+	fake.deleteBucketReturns = struct {
+		result1 *s3.DeleteBucketOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketReturnsOnCall(i int, result1 *s3.DeleteBucketOutput, result2 error) {
+	fake.deleteBucketMutex.Lock()
+	defer fake.deleteBucketMutex.Unlock()
+	fake.DeleteBucketStub = nil
+	if fake.deleteBucketReturnsOnCall == nil {
+		fake.deleteBucketReturnsOnCall = make(map[int]struct {
+			result1 *s3.DeleteBucketOutput
+			result2 error
+		})
+	}
+	fake.deleteBucketReturnsOnCall[i] = struct {
+		result1 *s3.DeleteBucketOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketAnalyticsConfiguration(arg1 *s3.DeleteBucketAnalyticsConfigurationInput) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+	fake.deleteBucketAnalyticsConfigurationMutex.Lock()
+	ret, specificReturn := fake.deleteBucketAnalyticsConfigurationReturnsOnCall[len(fake.deleteBucketAnalyticsConfigurationArgsForCall)]
+	fake.deleteBucketAnalyticsConfigurationArgsForCall = append(fake.deleteBucketAnalyticsConfigurationArgsForCall, struct {
 		arg1 *s3.DeleteBucketAnalyticsConfigurationInput
 	}{arg1})
+	stub := fake.DeleteBucketAnalyticsConfigurationStub
+	fakeReturns := fake.deleteBucketAnalyticsConfigurationReturns
 	fake.recordInvocation("DeleteBucketAnalyticsConfiguration", []interface{}{arg1})
 	fake.deleteBucketAnalyticsConfigurationMutex.Unlock()
-	if fake.DeleteBucketAnalyticsConfigurationStub != nil {
-		return fake.DeleteBucketAnalyticsConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketAnalyticsConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4936,15 +5624,16 @@ func (fake *FakeS3API) DeleteBucketAnalyticsConfigurationRequest(arg1 *s3.Delete
 	fake.deleteBucketAnalyticsConfigurationRequestArgsForCall = append(fake.deleteBucketAnalyticsConfigurationRequestArgsForCall, struct {
 		arg1 *s3.DeleteBucketAnalyticsConfigurationInput
 	}{arg1})
+	stub := fake.DeleteBucketAnalyticsConfigurationRequestStub
+	fakeReturns := fake.deleteBucketAnalyticsConfigurationRequestReturns
 	fake.recordInvocation("DeleteBucketAnalyticsConfigurationRequest", []interface{}{arg1})
 	fake.deleteBucketAnalyticsConfigurationRequestMutex.Unlock()
-	if fake.DeleteBucketAnalyticsConfigurationRequestStub != nil {
-		return fake.DeleteBucketAnalyticsConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketAnalyticsConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -4993,23 +5682,24 @@ func (fake *FakeS3API) DeleteBucketAnalyticsConfigurationRequestReturnsOnCall(i
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketAnalyticsConfigurationWithContext(arg1 context.Context, arg2 *s3.DeleteBucketAnalyticsConfigurationInput, arg3 ...request.Option) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+func (fake *FakeS3API) DeleteBucketAnalyticsConfigurationWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketAnalyticsConfigurationInput, arg3 ...request.Option) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
 	fake.deleteBucketAnalyticsConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteBucketAnalyticsConfigurationWithContextReturnsOnCall[len(fake.deleteBucketAnalyticsConfigurationWithContextArgsForCall)]
 	fake.deleteBucketAnalyticsConfigurationWithContextArgsForCall = append(fake.deleteBucketAnalyticsConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketAnalyticsConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketAnalyticsConfigurationWithContextStub
+	fakeReturns := fake.deleteBucketAnalyticsConfigurationWithContextReturns
 	fake.recordInvocation("DeleteBucketAnalyticsConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteBucketAnalyticsConfigurationWithContextMutex.Unlock()
-	if fake.DeleteBucketAnalyticsConfigurationWithContextStub != nil {
-		return fake.DeleteBucketAnalyticsConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketAnalyticsConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5019,13 +5709,13 @@ func (fake *FakeS3API) DeleteBucketAnalyticsConfigurationWithContextCallCount()
 	return len(fake.deleteBucketAnalyticsConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketAnalyticsConfigurationWithContextCalls(stub func(context.Context, *s3.DeleteBucketAnalyticsConfigurationInput, ...request.Option) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)) {
+func (fake *FakeS3API) DeleteBucketAnalyticsConfigurationWithContextCalls(stub func(aws.Context, *s3.DeleteBucketAnalyticsConfigurationInput, ...request.Option) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)) {
 	fake.deleteBucketAnalyticsConfigurationWithContextMutex.Lock()
 	defer fake.deleteBucketAnalyticsConfigurationWithContextMutex.Unlock()
 	fake.DeleteBucketAnalyticsConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketAnalyticsConfigurationWithContextArgsForCall(i int) (context.Context, *s3.DeleteBucketAnalyticsConfigurationInput, []request.Option) {
+func (fake *FakeS3API) DeleteBucketAnalyticsConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketAnalyticsConfigurationInput, []request.Option) {
 	fake.deleteBucketAnalyticsConfigurationWithContextMutex.RLock()
 	defer fake.deleteBucketAnalyticsConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.deleteBucketAnalyticsConfigurationWithContextArgsForCall[i]
@@ -5064,15 +5754,16 @@ func (fake *FakeS3API) DeleteBucketCors(arg1 *s3.DeleteBucketCorsInput) (*s3.Del
 	fake.deleteBucketCorsArgsForCall = append(fake.deleteBucketCorsArgsForCall, struct {
 		arg1 *s3.DeleteBucketCorsInput
 	}{arg1})
+	stub := fake.DeleteBucketCorsStub
+	fakeReturns := fake.deleteBucketCorsReturns
 	fake.recordInvocation("DeleteBucketCors", []interface{}{arg1})
 	fake.deleteBucketCorsMutex.Unlock()
-	if fake.DeleteBucketCorsStub != nil {
-		return fake.DeleteBucketCorsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketCorsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5127,15 +5818,16 @@ func (fake *FakeS3API) DeleteBucketCorsRequest(arg1 *s3.DeleteBucketCorsInput) (
 	fake.deleteBucketCorsRequestArgsForCall = append(fake.deleteBucketCorsRequestArgsForCall, struct {
 		arg1 *s3.DeleteBucketCorsInput
 	}{arg1})
+	stub := fake.DeleteBucketCorsRequestStub
+	fakeReturns := fake.deleteBucketCorsRequestReturns
 	fake.recordInvocation("DeleteBucketCorsRequest", []interface{}{arg1})
 	fake.deleteBucketCorsRequestMutex.Unlock()
-	if fake.DeleteBucketCorsRequestStub != nil {
-		return fake.DeleteBucketCorsRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketCorsRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5184,23 +5876,24 @@ func (fake *FakeS3API) DeleteBucketCorsRequestReturnsOnCall(i int, result1 *requ
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketCorsWithContext(arg1 context.Context, arg2 *s3.DeleteBucketCorsInput, arg3 ...request.Option) (*s3.DeleteBucketCorsOutput, error) {
+func (fake *FakeS3API) DeleteBucketCorsWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketCorsInput, arg3 ...request.Option) (*s3.DeleteBucketCorsOutput, error) {
 	fake.deleteBucketCorsWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteBucketCorsWithContextReturnsOnCall[len(fake.deleteBucketCorsWithContextArgsForCall)]
 	fake.deleteBucketCorsWithContextArgsForCall = append(fake.deleteBucketCorsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketCorsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketCorsWithContextStub
+	fakeReturns := fake.deleteBucketCorsWithContextReturns
 	fake.recordInvocation("DeleteBucketCorsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteBucketCorsWithContextMutex.Unlock()
-	if fake.DeleteBucketCorsWithContextStub != nil {
-		return fake.DeleteBucketCorsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketCorsWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5210,13 +5903,13 @@ func (fake *FakeS3API) DeleteBucketCorsWithContextCallCount() int {
 	return len(fake.deleteBucketCorsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketCorsWithContextCalls(stub func(context.Context, *s3.DeleteBucketCorsInput, ...request.Option) (*s3.DeleteBucketCorsOutput, error)) {
+func (fake *FakeS3API) DeleteBucketCorsWithContextCalls(stub func(aws.Context, *s3.DeleteBucketCorsInput, ...request.Option) (*s3.DeleteBucketCorsOutput, error)) {
 	fake.deleteBucketCorsWithContextMutex.Lock()
 	defer fake.deleteBucketCorsWithContextMutex.Unlock()
 	fake.DeleteBucketCorsWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketCorsWithContextArgsForCall(i int) (context.Context, *s3.DeleteBucketCorsInput, []request.Option) {
+func (fake *FakeS3API) DeleteBucketCorsWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketCorsInput, []request.Option) {
 	fake.deleteBucketCorsWithContextMutex.RLock()
 	defer fake.deleteBucketCorsWithContextMutex.RUnlock()
 	argsForCall := fake.deleteBucketCorsWithContextArgsForCall[i]
@@ -5255,15 +5948,16 @@ func (fake *FakeS3API) DeleteBucketEncryption(arg1 *s3.DeleteBucketEncryptionInp
 	fake.deleteBucketEncryptionArgsForCall = append(fake.deleteBucketEncryptionArgsForCall, struct {
 		arg1 *s3.DeleteBucketEncryptionInput
 	}{arg1})
+	stub := fake.DeleteBucketEncryptionStub
+	fakeReturns := fake.deleteBucketEncryptionReturns
 	fake.recordInvocation("DeleteBucketEncryption", []interface{}{arg1})
 	fake.deleteBucketEncryptionMutex.Unlock()
-	if fake.DeleteBucketEncryptionStub != nil {
-		return fake.DeleteBucketEncryptionStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketEncryptionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5318,15 +6012,16 @@ func (fake *FakeS3API) DeleteBucketEncryptionRequest(arg1 *s3.DeleteBucketEncryp
 	fake.deleteBucketEncryptionRequestArgsForCall = append(fake.deleteBucketEncryptionRequestArgsForCall, struct {
 		arg1 *s3.DeleteBucketEncryptionInput
 	}{arg1})
+	stub := fake.DeleteBucketEncryptionRequestStub
+	fakeReturns := fake.deleteBucketEncryptionRequestReturns
 	fake.recordInvocation("DeleteBucketEncryptionRequest", []interface{}{arg1})
 	fake.deleteBucketEncryptionRequestMutex.Unlock()
-	if fake.DeleteBucketEncryptionRequestStub != nil {
-		return fake.DeleteBucketEncryptionRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketEncryptionRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5375,23 +6070,24 @@ func (fake *FakeS3API) DeleteBucketEncryptionRequestReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketEncryptionWithContext(arg1 context.Context, arg2 *s3.DeleteBucketEncryptionInput, arg3 ...request.Option) (*s3.DeleteBucketEncryptionOutput, error) {
+func (fake *FakeS3API) DeleteBucketEncryptionWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketEncryptionInput, arg3 ...request.Option) (*s3.DeleteBucketEncryptionOutput, error) {
 	fake.deleteBucketEncryptionWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteBucketEncryptionWithContextReturnsOnCall[len(fake.deleteBucketEncryptionWithContextArgsForCall)]
 	fake.deleteBucketEncryptionWithContextArgsForCall = append(fake.deleteBucketEncryptionWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketEncryptionInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketEncryptionWithContextStub
+	fakeReturns := fake.deleteBucketEncryptionWithContextReturns
 	fake.recordInvocation("DeleteBucketEncryptionWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteBucketEncryptionWithContextMutex.Unlock()
-	if fake.DeleteBucketEncryptionWithContextStub != nil {
-		return fake.DeleteBucketEncryptionWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketEncryptionWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5401,13 +6097,13 @@ func (fake *FakeS3API) DeleteBucketEncryptionWithContextCallCount() int {
 	return len(fake.deleteBucketEncryptionWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketEncryptionWithContextCalls(stub func(context.Context, *s3.DeleteBucketEncryptionInput, ...request.Option) (*s3.DeleteBucketEncryptionOutput, error)) {
+func (fake *FakeS3API) DeleteBucketEncryptionWithContextCalls(stub func(aws.Context, *s3.DeleteBucketEncryptionInput, ...request.Option) (*s3.DeleteBucketEncryptionOutput, error)) {
 	fake.deleteBucketEncryptionWithContextMutex.Lock()
 	defer fake.deleteBucketEncryptionWithContextMutex.Unlock()
 	fake.DeleteBucketEncryptionWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketEncryptionWithContextArgsForCall(i int) (context.Context, *s3.DeleteBucketEncryptionInput, []request.Option) {
+func (fake *FakeS3API) DeleteBucketEncryptionWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketEncryptionInput, []request.Option) {
 	fake.deleteBucketEncryptionWithContextMutex.RLock()
 	defer fake.deleteBucketEncryptionWithContextMutex.RUnlock()
 	argsForCall := fake.deleteBucketEncryptionWithContextArgsForCall[i]
@@ -5440,21 +6136,216 @@ func (fake *FakeS3API) DeleteBucketEncryptionWithContextReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfiguration(arg1 *s3.DeleteBucketIntelligentTieringConfigurationInput) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+	fake.deleteBucketIntelligentTieringConfigurationMutex.Lock()
+	ret, specificReturn := fake.deleteBucketIntelligentTieringConfigurationReturnsOnCall[len(fake.deleteBucketIntelligentTieringConfigurationArgsForCall)]
+	fake.deleteBucketIntelligentTieringConfigurationArgsForCall = append(fake.deleteBucketIntelligentTieringConfigurationArgsForCall, struct {
+		arg1 *s3.DeleteBucketIntelligentTieringConfigurationInput
+	}{arg1})
+	stub := fake.DeleteBucketIntelligentTieringConfigurationStub
+	fakeReturns := fake.deleteBucketIntelligentTieringConfigurationReturns
+	fake.recordInvocation("DeleteBucketIntelligentTieringConfiguration", []interface{}{arg1})
+	fake.deleteBucketIntelligentTieringConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationCallCount() int {
+	fake.deleteBucketIntelligentTieringConfigurationMutex.RLock()
+	defer fake.deleteBucketIntelligentTieringConfigurationMutex.RUnlock()
+	return len(fake.deleteBucketIntelligentTieringConfigurationArgsForCall)
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationCalls(stub func(*s3.DeleteBucketIntelligentTieringConfigurationInput) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)) {
+	fake.deleteBucketIntelligentTieringConfigurationMutex.Lock()
+	defer fake.deleteBucketIntelligentTieringConfigurationMutex.Unlock()
+	fake.DeleteBucketIntelligentTieringConfigurationStub = stub
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationArgsForCall(i int) *s3.DeleteBucketIntelligentTieringConfigurationInput {
+	fake.deleteBucketIntelligentTieringConfigurationMutex.RLock()
+	defer fake.deleteBucketIntelligentTieringConfigurationMutex.RUnlock()
+	argsForCall := fake.deleteBucketIntelligentTieringConfigurationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationReturns(result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput, result2 error) {
+	fake.deleteBucketIntelligentTieringConfigurationMutex.Lock()
+	defer fake.deleteBucketIntelligentTieringConfigurationMutex.Unlock()
+	fake.DeleteBucketIntelligentTieringConfigurationStub = nil
+	fake.deleteBucketIntelligentTieringConfigurationReturns = struct {
+		result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationReturnsOnCall(i int, result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput, result2 error) {
+	fake.deleteBucketIntelligentTieringConfigurationMutex.Lock()
+	defer fake.deleteBucketIntelligentTieringConfigurationMutex.Unlock()
+	fake.DeleteBucketIntelligentTieringConfigurationStub = nil
+	if fake.deleteBucketIntelligentTieringConfigurationReturnsOnCall == nil {
+		fake.deleteBucketIntelligentTieringConfigurationReturnsOnCall = make(map[int]struct {
+			result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+			result2 error
+		})
+	}
+	fake.deleteBucketIntelligentTieringConfigurationReturnsOnCall[i] = struct {
+		result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationRequest(arg1 *s3.DeleteBucketIntelligentTieringConfigurationInput) (*request.Request, *s3.DeleteBucketIntelligentTieringConfigurationOutput) {
+	fake.deleteBucketIntelligentTieringConfigurationRequestMutex.Lock()
+	ret, specificReturn := fake.deleteBucketIntelligentTieringConfigurationRequestReturnsOnCall[len(fake.deleteBucketIntelligentTieringConfigurationRequestArgsForCall)]
+	fake.deleteBucketIntelligentTieringConfigurationRequestArgsForCall = append(fake.deleteBucketIntelligentTieringConfigurationRequestArgsForCall, struct {
+		arg1 *s3.DeleteBucketIntelligentTieringConfigurationInput
+	}{arg1})
+	stub := fake.DeleteBucketIntelligentTieringConfigurationRequestStub
+	fakeReturns := fake.deleteBucketIntelligentTieringConfigurationRequestReturns
+	fake.recordInvocation("DeleteBucketIntelligentTieringConfigurationRequest", []interface{}{arg1})
+	fake.deleteBucketIntelligentTieringConfigurationRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationRequestCallCount() int {
+	fake.deleteBucketIntelligentTieringConfigurationRequestMutex.RLock()
+	defer fake.deleteBucketIntelligentTieringConfigurationRequestMutex.RUnlock()
+	return len(fake.deleteBucketIntelligentTieringConfigurationRequestArgsForCall)
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationRequestCalls(stub func(*s3.DeleteBucketIntelligentTieringConfigurationInput) (*request.Request, *s3.DeleteBucketIntelligentTieringConfigurationOutput)) {
+	fake.deleteBucketIntelligentTieringConfigurationRequestMutex.Lock()
+	defer fake.deleteBucketIntelligentTieringConfigurationRequestMutex.Unlock()
+	fake.DeleteBucketIntelligentTieringConfigurationRequestStub = stub
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationRequestArgsForCall(i int) *s3.DeleteBucketIntelligentTieringConfigurationInput {
+	fake.deleteBucketIntelligentTieringConfigurationRequestMutex.RLock()
+	defer fake.deleteBucketIntelligentTieringConfigurationRequestMutex.RUnlock()
+	argsForCall := fake.deleteBucketIntelligentTieringConfigurationRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationRequestReturns(result1 *request.Request, result2 *s3.DeleteBucketIntelligentTieringConfigurationOutput) {
+	fake.deleteBucketIntelligentTieringConfigurationRequestMutex.Lock()
+	defer fake.deleteBucketIntelligentTieringConfigurationRequestMutex.Unlock()
+	fake.DeleteBucketIntelligentTieringConfigurationRequestStub = nil
+	fake.deleteBucketIntelligentTieringConfigurationRequestReturns = struct {
+		result1 *request.Request
+		result2 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.DeleteBucketIntelligentTieringConfigurationOutput) {
+	fake.deleteBucketIntelligentTieringConfigurationRequestMutex.Lock()
+	defer fake.deleteBucketIntelligentTieringConfigurationRequestMutex.Unlock()
+	fake.DeleteBucketIntelligentTieringConfigurationRequestStub = nil
+	if fake.deleteBucketIntelligentTieringConfigurationRequestReturnsOnCall == nil {
+		fake.deleteBucketIntelligentTieringConfigurationRequestReturnsOnCall = make(map[int]struct {
+			result1 *request.Request
+			result2 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+		})
+	}
+	fake.deleteBucketIntelligentTieringConfigurationRequestReturnsOnCall[i] = struct {
+		result1 *request.Request
+		result2 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketIntelligentTieringConfigurationInput, arg3 ...request.Option) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+	fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.Lock()
+	ret, specificReturn := fake.deleteBucketIntelligentTieringConfigurationWithContextReturnsOnCall[len(fake.deleteBucketIntelligentTieringConfigurationWithContextArgsForCall)]
+	fake.deleteBucketIntelligentTieringConfigurationWithContextArgsForCall = append(fake.deleteBucketIntelligentTieringConfigurationWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.DeleteBucketIntelligentTieringConfigurationInput
+		arg3 []request.Option
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketIntelligentTieringConfigurationWithContextStub
+	fakeReturns := fake.deleteBucketIntelligentTieringConfigurationWithContextReturns
+	fake.recordInvocation("DeleteBucketIntelligentTieringConfigurationWithContext", []interface{}{arg1, arg2, arg3})
+	fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationWithContextCallCount() int {
+	fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.RLock()
+	defer fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.RUnlock()
+	return len(fake.deleteBucketIntelligentTieringConfigurationWithContextArgsForCall)
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationWithContextCalls(stub func(aws.Context, *s3.DeleteBucketIntelligentTieringConfigurationInput, ...request.Option) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)) {
+	fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.Lock()
+	defer fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.Unlock()
+	fake.DeleteBucketIntelligentTieringConfigurationWithContextStub = stub
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketIntelligentTieringConfigurationInput, []request.Option) {
+	fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.RLock()
+	defer fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.RUnlock()
+	argsForCall := fake.deleteBucketIntelligentTieringConfigurationWithContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationWithContextReturns(result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput, result2 error) {
+	fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.Lock()
+	defer fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.Unlock()
+	fake.DeleteBucketIntelligentTieringConfigurationWithContextStub = nil
+	fake.deleteBucketIntelligentTieringConfigurationWithContextReturns = struct {
+		result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketIntelligentTieringConfigurationWithContextReturnsOnCall(i int, result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput, result2 error) {
+	fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.Lock()
+	defer fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.Unlock()
+	fake.DeleteBucketIntelligentTieringConfigurationWithContextStub = nil
+	if fake.deleteBucketIntelligentTieringConfigurationWithContextReturnsOnCall == nil {
+		fake.deleteBucketIntelligentTieringConfigurationWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+			result2 error
+		})
+	}
+	fake.deleteBucketIntelligentTieringConfigurationWithContextReturnsOnCall[i] = struct {
+		result1 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeS3API) DeleteBucketInventoryConfiguration(arg1 *s3.DeleteBucketInventoryConfigurationInput) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
 	fake.deleteBucketInventoryConfigurationMutex.Lock()
 	ret, specificReturn := fake.deleteBucketInventoryConfigurationReturnsOnCall[len(fake.deleteBucketInventoryConfigurationArgsForCall)]
 	fake.deleteBucketInventoryConfigurationArgsForCall = append(fake.deleteBucketInventoryConfigurationArgsForCall, struct {
 		arg1 *s3.DeleteBucketInventoryConfigurationInput
 	}{arg1})
+	stub := fake.DeleteBucketInventoryConfigurationStub
+	fakeReturns := fake.deleteBucketInventoryConfigurationReturns
 	fake.recordInvocation("DeleteBucketInventoryConfiguration", []interface{}{arg1})
 	fake.deleteBucketInventoryConfigurationMutex.Unlock()
-	if fake.DeleteBucketInventoryConfigurationStub != nil {
-		return fake.DeleteBucketInventoryConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketInventoryConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5509,15 +6400,16 @@ func (fake *FakeS3API) DeleteBucketInventoryConfigurationRequest(arg1 *s3.Delete
 	fake.deleteBucketInventoryConfigurationRequestArgsForCall = append(fake.deleteBucketInventoryConfigurationRequestArgsForCall, struct {
 		arg1 *s3.DeleteBucketInventoryConfigurationInput
 	}{arg1})
+	stub := fake.DeleteBucketInventoryConfigurationRequestStub
+	fakeReturns := fake.deleteBucketInventoryConfigurationRequestReturns
 	fake.recordInvocation("DeleteBucketInventoryConfigurationRequest", []interface{}{arg1})
 	fake.deleteBucketInventoryConfigurationRequestMutex.Unlock()
-	if fake.DeleteBucketInventoryConfigurationRequestStub != nil {
-		return fake.DeleteBucketInventoryConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketInventoryConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5566,23 +6458,24 @@ func (fake *FakeS3API) DeleteBucketInventoryConfigurationRequestReturnsOnCall(i
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketInventoryConfigurationWithContext(arg1 context.Context, arg2 *s3.DeleteBucketInventoryConfigurationInput, arg3 ...request.Option) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+func (fake *FakeS3API) DeleteBucketInventoryConfigurationWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketInventoryConfigurationInput, arg3 ...request.Option) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
 	fake.deleteBucketInventoryConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteBucketInventoryConfigurationWithContextReturnsOnCall[len(fake.deleteBucketInventoryConfigurationWithContextArgsForCall)]
 	fake.deleteBucketInventoryConfigurationWithContextArgsForCall = append(fake.deleteBucketInventoryConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketInventoryConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketInventoryConfigurationWithContextStub
+	fakeReturns := fake.deleteBucketInventoryConfigurationWithContextReturns
 	fake.recordInvocation("DeleteBucketInventoryConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteBucketInventoryConfigurationWithContextMutex.Unlock()
-	if fake.DeleteBucketInventoryConfigurationWithContextStub != nil {
-		return fake.DeleteBucketInventoryConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketInventoryConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5592,13 +6485,13 @@ func (fake *FakeS3API) DeleteBucketInventoryConfigurationWithContextCallCount()
 	return len(fake.deleteBucketInventoryConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketInventoryConfigurationWithContextCalls(stub func(context.Context, *s3.DeleteBucketInventoryConfigurationInput, ...request.Option) (*s3.DeleteBucketInventoryConfigurationOutput, error)) {
+func (fake *FakeS3API) DeleteBucketInventoryConfigurationWithContextCalls(stub func(aws.Context, *s3.DeleteBucketInventoryConfigurationInput, ...request.Option) (*s3.DeleteBucketInventoryConfigurationOutput, error)) {
 	fake.deleteBucketInventoryConfigurationWithContextMutex.Lock()
 	defer fake.deleteBucketInventoryConfigurationWithContextMutex.Unlock()
 	fake.DeleteBucketInventoryConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketInventoryConfigurationWithContextArgsForCall(i int) (context.Context, *s3.DeleteBucketInventoryConfigurationInput, []request.Option) {
+func (fake *FakeS3API) DeleteBucketInventoryConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketInventoryConfigurationInput, []request.Option) {
 	fake.deleteBucketInventoryConfigurationWithContextMutex.RLock()
 	defer fake.deleteBucketInventoryConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.deleteBucketInventoryConfigurationWithContextArgsForCall[i]
@@ -5637,15 +6530,16 @@ func (fake *FakeS3API) DeleteBucketLifecycle(arg1 *s3.DeleteBucketLifecycleInput
 	fake.deleteBucketLifecycleArgsForCall = append(fake.deleteBucketLifecycleArgsForCall, struct {
 		arg1 *s3.DeleteBucketLifecycleInput
 	}{arg1})
+	stub := fake.DeleteBucketLifecycleStub
+	fakeReturns := fake.deleteBucketLifecycleReturns
 	fake.recordInvocation("DeleteBucketLifecycle", []interface{}{arg1})
 	fake.deleteBucketLifecycleMutex.Unlock()
-	if fake.DeleteBucketLifecycleStub != nil {
-		return fake.DeleteBucketLifecycleStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketLifecycleReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5700,15 +6594,16 @@ func (fake *FakeS3API) DeleteBucketLifecycleRequest(arg1 *s3.DeleteBucketLifecyc
 	fake.deleteBucketLifecycleRequestArgsForCall = append(fake.deleteBucketLifecycleRequestArgsForCall, struct {
 		arg1 *s3.DeleteBucketLifecycleInput
 	}{arg1})
+	stub := fake.DeleteBucketLifecycleRequestStub
+	fakeReturns := fake.deleteBucketLifecycleRequestReturns
 	fake.recordInvocation("DeleteBucketLifecycleRequest", []interface{}{arg1})
 	fake.deleteBucketLifecycleRequestMutex.Unlock()
-	if fake.DeleteBucketLifecycleRequestStub != nil {
-		return fake.DeleteBucketLifecycleRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketLifecycleRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5757,23 +6652,24 @@ func (fake *FakeS3API) DeleteBucketLifecycleRequestReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketLifecycleWithContext(arg1 context.Context, arg2 *s3.DeleteBucketLifecycleInput, arg3 ...request.Option) (*s3.DeleteBucketLifecycleOutput, error) {
+func (fake *FakeS3API) DeleteBucketLifecycleWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketLifecycleInput, arg3 ...request.Option) (*s3.DeleteBucketLifecycleOutput, error) {
 	fake.deleteBucketLifecycleWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteBucketLifecycleWithContextReturnsOnCall[len(fake.deleteBucketLifecycleWithContextArgsForCall)]
 	fake.deleteBucketLifecycleWithContextArgsForCall = append(fake.deleteBucketLifecycleWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketLifecycleInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketLifecycleWithContextStub
+	fakeReturns := fake.deleteBucketLifecycleWithContextReturns
 	fake.recordInvocation("DeleteBucketLifecycleWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteBucketLifecycleWithContextMutex.Unlock()
-	if fake.DeleteBucketLifecycleWithContextStub != nil {
-		return fake.DeleteBucketLifecycleWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketLifecycleWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5783,13 +6679,13 @@ func (fake *FakeS3API) DeleteBucketLifecycleWithContextCallCount() int {
 	return len(fake.deleteBucketLifecycleWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketLifecycleWithContextCalls(stub func(context.Context, *s3.DeleteBucketLifecycleInput, ...request.Option) (*s3.DeleteBucketLifecycleOutput, error)) {
+func (fake *FakeS3API) DeleteBucketLifecycleWithContextCalls(stub func(aws.Context, *s3.DeleteBucketLifecycleInput, ...request.Option) (*s3.DeleteBucketLifecycleOutput, error)) {
 	fake.deleteBucketLifecycleWithContextMutex.Lock()
 	defer fake.deleteBucketLifecycleWithContextMutex.Unlock()
 	fake.DeleteBucketLifecycleWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketLifecycleWithContextArgsForCall(i int) (context.Context, *s3.DeleteBucketLifecycleInput, []request.Option) {
+func (fake *FakeS3API) DeleteBucketLifecycleWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketLifecycleInput, []request.Option) {
 	fake.deleteBucketLifecycleWithContextMutex.RLock()
 	defer fake.deleteBucketLifecycleWithContextMutex.RUnlock()
 	argsForCall := fake.deleteBucketLifecycleWithContextArgsForCall[i]
@@ -5828,15 +6724,16 @@ func (fake *FakeS3API) DeleteBucketMetricsConfiguration(arg1 *s3.DeleteBucketMet
 	fake.deleteBucketMetricsConfigurationArgsForCall = append(fake.deleteBucketMetricsConfigurationArgsForCall, struct {
 		arg1 *s3.DeleteBucketMetricsConfigurationInput
 	}{arg1})
+	stub := fake.DeleteBucketMetricsConfigurationStub
+	fakeReturns := fake.deleteBucketMetricsConfigurationReturns
 	fake.recordInvocation("DeleteBucketMetricsConfiguration", []interface{}{arg1})
 	fake.deleteBucketMetricsConfigurationMutex.Unlock()
-	if fake.DeleteBucketMetricsConfigurationStub != nil {
-		return fake.DeleteBucketMetricsConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketMetricsConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5891,15 +6788,16 @@ func (fake *FakeS3API) DeleteBucketMetricsConfigurationRequest(arg1 *s3.DeleteBu
 	fake.deleteBucketMetricsConfigurationRequestArgsForCall = append(fake.deleteBucketMetricsConfigurationRequestArgsForCall, struct {
 		arg1 *s3.DeleteBucketMetricsConfigurationInput
 	}{arg1})
+	stub := fake.DeleteBucketMetricsConfigurationRequestStub
+	fakeReturns := fake.deleteBucketMetricsConfigurationRequestReturns
 	fake.recordInvocation("DeleteBucketMetricsConfigurationRequest", []interface{}{arg1})
 	fake.deleteBucketMetricsConfigurationRequestMutex.Unlock()
-	if fake.DeleteBucketMetricsConfigurationRequestStub != nil {
-		return fake.DeleteBucketMetricsConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketMetricsConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5948,23 +6846,24 @@ func (fake *FakeS3API) DeleteBucketMetricsConfigurationRequestReturnsOnCall(i in
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketMetricsConfigurationWithContext(arg1 context.Context, arg2 *s3.DeleteBucketMetricsConfigurationInput, arg3 ...request.Option) (*s3.DeleteBucketMetricsConfigurationOutput, error) {
+func (fake *FakeS3API) DeleteBucketMetricsConfigurationWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketMetricsConfigurationInput, arg3 ...request.Option) (*s3.DeleteBucketMetricsConfigurationOutput, error) {
 	fake.deleteBucketMetricsConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteBucketMetricsConfigurationWithContextReturnsOnCall[len(fake.deleteBucketMetricsConfigurationWithContextArgsForCall)]
 	fake.deleteBucketMetricsConfigurationWithContextArgsForCall = append(fake.deleteBucketMetricsConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketMetricsConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketMetricsConfigurationWithContextStub
+	fakeReturns := fake.deleteBucketMetricsConfigurationWithContextReturns
 	fake.recordInvocation("DeleteBucketMetricsConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteBucketMetricsConfigurationWithContextMutex.Unlock()
-	if fake.DeleteBucketMetricsConfigurationWithContextStub != nil {
-		return fake.DeleteBucketMetricsConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketMetricsConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -5974,13 +6873,13 @@ func (fake *FakeS3API) DeleteBucketMetricsConfigurationWithContextCallCount() in
 	return len(fake.deleteBucketMetricsConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketMetricsConfigurationWithContextCalls(stub func(context.Context, *s3.DeleteBucketMetricsConfigurationInput, ...request.Option) (*s3.DeleteBucketMetricsConfigurationOutput, error)) {
+func (fake *FakeS3API) DeleteBucketMetricsConfigurationWithContextCalls(stub func(aws.Context, *s3.DeleteBucketMetricsConfigurationInput, ...request.Option) (*s3.DeleteBucketMetricsConfigurationOutput, error)) {
 	fake.deleteBucketMetricsConfigurationWithContextMutex.Lock()
 	defer fake.deleteBucketMetricsConfigurationWithContextMutex.Unlock()
 	fake.DeleteBucketMetricsConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketMetricsConfigurationWithContextArgsForCall(i int) (context.Context, *s3.DeleteBucketMetricsConfigurationInput, []request.Option) {
+func (fake *FakeS3API) DeleteBucketMetricsConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketMetricsConfigurationInput, []request.Option) {
 	fake.deleteBucketMetricsConfigurationWithContextMutex.RLock()
 	defer fake.deleteBucketMetricsConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.deleteBucketMetricsConfigurationWithContextArgsForCall[i]
@@ -6013,46 +6912,241 @@ func (fake *FakeS3API) DeleteBucketMetricsConfigurationWithContextReturnsOnCall(
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketPolicy(arg1 *s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error) {
-	fake.deleteBucketPolicyMutex.Lock()
-	ret, specificReturn := fake.deleteBucketPolicyReturnsOnCall[len(fake.deleteBucketPolicyArgsForCall)]
-	fake.deleteBucketPolicyArgsForCall = append(fake.deleteBucketPolicyArgsForCall, struct {
-		arg1 *s3.DeleteBucketPolicyInput
+func (fake *FakeS3API) DeleteBucketOwnershipControls(arg1 *s3.DeleteBucketOwnershipControlsInput) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+	fake.deleteBucketOwnershipControlsMutex.Lock()
+	ret, specificReturn := fake.deleteBucketOwnershipControlsReturnsOnCall[len(fake.deleteBucketOwnershipControlsArgsForCall)]
+	fake.deleteBucketOwnershipControlsArgsForCall = append(fake.deleteBucketOwnershipControlsArgsForCall, struct {
+		arg1 *s3.DeleteBucketOwnershipControlsInput
 	}{arg1})
-	fake.recordInvocation("DeleteBucketPolicy", []interface{}{arg1})
-	fake.deleteBucketPolicyMutex.Unlock()
-	if fake.DeleteBucketPolicyStub != nil {
-		return fake.DeleteBucketPolicyStub(arg1)
+	stub := fake.DeleteBucketOwnershipControlsStub
+	fakeReturns := fake.deleteBucketOwnershipControlsReturns
+	fake.recordInvocation("DeleteBucketOwnershipControls", []interface{}{arg1})
+	fake.deleteBucketOwnershipControlsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketPolicyReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) DeleteBucketPolicyCallCount() int {
-	fake.deleteBucketPolicyMutex.RLock()
-	defer fake.deleteBucketPolicyMutex.RUnlock()
-	return len(fake.deleteBucketPolicyArgsForCall)
+func (fake *FakeS3API) DeleteBucketOwnershipControlsCallCount() int {
+	fake.deleteBucketOwnershipControlsMutex.RLock()
+	defer fake.deleteBucketOwnershipControlsMutex.RUnlock()
+	return len(fake.deleteBucketOwnershipControlsArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketPolicyCalls(stub func(*s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error)) {
-	fake.deleteBucketPolicyMutex.Lock()
-	defer fake.deleteBucketPolicyMutex.Unlock()
-	fake.DeleteBucketPolicyStub = stub
+func (fake *FakeS3API) DeleteBucketOwnershipControlsCalls(stub func(*s3.DeleteBucketOwnershipControlsInput) (*s3.DeleteBucketOwnershipControlsOutput, error)) {
+	fake.deleteBucketOwnershipControlsMutex.Lock()
+	defer fake.deleteBucketOwnershipControlsMutex.Unlock()
+	fake.DeleteBucketOwnershipControlsStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketPolicyArgsForCall(i int) *s3.DeleteBucketPolicyInput {
-	fake.deleteBucketPolicyMutex.RLock()
-	defer fake.deleteBucketPolicyMutex.RUnlock()
-	argsForCall := fake.deleteBucketPolicyArgsForCall[i]
+func (fake *FakeS3API) DeleteBucketOwnershipControlsArgsForCall(i int) *s3.DeleteBucketOwnershipControlsInput {
+	fake.deleteBucketOwnershipControlsMutex.RLock()
+	defer fake.deleteBucketOwnershipControlsMutex.RUnlock()
+	argsForCall := fake.deleteBucketOwnershipControlsArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeS3API) DeleteBucketPolicyReturns(result1 *s3.DeleteBucketPolicyOutput, result2 error) {
-	fake.deleteBucketPolicyMutex.Lock()
-	defer fake.deleteBucketPolicyMutex.Unlock()
+func (fake *FakeS3API) DeleteBucketOwnershipControlsReturns(result1 *s3.DeleteBucketOwnershipControlsOutput, result2 error) {
+	fake.deleteBucketOwnershipControlsMutex.Lock()
+	defer fake.deleteBucketOwnershipControlsMutex.Unlock()
+	fake.DeleteBucketOwnershipControlsStub = nil
+	fake.deleteBucketOwnershipControlsReturns = struct {
+		result1 *s3.DeleteBucketOwnershipControlsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsReturnsOnCall(i int, result1 *s3.DeleteBucketOwnershipControlsOutput, result2 error) {
+	fake.deleteBucketOwnershipControlsMutex.Lock()
+	defer fake.deleteBucketOwnershipControlsMutex.Unlock()
+	fake.DeleteBucketOwnershipControlsStub = nil
+	if fake.deleteBucketOwnershipControlsReturnsOnCall == nil {
+		fake.deleteBucketOwnershipControlsReturnsOnCall = make(map[int]struct {
+			result1 *s3.DeleteBucketOwnershipControlsOutput
+			result2 error
+		})
+	}
+	fake.deleteBucketOwnershipControlsReturnsOnCall[i] = struct {
+		result1 *s3.DeleteBucketOwnershipControlsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsRequest(arg1 *s3.DeleteBucketOwnershipControlsInput) (*request.Request, *s3.DeleteBucketOwnershipControlsOutput) {
+	fake.deleteBucketOwnershipControlsRequestMutex.Lock()
+	ret, specificReturn := fake.deleteBucketOwnershipControlsRequestReturnsOnCall[len(fake.deleteBucketOwnershipControlsRequestArgsForCall)]
+	fake.deleteBucketOwnershipControlsRequestArgsForCall = append(fake.deleteBucketOwnershipControlsRequestArgsForCall, struct {
+		arg1 *s3.DeleteBucketOwnershipControlsInput
+	}{arg1})
+	stub := fake.DeleteBucketOwnershipControlsRequestStub
+	fakeReturns := fake.deleteBucketOwnershipControlsRequestReturns
+	fake.recordInvocation("DeleteBucketOwnershipControlsRequest", []interface{}{arg1})
+	fake.deleteBucketOwnershipControlsRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsRequestCallCount() int {
+	fake.deleteBucketOwnershipControlsRequestMutex.RLock()
+	defer fake.deleteBucketOwnershipControlsRequestMutex.RUnlock()
+	return len(fake.deleteBucketOwnershipControlsRequestArgsForCall)
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsRequestCalls(stub func(*s3.DeleteBucketOwnershipControlsInput) (*request.Request, *s3.DeleteBucketOwnershipControlsOutput)) {
+	fake.deleteBucketOwnershipControlsRequestMutex.Lock()
+	defer fake.deleteBucketOwnershipControlsRequestMutex.Unlock()
+	fake.DeleteBucketOwnershipControlsRequestStub = stub
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsRequestArgsForCall(i int) *s3.DeleteBucketOwnershipControlsInput {
+	fake.deleteBucketOwnershipControlsRequestMutex.RLock()
+	defer fake.deleteBucketOwnershipControlsRequestMutex.RUnlock()
+	argsForCall := fake.deleteBucketOwnershipControlsRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsRequestReturns(result1 *request.Request, result2 *s3.DeleteBucketOwnershipControlsOutput) {
+	fake.deleteBucketOwnershipControlsRequestMutex.Lock()
+	defer fake.deleteBucketOwnershipControlsRequestMutex.Unlock()
+	fake.DeleteBucketOwnershipControlsRequestStub = nil
+	fake.deleteBucketOwnershipControlsRequestReturns = struct {
+		result1 *request.Request
+		result2 *s3.DeleteBucketOwnershipControlsOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.DeleteBucketOwnershipControlsOutput) {
+	fake.deleteBucketOwnershipControlsRequestMutex.Lock()
+	defer fake.deleteBucketOwnershipControlsRequestMutex.Unlock()
+	fake.DeleteBucketOwnershipControlsRequestStub = nil
+	if fake.deleteBucketOwnershipControlsRequestReturnsOnCall == nil {
+		fake.deleteBucketOwnershipControlsRequestReturnsOnCall = make(map[int]struct {
+			result1 *request.Request
+			result2 *s3.DeleteBucketOwnershipControlsOutput
+		})
+	}
+	fake.deleteBucketOwnershipControlsRequestReturnsOnCall[i] = struct {
+		result1 *request.Request
+		result2 *s3.DeleteBucketOwnershipControlsOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketOwnershipControlsInput, arg3 ...request.Option) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+	fake.deleteBucketOwnershipControlsWithContextMutex.Lock()
+	ret, specificReturn := fake.deleteBucketOwnershipControlsWithContextReturnsOnCall[len(fake.deleteBucketOwnershipControlsWithContextArgsForCall)]
+	fake.deleteBucketOwnershipControlsWithContextArgsForCall = append(fake.deleteBucketOwnershipControlsWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.DeleteBucketOwnershipControlsInput
+		arg3 []request.Option
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketOwnershipControlsWithContextStub
+	fakeReturns := fake.deleteBucketOwnershipControlsWithContextReturns
+	fake.recordInvocation("DeleteBucketOwnershipControlsWithContext", []interface{}{arg1, arg2, arg3})
+	fake.deleteBucketOwnershipControlsWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsWithContextCallCount() int {
+	fake.deleteBucketOwnershipControlsWithContextMutex.RLock()
+	defer fake.deleteBucketOwnershipControlsWithContextMutex.RUnlock()
+	return len(fake.deleteBucketOwnershipControlsWithContextArgsForCall)
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsWithContextCalls(stub func(aws.Context, *s3.DeleteBucketOwnershipControlsInput, ...request.Option) (*s3.DeleteBucketOwnershipControlsOutput, error)) {
+	fake.deleteBucketOwnershipControlsWithContextMutex.Lock()
+	defer fake.deleteBucketOwnershipControlsWithContextMutex.Unlock()
+	fake.DeleteBucketOwnershipControlsWithContextStub = stub
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketOwnershipControlsInput, []request.Option) {
+	fake.deleteBucketOwnershipControlsWithContextMutex.RLock()
+	defer fake.deleteBucketOwnershipControlsWithContextMutex.RUnlock()
+	argsForCall := fake.deleteBucketOwnershipControlsWithContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsWithContextReturns(result1 *s3.DeleteBucketOwnershipControlsOutput, result2 error) {
+	fake.deleteBucketOwnershipControlsWithContextMutex.Lock()
+	defer fake.deleteBucketOwnershipControlsWithContextMutex.Unlock()
+	fake.DeleteBucketOwnershipControlsWithContextStub = nil
+	fake.deleteBucketOwnershipControlsWithContextReturns = struct {
+		result1 *s3.DeleteBucketOwnershipControlsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketOwnershipControlsWithContextReturnsOnCall(i int, result1 *s3.DeleteBucketOwnershipControlsOutput, result2 error) {
+	fake.deleteBucketOwnershipControlsWithContextMutex.Lock()
+	defer fake.deleteBucketOwnershipControlsWithContextMutex.Unlock()
+	fake.DeleteBucketOwnershipControlsWithContextStub = nil
+	if fake.deleteBucketOwnershipControlsWithContextReturnsOnCall == nil {
+		fake.deleteBucketOwnershipControlsWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.DeleteBucketOwnershipControlsOutput
+			result2 error
+		})
+	}
+	fake.deleteBucketOwnershipControlsWithContextReturnsOnCall[i] = struct {
+		result1 *s3.DeleteBucketOwnershipControlsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) DeleteBucketPolicy(arg1 *s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error) {
+	fake.deleteBucketPolicyMutex.Lock()
+	ret, specificReturn := fake.deleteBucketPolicyReturnsOnCall[len(fake.deleteBucketPolicyArgsForCall)]
+	fake.deleteBucketPolicyArgsForCall = append(fake.deleteBucketPolicyArgsForCall, struct {
+		arg1 *s3.DeleteBucketPolicyInput
+	}{arg1})
+	stub := fake.DeleteBucketPolicyStub
+	fakeReturns := fake.deleteBucketPolicyReturns
+	fake.recordInvocation("DeleteBucketPolicy", []interface{}{arg1})
+	fake.deleteBucketPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) DeleteBucketPolicyCallCount() int {
+	fake.deleteBucketPolicyMutex.RLock()
+	defer fake.deleteBucketPolicyMutex.RUnlock()
+	return len(fake.deleteBucketPolicyArgsForCall)
+}
+
+func (fake *FakeS3API) DeleteBucketPolicyCalls(stub func(*s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error)) {
+	fake.deleteBucketPolicyMutex.Lock()
+	defer fake.deleteBucketPolicyMutex.Unlock()
+	fake.DeleteBucketPolicyStub = stub
+}
+
+func (fake *FakeS3API) DeleteBucketPolicyArgsForCall(i int) *s3.DeleteBucketPolicyInput {
+	fake.deleteBucketPolicyMutex.RLock()
+	defer fake.deleteBucketPolicyMutex.RUnlock()
+	argsForCall := fake.deleteBucketPolicyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) DeleteBucketPolicyReturns(result1 *s3.DeleteBucketPolicyOutput, result2 error) {
+	fake.deleteBucketPolicyMutex.Lock()
+	defer fake.deleteBucketPolicyMutex.Unlock()
 	fake.DeleteBucketPolicyStub = nil
 	fake.deleteBucketPolicyReturns = struct {
 		result1 *s3.DeleteBucketPolicyOutput
@@ -6082,15 +7176,16 @@ func (fake *FakeS3API) DeleteBucketPolicyRequest(arg1 *s3.DeleteBucketPolicyInpu
 	fake.deleteBucketPolicyRequestArgsForCall = append(fake.deleteBucketPolicyRequestArgsForCall, struct {
 		arg1 *s3.DeleteBucketPolicyInput
 	}{arg1})
+	stub := fake.DeleteBucketPolicyRequestStub
+	fakeReturns := fake.deleteBucketPolicyRequestReturns
 	fake.recordInvocation("DeleteBucketPolicyRequest", []interface{}{arg1})
 	fake.deleteBucketPolicyRequestMutex.Unlock()
-	if fake.DeleteBucketPolicyRequestStub != nil {
-		return fake.DeleteBucketPolicyRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketPolicyRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6139,23 +7234,24 @@ func (fake *FakeS3API) DeleteBucketPolicyRequestReturnsOnCall(i int, result1 *re
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketPolicyWithContext(arg1 context.Context, arg2 *s3.DeleteBucketPolicyInput, arg3 ...request.Option) (*s3.DeleteBucketPolicyOutput, error) {
+func (fake *FakeS3API) DeleteBucketPolicyWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketPolicyInput, arg3 ...request.Option) (*s3.DeleteBucketPolicyOutput, error) {
 	fake.deleteBucketPolicyWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteBucketPolicyWithContextReturnsOnCall[len(fake.deleteBucketPolicyWithContextArgsForCall)]
 	fake.deleteBucketPolicyWithContextArgsForCall = append(fake.deleteBucketPolicyWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketPolicyInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketPolicyWithContextStub
+	fakeReturns := fake.deleteBucketPolicyWithContextReturns
 	fake.recordInvocation("DeleteBucketPolicyWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteBucketPolicyWithContextMutex.Unlock()
-	if fake.DeleteBucketPolicyWithContextStub != nil {
-		return fake.DeleteBucketPolicyWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketPolicyWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6165,13 +7261,13 @@ func (fake *FakeS3API) DeleteBucketPolicyWithContextCallCount() int {
 	return len(fake.deleteBucketPolicyWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketPolicyWithContextCalls(stub func(context.Context, *s3.DeleteBucketPolicyInput, ...request.Option) (*s3.DeleteBucketPolicyOutput, error)) {
+func (fake *FakeS3API) DeleteBucketPolicyWithContextCalls(stub func(aws.Context, *s3.DeleteBucketPolicyInput, ...request.Option) (*s3.DeleteBucketPolicyOutput, error)) {
 	fake.deleteBucketPolicyWithContextMutex.Lock()
 	defer fake.deleteBucketPolicyWithContextMutex.Unlock()
 	fake.DeleteBucketPolicyWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketPolicyWithContextArgsForCall(i int) (context.Context, *s3.DeleteBucketPolicyInput, []request.Option) {
+func (fake *FakeS3API) DeleteBucketPolicyWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketPolicyInput, []request.Option) {
 	fake.deleteBucketPolicyWithContextMutex.RLock()
 	defer fake.deleteBucketPolicyWithContextMutex.RUnlock()
 	argsForCall := fake.deleteBucketPolicyWithContextArgsForCall[i]
@@ -6210,15 +7306,16 @@ func (fake *FakeS3API) DeleteBucketReplication(arg1 *s3.DeleteBucketReplicationI
 	fake.deleteBucketReplicationArgsForCall = append(fake.deleteBucketReplicationArgsForCall, struct {
 		arg1 *s3.DeleteBucketReplicationInput
 	}{arg1})
+	stub := fake.DeleteBucketReplicationStub
+	fakeReturns := fake.deleteBucketReplicationReturns
 	fake.recordInvocation("DeleteBucketReplication", []interface{}{arg1})
 	fake.deleteBucketReplicationMutex.Unlock()
-	if fake.DeleteBucketReplicationStub != nil {
-		return fake.DeleteBucketReplicationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketReplicationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6273,15 +7370,16 @@ func (fake *FakeS3API) DeleteBucketReplicationRequest(arg1 *s3.DeleteBucketRepli
 	fake.deleteBucketReplicationRequestArgsForCall = append(fake.deleteBucketReplicationRequestArgsForCall, struct {
 		arg1 *s3.DeleteBucketReplicationInput
 	}{arg1})
+	stub := fake.DeleteBucketReplicationRequestStub
+	fakeReturns := fake.deleteBucketReplicationRequestReturns
 	fake.recordInvocation("DeleteBucketReplicationRequest", []interface{}{arg1})
 	fake.deleteBucketReplicationRequestMutex.Unlock()
-	if fake.DeleteBucketReplicationRequestStub != nil {
-		return fake.DeleteBucketReplicationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketReplicationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6330,23 +7428,24 @@ func (fake *FakeS3API) DeleteBucketReplicationRequestReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketReplicationWithContext(arg1 context.Context, arg2 *s3.DeleteBucketReplicationInput, arg3 ...request.Option) (*s3.DeleteBucketReplicationOutput, error) {
+func (fake *FakeS3API) DeleteBucketReplicationWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketReplicationInput, arg3 ...request.Option) (*s3.DeleteBucketReplicationOutput, error) {
 	fake.deleteBucketReplicationWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteBucketReplicationWithContextReturnsOnCall[len(fake.deleteBucketReplicationWithContextArgsForCall)]
 	fake.deleteBucketReplicationWithContextArgsForCall = append(fake.deleteBucketReplicationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketReplicationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketReplicationWithContextStub
+	fakeReturns := fake.deleteBucketReplicationWithContextReturns
 	fake.recordInvocation("DeleteBucketReplicationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteBucketReplicationWithContextMutex.Unlock()
-	if fake.DeleteBucketReplicationWithContextStub != nil {
-		return fake.DeleteBucketReplicationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketReplicationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6356,13 +7455,13 @@ func (fake *FakeS3API) DeleteBucketReplicationWithContextCallCount() int {
 	return len(fake.deleteBucketReplicationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketReplicationWithContextCalls(stub func(context.Context, *s3.DeleteBucketReplicationInput, ...request.Option) (*s3.DeleteBucketReplicationOutput, error)) {
+func (fake *FakeS3API) DeleteBucketReplicationWithContextCalls(stub func(aws.Context, *s3.DeleteBucketReplicationInput, ...request.Option) (*s3.DeleteBucketReplicationOutput, error)) {
 	fake.deleteBucketReplicationWithContextMutex.Lock()
 	defer fake.deleteBucketReplicationWithContextMutex.Unlock()
 	fake.DeleteBucketReplicationWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketReplicationWithContextArgsForCall(i int) (context.Context, *s3.DeleteBucketReplicationInput, []request.Option) {
+func (fake *FakeS3API) DeleteBucketReplicationWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketReplicationInput, []request.Option) {
 	fake.deleteBucketReplicationWithContextMutex.RLock()
 	defer fake.deleteBucketReplicationWithContextMutex.RUnlock()
 	argsForCall := fake.deleteBucketReplicationWithContextArgsForCall[i]
@@ -6401,15 +7500,16 @@ func (fake *FakeS3API) DeleteBucketRequest(arg1 *s3.DeleteBucketInput) (*request
 	fake.deleteBucketRequestArgsForCall = append(fake.deleteBucketRequestArgsForCall, struct {
 		arg1 *s3.DeleteBucketInput
 	}{arg1})
+	stub := fake.DeleteBucketRequestStub
+	fakeReturns := fake.deleteBucketRequestReturns
 	fake.recordInvocation("DeleteBucketRequest", []interface{}{arg1})
 	fake.deleteBucketRequestMutex.Unlock()
-	if fake.DeleteBucketRequestStub != nil {
-		return fake.DeleteBucketRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6464,15 +7564,16 @@ func (fake *FakeS3API) DeleteBucketTagging(arg1 *s3.DeleteBucketTaggingInput) (*
 	fake.deleteBucketTaggingArgsForCall = append(fake.deleteBucketTaggingArgsForCall, struct {
 		arg1 *s3.DeleteBucketTaggingInput
 	}{arg1})
+	stub := fake.DeleteBucketTaggingStub
+	fakeReturns := fake.deleteBucketTaggingReturns
 	fake.recordInvocation("DeleteBucketTagging", []interface{}{arg1})
 	fake.deleteBucketTaggingMutex.Unlock()
-	if fake.DeleteBucketTaggingStub != nil {
-		return fake.DeleteBucketTaggingStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketTaggingReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6527,15 +7628,16 @@ func (fake *FakeS3API) DeleteBucketTaggingRequest(arg1 *s3.DeleteBucketTaggingIn
 	fake.deleteBucketTaggingRequestArgsForCall = append(fake.deleteBucketTaggingRequestArgsForCall, struct {
 		arg1 *s3.DeleteBucketTaggingInput
 	}{arg1})
+	stub := fake.DeleteBucketTaggingRequestStub
+	fakeReturns := fake.deleteBucketTaggingRequestReturns
 	fake.recordInvocation("DeleteBucketTaggingRequest", []interface{}{arg1})
 	fake.deleteBucketTaggingRequestMutex.Unlock()
-	if fake.DeleteBucketTaggingRequestStub != nil {
-		return fake.DeleteBucketTaggingRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketTaggingRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6584,23 +7686,24 @@ func (fake *FakeS3API) DeleteBucketTaggingRequestReturnsOnCall(i int, result1 *r
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketTaggingWithContext(arg1 context.Context, arg2 *s3.DeleteBucketTaggingInput, arg3 ...request.Option) (*s3.DeleteBucketTaggingOutput, error) {
+func (fake *FakeS3API) DeleteBucketTaggingWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketTaggingInput, arg3 ...request.Option) (*s3.DeleteBucketTaggingOutput, error) {
 	fake.deleteBucketTaggingWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteBucketTaggingWithContextReturnsOnCall[len(fake.deleteBucketTaggingWithContextArgsForCall)]
 	fake.deleteBucketTaggingWithContextArgsForCall = append(fake.deleteBucketTaggingWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketTaggingInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketTaggingWithContextStub
+	fakeReturns := fake.deleteBucketTaggingWithContextReturns
 	fake.recordInvocation("DeleteBucketTaggingWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteBucketTaggingWithContextMutex.Unlock()
-	if fake.DeleteBucketTaggingWithContextStub != nil {
-		return fake.DeleteBucketTaggingWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketTaggingWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6610,13 +7713,13 @@ func (fake *FakeS3API) DeleteBucketTaggingWithContextCallCount() int {
 	return len(fake.deleteBucketTaggingWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketTaggingWithContextCalls(stub func(context.Context, *s3.DeleteBucketTaggingInput, ...request.Option) (*s3.DeleteBucketTaggingOutput, error)) {
+func (fake *FakeS3API) DeleteBucketTaggingWithContextCalls(stub func(aws.Context, *s3.DeleteBucketTaggingInput, ...request.Option) (*s3.DeleteBucketTaggingOutput, error)) {
 	fake.deleteBucketTaggingWithContextMutex.Lock()
 	defer fake.deleteBucketTaggingWithContextMutex.Unlock()
 	fake.DeleteBucketTaggingWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketTaggingWithContextArgsForCall(i int) (context.Context, *s3.DeleteBucketTaggingInput, []request.Option) {
+func (fake *FakeS3API) DeleteBucketTaggingWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketTaggingInput, []request.Option) {
 	fake.deleteBucketTaggingWithContextMutex.RLock()
 	defer fake.deleteBucketTaggingWithContextMutex.RUnlock()
 	argsForCall := fake.deleteBucketTaggingWithContextArgsForCall[i]
@@ -6655,15 +7758,16 @@ func (fake *FakeS3API) DeleteBucketWebsite(arg1 *s3.DeleteBucketWebsiteInput) (*
 	fake.deleteBucketWebsiteArgsForCall = append(fake.deleteBucketWebsiteArgsForCall, struct {
 		arg1 *s3.DeleteBucketWebsiteInput
 	}{arg1})
+	stub := fake.DeleteBucketWebsiteStub
+	fakeReturns := fake.deleteBucketWebsiteReturns
 	fake.recordInvocation("DeleteBucketWebsite", []interface{}{arg1})
 	fake.deleteBucketWebsiteMutex.Unlock()
-	if fake.DeleteBucketWebsiteStub != nil {
-		return fake.DeleteBucketWebsiteStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketWebsiteReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6718,15 +7822,16 @@ func (fake *FakeS3API) DeleteBucketWebsiteRequest(arg1 *s3.DeleteBucketWebsiteIn
 	fake.deleteBucketWebsiteRequestArgsForCall = append(fake.deleteBucketWebsiteRequestArgsForCall, struct {
 		arg1 *s3.DeleteBucketWebsiteInput
 	}{arg1})
+	stub := fake.DeleteBucketWebsiteRequestStub
+	fakeReturns := fake.deleteBucketWebsiteRequestReturns
 	fake.recordInvocation("DeleteBucketWebsiteRequest", []interface{}{arg1})
 	fake.deleteBucketWebsiteRequestMutex.Unlock()
-	if fake.DeleteBucketWebsiteRequestStub != nil {
-		return fake.DeleteBucketWebsiteRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketWebsiteRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6775,23 +7880,24 @@ func (fake *FakeS3API) DeleteBucketWebsiteRequestReturnsOnCall(i int, result1 *r
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketWebsiteWithContext(arg1 context.Context, arg2 *s3.DeleteBucketWebsiteInput, arg3 ...request.Option) (*s3.DeleteBucketWebsiteOutput, error) {
+func (fake *FakeS3API) DeleteBucketWebsiteWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketWebsiteInput, arg3 ...request.Option) (*s3.DeleteBucketWebsiteOutput, error) {
 	fake.deleteBucketWebsiteWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteBucketWebsiteWithContextReturnsOnCall[len(fake.deleteBucketWebsiteWithContextArgsForCall)]
 	fake.deleteBucketWebsiteWithContextArgsForCall = append(fake.deleteBucketWebsiteWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketWebsiteInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketWebsiteWithContextStub
+	fakeReturns := fake.deleteBucketWebsiteWithContextReturns
 	fake.recordInvocation("DeleteBucketWebsiteWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteBucketWebsiteWithContextMutex.Unlock()
-	if fake.DeleteBucketWebsiteWithContextStub != nil {
-		return fake.DeleteBucketWebsiteWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketWebsiteWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6801,13 +7907,13 @@ func (fake *FakeS3API) DeleteBucketWebsiteWithContextCallCount() int {
 	return len(fake.deleteBucketWebsiteWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketWebsiteWithContextCalls(stub func(context.Context, *s3.DeleteBucketWebsiteInput, ...request.Option) (*s3.DeleteBucketWebsiteOutput, error)) {
+func (fake *FakeS3API) DeleteBucketWebsiteWithContextCalls(stub func(aws.Context, *s3.DeleteBucketWebsiteInput, ...request.Option) (*s3.DeleteBucketWebsiteOutput, error)) {
 	fake.deleteBucketWebsiteWithContextMutex.Lock()
 	defer fake.deleteBucketWebsiteWithContextMutex.Unlock()
 	fake.DeleteBucketWebsiteWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketWebsiteWithContextArgsForCall(i int) (context.Context, *s3.DeleteBucketWebsiteInput, []request.Option) {
+func (fake *FakeS3API) DeleteBucketWebsiteWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketWebsiteInput, []request.Option) {
 	fake.deleteBucketWebsiteWithContextMutex.RLock()
 	defer fake.deleteBucketWebsiteWithContextMutex.RUnlock()
 	argsForCall := fake.deleteBucketWebsiteWithContextArgsForCall[i]
@@ -6840,23 +7946,24 @@ func (fake *FakeS3API) DeleteBucketWebsiteWithContextReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteBucketWithContext(arg1 context.Context, arg2 *s3.DeleteBucketInput, arg3 ...request.Option) (*s3.DeleteBucketOutput, error) {
+func (fake *FakeS3API) DeleteBucketWithContext(arg1 aws.Context, arg2 *s3.DeleteBucketInput, arg3 ...request.Option) (*s3.DeleteBucketOutput, error) {
 	fake.deleteBucketWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteBucketWithContextReturnsOnCall[len(fake.deleteBucketWithContextArgsForCall)]
 	fake.deleteBucketWithContextArgsForCall = append(fake.deleteBucketWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteBucketInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketWithContextStub
+	fakeReturns := fake.deleteBucketWithContextReturns
 	fake.recordInvocation("DeleteBucketWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteBucketWithContextMutex.Unlock()
-	if fake.DeleteBucketWithContextStub != nil {
-		return fake.DeleteBucketWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteBucketWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6866,13 +7973,13 @@ func (fake *FakeS3API) DeleteBucketWithContextCallCount() int {
 	return len(fake.deleteBucketWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteBucketWithContextCalls(stub func(context.Context, *s3.DeleteBucketInput, ...request.Option) (*s3.DeleteBucketOutput, error)) {
+func (fake *FakeS3API) DeleteBucketWithContextCalls(stub func(aws.Context, *s3.DeleteBucketInput, ...request.Option) (*s3.DeleteBucketOutput, error)) {
 	fake.deleteBucketWithContextMutex.Lock()
 	defer fake.deleteBucketWithContextMutex.Unlock()
 	fake.DeleteBucketWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteBucketWithContextArgsForCall(i int) (context.Context, *s3.DeleteBucketInput, []request.Option) {
+func (fake *FakeS3API) DeleteBucketWithContextArgsForCall(i int) (aws.Context, *s3.DeleteBucketInput, []request.Option) {
 	fake.deleteBucketWithContextMutex.RLock()
 	defer fake.deleteBucketWithContextMutex.RUnlock()
 	argsForCall := fake.deleteBucketWithContextArgsForCall[i]
@@ -6911,15 +8018,16 @@ func (fake *FakeS3API) DeleteObject(arg1 *s3.DeleteObjectInput) (*s3.DeleteObjec
 	fake.deleteObjectArgsForCall = append(fake.deleteObjectArgsForCall, struct {
 		arg1 *s3.DeleteObjectInput
 	}{arg1})
+	stub := fake.DeleteObjectStub
+	fakeReturns := fake.deleteObjectReturns
 	fake.recordInvocation("DeleteObject", []interface{}{arg1})
 	fake.deleteObjectMutex.Unlock()
-	if fake.DeleteObjectStub != nil {
-		return fake.DeleteObjectStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteObjectReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -6974,15 +8082,16 @@ func (fake *FakeS3API) DeleteObjectRequest(arg1 *s3.DeleteObjectInput) (*request
 	fake.deleteObjectRequestArgsForCall = append(fake.deleteObjectRequestArgsForCall, struct {
 		arg1 *s3.DeleteObjectInput
 	}{arg1})
+	stub := fake.DeleteObjectRequestStub
+	fakeReturns := fake.deleteObjectRequestReturns
 	fake.recordInvocation("DeleteObjectRequest", []interface{}{arg1})
 	fake.deleteObjectRequestMutex.Unlock()
-	if fake.DeleteObjectRequestStub != nil {
-		return fake.DeleteObjectRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteObjectRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7037,15 +8146,16 @@ func (fake *FakeS3API) DeleteObjectTagging(arg1 *s3.DeleteObjectTaggingInput) (*
 	fake.deleteObjectTaggingArgsForCall = append(fake.deleteObjectTaggingArgsForCall, struct {
 		arg1 *s3.DeleteObjectTaggingInput
 	}{arg1})
+	stub := fake.DeleteObjectTaggingStub
+	fakeReturns := fake.deleteObjectTaggingReturns
 	fake.recordInvocation("DeleteObjectTagging", []interface{}{arg1})
 	fake.deleteObjectTaggingMutex.Unlock()
-	if fake.DeleteObjectTaggingStub != nil {
-		return fake.DeleteObjectTaggingStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteObjectTaggingReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7100,15 +8210,16 @@ func (fake *FakeS3API) DeleteObjectTaggingRequest(arg1 *s3.DeleteObjectTaggingIn
 	fake.deleteObjectTaggingRequestArgsForCall = append(fake.deleteObjectTaggingRequestArgsForCall, struct {
 		arg1 *s3.DeleteObjectTaggingInput
 	}{arg1})
+	stub := fake.DeleteObjectTaggingRequestStub
+	fakeReturns := fake.deleteObjectTaggingRequestReturns
 	fake.recordInvocation("DeleteObjectTaggingRequest", []interface{}{arg1})
 	fake.deleteObjectTaggingRequestMutex.Unlock()
-	if fake.DeleteObjectTaggingRequestStub != nil {
-		return fake.DeleteObjectTaggingRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteObjectTaggingRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7157,23 +8268,24 @@ func (fake *FakeS3API) DeleteObjectTaggingRequestReturnsOnCall(i int, result1 *r
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteObjectTaggingWithContext(arg1 context.Context, arg2 *s3.DeleteObjectTaggingInput, arg3 ...request.Option) (*s3.DeleteObjectTaggingOutput, error) {
+func (fake *FakeS3API) DeleteObjectTaggingWithContext(arg1 aws.Context, arg2 *s3.DeleteObjectTaggingInput, arg3 ...request.Option) (*s3.DeleteObjectTaggingOutput, error) {
 	fake.deleteObjectTaggingWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteObjectTaggingWithContextReturnsOnCall[len(fake.deleteObjectTaggingWithContextArgsForCall)]
 	fake.deleteObjectTaggingWithContextArgsForCall = append(fake.deleteObjectTaggingWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteObjectTaggingInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteObjectTaggingWithContextStub
+	fakeReturns := fake.deleteObjectTaggingWithContextReturns
 	fake.recordInvocation("DeleteObjectTaggingWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteObjectTaggingWithContextMutex.Unlock()
-	if fake.DeleteObjectTaggingWithContextStub != nil {
-		return fake.DeleteObjectTaggingWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteObjectTaggingWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7183,13 +8295,13 @@ func (fake *FakeS3API) DeleteObjectTaggingWithContextCallCount() int {
 	return len(fake.deleteObjectTaggingWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteObjectTaggingWithContextCalls(stub func(context.Context, *s3.DeleteObjectTaggingInput, ...request.Option) (*s3.DeleteObjectTaggingOutput, error)) {
+func (fake *FakeS3API) DeleteObjectTaggingWithContextCalls(stub func(aws.Context, *s3.DeleteObjectTaggingInput, ...request.Option) (*s3.DeleteObjectTaggingOutput, error)) {
 	fake.deleteObjectTaggingWithContextMutex.Lock()
 	defer fake.deleteObjectTaggingWithContextMutex.Unlock()
 	fake.DeleteObjectTaggingWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteObjectTaggingWithContextArgsForCall(i int) (context.Context, *s3.DeleteObjectTaggingInput, []request.Option) {
+func (fake *FakeS3API) DeleteObjectTaggingWithContextArgsForCall(i int) (aws.Context, *s3.DeleteObjectTaggingInput, []request.Option) {
 	fake.deleteObjectTaggingWithContextMutex.RLock()
 	defer fake.deleteObjectTaggingWithContextMutex.RUnlock()
 	argsForCall := fake.deleteObjectTaggingWithContextArgsForCall[i]
@@ -7222,23 +8334,24 @@ func (fake *FakeS3API) DeleteObjectTaggingWithContextReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteObjectWithContext(arg1 context.Context, arg2 *s3.DeleteObjectInput, arg3 ...request.Option) (*s3.DeleteObjectOutput, error) {
+func (fake *FakeS3API) DeleteObjectWithContext(arg1 aws.Context, arg2 *s3.DeleteObjectInput, arg3 ...request.Option) (*s3.DeleteObjectOutput, error) {
 	fake.deleteObjectWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteObjectWithContextReturnsOnCall[len(fake.deleteObjectWithContextArgsForCall)]
 	fake.deleteObjectWithContextArgsForCall = append(fake.deleteObjectWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteObjectInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteObjectWithContextStub
+	fakeReturns := fake.deleteObjectWithContextReturns
 	fake.recordInvocation("DeleteObjectWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteObjectWithContextMutex.Unlock()
-	if fake.DeleteObjectWithContextStub != nil {
-		return fake.DeleteObjectWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteObjectWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7248,13 +8361,13 @@ func (fake *FakeS3API) DeleteObjectWithContextCallCount() int {
 	return len(fake.deleteObjectWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteObjectWithContextCalls(stub func(context.Context, *s3.DeleteObjectInput, ...request.Option) (*s3.DeleteObjectOutput, error)) {
+func (fake *FakeS3API) DeleteObjectWithContextCalls(stub func(aws.Context, *s3.DeleteObjectInput, ...request.Option) (*s3.DeleteObjectOutput, error)) {
 	fake.deleteObjectWithContextMutex.Lock()
 	defer fake.deleteObjectWithContextMutex.Unlock()
 	fake.DeleteObjectWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteObjectWithContextArgsForCall(i int) (context.Context, *s3.DeleteObjectInput, []request.Option) {
+func (fake *FakeS3API) DeleteObjectWithContextArgsForCall(i int) (aws.Context, *s3.DeleteObjectInput, []request.Option) {
 	fake.deleteObjectWithContextMutex.RLock()
 	defer fake.deleteObjectWithContextMutex.RUnlock()
 	argsForCall := fake.deleteObjectWithContextArgsForCall[i]
@@ -7293,15 +8406,16 @@ func (fake *FakeS3API) DeleteObjects(arg1 *s3.DeleteObjectsInput) (*s3.DeleteObj
 	fake.deleteObjectsArgsForCall = append(fake.deleteObjectsArgsForCall, struct {
 		arg1 *s3.DeleteObjectsInput
 	}{arg1})
+	stub := fake.DeleteObjectsStub
+	fakeReturns := fake.deleteObjectsReturns
 	fake.recordInvocation("DeleteObjects", []interface{}{arg1})
 	fake.deleteObjectsMutex.Unlock()
-	if fake.DeleteObjectsStub != nil {
-		return fake.DeleteObjectsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteObjectsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7356,15 +8470,16 @@ func (fake *FakeS3API) DeleteObjectsRequest(arg1 *s3.DeleteObjectsInput) (*reque
 	fake.deleteObjectsRequestArgsForCall = append(fake.deleteObjectsRequestArgsForCall, struct {
 		arg1 *s3.DeleteObjectsInput
 	}{arg1})
+	stub := fake.DeleteObjectsRequestStub
+	fakeReturns := fake.deleteObjectsRequestReturns
 	fake.recordInvocation("DeleteObjectsRequest", []interface{}{arg1})
 	fake.deleteObjectsRequestMutex.Unlock()
-	if fake.DeleteObjectsRequestStub != nil {
-		return fake.DeleteObjectsRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteObjectsRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7413,23 +8528,24 @@ func (fake *FakeS3API) DeleteObjectsRequestReturnsOnCall(i int, result1 *request
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeleteObjectsWithContext(arg1 context.Context, arg2 *s3.DeleteObjectsInput, arg3 ...request.Option) (*s3.DeleteObjectsOutput, error) {
+func (fake *FakeS3API) DeleteObjectsWithContext(arg1 aws.Context, arg2 *s3.DeleteObjectsInput, arg3 ...request.Option) (*s3.DeleteObjectsOutput, error) {
 	fake.deleteObjectsWithContextMutex.Lock()
 	ret, specificReturn := fake.deleteObjectsWithContextReturnsOnCall[len(fake.deleteObjectsWithContextArgsForCall)]
 	fake.deleteObjectsWithContextArgsForCall = append(fake.deleteObjectsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeleteObjectsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteObjectsWithContextStub
+	fakeReturns := fake.deleteObjectsWithContextReturns
 	fake.recordInvocation("DeleteObjectsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deleteObjectsWithContextMutex.Unlock()
-	if fake.DeleteObjectsWithContextStub != nil {
-		return fake.DeleteObjectsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteObjectsWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7439,13 +8555,13 @@ func (fake *FakeS3API) DeleteObjectsWithContextCallCount() int {
 	return len(fake.deleteObjectsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeleteObjectsWithContextCalls(stub func(context.Context, *s3.DeleteObjectsInput, ...request.Option) (*s3.DeleteObjectsOutput, error)) {
+func (fake *FakeS3API) DeleteObjectsWithContextCalls(stub func(aws.Context, *s3.DeleteObjectsInput, ...request.Option) (*s3.DeleteObjectsOutput, error)) {
 	fake.deleteObjectsWithContextMutex.Lock()
 	defer fake.deleteObjectsWithContextMutex.Unlock()
 	fake.DeleteObjectsWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeleteObjectsWithContextArgsForCall(i int) (context.Context, *s3.DeleteObjectsInput, []request.Option) {
+func (fake *FakeS3API) DeleteObjectsWithContextArgsForCall(i int) (aws.Context, *s3.DeleteObjectsInput, []request.Option) {
 	fake.deleteObjectsWithContextMutex.RLock()
 	defer fake.deleteObjectsWithContextMutex.RUnlock()
 	argsForCall := fake.deleteObjectsWithContextArgsForCall[i]
@@ -7484,15 +8600,16 @@ func (fake *FakeS3API) DeletePublicAccessBlock(arg1 *s3.DeletePublicAccessBlockI
 	fake.deletePublicAccessBlockArgsForCall = append(fake.deletePublicAccessBlockArgsForCall, struct {
 		arg1 *s3.DeletePublicAccessBlockInput
 	}{arg1})
+	stub := fake.DeletePublicAccessBlockStub
+	fakeReturns := fake.deletePublicAccessBlockReturns
 	fake.recordInvocation("DeletePublicAccessBlock", []interface{}{arg1})
 	fake.deletePublicAccessBlockMutex.Unlock()
-	if fake.DeletePublicAccessBlockStub != nil {
-		return fake.DeletePublicAccessBlockStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deletePublicAccessBlockReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7547,15 +8664,16 @@ func (fake *FakeS3API) DeletePublicAccessBlockRequest(arg1 *s3.DeletePublicAcces
 	fake.deletePublicAccessBlockRequestArgsForCall = append(fake.deletePublicAccessBlockRequestArgsForCall, struct {
 		arg1 *s3.DeletePublicAccessBlockInput
 	}{arg1})
+	stub := fake.DeletePublicAccessBlockRequestStub
+	fakeReturns := fake.deletePublicAccessBlockRequestReturns
 	fake.recordInvocation("DeletePublicAccessBlockRequest", []interface{}{arg1})
 	fake.deletePublicAccessBlockRequestMutex.Unlock()
-	if fake.DeletePublicAccessBlockRequestStub != nil {
-		return fake.DeletePublicAccessBlockRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deletePublicAccessBlockRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7604,23 +8722,24 @@ func (fake *FakeS3API) DeletePublicAccessBlockRequestReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) DeletePublicAccessBlockWithContext(arg1 context.Context, arg2 *s3.DeletePublicAccessBlockInput, arg3 ...request.Option) (*s3.DeletePublicAccessBlockOutput, error) {
+func (fake *FakeS3API) DeletePublicAccessBlockWithContext(arg1 aws.Context, arg2 *s3.DeletePublicAccessBlockInput, arg3 ...request.Option) (*s3.DeletePublicAccessBlockOutput, error) {
 	fake.deletePublicAccessBlockWithContextMutex.Lock()
 	ret, specificReturn := fake.deletePublicAccessBlockWithContextReturnsOnCall[len(fake.deletePublicAccessBlockWithContextArgsForCall)]
 	fake.deletePublicAccessBlockWithContextArgsForCall = append(fake.deletePublicAccessBlockWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.DeletePublicAccessBlockInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.DeletePublicAccessBlockWithContextStub
+	fakeReturns := fake.deletePublicAccessBlockWithContextReturns
 	fake.recordInvocation("DeletePublicAccessBlockWithContext", []interface{}{arg1, arg2, arg3})
 	fake.deletePublicAccessBlockWithContextMutex.Unlock()
-	if fake.DeletePublicAccessBlockWithContextStub != nil {
-		return fake.DeletePublicAccessBlockWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deletePublicAccessBlockWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7630,13 +8749,13 @@ func (fake *FakeS3API) DeletePublicAccessBlockWithContextCallCount() int {
 	return len(fake.deletePublicAccessBlockWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) DeletePublicAccessBlockWithContextCalls(stub func(context.Context, *s3.DeletePublicAccessBlockInput, ...request.Option) (*s3.DeletePublicAccessBlockOutput, error)) {
+func (fake *FakeS3API) DeletePublicAccessBlockWithContextCalls(stub func(aws.Context, *s3.DeletePublicAccessBlockInput, ...request.Option) (*s3.DeletePublicAccessBlockOutput, error)) {
 	fake.deletePublicAccessBlockWithContextMutex.Lock()
 	defer fake.deletePublicAccessBlockWithContextMutex.Unlock()
 	fake.DeletePublicAccessBlockWithContextStub = stub
 }
 
-func (fake *FakeS3API) DeletePublicAccessBlockWithContextArgsForCall(i int) (context.Context, *s3.DeletePublicAccessBlockInput, []request.Option) {
+func (fake *FakeS3API) DeletePublicAccessBlockWithContextArgsForCall(i int) (aws.Context, *s3.DeletePublicAccessBlockInput, []request.Option) {
 	fake.deletePublicAccessBlockWithContextMutex.RLock()
 	defer fake.deletePublicAccessBlockWithContextMutex.RUnlock()
 	argsForCall := fake.deletePublicAccessBlockWithContextArgsForCall[i]
@@ -7675,15 +8794,16 @@ func (fake *FakeS3API) GetBucketAccelerateConfiguration(arg1 *s3.GetBucketAccele
 	fake.getBucketAccelerateConfigurationArgsForCall = append(fake.getBucketAccelerateConfigurationArgsForCall, struct {
 		arg1 *s3.GetBucketAccelerateConfigurationInput
 	}{arg1})
+	stub := fake.GetBucketAccelerateConfigurationStub
+	fakeReturns := fake.getBucketAccelerateConfigurationReturns
 	fake.recordInvocation("GetBucketAccelerateConfiguration", []interface{}{arg1})
 	fake.getBucketAccelerateConfigurationMutex.Unlock()
-	if fake.GetBucketAccelerateConfigurationStub != nil {
-		return fake.GetBucketAccelerateConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketAccelerateConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7738,15 +8858,16 @@ func (fake *FakeS3API) GetBucketAccelerateConfigurationRequest(arg1 *s3.GetBucke
 	fake.getBucketAccelerateConfigurationRequestArgsForCall = append(fake.getBucketAccelerateConfigurationRequestArgsForCall, struct {
 		arg1 *s3.GetBucketAccelerateConfigurationInput
 	}{arg1})
+	stub := fake.GetBucketAccelerateConfigurationRequestStub
+	fakeReturns := fake.getBucketAccelerateConfigurationRequestReturns
 	fake.recordInvocation("GetBucketAccelerateConfigurationRequest", []interface{}{arg1})
 	fake.getBucketAccelerateConfigurationRequestMutex.Unlock()
-	if fake.GetBucketAccelerateConfigurationRequestStub != nil {
-		return fake.GetBucketAccelerateConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketAccelerateConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7795,23 +8916,24 @@ func (fake *FakeS3API) GetBucketAccelerateConfigurationRequestReturnsOnCall(i in
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketAccelerateConfigurationWithContext(arg1 context.Context, arg2 *s3.GetBucketAccelerateConfigurationInput, arg3 ...request.Option) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+func (fake *FakeS3API) GetBucketAccelerateConfigurationWithContext(arg1 aws.Context, arg2 *s3.GetBucketAccelerateConfigurationInput, arg3 ...request.Option) (*s3.GetBucketAccelerateConfigurationOutput, error) {
 	fake.getBucketAccelerateConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketAccelerateConfigurationWithContextReturnsOnCall[len(fake.getBucketAccelerateConfigurationWithContextArgsForCall)]
 	fake.getBucketAccelerateConfigurationWithContextArgsForCall = append(fake.getBucketAccelerateConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketAccelerateConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketAccelerateConfigurationWithContextStub
+	fakeReturns := fake.getBucketAccelerateConfigurationWithContextReturns
 	fake.recordInvocation("GetBucketAccelerateConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketAccelerateConfigurationWithContextMutex.Unlock()
-	if fake.GetBucketAccelerateConfigurationWithContextStub != nil {
-		return fake.GetBucketAccelerateConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketAccelerateConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7821,13 +8943,13 @@ func (fake *FakeS3API) GetBucketAccelerateConfigurationWithContextCallCount() in
 	return len(fake.getBucketAccelerateConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketAccelerateConfigurationWithContextCalls(stub func(context.Context, *s3.GetBucketAccelerateConfigurationInput, ...request.Option) (*s3.GetBucketAccelerateConfigurationOutput, error)) {
+func (fake *FakeS3API) GetBucketAccelerateConfigurationWithContextCalls(stub func(aws.Context, *s3.GetBucketAccelerateConfigurationInput, ...request.Option) (*s3.GetBucketAccelerateConfigurationOutput, error)) {
 	fake.getBucketAccelerateConfigurationWithContextMutex.Lock()
 	defer fake.getBucketAccelerateConfigurationWithContextMutex.Unlock()
 	fake.GetBucketAccelerateConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketAccelerateConfigurationWithContextArgsForCall(i int) (context.Context, *s3.GetBucketAccelerateConfigurationInput, []request.Option) {
+func (fake *FakeS3API) GetBucketAccelerateConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketAccelerateConfigurationInput, []request.Option) {
 	fake.getBucketAccelerateConfigurationWithContextMutex.RLock()
 	defer fake.getBucketAccelerateConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketAccelerateConfigurationWithContextArgsForCall[i]
@@ -7866,15 +8988,16 @@ func (fake *FakeS3API) GetBucketAcl(arg1 *s3.GetBucketAclInput) (*s3.GetBucketAc
 	fake.getBucketAclArgsForCall = append(fake.getBucketAclArgsForCall, struct {
 		arg1 *s3.GetBucketAclInput
 	}{arg1})
+	stub := fake.GetBucketAclStub
+	fakeReturns := fake.getBucketAclReturns
 	fake.recordInvocation("GetBucketAcl", []interface{}{arg1})
 	fake.getBucketAclMutex.Unlock()
-	if fake.GetBucketAclStub != nil {
-		return fake.GetBucketAclStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketAclReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7929,15 +9052,16 @@ func (fake *FakeS3API) GetBucketAclRequest(arg1 *s3.GetBucketAclInput) (*request
 	fake.getBucketAclRequestArgsForCall = append(fake.getBucketAclRequestArgsForCall, struct {
 		arg1 *s3.GetBucketAclInput
 	}{arg1})
+	stub := fake.GetBucketAclRequestStub
+	fakeReturns := fake.getBucketAclRequestReturns
 	fake.recordInvocation("GetBucketAclRequest", []interface{}{arg1})
 	fake.getBucketAclRequestMutex.Unlock()
-	if fake.GetBucketAclRequestStub != nil {
-		return fake.GetBucketAclRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketAclRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -7986,23 +9110,24 @@ func (fake *FakeS3API) GetBucketAclRequestReturnsOnCall(i int, result1 *request.
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketAclWithContext(arg1 context.Context, arg2 *s3.GetBucketAclInput, arg3 ...request.Option) (*s3.GetBucketAclOutput, error) {
+func (fake *FakeS3API) GetBucketAclWithContext(arg1 aws.Context, arg2 *s3.GetBucketAclInput, arg3 ...request.Option) (*s3.GetBucketAclOutput, error) {
 	fake.getBucketAclWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketAclWithContextReturnsOnCall[len(fake.getBucketAclWithContextArgsForCall)]
 	fake.getBucketAclWithContextArgsForCall = append(fake.getBucketAclWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketAclInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketAclWithContextStub
+	fakeReturns := fake.getBucketAclWithContextReturns
 	fake.recordInvocation("GetBucketAclWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketAclWithContextMutex.Unlock()
-	if fake.GetBucketAclWithContextStub != nil {
-		return fake.GetBucketAclWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketAclWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8012,13 +9137,13 @@ func (fake *FakeS3API) GetBucketAclWithContextCallCount() int {
 	return len(fake.getBucketAclWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketAclWithContextCalls(stub func(context.Context, *s3.GetBucketAclInput, ...request.Option) (*s3.GetBucketAclOutput, error)) {
+func (fake *FakeS3API) GetBucketAclWithContextCalls(stub func(aws.Context, *s3.GetBucketAclInput, ...request.Option) (*s3.GetBucketAclOutput, error)) {
 	fake.getBucketAclWithContextMutex.Lock()
 	defer fake.getBucketAclWithContextMutex.Unlock()
 	fake.GetBucketAclWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketAclWithContextArgsForCall(i int) (context.Context, *s3.GetBucketAclInput, []request.Option) {
+func (fake *FakeS3API) GetBucketAclWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketAclInput, []request.Option) {
 	fake.getBucketAclWithContextMutex.RLock()
 	defer fake.getBucketAclWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketAclWithContextArgsForCall[i]
@@ -8057,15 +9182,16 @@ func (fake *FakeS3API) GetBucketAnalyticsConfiguration(arg1 *s3.GetBucketAnalyti
 	fake.getBucketAnalyticsConfigurationArgsForCall = append(fake.getBucketAnalyticsConfigurationArgsForCall, struct {
 		arg1 *s3.GetBucketAnalyticsConfigurationInput
 	}{arg1})
+	stub := fake.GetBucketAnalyticsConfigurationStub
+	fakeReturns := fake.getBucketAnalyticsConfigurationReturns
 	fake.recordInvocation("GetBucketAnalyticsConfiguration", []interface{}{arg1})
 	fake.getBucketAnalyticsConfigurationMutex.Unlock()
-	if fake.GetBucketAnalyticsConfigurationStub != nil {
-		return fake.GetBucketAnalyticsConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketAnalyticsConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8120,15 +9246,16 @@ func (fake *FakeS3API) GetBucketAnalyticsConfigurationRequest(arg1 *s3.GetBucket
 	fake.getBucketAnalyticsConfigurationRequestArgsForCall = append(fake.getBucketAnalyticsConfigurationRequestArgsForCall, struct {
 		arg1 *s3.GetBucketAnalyticsConfigurationInput
 	}{arg1})
+	stub := fake.GetBucketAnalyticsConfigurationRequestStub
+	fakeReturns := fake.getBucketAnalyticsConfigurationRequestReturns
 	fake.recordInvocation("GetBucketAnalyticsConfigurationRequest", []interface{}{arg1})
 	fake.getBucketAnalyticsConfigurationRequestMutex.Unlock()
-	if fake.GetBucketAnalyticsConfigurationRequestStub != nil {
-		return fake.GetBucketAnalyticsConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketAnalyticsConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8177,23 +9304,24 @@ func (fake *FakeS3API) GetBucketAnalyticsConfigurationRequestReturnsOnCall(i int
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketAnalyticsConfigurationWithContext(arg1 context.Context, arg2 *s3.GetBucketAnalyticsConfigurationInput, arg3 ...request.Option) (*s3.GetBucketAnalyticsConfigurationOutput, error) {
+func (fake *FakeS3API) GetBucketAnalyticsConfigurationWithContext(arg1 aws.Context, arg2 *s3.GetBucketAnalyticsConfigurationInput, arg3 ...request.Option) (*s3.GetBucketAnalyticsConfigurationOutput, error) {
 	fake.getBucketAnalyticsConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketAnalyticsConfigurationWithContextReturnsOnCall[len(fake.getBucketAnalyticsConfigurationWithContextArgsForCall)]
 	fake.getBucketAnalyticsConfigurationWithContextArgsForCall = append(fake.getBucketAnalyticsConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketAnalyticsConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketAnalyticsConfigurationWithContextStub
+	fakeReturns := fake.getBucketAnalyticsConfigurationWithContextReturns
 	fake.recordInvocation("GetBucketAnalyticsConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketAnalyticsConfigurationWithContextMutex.Unlock()
-	if fake.GetBucketAnalyticsConfigurationWithContextStub != nil {
-		return fake.GetBucketAnalyticsConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketAnalyticsConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8203,13 +9331,13 @@ func (fake *FakeS3API) GetBucketAnalyticsConfigurationWithContextCallCount() int
 	return len(fake.getBucketAnalyticsConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketAnalyticsConfigurationWithContextCalls(stub func(context.Context, *s3.GetBucketAnalyticsConfigurationInput, ...request.Option) (*s3.GetBucketAnalyticsConfigurationOutput, error)) {
+func (fake *FakeS3API) GetBucketAnalyticsConfigurationWithContextCalls(stub func(aws.Context, *s3.GetBucketAnalyticsConfigurationInput, ...request.Option) (*s3.GetBucketAnalyticsConfigurationOutput, error)) {
 	fake.getBucketAnalyticsConfigurationWithContextMutex.Lock()
 	defer fake.getBucketAnalyticsConfigurationWithContextMutex.Unlock()
 	fake.GetBucketAnalyticsConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketAnalyticsConfigurationWithContextArgsForCall(i int) (context.Context, *s3.GetBucketAnalyticsConfigurationInput, []request.Option) {
+func (fake *FakeS3API) GetBucketAnalyticsConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketAnalyticsConfigurationInput, []request.Option) {
 	fake.getBucketAnalyticsConfigurationWithContextMutex.RLock()
 	defer fake.getBucketAnalyticsConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketAnalyticsConfigurationWithContextArgsForCall[i]
@@ -8248,15 +9376,16 @@ func (fake *FakeS3API) GetBucketCors(arg1 *s3.GetBucketCorsInput) (*s3.GetBucket
 	fake.getBucketCorsArgsForCall = append(fake.getBucketCorsArgsForCall, struct {
 		arg1 *s3.GetBucketCorsInput
 	}{arg1})
+	stub := fake.GetBucketCorsStub
+	fakeReturns := fake.getBucketCorsReturns
 	fake.recordInvocation("GetBucketCors", []interface{}{arg1})
 	fake.getBucketCorsMutex.Unlock()
-	if fake.GetBucketCorsStub != nil {
-		return fake.GetBucketCorsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketCorsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8311,15 +9440,16 @@ func (fake *FakeS3API) GetBucketCorsRequest(arg1 *s3.GetBucketCorsInput) (*reque
 	fake.getBucketCorsRequestArgsForCall = append(fake.getBucketCorsRequestArgsForCall, struct {
 		arg1 *s3.GetBucketCorsInput
 	}{arg1})
+	stub := fake.GetBucketCorsRequestStub
+	fakeReturns := fake.getBucketCorsRequestReturns
 	fake.recordInvocation("GetBucketCorsRequest", []interface{}{arg1})
 	fake.getBucketCorsRequestMutex.Unlock()
-	if fake.GetBucketCorsRequestStub != nil {
-		return fake.GetBucketCorsRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketCorsRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8368,23 +9498,24 @@ func (fake *FakeS3API) GetBucketCorsRequestReturnsOnCall(i int, result1 *request
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketCorsWithContext(arg1 context.Context, arg2 *s3.GetBucketCorsInput, arg3 ...request.Option) (*s3.GetBucketCorsOutput, error) {
+func (fake *FakeS3API) GetBucketCorsWithContext(arg1 aws.Context, arg2 *s3.GetBucketCorsInput, arg3 ...request.Option) (*s3.GetBucketCorsOutput, error) {
 	fake.getBucketCorsWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketCorsWithContextReturnsOnCall[len(fake.getBucketCorsWithContextArgsForCall)]
 	fake.getBucketCorsWithContextArgsForCall = append(fake.getBucketCorsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketCorsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketCorsWithContextStub
+	fakeReturns := fake.getBucketCorsWithContextReturns
 	fake.recordInvocation("GetBucketCorsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketCorsWithContextMutex.Unlock()
-	if fake.GetBucketCorsWithContextStub != nil {
-		return fake.GetBucketCorsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketCorsWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8394,13 +9525,13 @@ func (fake *FakeS3API) GetBucketCorsWithContextCallCount() int {
 	return len(fake.getBucketCorsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketCorsWithContextCalls(stub func(context.Context, *s3.GetBucketCorsInput, ...request.Option) (*s3.GetBucketCorsOutput, error)) {
+func (fake *FakeS3API) GetBucketCorsWithContextCalls(stub func(aws.Context, *s3.GetBucketCorsInput, ...request.Option) (*s3.GetBucketCorsOutput, error)) {
 	fake.getBucketCorsWithContextMutex.Lock()
 	defer fake.getBucketCorsWithContextMutex.Unlock()
 	fake.GetBucketCorsWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketCorsWithContextArgsForCall(i int) (context.Context, *s3.GetBucketCorsInput, []request.Option) {
+func (fake *FakeS3API) GetBucketCorsWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketCorsInput, []request.Option) {
 	fake.getBucketCorsWithContextMutex.RLock()
 	defer fake.getBucketCorsWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketCorsWithContextArgsForCall[i]
@@ -8439,15 +9570,16 @@ func (fake *FakeS3API) GetBucketEncryption(arg1 *s3.GetBucketEncryptionInput) (*
 	fake.getBucketEncryptionArgsForCall = append(fake.getBucketEncryptionArgsForCall, struct {
 		arg1 *s3.GetBucketEncryptionInput
 	}{arg1})
+	stub := fake.GetBucketEncryptionStub
+	fakeReturns := fake.getBucketEncryptionReturns
 	fake.recordInvocation("GetBucketEncryption", []interface{}{arg1})
 	fake.getBucketEncryptionMutex.Unlock()
-	if fake.GetBucketEncryptionStub != nil {
-		return fake.GetBucketEncryptionStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketEncryptionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8502,15 +9634,16 @@ func (fake *FakeS3API) GetBucketEncryptionRequest(arg1 *s3.GetBucketEncryptionIn
 	fake.getBucketEncryptionRequestArgsForCall = append(fake.getBucketEncryptionRequestArgsForCall, struct {
 		arg1 *s3.GetBucketEncryptionInput
 	}{arg1})
+	stub := fake.GetBucketEncryptionRequestStub
+	fakeReturns := fake.getBucketEncryptionRequestReturns
 	fake.recordInvocation("GetBucketEncryptionRequest", []interface{}{arg1})
 	fake.getBucketEncryptionRequestMutex.Unlock()
-	if fake.GetBucketEncryptionRequestStub != nil {
-		return fake.GetBucketEncryptionRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketEncryptionRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8559,23 +9692,24 @@ func (fake *FakeS3API) GetBucketEncryptionRequestReturnsOnCall(i int, result1 *r
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketEncryptionWithContext(arg1 context.Context, arg2 *s3.GetBucketEncryptionInput, arg3 ...request.Option) (*s3.GetBucketEncryptionOutput, error) {
+func (fake *FakeS3API) GetBucketEncryptionWithContext(arg1 aws.Context, arg2 *s3.GetBucketEncryptionInput, arg3 ...request.Option) (*s3.GetBucketEncryptionOutput, error) {
 	fake.getBucketEncryptionWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketEncryptionWithContextReturnsOnCall[len(fake.getBucketEncryptionWithContextArgsForCall)]
 	fake.getBucketEncryptionWithContextArgsForCall = append(fake.getBucketEncryptionWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketEncryptionInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketEncryptionWithContextStub
+	fakeReturns := fake.getBucketEncryptionWithContextReturns
 	fake.recordInvocation("GetBucketEncryptionWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketEncryptionWithContextMutex.Unlock()
-	if fake.GetBucketEncryptionWithContextStub != nil {
-		return fake.GetBucketEncryptionWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketEncryptionWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8585,13 +9719,13 @@ func (fake *FakeS3API) GetBucketEncryptionWithContextCallCount() int {
 	return len(fake.getBucketEncryptionWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketEncryptionWithContextCalls(stub func(context.Context, *s3.GetBucketEncryptionInput, ...request.Option) (*s3.GetBucketEncryptionOutput, error)) {
+func (fake *FakeS3API) GetBucketEncryptionWithContextCalls(stub func(aws.Context, *s3.GetBucketEncryptionInput, ...request.Option) (*s3.GetBucketEncryptionOutput, error)) {
 	fake.getBucketEncryptionWithContextMutex.Lock()
 	defer fake.getBucketEncryptionWithContextMutex.Unlock()
 	fake.GetBucketEncryptionWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketEncryptionWithContextArgsForCall(i int) (context.Context, *s3.GetBucketEncryptionInput, []request.Option) {
+func (fake *FakeS3API) GetBucketEncryptionWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketEncryptionInput, []request.Option) {
 	fake.getBucketEncryptionWithContextMutex.RLock()
 	defer fake.getBucketEncryptionWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketEncryptionWithContextArgsForCall[i]
@@ -8624,149 +9758,346 @@ func (fake *FakeS3API) GetBucketEncryptionWithContextReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfiguration(arg1 *s3.GetBucketInventoryConfigurationInput) (*s3.GetBucketInventoryConfigurationOutput, error) {
-	fake.getBucketInventoryConfigurationMutex.Lock()
-	ret, specificReturn := fake.getBucketInventoryConfigurationReturnsOnCall[len(fake.getBucketInventoryConfigurationArgsForCall)]
-	fake.getBucketInventoryConfigurationArgsForCall = append(fake.getBucketInventoryConfigurationArgsForCall, struct {
-		arg1 *s3.GetBucketInventoryConfigurationInput
+func (fake *FakeS3API) GetBucketIntelligentTieringConfiguration(arg1 *s3.GetBucketIntelligentTieringConfigurationInput) (*s3.GetBucketIntelligentTieringConfigurationOutput, error) {
+	fake.getBucketIntelligentTieringConfigurationMutex.Lock()
+	ret, specificReturn := fake.getBucketIntelligentTieringConfigurationReturnsOnCall[len(fake.getBucketIntelligentTieringConfigurationArgsForCall)]
+	fake.getBucketIntelligentTieringConfigurationArgsForCall = append(fake.getBucketIntelligentTieringConfigurationArgsForCall, struct {
+		arg1 *s3.GetBucketIntelligentTieringConfigurationInput
 	}{arg1})
-	fake.recordInvocation("GetBucketInventoryConfiguration", []interface{}{arg1})
-	fake.getBucketInventoryConfigurationMutex.Unlock()
-	if fake.GetBucketInventoryConfigurationStub != nil {
-		return fake.GetBucketInventoryConfigurationStub(arg1)
+	stub := fake.GetBucketIntelligentTieringConfigurationStub
+	fakeReturns := fake.getBucketIntelligentTieringConfigurationReturns
+	fake.recordInvocation("GetBucketIntelligentTieringConfiguration", []interface{}{arg1})
+	fake.getBucketIntelligentTieringConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketInventoryConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationCallCount() int {
-	fake.getBucketInventoryConfigurationMutex.RLock()
-	defer fake.getBucketInventoryConfigurationMutex.RUnlock()
-	return len(fake.getBucketInventoryConfigurationArgsForCall)
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationCallCount() int {
+	fake.getBucketIntelligentTieringConfigurationMutex.RLock()
+	defer fake.getBucketIntelligentTieringConfigurationMutex.RUnlock()
+	return len(fake.getBucketIntelligentTieringConfigurationArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationCalls(stub func(*s3.GetBucketInventoryConfigurationInput) (*s3.GetBucketInventoryConfigurationOutput, error)) {
-	fake.getBucketInventoryConfigurationMutex.Lock()
-	defer fake.getBucketInventoryConfigurationMutex.Unlock()
-	fake.GetBucketInventoryConfigurationStub = stub
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationCalls(stub func(*s3.GetBucketIntelligentTieringConfigurationInput) (*s3.GetBucketIntelligentTieringConfigurationOutput, error)) {
+	fake.getBucketIntelligentTieringConfigurationMutex.Lock()
+	defer fake.getBucketIntelligentTieringConfigurationMutex.Unlock()
+	fake.GetBucketIntelligentTieringConfigurationStub = stub
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationArgsForCall(i int) *s3.GetBucketInventoryConfigurationInput {
-	fake.getBucketInventoryConfigurationMutex.RLock()
-	defer fake.getBucketInventoryConfigurationMutex.RUnlock()
-	argsForCall := fake.getBucketInventoryConfigurationArgsForCall[i]
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationArgsForCall(i int) *s3.GetBucketIntelligentTieringConfigurationInput {
+	fake.getBucketIntelligentTieringConfigurationMutex.RLock()
+	defer fake.getBucketIntelligentTieringConfigurationMutex.RUnlock()
+	argsForCall := fake.getBucketIntelligentTieringConfigurationArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationReturns(result1 *s3.GetBucketInventoryConfigurationOutput, result2 error) {
-	fake.getBucketInventoryConfigurationMutex.Lock()
-	defer fake.getBucketInventoryConfigurationMutex.Unlock()
-	fake.GetBucketInventoryConfigurationStub = nil
-	fake.getBucketInventoryConfigurationReturns = struct {
-		result1 *s3.GetBucketInventoryConfigurationOutput
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationReturns(result1 *s3.GetBucketIntelligentTieringConfigurationOutput, result2 error) {
+	fake.getBucketIntelligentTieringConfigurationMutex.Lock()
+	defer fake.getBucketIntelligentTieringConfigurationMutex.Unlock()
+	fake.GetBucketIntelligentTieringConfigurationStub = nil
+	fake.getBucketIntelligentTieringConfigurationReturns = struct {
+		result1 *s3.GetBucketIntelligentTieringConfigurationOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationReturnsOnCall(i int, result1 *s3.GetBucketInventoryConfigurationOutput, result2 error) {
-	fake.getBucketInventoryConfigurationMutex.Lock()
-	defer fake.getBucketInventoryConfigurationMutex.Unlock()
-	fake.GetBucketInventoryConfigurationStub = nil
-	if fake.getBucketInventoryConfigurationReturnsOnCall == nil {
-		fake.getBucketInventoryConfigurationReturnsOnCall = make(map[int]struct {
-			result1 *s3.GetBucketInventoryConfigurationOutput
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationReturnsOnCall(i int, result1 *s3.GetBucketIntelligentTieringConfigurationOutput, result2 error) {
+	fake.getBucketIntelligentTieringConfigurationMutex.Lock()
+	defer fake.getBucketIntelligentTieringConfigurationMutex.Unlock()
+	fake.GetBucketIntelligentTieringConfigurationStub = nil
+	if fake.getBucketIntelligentTieringConfigurationReturnsOnCall == nil {
+		fake.getBucketIntelligentTieringConfigurationReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketIntelligentTieringConfigurationOutput
 			result2 error
 		})
 	}
-	fake.getBucketInventoryConfigurationReturnsOnCall[i] = struct {
-		result1 *s3.GetBucketInventoryConfigurationOutput
+	fake.getBucketIntelligentTieringConfigurationReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketIntelligentTieringConfigurationOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationRequest(arg1 *s3.GetBucketInventoryConfigurationInput) (*request.Request, *s3.GetBucketInventoryConfigurationOutput) {
-	fake.getBucketInventoryConfigurationRequestMutex.Lock()
-	ret, specificReturn := fake.getBucketInventoryConfigurationRequestReturnsOnCall[len(fake.getBucketInventoryConfigurationRequestArgsForCall)]
-	fake.getBucketInventoryConfigurationRequestArgsForCall = append(fake.getBucketInventoryConfigurationRequestArgsForCall, struct {
-		arg1 *s3.GetBucketInventoryConfigurationInput
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationRequest(arg1 *s3.GetBucketIntelligentTieringConfigurationInput) (*request.Request, *s3.GetBucketIntelligentTieringConfigurationOutput) {
+	fake.getBucketIntelligentTieringConfigurationRequestMutex.Lock()
+	ret, specificReturn := fake.getBucketIntelligentTieringConfigurationRequestReturnsOnCall[len(fake.getBucketIntelligentTieringConfigurationRequestArgsForCall)]
+	fake.getBucketIntelligentTieringConfigurationRequestArgsForCall = append(fake.getBucketIntelligentTieringConfigurationRequestArgsForCall, struct {
+		arg1 *s3.GetBucketIntelligentTieringConfigurationInput
 	}{arg1})
-	fake.recordInvocation("GetBucketInventoryConfigurationRequest", []interface{}{arg1})
-	fake.getBucketInventoryConfigurationRequestMutex.Unlock()
-	if fake.GetBucketInventoryConfigurationRequestStub != nil {
-		return fake.GetBucketInventoryConfigurationRequestStub(arg1)
+	stub := fake.GetBucketIntelligentTieringConfigurationRequestStub
+	fakeReturns := fake.getBucketIntelligentTieringConfigurationRequestReturns
+	fake.recordInvocation("GetBucketIntelligentTieringConfigurationRequest", []interface{}{arg1})
+	fake.getBucketIntelligentTieringConfigurationRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketInventoryConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationRequestCallCount() int {
-	fake.getBucketInventoryConfigurationRequestMutex.RLock()
-	defer fake.getBucketInventoryConfigurationRequestMutex.RUnlock()
-	return len(fake.getBucketInventoryConfigurationRequestArgsForCall)
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationRequestCallCount() int {
+	fake.getBucketIntelligentTieringConfigurationRequestMutex.RLock()
+	defer fake.getBucketIntelligentTieringConfigurationRequestMutex.RUnlock()
+	return len(fake.getBucketIntelligentTieringConfigurationRequestArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationRequestCalls(stub func(*s3.GetBucketInventoryConfigurationInput) (*request.Request, *s3.GetBucketInventoryConfigurationOutput)) {
-	fake.getBucketInventoryConfigurationRequestMutex.Lock()
-	defer fake.getBucketInventoryConfigurationRequestMutex.Unlock()
-	fake.GetBucketInventoryConfigurationRequestStub = stub
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationRequestCalls(stub func(*s3.GetBucketIntelligentTieringConfigurationInput) (*request.Request, *s3.GetBucketIntelligentTieringConfigurationOutput)) {
+	fake.getBucketIntelligentTieringConfigurationRequestMutex.Lock()
+	defer fake.getBucketIntelligentTieringConfigurationRequestMutex.Unlock()
+	fake.GetBucketIntelligentTieringConfigurationRequestStub = stub
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationRequestArgsForCall(i int) *s3.GetBucketInventoryConfigurationInput {
-	fake.getBucketInventoryConfigurationRequestMutex.RLock()
-	defer fake.getBucketInventoryConfigurationRequestMutex.RUnlock()
-	argsForCall := fake.getBucketInventoryConfigurationRequestArgsForCall[i]
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationRequestArgsForCall(i int) *s3.GetBucketIntelligentTieringConfigurationInput {
+	fake.getBucketIntelligentTieringConfigurationRequestMutex.RLock()
+	defer fake.getBucketIntelligentTieringConfigurationRequestMutex.RUnlock()
+	argsForCall := fake.getBucketIntelligentTieringConfigurationRequestArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationRequestReturns(result1 *request.Request, result2 *s3.GetBucketInventoryConfigurationOutput) {
-	fake.getBucketInventoryConfigurationRequestMutex.Lock()
-	defer fake.getBucketInventoryConfigurationRequestMutex.Unlock()
-	fake.GetBucketInventoryConfigurationRequestStub = nil
-	fake.getBucketInventoryConfigurationRequestReturns = struct {
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationRequestReturns(result1 *request.Request, result2 *s3.GetBucketIntelligentTieringConfigurationOutput) {
+	fake.getBucketIntelligentTieringConfigurationRequestMutex.Lock()
+	defer fake.getBucketIntelligentTieringConfigurationRequestMutex.Unlock()
+	fake.GetBucketIntelligentTieringConfigurationRequestStub = nil
+	fake.getBucketIntelligentTieringConfigurationRequestReturns = struct {
 		result1 *request.Request
-		result2 *s3.GetBucketInventoryConfigurationOutput
+		result2 *s3.GetBucketIntelligentTieringConfigurationOutput
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.GetBucketInventoryConfigurationOutput) {
-	fake.getBucketInventoryConfigurationRequestMutex.Lock()
-	defer fake.getBucketInventoryConfigurationRequestMutex.Unlock()
-	fake.GetBucketInventoryConfigurationRequestStub = nil
-	if fake.getBucketInventoryConfigurationRequestReturnsOnCall == nil {
-		fake.getBucketInventoryConfigurationRequestReturnsOnCall = make(map[int]struct {
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.GetBucketIntelligentTieringConfigurationOutput) {
+	fake.getBucketIntelligentTieringConfigurationRequestMutex.Lock()
+	defer fake.getBucketIntelligentTieringConfigurationRequestMutex.Unlock()
+	fake.GetBucketIntelligentTieringConfigurationRequestStub = nil
+	if fake.getBucketIntelligentTieringConfigurationRequestReturnsOnCall == nil {
+		fake.getBucketIntelligentTieringConfigurationRequestReturnsOnCall = make(map[int]struct {
 			result1 *request.Request
-			result2 *s3.GetBucketInventoryConfigurationOutput
+			result2 *s3.GetBucketIntelligentTieringConfigurationOutput
 		})
 	}
-	fake.getBucketInventoryConfigurationRequestReturnsOnCall[i] = struct {
+	fake.getBucketIntelligentTieringConfigurationRequestReturnsOnCall[i] = struct {
 		result1 *request.Request
-		result2 *s3.GetBucketInventoryConfigurationOutput
+		result2 *s3.GetBucketIntelligentTieringConfigurationOutput
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationWithContext(arg1 context.Context, arg2 *s3.GetBucketInventoryConfigurationInput, arg3 ...request.Option) (*s3.GetBucketInventoryConfigurationOutput, error) {
-	fake.getBucketInventoryConfigurationWithContextMutex.Lock()
-	ret, specificReturn := fake.getBucketInventoryConfigurationWithContextReturnsOnCall[len(fake.getBucketInventoryConfigurationWithContextArgsForCall)]
-	fake.getBucketInventoryConfigurationWithContextArgsForCall = append(fake.getBucketInventoryConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
-		arg2 *s3.GetBucketInventoryConfigurationInput
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationWithContext(arg1 aws.Context, arg2 *s3.GetBucketIntelligentTieringConfigurationInput, arg3 ...request.Option) (*s3.GetBucketIntelligentTieringConfigurationOutput, error) {
+	fake.getBucketIntelligentTieringConfigurationWithContextMutex.Lock()
+	ret, specificReturn := fake.getBucketIntelligentTieringConfigurationWithContextReturnsOnCall[len(fake.getBucketIntelligentTieringConfigurationWithContextArgsForCall)]
+	fake.getBucketIntelligentTieringConfigurationWithContextArgsForCall = append(fake.getBucketIntelligentTieringConfigurationWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.GetBucketIntelligentTieringConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketIntelligentTieringConfigurationWithContextStub
+	fakeReturns := fake.getBucketIntelligentTieringConfigurationWithContextReturns
+	fake.recordInvocation("GetBucketIntelligentTieringConfigurationWithContext", []interface{}{arg1, arg2, arg3})
+	fake.getBucketIntelligentTieringConfigurationWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationWithContextCallCount() int {
+	fake.getBucketIntelligentTieringConfigurationWithContextMutex.RLock()
+	defer fake.getBucketIntelligentTieringConfigurationWithContextMutex.RUnlock()
+	return len(fake.getBucketIntelligentTieringConfigurationWithContextArgsForCall)
+}
+
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationWithContextCalls(stub func(aws.Context, *s3.GetBucketIntelligentTieringConfigurationInput, ...request.Option) (*s3.GetBucketIntelligentTieringConfigurationOutput, error)) {
+	fake.getBucketIntelligentTieringConfigurationWithContextMutex.Lock()
+	defer fake.getBucketIntelligentTieringConfigurationWithContextMutex.Unlock()
+	fake.GetBucketIntelligentTieringConfigurationWithContextStub = stub
+}
+
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketIntelligentTieringConfigurationInput, []request.Option) {
+	fake.getBucketIntelligentTieringConfigurationWithContextMutex.RLock()
+	defer fake.getBucketIntelligentTieringConfigurationWithContextMutex.RUnlock()
+	argsForCall := fake.getBucketIntelligentTieringConfigurationWithContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationWithContextReturns(result1 *s3.GetBucketIntelligentTieringConfigurationOutput, result2 error) {
+	fake.getBucketIntelligentTieringConfigurationWithContextMutex.Lock()
+	defer fake.getBucketIntelligentTieringConfigurationWithContextMutex.Unlock()
+	fake.GetBucketIntelligentTieringConfigurationWithContextStub = nil
+	fake.getBucketIntelligentTieringConfigurationWithContextReturns = struct {
+		result1 *s3.GetBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetBucketIntelligentTieringConfigurationWithContextReturnsOnCall(i int, result1 *s3.GetBucketIntelligentTieringConfigurationOutput, result2 error) {
+	fake.getBucketIntelligentTieringConfigurationWithContextMutex.Lock()
+	defer fake.getBucketIntelligentTieringConfigurationWithContextMutex.Unlock()
+	fake.GetBucketIntelligentTieringConfigurationWithContextStub = nil
+	if fake.getBucketIntelligentTieringConfigurationWithContextReturnsOnCall == nil {
+		fake.getBucketIntelligentTieringConfigurationWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketIntelligentTieringConfigurationOutput
+			result2 error
+		})
+	}
+	fake.getBucketIntelligentTieringConfigurationWithContextReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfiguration(arg1 *s3.GetBucketInventoryConfigurationInput) (*s3.GetBucketInventoryConfigurationOutput, error) {
+	fake.getBucketInventoryConfigurationMutex.Lock()
+	ret, specificReturn := fake.getBucketInventoryConfigurationReturnsOnCall[len(fake.getBucketInventoryConfigurationArgsForCall)]
+	fake.getBucketInventoryConfigurationArgsForCall = append(fake.getBucketInventoryConfigurationArgsForCall, struct {
+		arg1 *s3.GetBucketInventoryConfigurationInput
+	}{arg1})
+	stub := fake.GetBucketInventoryConfigurationStub
+	fakeReturns := fake.getBucketInventoryConfigurationReturns
+	fake.recordInvocation("GetBucketInventoryConfiguration", []interface{}{arg1})
+	fake.getBucketInventoryConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfigurationCallCount() int {
+	fake.getBucketInventoryConfigurationMutex.RLock()
+	defer fake.getBucketInventoryConfigurationMutex.RUnlock()
+	return len(fake.getBucketInventoryConfigurationArgsForCall)
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfigurationCalls(stub func(*s3.GetBucketInventoryConfigurationInput) (*s3.GetBucketInventoryConfigurationOutput, error)) {
+	fake.getBucketInventoryConfigurationMutex.Lock()
+	defer fake.getBucketInventoryConfigurationMutex.Unlock()
+	fake.GetBucketInventoryConfigurationStub = stub
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfigurationArgsForCall(i int) *s3.GetBucketInventoryConfigurationInput {
+	fake.getBucketInventoryConfigurationMutex.RLock()
+	defer fake.getBucketInventoryConfigurationMutex.RUnlock()
+	argsForCall := fake.getBucketInventoryConfigurationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfigurationReturns(result1 *s3.GetBucketInventoryConfigurationOutput, result2 error) {
+	fake.getBucketInventoryConfigurationMutex.Lock()
+	defer fake.getBucketInventoryConfigurationMutex.Unlock()
+	fake.GetBucketInventoryConfigurationStub = nil
+	fake.getBucketInventoryConfigurationReturns = struct {
+		result1 *s3.GetBucketInventoryConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfigurationReturnsOnCall(i int, result1 *s3.GetBucketInventoryConfigurationOutput, result2 error) {
+	fake.getBucketInventoryConfigurationMutex.Lock()
+	defer fake.getBucketInventoryConfigurationMutex.Unlock()
+	fake.GetBucketInventoryConfigurationStub = nil
+	if fake.getBucketInventoryConfigurationReturnsOnCall == nil {
+		fake.getBucketInventoryConfigurationReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketInventoryConfigurationOutput
+			result2 error
+		})
+	}
+	fake.getBucketInventoryConfigurationReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketInventoryConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfigurationRequest(arg1 *s3.GetBucketInventoryConfigurationInput) (*request.Request, *s3.GetBucketInventoryConfigurationOutput) {
+	fake.getBucketInventoryConfigurationRequestMutex.Lock()
+	ret, specificReturn := fake.getBucketInventoryConfigurationRequestReturnsOnCall[len(fake.getBucketInventoryConfigurationRequestArgsForCall)]
+	fake.getBucketInventoryConfigurationRequestArgsForCall = append(fake.getBucketInventoryConfigurationRequestArgsForCall, struct {
+		arg1 *s3.GetBucketInventoryConfigurationInput
+	}{arg1})
+	stub := fake.GetBucketInventoryConfigurationRequestStub
+	fakeReturns := fake.getBucketInventoryConfigurationRequestReturns
+	fake.recordInvocation("GetBucketInventoryConfigurationRequest", []interface{}{arg1})
+	fake.getBucketInventoryConfigurationRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfigurationRequestCallCount() int {
+	fake.getBucketInventoryConfigurationRequestMutex.RLock()
+	defer fake.getBucketInventoryConfigurationRequestMutex.RUnlock()
+	return len(fake.getBucketInventoryConfigurationRequestArgsForCall)
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfigurationRequestCalls(stub func(*s3.GetBucketInventoryConfigurationInput) (*request.Request, *s3.GetBucketInventoryConfigurationOutput)) {
+	fake.getBucketInventoryConfigurationRequestMutex.Lock()
+	defer fake.getBucketInventoryConfigurationRequestMutex.Unlock()
+	fake.GetBucketInventoryConfigurationRequestStub = stub
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfigurationRequestArgsForCall(i int) *s3.GetBucketInventoryConfigurationInput {
+	fake.getBucketInventoryConfigurationRequestMutex.RLock()
+	defer fake.getBucketInventoryConfigurationRequestMutex.RUnlock()
+	argsForCall := fake.getBucketInventoryConfigurationRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfigurationRequestReturns(result1 *request.Request, result2 *s3.GetBucketInventoryConfigurationOutput) {
+	fake.getBucketInventoryConfigurationRequestMutex.Lock()
+	defer fake.getBucketInventoryConfigurationRequestMutex.Unlock()
+	fake.GetBucketInventoryConfigurationRequestStub = nil
+	fake.getBucketInventoryConfigurationRequestReturns = struct {
+		result1 *request.Request
+		result2 *s3.GetBucketInventoryConfigurationOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfigurationRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.GetBucketInventoryConfigurationOutput) {
+	fake.getBucketInventoryConfigurationRequestMutex.Lock()
+	defer fake.getBucketInventoryConfigurationRequestMutex.Unlock()
+	fake.GetBucketInventoryConfigurationRequestStub = nil
+	if fake.getBucketInventoryConfigurationRequestReturnsOnCall == nil {
+		fake.getBucketInventoryConfigurationRequestReturnsOnCall = make(map[int]struct {
+			result1 *request.Request
+			result2 *s3.GetBucketInventoryConfigurationOutput
+		})
+	}
+	fake.getBucketInventoryConfigurationRequestReturnsOnCall[i] = struct {
+		result1 *request.Request
+		result2 *s3.GetBucketInventoryConfigurationOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetBucketInventoryConfigurationWithContext(arg1 aws.Context, arg2 *s3.GetBucketInventoryConfigurationInput, arg3 ...request.Option) (*s3.GetBucketInventoryConfigurationOutput, error) {
+	fake.getBucketInventoryConfigurationWithContextMutex.Lock()
+	ret, specificReturn := fake.getBucketInventoryConfigurationWithContextReturnsOnCall[len(fake.getBucketInventoryConfigurationWithContextArgsForCall)]
+	fake.getBucketInventoryConfigurationWithContextArgsForCall = append(fake.getBucketInventoryConfigurationWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.GetBucketInventoryConfigurationInput
+		arg3 []request.Option
+	}{arg1, arg2, arg3})
+	stub := fake.GetBucketInventoryConfigurationWithContextStub
+	fakeReturns := fake.getBucketInventoryConfigurationWithContextReturns
 	fake.recordInvocation("GetBucketInventoryConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketInventoryConfigurationWithContextMutex.Unlock()
-	if fake.GetBucketInventoryConfigurationWithContextStub != nil {
-		return fake.GetBucketInventoryConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketInventoryConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8776,13 +10107,13 @@ func (fake *FakeS3API) GetBucketInventoryConfigurationWithContextCallCount() int
 	return len(fake.getBucketInventoryConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationWithContextCalls(stub func(context.Context, *s3.GetBucketInventoryConfigurationInput, ...request.Option) (*s3.GetBucketInventoryConfigurationOutput, error)) {
+func (fake *FakeS3API) GetBucketInventoryConfigurationWithContextCalls(stub func(aws.Context, *s3.GetBucketInventoryConfigurationInput, ...request.Option) (*s3.GetBucketInventoryConfigurationOutput, error)) {
 	fake.getBucketInventoryConfigurationWithContextMutex.Lock()
 	defer fake.getBucketInventoryConfigurationWithContextMutex.Unlock()
 	fake.GetBucketInventoryConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketInventoryConfigurationWithContextArgsForCall(i int) (context.Context, *s3.GetBucketInventoryConfigurationInput, []request.Option) {
+func (fake *FakeS3API) GetBucketInventoryConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketInventoryConfigurationInput, []request.Option) {
 	fake.getBucketInventoryConfigurationWithContextMutex.RLock()
 	defer fake.getBucketInventoryConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketInventoryConfigurationWithContextArgsForCall[i]
@@ -8821,15 +10152,16 @@ func (fake *FakeS3API) GetBucketLifecycle(arg1 *s3.GetBucketLifecycleInput) (*s3
 	fake.getBucketLifecycleArgsForCall = append(fake.getBucketLifecycleArgsForCall, struct {
 		arg1 *s3.GetBucketLifecycleInput
 	}{arg1})
+	stub := fake.GetBucketLifecycleStub
+	fakeReturns := fake.getBucketLifecycleReturns
 	fake.recordInvocation("GetBucketLifecycle", []interface{}{arg1})
 	fake.getBucketLifecycleMutex.Unlock()
-	if fake.GetBucketLifecycleStub != nil {
-		return fake.GetBucketLifecycleStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketLifecycleReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8884,15 +10216,16 @@ func (fake *FakeS3API) GetBucketLifecycleConfiguration(arg1 *s3.GetBucketLifecyc
 	fake.getBucketLifecycleConfigurationArgsForCall = append(fake.getBucketLifecycleConfigurationArgsForCall, struct {
 		arg1 *s3.GetBucketLifecycleConfigurationInput
 	}{arg1})
+	stub := fake.GetBucketLifecycleConfigurationStub
+	fakeReturns := fake.getBucketLifecycleConfigurationReturns
 	fake.recordInvocation("GetBucketLifecycleConfiguration", []interface{}{arg1})
 	fake.getBucketLifecycleConfigurationMutex.Unlock()
-	if fake.GetBucketLifecycleConfigurationStub != nil {
-		return fake.GetBucketLifecycleConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketLifecycleConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -8947,15 +10280,16 @@ func (fake *FakeS3API) GetBucketLifecycleConfigurationRequest(arg1 *s3.GetBucket
 	fake.getBucketLifecycleConfigurationRequestArgsForCall = append(fake.getBucketLifecycleConfigurationRequestArgsForCall, struct {
 		arg1 *s3.GetBucketLifecycleConfigurationInput
 	}{arg1})
+	stub := fake.GetBucketLifecycleConfigurationRequestStub
+	fakeReturns := fake.getBucketLifecycleConfigurationRequestReturns
 	fake.recordInvocation("GetBucketLifecycleConfigurationRequest", []interface{}{arg1})
 	fake.getBucketLifecycleConfigurationRequestMutex.Unlock()
-	if fake.GetBucketLifecycleConfigurationRequestStub != nil {
-		return fake.GetBucketLifecycleConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketLifecycleConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9004,23 +10338,24 @@ func (fake *FakeS3API) GetBucketLifecycleConfigurationRequestReturnsOnCall(i int
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketLifecycleConfigurationWithContext(arg1 context.Context, arg2 *s3.GetBucketLifecycleConfigurationInput, arg3 ...request.Option) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+func (fake *FakeS3API) GetBucketLifecycleConfigurationWithContext(arg1 aws.Context, arg2 *s3.GetBucketLifecycleConfigurationInput, arg3 ...request.Option) (*s3.GetBucketLifecycleConfigurationOutput, error) {
 	fake.getBucketLifecycleConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketLifecycleConfigurationWithContextReturnsOnCall[len(fake.getBucketLifecycleConfigurationWithContextArgsForCall)]
 	fake.getBucketLifecycleConfigurationWithContextArgsForCall = append(fake.getBucketLifecycleConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketLifecycleConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketLifecycleConfigurationWithContextStub
+	fakeReturns := fake.getBucketLifecycleConfigurationWithContextReturns
 	fake.recordInvocation("GetBucketLifecycleConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketLifecycleConfigurationWithContextMutex.Unlock()
-	if fake.GetBucketLifecycleConfigurationWithContextStub != nil {
-		return fake.GetBucketLifecycleConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketLifecycleConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9030,13 +10365,13 @@ func (fake *FakeS3API) GetBucketLifecycleConfigurationWithContextCallCount() int
 	return len(fake.getBucketLifecycleConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketLifecycleConfigurationWithContextCalls(stub func(context.Context, *s3.GetBucketLifecycleConfigurationInput, ...request.Option) (*s3.GetBucketLifecycleConfigurationOutput, error)) {
+func (fake *FakeS3API) GetBucketLifecycleConfigurationWithContextCalls(stub func(aws.Context, *s3.GetBucketLifecycleConfigurationInput, ...request.Option) (*s3.GetBucketLifecycleConfigurationOutput, error)) {
 	fake.getBucketLifecycleConfigurationWithContextMutex.Lock()
 	defer fake.getBucketLifecycleConfigurationWithContextMutex.Unlock()
 	fake.GetBucketLifecycleConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketLifecycleConfigurationWithContextArgsForCall(i int) (context.Context, *s3.GetBucketLifecycleConfigurationInput, []request.Option) {
+func (fake *FakeS3API) GetBucketLifecycleConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketLifecycleConfigurationInput, []request.Option) {
 	fake.getBucketLifecycleConfigurationWithContextMutex.RLock()
 	defer fake.getBucketLifecycleConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketLifecycleConfigurationWithContextArgsForCall[i]
@@ -9075,15 +10410,16 @@ func (fake *FakeS3API) GetBucketLifecycleRequest(arg1 *s3.GetBucketLifecycleInpu
 	fake.getBucketLifecycleRequestArgsForCall = append(fake.getBucketLifecycleRequestArgsForCall, struct {
 		arg1 *s3.GetBucketLifecycleInput
 	}{arg1})
+	stub := fake.GetBucketLifecycleRequestStub
+	fakeReturns := fake.getBucketLifecycleRequestReturns
 	fake.recordInvocation("GetBucketLifecycleRequest", []interface{}{arg1})
 	fake.getBucketLifecycleRequestMutex.Unlock()
-	if fake.GetBucketLifecycleRequestStub != nil {
-		return fake.GetBucketLifecycleRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketLifecycleRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9132,23 +10468,24 @@ func (fake *FakeS3API) GetBucketLifecycleRequestReturnsOnCall(i int, result1 *re
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketLifecycleWithContext(arg1 context.Context, arg2 *s3.GetBucketLifecycleInput, arg3 ...request.Option) (*s3.GetBucketLifecycleOutput, error) {
+func (fake *FakeS3API) GetBucketLifecycleWithContext(arg1 aws.Context, arg2 *s3.GetBucketLifecycleInput, arg3 ...request.Option) (*s3.GetBucketLifecycleOutput, error) {
 	fake.getBucketLifecycleWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketLifecycleWithContextReturnsOnCall[len(fake.getBucketLifecycleWithContextArgsForCall)]
 	fake.getBucketLifecycleWithContextArgsForCall = append(fake.getBucketLifecycleWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketLifecycleInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketLifecycleWithContextStub
+	fakeReturns := fake.getBucketLifecycleWithContextReturns
 	fake.recordInvocation("GetBucketLifecycleWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketLifecycleWithContextMutex.Unlock()
-	if fake.GetBucketLifecycleWithContextStub != nil {
-		return fake.GetBucketLifecycleWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketLifecycleWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9158,13 +10495,13 @@ func (fake *FakeS3API) GetBucketLifecycleWithContextCallCount() int {
 	return len(fake.getBucketLifecycleWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketLifecycleWithContextCalls(stub func(context.Context, *s3.GetBucketLifecycleInput, ...request.Option) (*s3.GetBucketLifecycleOutput, error)) {
+func (fake *FakeS3API) GetBucketLifecycleWithContextCalls(stub func(aws.Context, *s3.GetBucketLifecycleInput, ...request.Option) (*s3.GetBucketLifecycleOutput, error)) {
 	fake.getBucketLifecycleWithContextMutex.Lock()
 	defer fake.getBucketLifecycleWithContextMutex.Unlock()
 	fake.GetBucketLifecycleWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketLifecycleWithContextArgsForCall(i int) (context.Context, *s3.GetBucketLifecycleInput, []request.Option) {
+func (fake *FakeS3API) GetBucketLifecycleWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketLifecycleInput, []request.Option) {
 	fake.getBucketLifecycleWithContextMutex.RLock()
 	defer fake.getBucketLifecycleWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketLifecycleWithContextArgsForCall[i]
@@ -9203,15 +10540,16 @@ func (fake *FakeS3API) GetBucketLocation(arg1 *s3.GetBucketLocationInput) (*s3.G
 	fake.getBucketLocationArgsForCall = append(fake.getBucketLocationArgsForCall, struct {
 		arg1 *s3.GetBucketLocationInput
 	}{arg1})
+	stub := fake.GetBucketLocationStub
+	fakeReturns := fake.getBucketLocationReturns
 	fake.recordInvocation("GetBucketLocation", []interface{}{arg1})
 	fake.getBucketLocationMutex.Unlock()
-	if fake.GetBucketLocationStub != nil {
-		return fake.GetBucketLocationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketLocationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9266,15 +10604,16 @@ func (fake *FakeS3API) GetBucketLocationRequest(arg1 *s3.GetBucketLocationInput)
 	fake.getBucketLocationRequestArgsForCall = append(fake.getBucketLocationRequestArgsForCall, struct {
 		arg1 *s3.GetBucketLocationInput
 	}{arg1})
+	stub := fake.GetBucketLocationRequestStub
+	fakeReturns := fake.getBucketLocationRequestReturns
 	fake.recordInvocation("GetBucketLocationRequest", []interface{}{arg1})
 	fake.getBucketLocationRequestMutex.Unlock()
-	if fake.GetBucketLocationRequestStub != nil {
-		return fake.GetBucketLocationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketLocationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9323,23 +10662,24 @@ func (fake *FakeS3API) GetBucketLocationRequestReturnsOnCall(i int, result1 *req
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketLocationWithContext(arg1 context.Context, arg2 *s3.GetBucketLocationInput, arg3 ...request.Option) (*s3.GetBucketLocationOutput, error) {
+func (fake *FakeS3API) GetBucketLocationWithContext(arg1 aws.Context, arg2 *s3.GetBucketLocationInput, arg3 ...request.Option) (*s3.GetBucketLocationOutput, error) {
 	fake.getBucketLocationWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketLocationWithContextReturnsOnCall[len(fake.getBucketLocationWithContextArgsForCall)]
 	fake.getBucketLocationWithContextArgsForCall = append(fake.getBucketLocationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketLocationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketLocationWithContextStub
+	fakeReturns := fake.getBucketLocationWithContextReturns
 	fake.recordInvocation("GetBucketLocationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketLocationWithContextMutex.Unlock()
-	if fake.GetBucketLocationWithContextStub != nil {
-		return fake.GetBucketLocationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketLocationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9349,13 +10689,13 @@ func (fake *FakeS3API) GetBucketLocationWithContextCallCount() int {
 	return len(fake.getBucketLocationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketLocationWithContextCalls(stub func(context.Context, *s3.GetBucketLocationInput, ...request.Option) (*s3.GetBucketLocationOutput, error)) {
+func (fake *FakeS3API) GetBucketLocationWithContextCalls(stub func(aws.Context, *s3.GetBucketLocationInput, ...request.Option) (*s3.GetBucketLocationOutput, error)) {
 	fake.getBucketLocationWithContextMutex.Lock()
 	defer fake.getBucketLocationWithContextMutex.Unlock()
 	fake.GetBucketLocationWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketLocationWithContextArgsForCall(i int) (context.Context, *s3.GetBucketLocationInput, []request.Option) {
+func (fake *FakeS3API) GetBucketLocationWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketLocationInput, []request.Option) {
 	fake.getBucketLocationWithContextMutex.RLock()
 	defer fake.getBucketLocationWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketLocationWithContextArgsForCall[i]
@@ -9394,15 +10734,16 @@ func (fake *FakeS3API) GetBucketLogging(arg1 *s3.GetBucketLoggingInput) (*s3.Get
 	fake.getBucketLoggingArgsForCall = append(fake.getBucketLoggingArgsForCall, struct {
 		arg1 *s3.GetBucketLoggingInput
 	}{arg1})
+	stub := fake.GetBucketLoggingStub
+	fakeReturns := fake.getBucketLoggingReturns
 	fake.recordInvocation("GetBucketLogging", []interface{}{arg1})
 	fake.getBucketLoggingMutex.Unlock()
-	if fake.GetBucketLoggingStub != nil {
-		return fake.GetBucketLoggingStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketLoggingReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9457,15 +10798,16 @@ func (fake *FakeS3API) GetBucketLoggingRequest(arg1 *s3.GetBucketLoggingInput) (
 	fake.getBucketLoggingRequestArgsForCall = append(fake.getBucketLoggingRequestArgsForCall, struct {
 		arg1 *s3.GetBucketLoggingInput
 	}{arg1})
+	stub := fake.GetBucketLoggingRequestStub
+	fakeReturns := fake.getBucketLoggingRequestReturns
 	fake.recordInvocation("GetBucketLoggingRequest", []interface{}{arg1})
 	fake.getBucketLoggingRequestMutex.Unlock()
-	if fake.GetBucketLoggingRequestStub != nil {
-		return fake.GetBucketLoggingRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketLoggingRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9514,23 +10856,24 @@ func (fake *FakeS3API) GetBucketLoggingRequestReturnsOnCall(i int, result1 *requ
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketLoggingWithContext(arg1 context.Context, arg2 *s3.GetBucketLoggingInput, arg3 ...request.Option) (*s3.GetBucketLoggingOutput, error) {
+func (fake *FakeS3API) GetBucketLoggingWithContext(arg1 aws.Context, arg2 *s3.GetBucketLoggingInput, arg3 ...request.Option) (*s3.GetBucketLoggingOutput, error) {
 	fake.getBucketLoggingWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketLoggingWithContextReturnsOnCall[len(fake.getBucketLoggingWithContextArgsForCall)]
 	fake.getBucketLoggingWithContextArgsForCall = append(fake.getBucketLoggingWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketLoggingInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketLoggingWithContextStub
+	fakeReturns := fake.getBucketLoggingWithContextReturns
 	fake.recordInvocation("GetBucketLoggingWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketLoggingWithContextMutex.Unlock()
-	if fake.GetBucketLoggingWithContextStub != nil {
-		return fake.GetBucketLoggingWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketLoggingWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9540,13 +10883,13 @@ func (fake *FakeS3API) GetBucketLoggingWithContextCallCount() int {
 	return len(fake.getBucketLoggingWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketLoggingWithContextCalls(stub func(context.Context, *s3.GetBucketLoggingInput, ...request.Option) (*s3.GetBucketLoggingOutput, error)) {
+func (fake *FakeS3API) GetBucketLoggingWithContextCalls(stub func(aws.Context, *s3.GetBucketLoggingInput, ...request.Option) (*s3.GetBucketLoggingOutput, error)) {
 	fake.getBucketLoggingWithContextMutex.Lock()
 	defer fake.getBucketLoggingWithContextMutex.Unlock()
 	fake.GetBucketLoggingWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketLoggingWithContextArgsForCall(i int) (context.Context, *s3.GetBucketLoggingInput, []request.Option) {
+func (fake *FakeS3API) GetBucketLoggingWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketLoggingInput, []request.Option) {
 	fake.getBucketLoggingWithContextMutex.RLock()
 	defer fake.getBucketLoggingWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketLoggingWithContextArgsForCall[i]
@@ -9585,15 +10928,16 @@ func (fake *FakeS3API) GetBucketMetricsConfiguration(arg1 *s3.GetBucketMetricsCo
 	fake.getBucketMetricsConfigurationArgsForCall = append(fake.getBucketMetricsConfigurationArgsForCall, struct {
 		arg1 *s3.GetBucketMetricsConfigurationInput
 	}{arg1})
+	stub := fake.GetBucketMetricsConfigurationStub
+	fakeReturns := fake.getBucketMetricsConfigurationReturns
 	fake.recordInvocation("GetBucketMetricsConfiguration", []interface{}{arg1})
 	fake.getBucketMetricsConfigurationMutex.Unlock()
-	if fake.GetBucketMetricsConfigurationStub != nil {
-		return fake.GetBucketMetricsConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketMetricsConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9648,15 +10992,16 @@ func (fake *FakeS3API) GetBucketMetricsConfigurationRequest(arg1 *s3.GetBucketMe
 	fake.getBucketMetricsConfigurationRequestArgsForCall = append(fake.getBucketMetricsConfigurationRequestArgsForCall, struct {
 		arg1 *s3.GetBucketMetricsConfigurationInput
 	}{arg1})
+	stub := fake.GetBucketMetricsConfigurationRequestStub
+	fakeReturns := fake.getBucketMetricsConfigurationRequestReturns
 	fake.recordInvocation("GetBucketMetricsConfigurationRequest", []interface{}{arg1})
 	fake.getBucketMetricsConfigurationRequestMutex.Unlock()
-	if fake.GetBucketMetricsConfigurationRequestStub != nil {
-		return fake.GetBucketMetricsConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketMetricsConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9705,23 +11050,24 @@ func (fake *FakeS3API) GetBucketMetricsConfigurationRequestReturnsOnCall(i int,
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketMetricsConfigurationWithContext(arg1 context.Context, arg2 *s3.GetBucketMetricsConfigurationInput, arg3 ...request.Option) (*s3.GetBucketMetricsConfigurationOutput, error) {
+func (fake *FakeS3API) GetBucketMetricsConfigurationWithContext(arg1 aws.Context, arg2 *s3.GetBucketMetricsConfigurationInput, arg3 ...request.Option) (*s3.GetBucketMetricsConfigurationOutput, error) {
 	fake.getBucketMetricsConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketMetricsConfigurationWithContextReturnsOnCall[len(fake.getBucketMetricsConfigurationWithContextArgsForCall)]
 	fake.getBucketMetricsConfigurationWithContextArgsForCall = append(fake.getBucketMetricsConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketMetricsConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketMetricsConfigurationWithContextStub
+	fakeReturns := fake.getBucketMetricsConfigurationWithContextReturns
 	fake.recordInvocation("GetBucketMetricsConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketMetricsConfigurationWithContextMutex.Unlock()
-	if fake.GetBucketMetricsConfigurationWithContextStub != nil {
-		return fake.GetBucketMetricsConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketMetricsConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9731,13 +11077,13 @@ func (fake *FakeS3API) GetBucketMetricsConfigurationWithContextCallCount() int {
 	return len(fake.getBucketMetricsConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketMetricsConfigurationWithContextCalls(stub func(context.Context, *s3.GetBucketMetricsConfigurationInput, ...request.Option) (*s3.GetBucketMetricsConfigurationOutput, error)) {
+func (fake *FakeS3API) GetBucketMetricsConfigurationWithContextCalls(stub func(aws.Context, *s3.GetBucketMetricsConfigurationInput, ...request.Option) (*s3.GetBucketMetricsConfigurationOutput, error)) {
 	fake.getBucketMetricsConfigurationWithContextMutex.Lock()
 	defer fake.getBucketMetricsConfigurationWithContextMutex.Unlock()
 	fake.GetBucketMetricsConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketMetricsConfigurationWithContextArgsForCall(i int) (context.Context, *s3.GetBucketMetricsConfigurationInput, []request.Option) {
+func (fake *FakeS3API) GetBucketMetricsConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketMetricsConfigurationInput, []request.Option) {
 	fake.getBucketMetricsConfigurationWithContextMutex.RLock()
 	defer fake.getBucketMetricsConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketMetricsConfigurationWithContextArgsForCall[i]
@@ -9776,15 +11122,16 @@ func (fake *FakeS3API) GetBucketNotification(arg1 *s3.GetBucketNotificationConfi
 	fake.getBucketNotificationArgsForCall = append(fake.getBucketNotificationArgsForCall, struct {
 		arg1 *s3.GetBucketNotificationConfigurationRequest
 	}{arg1})
+	stub := fake.GetBucketNotificationStub
+	fakeReturns := fake.getBucketNotificationReturns
 	fake.recordInvocation("GetBucketNotification", []interface{}{arg1})
 	fake.getBucketNotificationMutex.Unlock()
-	if fake.GetBucketNotificationStub != nil {
-		return fake.GetBucketNotificationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketNotificationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9839,15 +11186,16 @@ func (fake *FakeS3API) GetBucketNotificationConfiguration(arg1 *s3.GetBucketNoti
 	fake.getBucketNotificationConfigurationArgsForCall = append(fake.getBucketNotificationConfigurationArgsForCall, struct {
 		arg1 *s3.GetBucketNotificationConfigurationRequest
 	}{arg1})
+	stub := fake.GetBucketNotificationConfigurationStub
+	fakeReturns := fake.getBucketNotificationConfigurationReturns
 	fake.recordInvocation("GetBucketNotificationConfiguration", []interface{}{arg1})
 	fake.getBucketNotificationConfigurationMutex.Unlock()
-	if fake.GetBucketNotificationConfigurationStub != nil {
-		return fake.GetBucketNotificationConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketNotificationConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9902,15 +11250,16 @@ func (fake *FakeS3API) GetBucketNotificationConfigurationRequest(arg1 *s3.GetBuc
 	fake.getBucketNotificationConfigurationRequestArgsForCall = append(fake.getBucketNotificationConfigurationRequestArgsForCall, struct {
 		arg1 *s3.GetBucketNotificationConfigurationRequest
 	}{arg1})
+	stub := fake.GetBucketNotificationConfigurationRequestStub
+	fakeReturns := fake.getBucketNotificationConfigurationRequestReturns
 	fake.recordInvocation("GetBucketNotificationConfigurationRequest", []interface{}{arg1})
 	fake.getBucketNotificationConfigurationRequestMutex.Unlock()
-	if fake.GetBucketNotificationConfigurationRequestStub != nil {
-		return fake.GetBucketNotificationConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketNotificationConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9959,23 +11308,24 @@ func (fake *FakeS3API) GetBucketNotificationConfigurationRequestReturnsOnCall(i
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketNotificationConfigurationWithContext(arg1 context.Context, arg2 *s3.GetBucketNotificationConfigurationRequest, arg3 ...request.Option) (*s3.NotificationConfiguration, error) {
+func (fake *FakeS3API) GetBucketNotificationConfigurationWithContext(arg1 aws.Context, arg2 *s3.GetBucketNotificationConfigurationRequest, arg3 ...request.Option) (*s3.NotificationConfiguration, error) {
 	fake.getBucketNotificationConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketNotificationConfigurationWithContextReturnsOnCall[len(fake.getBucketNotificationConfigurationWithContextArgsForCall)]
 	fake.getBucketNotificationConfigurationWithContextArgsForCall = append(fake.getBucketNotificationConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketNotificationConfigurationRequest
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketNotificationConfigurationWithContextStub
+	fakeReturns := fake.getBucketNotificationConfigurationWithContextReturns
 	fake.recordInvocation("GetBucketNotificationConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketNotificationConfigurationWithContextMutex.Unlock()
-	if fake.GetBucketNotificationConfigurationWithContextStub != nil {
-		return fake.GetBucketNotificationConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketNotificationConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -9985,13 +11335,13 @@ func (fake *FakeS3API) GetBucketNotificationConfigurationWithContextCallCount()
 	return len(fake.getBucketNotificationConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketNotificationConfigurationWithContextCalls(stub func(context.Context, *s3.GetBucketNotificationConfigurationRequest, ...request.Option) (*s3.NotificationConfiguration, error)) {
+func (fake *FakeS3API) GetBucketNotificationConfigurationWithContextCalls(stub func(aws.Context, *s3.GetBucketNotificationConfigurationRequest, ...request.Option) (*s3.NotificationConfiguration, error)) {
 	fake.getBucketNotificationConfigurationWithContextMutex.Lock()
 	defer fake.getBucketNotificationConfigurationWithContextMutex.Unlock()
 	fake.GetBucketNotificationConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketNotificationConfigurationWithContextArgsForCall(i int) (context.Context, *s3.GetBucketNotificationConfigurationRequest, []request.Option) {
+func (fake *FakeS3API) GetBucketNotificationConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketNotificationConfigurationRequest, []request.Option) {
 	fake.getBucketNotificationConfigurationWithContextMutex.RLock()
 	defer fake.getBucketNotificationConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketNotificationConfigurationWithContextArgsForCall[i]
@@ -10030,15 +11380,16 @@ func (fake *FakeS3API) GetBucketNotificationRequest(arg1 *s3.GetBucketNotificati
 	fake.getBucketNotificationRequestArgsForCall = append(fake.getBucketNotificationRequestArgsForCall, struct {
 		arg1 *s3.GetBucketNotificationConfigurationRequest
 	}{arg1})
+	stub := fake.GetBucketNotificationRequestStub
+	fakeReturns := fake.getBucketNotificationRequestReturns
 	fake.recordInvocation("GetBucketNotificationRequest", []interface{}{arg1})
 	fake.getBucketNotificationRequestMutex.Unlock()
-	if fake.GetBucketNotificationRequestStub != nil {
-		return fake.GetBucketNotificationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketNotificationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10087,23 +11438,24 @@ func (fake *FakeS3API) GetBucketNotificationRequestReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketNotificationWithContext(arg1 context.Context, arg2 *s3.GetBucketNotificationConfigurationRequest, arg3 ...request.Option) (*s3.NotificationConfigurationDeprecated, error) {
+func (fake *FakeS3API) GetBucketNotificationWithContext(arg1 aws.Context, arg2 *s3.GetBucketNotificationConfigurationRequest, arg3 ...request.Option) (*s3.NotificationConfigurationDeprecated, error) {
 	fake.getBucketNotificationWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketNotificationWithContextReturnsOnCall[len(fake.getBucketNotificationWithContextArgsForCall)]
 	fake.getBucketNotificationWithContextArgsForCall = append(fake.getBucketNotificationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketNotificationConfigurationRequest
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketNotificationWithContextStub
+	fakeReturns := fake.getBucketNotificationWithContextReturns
 	fake.recordInvocation("GetBucketNotificationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketNotificationWithContextMutex.Unlock()
-	if fake.GetBucketNotificationWithContextStub != nil {
-		return fake.GetBucketNotificationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketNotificationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10113,13 +11465,13 @@ func (fake *FakeS3API) GetBucketNotificationWithContextCallCount() int {
 	return len(fake.getBucketNotificationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketNotificationWithContextCalls(stub func(context.Context, *s3.GetBucketNotificationConfigurationRequest, ...request.Option) (*s3.NotificationConfigurationDeprecated, error)) {
+func (fake *FakeS3API) GetBucketNotificationWithContextCalls(stub func(aws.Context, *s3.GetBucketNotificationConfigurationRequest, ...request.Option) (*s3.NotificationConfigurationDeprecated, error)) {
 	fake.getBucketNotificationWithContextMutex.Lock()
 	defer fake.getBucketNotificationWithContextMutex.Unlock()
 	fake.GetBucketNotificationWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketNotificationWithContextArgsForCall(i int) (context.Context, *s3.GetBucketNotificationConfigurationRequest, []request.Option) {
+func (fake *FakeS3API) GetBucketNotificationWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketNotificationConfigurationRequest, []request.Option) {
 	fake.getBucketNotificationWithContextMutex.RLock()
 	defer fake.getBucketNotificationWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketNotificationWithContextArgsForCall[i]
@@ -10152,110 +11504,306 @@ func (fake *FakeS3API) GetBucketNotificationWithContextReturnsOnCall(i int, resu
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketPolicy(arg1 *s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error) {
-	fake.getBucketPolicyMutex.Lock()
-	ret, specificReturn := fake.getBucketPolicyReturnsOnCall[len(fake.getBucketPolicyArgsForCall)]
-	fake.getBucketPolicyArgsForCall = append(fake.getBucketPolicyArgsForCall, struct {
-		arg1 *s3.GetBucketPolicyInput
+func (fake *FakeS3API) GetBucketOwnershipControls(arg1 *s3.GetBucketOwnershipControlsInput) (*s3.GetBucketOwnershipControlsOutput, error) {
+	fake.getBucketOwnershipControlsMutex.Lock()
+	ret, specificReturn := fake.getBucketOwnershipControlsReturnsOnCall[len(fake.getBucketOwnershipControlsArgsForCall)]
+	fake.getBucketOwnershipControlsArgsForCall = append(fake.getBucketOwnershipControlsArgsForCall, struct {
+		arg1 *s3.GetBucketOwnershipControlsInput
 	}{arg1})
-	fake.recordInvocation("GetBucketPolicy", []interface{}{arg1})
-	fake.getBucketPolicyMutex.Unlock()
-	if fake.GetBucketPolicyStub != nil {
-		return fake.GetBucketPolicyStub(arg1)
+	stub := fake.GetBucketOwnershipControlsStub
+	fakeReturns := fake.getBucketOwnershipControlsReturns
+	fake.recordInvocation("GetBucketOwnershipControls", []interface{}{arg1})
+	fake.getBucketOwnershipControlsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketPolicyReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) GetBucketPolicyCallCount() int {
-	fake.getBucketPolicyMutex.RLock()
-	defer fake.getBucketPolicyMutex.RUnlock()
-	return len(fake.getBucketPolicyArgsForCall)
+func (fake *FakeS3API) GetBucketOwnershipControlsCallCount() int {
+	fake.getBucketOwnershipControlsMutex.RLock()
+	defer fake.getBucketOwnershipControlsMutex.RUnlock()
+	return len(fake.getBucketOwnershipControlsArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketPolicyCalls(stub func(*s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error)) {
-	fake.getBucketPolicyMutex.Lock()
-	defer fake.getBucketPolicyMutex.Unlock()
-	fake.GetBucketPolicyStub = stub
+func (fake *FakeS3API) GetBucketOwnershipControlsCalls(stub func(*s3.GetBucketOwnershipControlsInput) (*s3.GetBucketOwnershipControlsOutput, error)) {
+	fake.getBucketOwnershipControlsMutex.Lock()
+	defer fake.getBucketOwnershipControlsMutex.Unlock()
+	fake.GetBucketOwnershipControlsStub = stub
 }
 
-func (fake *FakeS3API) GetBucketPolicyArgsForCall(i int) *s3.GetBucketPolicyInput {
-	fake.getBucketPolicyMutex.RLock()
-	defer fake.getBucketPolicyMutex.RUnlock()
-	argsForCall := fake.getBucketPolicyArgsForCall[i]
+func (fake *FakeS3API) GetBucketOwnershipControlsArgsForCall(i int) *s3.GetBucketOwnershipControlsInput {
+	fake.getBucketOwnershipControlsMutex.RLock()
+	defer fake.getBucketOwnershipControlsMutex.RUnlock()
+	argsForCall := fake.getBucketOwnershipControlsArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeS3API) GetBucketPolicyReturns(result1 *s3.GetBucketPolicyOutput, result2 error) {
-	fake.getBucketPolicyMutex.Lock()
-	defer fake.getBucketPolicyMutex.Unlock()
-	fake.GetBucketPolicyStub = nil
-	fake.getBucketPolicyReturns = struct {
-		result1 *s3.GetBucketPolicyOutput
+func (fake *FakeS3API) GetBucketOwnershipControlsReturns(result1 *s3.GetBucketOwnershipControlsOutput, result2 error) {
+	fake.getBucketOwnershipControlsMutex.Lock()
+	defer fake.getBucketOwnershipControlsMutex.Unlock()
+	fake.GetBucketOwnershipControlsStub = nil
+	fake.getBucketOwnershipControlsReturns = struct {
+		result1 *s3.GetBucketOwnershipControlsOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketPolicyReturnsOnCall(i int, result1 *s3.GetBucketPolicyOutput, result2 error) {
-	fake.getBucketPolicyMutex.Lock()
-	defer fake.getBucketPolicyMutex.Unlock()
-	fake.GetBucketPolicyStub = nil
-	if fake.getBucketPolicyReturnsOnCall == nil {
-		fake.getBucketPolicyReturnsOnCall = make(map[int]struct {
-			result1 *s3.GetBucketPolicyOutput
+func (fake *FakeS3API) GetBucketOwnershipControlsReturnsOnCall(i int, result1 *s3.GetBucketOwnershipControlsOutput, result2 error) {
+	fake.getBucketOwnershipControlsMutex.Lock()
+	defer fake.getBucketOwnershipControlsMutex.Unlock()
+	fake.GetBucketOwnershipControlsStub = nil
+	if fake.getBucketOwnershipControlsReturnsOnCall == nil {
+		fake.getBucketOwnershipControlsReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketOwnershipControlsOutput
 			result2 error
 		})
 	}
-	fake.getBucketPolicyReturnsOnCall[i] = struct {
-		result1 *s3.GetBucketPolicyOutput
+	fake.getBucketOwnershipControlsReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketOwnershipControlsOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketPolicyRequest(arg1 *s3.GetBucketPolicyInput) (*request.Request, *s3.GetBucketPolicyOutput) {
-	fake.getBucketPolicyRequestMutex.Lock()
-	ret, specificReturn := fake.getBucketPolicyRequestReturnsOnCall[len(fake.getBucketPolicyRequestArgsForCall)]
-	fake.getBucketPolicyRequestArgsForCall = append(fake.getBucketPolicyRequestArgsForCall, struct {
-		arg1 *s3.GetBucketPolicyInput
+func (fake *FakeS3API) GetBucketOwnershipControlsRequest(arg1 *s3.GetBucketOwnershipControlsInput) (*request.Request, *s3.GetBucketOwnershipControlsOutput) {
+	fake.getBucketOwnershipControlsRequestMutex.Lock()
+	ret, specificReturn := fake.getBucketOwnershipControlsRequestReturnsOnCall[len(fake.getBucketOwnershipControlsRequestArgsForCall)]
+	fake.getBucketOwnershipControlsRequestArgsForCall = append(fake.getBucketOwnershipControlsRequestArgsForCall, struct {
+		arg1 *s3.GetBucketOwnershipControlsInput
 	}{arg1})
-	fake.recordInvocation("GetBucketPolicyRequest", []interface{}{arg1})
-	fake.getBucketPolicyRequestMutex.Unlock()
-	if fake.GetBucketPolicyRequestStub != nil {
-		return fake.GetBucketPolicyRequestStub(arg1)
+	stub := fake.GetBucketOwnershipControlsRequestStub
+	fakeReturns := fake.getBucketOwnershipControlsRequestReturns
+	fake.recordInvocation("GetBucketOwnershipControlsRequest", []interface{}{arg1})
+	fake.getBucketOwnershipControlsRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketPolicyRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) GetBucketPolicyRequestCallCount() int {
-	fake.getBucketPolicyRequestMutex.RLock()
-	defer fake.getBucketPolicyRequestMutex.RUnlock()
-	return len(fake.getBucketPolicyRequestArgsForCall)
+func (fake *FakeS3API) GetBucketOwnershipControlsRequestCallCount() int {
+	fake.getBucketOwnershipControlsRequestMutex.RLock()
+	defer fake.getBucketOwnershipControlsRequestMutex.RUnlock()
+	return len(fake.getBucketOwnershipControlsRequestArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketPolicyRequestCalls(stub func(*s3.GetBucketPolicyInput) (*request.Request, *s3.GetBucketPolicyOutput)) {
-	fake.getBucketPolicyRequestMutex.Lock()
-	defer fake.getBucketPolicyRequestMutex.Unlock()
-	fake.GetBucketPolicyRequestStub = stub
+func (fake *FakeS3API) GetBucketOwnershipControlsRequestCalls(stub func(*s3.GetBucketOwnershipControlsInput) (*request.Request, *s3.GetBucketOwnershipControlsOutput)) {
+	fake.getBucketOwnershipControlsRequestMutex.Lock()
+	defer fake.getBucketOwnershipControlsRequestMutex.Unlock()
+	fake.GetBucketOwnershipControlsRequestStub = stub
 }
 
-func (fake *FakeS3API) GetBucketPolicyRequestArgsForCall(i int) *s3.GetBucketPolicyInput {
-	fake.getBucketPolicyRequestMutex.RLock()
-	defer fake.getBucketPolicyRequestMutex.RUnlock()
-	argsForCall := fake.getBucketPolicyRequestArgsForCall[i]
+func (fake *FakeS3API) GetBucketOwnershipControlsRequestArgsForCall(i int) *s3.GetBucketOwnershipControlsInput {
+	fake.getBucketOwnershipControlsRequestMutex.RLock()
+	defer fake.getBucketOwnershipControlsRequestMutex.RUnlock()
+	argsForCall := fake.getBucketOwnershipControlsRequestArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeS3API) GetBucketPolicyRequestReturns(result1 *request.Request, result2 *s3.GetBucketPolicyOutput) {
-	fake.getBucketPolicyRequestMutex.Lock()
-	defer fake.getBucketPolicyRequestMutex.Unlock()
-	fake.GetBucketPolicyRequestStub = nil
+func (fake *FakeS3API) GetBucketOwnershipControlsRequestReturns(result1 *request.Request, result2 *s3.GetBucketOwnershipControlsOutput) {
+	fake.getBucketOwnershipControlsRequestMutex.Lock()
+	defer fake.getBucketOwnershipControlsRequestMutex.Unlock()
+	fake.GetBucketOwnershipControlsRequestStub = nil
+	fake.getBucketOwnershipControlsRequestReturns = struct {
+		result1 *request.Request
+		result2 *s3.GetBucketOwnershipControlsOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetBucketOwnershipControlsRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.GetBucketOwnershipControlsOutput) {
+	fake.getBucketOwnershipControlsRequestMutex.Lock()
+	defer fake.getBucketOwnershipControlsRequestMutex.Unlock()
+	fake.GetBucketOwnershipControlsRequestStub = nil
+	if fake.getBucketOwnershipControlsRequestReturnsOnCall == nil {
+		fake.getBucketOwnershipControlsRequestReturnsOnCall = make(map[int]struct {
+			result1 *request.Request
+			result2 *s3.GetBucketOwnershipControlsOutput
+		})
+	}
+	fake.getBucketOwnershipControlsRequestReturnsOnCall[i] = struct {
+		result1 *request.Request
+		result2 *s3.GetBucketOwnershipControlsOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetBucketOwnershipControlsWithContext(arg1 aws.Context, arg2 *s3.GetBucketOwnershipControlsInput, arg3 ...request.Option) (*s3.GetBucketOwnershipControlsOutput, error) {
+	fake.getBucketOwnershipControlsWithContextMutex.Lock()
+	ret, specificReturn := fake.getBucketOwnershipControlsWithContextReturnsOnCall[len(fake.getBucketOwnershipControlsWithContextArgsForCall)]
+	fake.getBucketOwnershipControlsWithContextArgsForCall = append(fake.getBucketOwnershipControlsWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.GetBucketOwnershipControlsInput
+		arg3 []request.Option
+	}{arg1, arg2, arg3})
+	stub := fake.GetBucketOwnershipControlsWithContextStub
+	fakeReturns := fake.getBucketOwnershipControlsWithContextReturns
+	fake.recordInvocation("GetBucketOwnershipControlsWithContext", []interface{}{arg1, arg2, arg3})
+	fake.getBucketOwnershipControlsWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) GetBucketOwnershipControlsWithContextCallCount() int {
+	fake.getBucketOwnershipControlsWithContextMutex.RLock()
+	defer fake.getBucketOwnershipControlsWithContextMutex.RUnlock()
+	return len(fake.getBucketOwnershipControlsWithContextArgsForCall)
+}
+
+func (fake *FakeS3API) GetBucketOwnershipControlsWithContextCalls(stub func(aws.Context, *s3.GetBucketOwnershipControlsInput, ...request.Option) (*s3.GetBucketOwnershipControlsOutput, error)) {
+	fake.getBucketOwnershipControlsWithContextMutex.Lock()
+	defer fake.getBucketOwnershipControlsWithContextMutex.Unlock()
+	fake.GetBucketOwnershipControlsWithContextStub = stub
+}
+
+func (fake *FakeS3API) GetBucketOwnershipControlsWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketOwnershipControlsInput, []request.Option) {
+	fake.getBucketOwnershipControlsWithContextMutex.RLock()
+	defer fake.getBucketOwnershipControlsWithContextMutex.RUnlock()
+	argsForCall := fake.getBucketOwnershipControlsWithContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3API) GetBucketOwnershipControlsWithContextReturns(result1 *s3.GetBucketOwnershipControlsOutput, result2 error) {
+	fake.getBucketOwnershipControlsWithContextMutex.Lock()
+	defer fake.getBucketOwnershipControlsWithContextMutex.Unlock()
+	fake.GetBucketOwnershipControlsWithContextStub = nil
+	fake.getBucketOwnershipControlsWithContextReturns = struct {
+		result1 *s3.GetBucketOwnershipControlsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetBucketOwnershipControlsWithContextReturnsOnCall(i int, result1 *s3.GetBucketOwnershipControlsOutput, result2 error) {
+	fake.getBucketOwnershipControlsWithContextMutex.Lock()
+	defer fake.getBucketOwnershipControlsWithContextMutex.Unlock()
+	fake.GetBucketOwnershipControlsWithContextStub = nil
+	if fake.getBucketOwnershipControlsWithContextReturnsOnCall == nil {
+		fake.getBucketOwnershipControlsWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketOwnershipControlsOutput
+			result2 error
+		})
+	}
+	fake.getBucketOwnershipControlsWithContextReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketOwnershipControlsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetBucketPolicy(arg1 *s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error) {
+	fake.getBucketPolicyMutex.Lock()
+	ret, specificReturn := fake.getBucketPolicyReturnsOnCall[len(fake.getBucketPolicyArgsForCall)]
+	fake.getBucketPolicyArgsForCall = append(fake.getBucketPolicyArgsForCall, struct {
+		arg1 *s3.GetBucketPolicyInput
+	}{arg1})
+	stub := fake.GetBucketPolicyStub
+	fakeReturns := fake.getBucketPolicyReturns
+	fake.recordInvocation("GetBucketPolicy", []interface{}{arg1})
+	fake.getBucketPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) GetBucketPolicyCallCount() int {
+	fake.getBucketPolicyMutex.RLock()
+	defer fake.getBucketPolicyMutex.RUnlock()
+	return len(fake.getBucketPolicyArgsForCall)
+}
+
+func (fake *FakeS3API) GetBucketPolicyCalls(stub func(*s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error)) {
+	fake.getBucketPolicyMutex.Lock()
+	defer fake.getBucketPolicyMutex.Unlock()
+	fake.GetBucketPolicyStub = stub
+}
+
+func (fake *FakeS3API) GetBucketPolicyArgsForCall(i int) *s3.GetBucketPolicyInput {
+	fake.getBucketPolicyMutex.RLock()
+	defer fake.getBucketPolicyMutex.RUnlock()
+	argsForCall := fake.getBucketPolicyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) GetBucketPolicyReturns(result1 *s3.GetBucketPolicyOutput, result2 error) {
+	fake.getBucketPolicyMutex.Lock()
+	defer fake.getBucketPolicyMutex.Unlock()
+	fake.GetBucketPolicyStub = nil
+	fake.getBucketPolicyReturns = struct {
+		result1 *s3.GetBucketPolicyOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetBucketPolicyReturnsOnCall(i int, result1 *s3.GetBucketPolicyOutput, result2 error) {
+	fake.getBucketPolicyMutex.Lock()
+	defer fake.getBucketPolicyMutex.Unlock()
+	fake.GetBucketPolicyStub = nil
+	if fake.getBucketPolicyReturnsOnCall == nil {
+		fake.getBucketPolicyReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketPolicyOutput
+			result2 error
+		})
+	}
+	fake.getBucketPolicyReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketPolicyOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetBucketPolicyRequest(arg1 *s3.GetBucketPolicyInput) (*request.Request, *s3.GetBucketPolicyOutput) {
+	fake.getBucketPolicyRequestMutex.Lock()
+	ret, specificReturn := fake.getBucketPolicyRequestReturnsOnCall[len(fake.getBucketPolicyRequestArgsForCall)]
+	fake.getBucketPolicyRequestArgsForCall = append(fake.getBucketPolicyRequestArgsForCall, struct {
+		arg1 *s3.GetBucketPolicyInput
+	}{arg1})
+	stub := fake.GetBucketPolicyRequestStub
+	fakeReturns := fake.getBucketPolicyRequestReturns
+	fake.recordInvocation("GetBucketPolicyRequest", []interface{}{arg1})
+	fake.getBucketPolicyRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) GetBucketPolicyRequestCallCount() int {
+	fake.getBucketPolicyRequestMutex.RLock()
+	defer fake.getBucketPolicyRequestMutex.RUnlock()
+	return len(fake.getBucketPolicyRequestArgsForCall)
+}
+
+func (fake *FakeS3API) GetBucketPolicyRequestCalls(stub func(*s3.GetBucketPolicyInput) (*request.Request, *s3.GetBucketPolicyOutput)) {
+	fake.getBucketPolicyRequestMutex.Lock()
+	defer fake.getBucketPolicyRequestMutex.Unlock()
+	fake.GetBucketPolicyRequestStub = stub
+}
+
+func (fake *FakeS3API) GetBucketPolicyRequestArgsForCall(i int) *s3.GetBucketPolicyInput {
+	fake.getBucketPolicyRequestMutex.RLock()
+	defer fake.getBucketPolicyRequestMutex.RUnlock()
+	argsForCall := fake.getBucketPolicyRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) GetBucketPolicyRequestReturns(result1 *request.Request, result2 *s3.GetBucketPolicyOutput) {
+	fake.getBucketPolicyRequestMutex.Lock()
+	defer fake.getBucketPolicyRequestMutex.Unlock()
+	fake.GetBucketPolicyRequestStub = nil
 	fake.getBucketPolicyRequestReturns = struct {
 		result1 *request.Request
 		result2 *s3.GetBucketPolicyOutput
@@ -10284,15 +11832,16 @@ func (fake *FakeS3API) GetBucketPolicyStatus(arg1 *s3.GetBucketPolicyStatusInput
 	fake.getBucketPolicyStatusArgsForCall = append(fake.getBucketPolicyStatusArgsForCall, struct {
 		arg1 *s3.GetBucketPolicyStatusInput
 	}{arg1})
+	stub := fake.GetBucketPolicyStatusStub
+	fakeReturns := fake.getBucketPolicyStatusReturns
 	fake.recordInvocation("GetBucketPolicyStatus", []interface{}{arg1})
 	fake.getBucketPolicyStatusMutex.Unlock()
-	if fake.GetBucketPolicyStatusStub != nil {
-		return fake.GetBucketPolicyStatusStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketPolicyStatusReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10347,15 +11896,16 @@ func (fake *FakeS3API) GetBucketPolicyStatusRequest(arg1 *s3.GetBucketPolicyStat
 	fake.getBucketPolicyStatusRequestArgsForCall = append(fake.getBucketPolicyStatusRequestArgsForCall, struct {
 		arg1 *s3.GetBucketPolicyStatusInput
 	}{arg1})
+	stub := fake.GetBucketPolicyStatusRequestStub
+	fakeReturns := fake.getBucketPolicyStatusRequestReturns
 	fake.recordInvocation("GetBucketPolicyStatusRequest", []interface{}{arg1})
 	fake.getBucketPolicyStatusRequestMutex.Unlock()
-	if fake.GetBucketPolicyStatusRequestStub != nil {
-		return fake.GetBucketPolicyStatusRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketPolicyStatusRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10404,23 +11954,24 @@ func (fake *FakeS3API) GetBucketPolicyStatusRequestReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketPolicyStatusWithContext(arg1 context.Context, arg2 *s3.GetBucketPolicyStatusInput, arg3 ...request.Option) (*s3.GetBucketPolicyStatusOutput, error) {
+func (fake *FakeS3API) GetBucketPolicyStatusWithContext(arg1 aws.Context, arg2 *s3.GetBucketPolicyStatusInput, arg3 ...request.Option) (*s3.GetBucketPolicyStatusOutput, error) {
 	fake.getBucketPolicyStatusWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketPolicyStatusWithContextReturnsOnCall[len(fake.getBucketPolicyStatusWithContextArgsForCall)]
 	fake.getBucketPolicyStatusWithContextArgsForCall = append(fake.getBucketPolicyStatusWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketPolicyStatusInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketPolicyStatusWithContextStub
+	fakeReturns := fake.getBucketPolicyStatusWithContextReturns
 	fake.recordInvocation("GetBucketPolicyStatusWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketPolicyStatusWithContextMutex.Unlock()
-	if fake.GetBucketPolicyStatusWithContextStub != nil {
-		return fake.GetBucketPolicyStatusWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketPolicyStatusWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10430,13 +11981,13 @@ func (fake *FakeS3API) GetBucketPolicyStatusWithContextCallCount() int {
 	return len(fake.getBucketPolicyStatusWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketPolicyStatusWithContextCalls(stub func(context.Context, *s3.GetBucketPolicyStatusInput, ...request.Option) (*s3.GetBucketPolicyStatusOutput, error)) {
+func (fake *FakeS3API) GetBucketPolicyStatusWithContextCalls(stub func(aws.Context, *s3.GetBucketPolicyStatusInput, ...request.Option) (*s3.GetBucketPolicyStatusOutput, error)) {
 	fake.getBucketPolicyStatusWithContextMutex.Lock()
 	defer fake.getBucketPolicyStatusWithContextMutex.Unlock()
 	fake.GetBucketPolicyStatusWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketPolicyStatusWithContextArgsForCall(i int) (context.Context, *s3.GetBucketPolicyStatusInput, []request.Option) {
+func (fake *FakeS3API) GetBucketPolicyStatusWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketPolicyStatusInput, []request.Option) {
 	fake.getBucketPolicyStatusWithContextMutex.RLock()
 	defer fake.getBucketPolicyStatusWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketPolicyStatusWithContextArgsForCall[i]
@@ -10469,23 +12020,24 @@ func (fake *FakeS3API) GetBucketPolicyStatusWithContextReturnsOnCall(i int, resu
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketPolicyWithContext(arg1 context.Context, arg2 *s3.GetBucketPolicyInput, arg3 ...request.Option) (*s3.GetBucketPolicyOutput, error) {
+func (fake *FakeS3API) GetBucketPolicyWithContext(arg1 aws.Context, arg2 *s3.GetBucketPolicyInput, arg3 ...request.Option) (*s3.GetBucketPolicyOutput, error) {
 	fake.getBucketPolicyWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketPolicyWithContextReturnsOnCall[len(fake.getBucketPolicyWithContextArgsForCall)]
 	fake.getBucketPolicyWithContextArgsForCall = append(fake.getBucketPolicyWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketPolicyInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketPolicyWithContextStub
+	fakeReturns := fake.getBucketPolicyWithContextReturns
 	fake.recordInvocation("GetBucketPolicyWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketPolicyWithContextMutex.Unlock()
-	if fake.GetBucketPolicyWithContextStub != nil {
-		return fake.GetBucketPolicyWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketPolicyWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10495,13 +12047,13 @@ func (fake *FakeS3API) GetBucketPolicyWithContextCallCount() int {
 	return len(fake.getBucketPolicyWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketPolicyWithContextCalls(stub func(context.Context, *s3.GetBucketPolicyInput, ...request.Option) (*s3.GetBucketPolicyOutput, error)) {
+func (fake *FakeS3API) GetBucketPolicyWithContextCalls(stub func(aws.Context, *s3.GetBucketPolicyInput, ...request.Option) (*s3.GetBucketPolicyOutput, error)) {
 	fake.getBucketPolicyWithContextMutex.Lock()
 	defer fake.getBucketPolicyWithContextMutex.Unlock()
 	fake.GetBucketPolicyWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketPolicyWithContextArgsForCall(i int) (context.Context, *s3.GetBucketPolicyInput, []request.Option) {
+func (fake *FakeS3API) GetBucketPolicyWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketPolicyInput, []request.Option) {
 	fake.getBucketPolicyWithContextMutex.RLock()
 	defer fake.getBucketPolicyWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketPolicyWithContextArgsForCall[i]
@@ -10540,15 +12092,16 @@ func (fake *FakeS3API) GetBucketReplication(arg1 *s3.GetBucketReplicationInput)
 	fake.getBucketReplicationArgsForCall = append(fake.getBucketReplicationArgsForCall, struct {
 		arg1 *s3.GetBucketReplicationInput
 	}{arg1})
+	stub := fake.GetBucketReplicationStub
+	fakeReturns := fake.getBucketReplicationReturns
 	fake.recordInvocation("GetBucketReplication", []interface{}{arg1})
 	fake.getBucketReplicationMutex.Unlock()
-	if fake.GetBucketReplicationStub != nil {
-		return fake.GetBucketReplicationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketReplicationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10603,15 +12156,16 @@ func (fake *FakeS3API) GetBucketReplicationRequest(arg1 *s3.GetBucketReplication
 	fake.getBucketReplicationRequestArgsForCall = append(fake.getBucketReplicationRequestArgsForCall, struct {
 		arg1 *s3.GetBucketReplicationInput
 	}{arg1})
+	stub := fake.GetBucketReplicationRequestStub
+	fakeReturns := fake.getBucketReplicationRequestReturns
 	fake.recordInvocation("GetBucketReplicationRequest", []interface{}{arg1})
 	fake.getBucketReplicationRequestMutex.Unlock()
-	if fake.GetBucketReplicationRequestStub != nil {
-		return fake.GetBucketReplicationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketReplicationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10660,23 +12214,24 @@ func (fake *FakeS3API) GetBucketReplicationRequestReturnsOnCall(i int, result1 *
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketReplicationWithContext(arg1 context.Context, arg2 *s3.GetBucketReplicationInput, arg3 ...request.Option) (*s3.GetBucketReplicationOutput, error) {
+func (fake *FakeS3API) GetBucketReplicationWithContext(arg1 aws.Context, arg2 *s3.GetBucketReplicationInput, arg3 ...request.Option) (*s3.GetBucketReplicationOutput, error) {
 	fake.getBucketReplicationWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketReplicationWithContextReturnsOnCall[len(fake.getBucketReplicationWithContextArgsForCall)]
 	fake.getBucketReplicationWithContextArgsForCall = append(fake.getBucketReplicationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketReplicationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketReplicationWithContextStub
+	fakeReturns := fake.getBucketReplicationWithContextReturns
 	fake.recordInvocation("GetBucketReplicationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketReplicationWithContextMutex.Unlock()
-	if fake.GetBucketReplicationWithContextStub != nil {
-		return fake.GetBucketReplicationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketReplicationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10686,13 +12241,13 @@ func (fake *FakeS3API) GetBucketReplicationWithContextCallCount() int {
 	return len(fake.getBucketReplicationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketReplicationWithContextCalls(stub func(context.Context, *s3.GetBucketReplicationInput, ...request.Option) (*s3.GetBucketReplicationOutput, error)) {
+func (fake *FakeS3API) GetBucketReplicationWithContextCalls(stub func(aws.Context, *s3.GetBucketReplicationInput, ...request.Option) (*s3.GetBucketReplicationOutput, error)) {
 	fake.getBucketReplicationWithContextMutex.Lock()
 	defer fake.getBucketReplicationWithContextMutex.Unlock()
 	fake.GetBucketReplicationWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketReplicationWithContextArgsForCall(i int) (context.Context, *s3.GetBucketReplicationInput, []request.Option) {
+func (fake *FakeS3API) GetBucketReplicationWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketReplicationInput, []request.Option) {
 	fake.getBucketReplicationWithContextMutex.RLock()
 	defer fake.getBucketReplicationWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketReplicationWithContextArgsForCall[i]
@@ -10731,15 +12286,16 @@ func (fake *FakeS3API) GetBucketRequestPayment(arg1 *s3.GetBucketRequestPaymentI
 	fake.getBucketRequestPaymentArgsForCall = append(fake.getBucketRequestPaymentArgsForCall, struct {
 		arg1 *s3.GetBucketRequestPaymentInput
 	}{arg1})
+	stub := fake.GetBucketRequestPaymentStub
+	fakeReturns := fake.getBucketRequestPaymentReturns
 	fake.recordInvocation("GetBucketRequestPayment", []interface{}{arg1})
 	fake.getBucketRequestPaymentMutex.Unlock()
-	if fake.GetBucketRequestPaymentStub != nil {
-		return fake.GetBucketRequestPaymentStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketRequestPaymentReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10794,15 +12350,16 @@ func (fake *FakeS3API) GetBucketRequestPaymentRequest(arg1 *s3.GetBucketRequestP
 	fake.getBucketRequestPaymentRequestArgsForCall = append(fake.getBucketRequestPaymentRequestArgsForCall, struct {
 		arg1 *s3.GetBucketRequestPaymentInput
 	}{arg1})
+	stub := fake.GetBucketRequestPaymentRequestStub
+	fakeReturns := fake.getBucketRequestPaymentRequestReturns
 	fake.recordInvocation("GetBucketRequestPaymentRequest", []interface{}{arg1})
 	fake.getBucketRequestPaymentRequestMutex.Unlock()
-	if fake.GetBucketRequestPaymentRequestStub != nil {
-		return fake.GetBucketRequestPaymentRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketRequestPaymentRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10851,23 +12408,24 @@ func (fake *FakeS3API) GetBucketRequestPaymentRequestReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketRequestPaymentWithContext(arg1 context.Context, arg2 *s3.GetBucketRequestPaymentInput, arg3 ...request.Option) (*s3.GetBucketRequestPaymentOutput, error) {
+func (fake *FakeS3API) GetBucketRequestPaymentWithContext(arg1 aws.Context, arg2 *s3.GetBucketRequestPaymentInput, arg3 ...request.Option) (*s3.GetBucketRequestPaymentOutput, error) {
 	fake.getBucketRequestPaymentWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketRequestPaymentWithContextReturnsOnCall[len(fake.getBucketRequestPaymentWithContextArgsForCall)]
 	fake.getBucketRequestPaymentWithContextArgsForCall = append(fake.getBucketRequestPaymentWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketRequestPaymentInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketRequestPaymentWithContextStub
+	fakeReturns := fake.getBucketRequestPaymentWithContextReturns
 	fake.recordInvocation("GetBucketRequestPaymentWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketRequestPaymentWithContextMutex.Unlock()
-	if fake.GetBucketRequestPaymentWithContextStub != nil {
-		return fake.GetBucketRequestPaymentWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketRequestPaymentWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10877,13 +12435,13 @@ func (fake *FakeS3API) GetBucketRequestPaymentWithContextCallCount() int {
 	return len(fake.getBucketRequestPaymentWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketRequestPaymentWithContextCalls(stub func(context.Context, *s3.GetBucketRequestPaymentInput, ...request.Option) (*s3.GetBucketRequestPaymentOutput, error)) {
+func (fake *FakeS3API) GetBucketRequestPaymentWithContextCalls(stub func(aws.Context, *s3.GetBucketRequestPaymentInput, ...request.Option) (*s3.GetBucketRequestPaymentOutput, error)) {
 	fake.getBucketRequestPaymentWithContextMutex.Lock()
 	defer fake.getBucketRequestPaymentWithContextMutex.Unlock()
 	fake.GetBucketRequestPaymentWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketRequestPaymentWithContextArgsForCall(i int) (context.Context, *s3.GetBucketRequestPaymentInput, []request.Option) {
+func (fake *FakeS3API) GetBucketRequestPaymentWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketRequestPaymentInput, []request.Option) {
 	fake.getBucketRequestPaymentWithContextMutex.RLock()
 	defer fake.getBucketRequestPaymentWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketRequestPaymentWithContextArgsForCall[i]
@@ -10922,15 +12480,16 @@ func (fake *FakeS3API) GetBucketTagging(arg1 *s3.GetBucketTaggingInput) (*s3.Get
 	fake.getBucketTaggingArgsForCall = append(fake.getBucketTaggingArgsForCall, struct {
 		arg1 *s3.GetBucketTaggingInput
 	}{arg1})
+	stub := fake.GetBucketTaggingStub
+	fakeReturns := fake.getBucketTaggingReturns
 	fake.recordInvocation("GetBucketTagging", []interface{}{arg1})
 	fake.getBucketTaggingMutex.Unlock()
-	if fake.GetBucketTaggingStub != nil {
-		return fake.GetBucketTaggingStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketTaggingReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -10985,15 +12544,16 @@ func (fake *FakeS3API) GetBucketTaggingRequest(arg1 *s3.GetBucketTaggingInput) (
 	fake.getBucketTaggingRequestArgsForCall = append(fake.getBucketTaggingRequestArgsForCall, struct {
 		arg1 *s3.GetBucketTaggingInput
 	}{arg1})
+	stub := fake.GetBucketTaggingRequestStub
+	fakeReturns := fake.getBucketTaggingRequestReturns
 	fake.recordInvocation("GetBucketTaggingRequest", []interface{}{arg1})
 	fake.getBucketTaggingRequestMutex.Unlock()
-	if fake.GetBucketTaggingRequestStub != nil {
-		return fake.GetBucketTaggingRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketTaggingRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11042,23 +12602,24 @@ func (fake *FakeS3API) GetBucketTaggingRequestReturnsOnCall(i int, result1 *requ
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketTaggingWithContext(arg1 context.Context, arg2 *s3.GetBucketTaggingInput, arg3 ...request.Option) (*s3.GetBucketTaggingOutput, error) {
+func (fake *FakeS3API) GetBucketTaggingWithContext(arg1 aws.Context, arg2 *s3.GetBucketTaggingInput, arg3 ...request.Option) (*s3.GetBucketTaggingOutput, error) {
 	fake.getBucketTaggingWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketTaggingWithContextReturnsOnCall[len(fake.getBucketTaggingWithContextArgsForCall)]
 	fake.getBucketTaggingWithContextArgsForCall = append(fake.getBucketTaggingWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketTaggingInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketTaggingWithContextStub
+	fakeReturns := fake.getBucketTaggingWithContextReturns
 	fake.recordInvocation("GetBucketTaggingWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketTaggingWithContextMutex.Unlock()
-	if fake.GetBucketTaggingWithContextStub != nil {
-		return fake.GetBucketTaggingWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketTaggingWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11068,13 +12629,13 @@ func (fake *FakeS3API) GetBucketTaggingWithContextCallCount() int {
 	return len(fake.getBucketTaggingWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketTaggingWithContextCalls(stub func(context.Context, *s3.GetBucketTaggingInput, ...request.Option) (*s3.GetBucketTaggingOutput, error)) {
+func (fake *FakeS3API) GetBucketTaggingWithContextCalls(stub func(aws.Context, *s3.GetBucketTaggingInput, ...request.Option) (*s3.GetBucketTaggingOutput, error)) {
 	fake.getBucketTaggingWithContextMutex.Lock()
 	defer fake.getBucketTaggingWithContextMutex.Unlock()
 	fake.GetBucketTaggingWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketTaggingWithContextArgsForCall(i int) (context.Context, *s3.GetBucketTaggingInput, []request.Option) {
+func (fake *FakeS3API) GetBucketTaggingWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketTaggingInput, []request.Option) {
 	fake.getBucketTaggingWithContextMutex.RLock()
 	defer fake.getBucketTaggingWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketTaggingWithContextArgsForCall[i]
@@ -11113,15 +12674,16 @@ func (fake *FakeS3API) GetBucketVersioning(arg1 *s3.GetBucketVersioningInput) (*
 	fake.getBucketVersioningArgsForCall = append(fake.getBucketVersioningArgsForCall, struct {
 		arg1 *s3.GetBucketVersioningInput
 	}{arg1})
+	stub := fake.GetBucketVersioningStub
+	fakeReturns := fake.getBucketVersioningReturns
 	fake.recordInvocation("GetBucketVersioning", []interface{}{arg1})
 	fake.getBucketVersioningMutex.Unlock()
-	if fake.GetBucketVersioningStub != nil {
-		return fake.GetBucketVersioningStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketVersioningReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11176,15 +12738,16 @@ func (fake *FakeS3API) GetBucketVersioningRequest(arg1 *s3.GetBucketVersioningIn
 	fake.getBucketVersioningRequestArgsForCall = append(fake.getBucketVersioningRequestArgsForCall, struct {
 		arg1 *s3.GetBucketVersioningInput
 	}{arg1})
+	stub := fake.GetBucketVersioningRequestStub
+	fakeReturns := fake.getBucketVersioningRequestReturns
 	fake.recordInvocation("GetBucketVersioningRequest", []interface{}{arg1})
 	fake.getBucketVersioningRequestMutex.Unlock()
-	if fake.GetBucketVersioningRequestStub != nil {
-		return fake.GetBucketVersioningRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketVersioningRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11233,23 +12796,24 @@ func (fake *FakeS3API) GetBucketVersioningRequestReturnsOnCall(i int, result1 *r
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketVersioningWithContext(arg1 context.Context, arg2 *s3.GetBucketVersioningInput, arg3 ...request.Option) (*s3.GetBucketVersioningOutput, error) {
+func (fake *FakeS3API) GetBucketVersioningWithContext(arg1 aws.Context, arg2 *s3.GetBucketVersioningInput, arg3 ...request.Option) (*s3.GetBucketVersioningOutput, error) {
 	fake.getBucketVersioningWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketVersioningWithContextReturnsOnCall[len(fake.getBucketVersioningWithContextArgsForCall)]
 	fake.getBucketVersioningWithContextArgsForCall = append(fake.getBucketVersioningWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketVersioningInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketVersioningWithContextStub
+	fakeReturns := fake.getBucketVersioningWithContextReturns
 	fake.recordInvocation("GetBucketVersioningWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketVersioningWithContextMutex.Unlock()
-	if fake.GetBucketVersioningWithContextStub != nil {
-		return fake.GetBucketVersioningWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketVersioningWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11259,13 +12823,13 @@ func (fake *FakeS3API) GetBucketVersioningWithContextCallCount() int {
 	return len(fake.getBucketVersioningWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketVersioningWithContextCalls(stub func(context.Context, *s3.GetBucketVersioningInput, ...request.Option) (*s3.GetBucketVersioningOutput, error)) {
+func (fake *FakeS3API) GetBucketVersioningWithContextCalls(stub func(aws.Context, *s3.GetBucketVersioningInput, ...request.Option) (*s3.GetBucketVersioningOutput, error)) {
 	fake.getBucketVersioningWithContextMutex.Lock()
 	defer fake.getBucketVersioningWithContextMutex.Unlock()
 	fake.GetBucketVersioningWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketVersioningWithContextArgsForCall(i int) (context.Context, *s3.GetBucketVersioningInput, []request.Option) {
+func (fake *FakeS3API) GetBucketVersioningWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketVersioningInput, []request.Option) {
 	fake.getBucketVersioningWithContextMutex.RLock()
 	defer fake.getBucketVersioningWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketVersioningWithContextArgsForCall[i]
@@ -11304,15 +12868,16 @@ func (fake *FakeS3API) GetBucketWebsite(arg1 *s3.GetBucketWebsiteInput) (*s3.Get
 	fake.getBucketWebsiteArgsForCall = append(fake.getBucketWebsiteArgsForCall, struct {
 		arg1 *s3.GetBucketWebsiteInput
 	}{arg1})
+	stub := fake.GetBucketWebsiteStub
+	fakeReturns := fake.getBucketWebsiteReturns
 	fake.recordInvocation("GetBucketWebsite", []interface{}{arg1})
 	fake.getBucketWebsiteMutex.Unlock()
-	if fake.GetBucketWebsiteStub != nil {
-		return fake.GetBucketWebsiteStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketWebsiteReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11367,15 +12932,16 @@ func (fake *FakeS3API) GetBucketWebsiteRequest(arg1 *s3.GetBucketWebsiteInput) (
 	fake.getBucketWebsiteRequestArgsForCall = append(fake.getBucketWebsiteRequestArgsForCall, struct {
 		arg1 *s3.GetBucketWebsiteInput
 	}{arg1})
+	stub := fake.GetBucketWebsiteRequestStub
+	fakeReturns := fake.getBucketWebsiteRequestReturns
 	fake.recordInvocation("GetBucketWebsiteRequest", []interface{}{arg1})
 	fake.getBucketWebsiteRequestMutex.Unlock()
-	if fake.GetBucketWebsiteRequestStub != nil {
-		return fake.GetBucketWebsiteRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketWebsiteRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11424,23 +12990,24 @@ func (fake *FakeS3API) GetBucketWebsiteRequestReturnsOnCall(i int, result1 *requ
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetBucketWebsiteWithContext(arg1 context.Context, arg2 *s3.GetBucketWebsiteInput, arg3 ...request.Option) (*s3.GetBucketWebsiteOutput, error) {
+func (fake *FakeS3API) GetBucketWebsiteWithContext(arg1 aws.Context, arg2 *s3.GetBucketWebsiteInput, arg3 ...request.Option) (*s3.GetBucketWebsiteOutput, error) {
 	fake.getBucketWebsiteWithContextMutex.Lock()
 	ret, specificReturn := fake.getBucketWebsiteWithContextReturnsOnCall[len(fake.getBucketWebsiteWithContextArgsForCall)]
 	fake.getBucketWebsiteWithContextArgsForCall = append(fake.getBucketWebsiteWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetBucketWebsiteInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetBucketWebsiteWithContextStub
+	fakeReturns := fake.getBucketWebsiteWithContextReturns
 	fake.recordInvocation("GetBucketWebsiteWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getBucketWebsiteWithContextMutex.Unlock()
-	if fake.GetBucketWebsiteWithContextStub != nil {
-		return fake.GetBucketWebsiteWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getBucketWebsiteWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11450,13 +13017,13 @@ func (fake *FakeS3API) GetBucketWebsiteWithContextCallCount() int {
 	return len(fake.getBucketWebsiteWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetBucketWebsiteWithContextCalls(stub func(context.Context, *s3.GetBucketWebsiteInput, ...request.Option) (*s3.GetBucketWebsiteOutput, error)) {
+func (fake *FakeS3API) GetBucketWebsiteWithContextCalls(stub func(aws.Context, *s3.GetBucketWebsiteInput, ...request.Option) (*s3.GetBucketWebsiteOutput, error)) {
 	fake.getBucketWebsiteWithContextMutex.Lock()
 	defer fake.getBucketWebsiteWithContextMutex.Unlock()
 	fake.GetBucketWebsiteWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetBucketWebsiteWithContextArgsForCall(i int) (context.Context, *s3.GetBucketWebsiteInput, []request.Option) {
+func (fake *FakeS3API) GetBucketWebsiteWithContextArgsForCall(i int) (aws.Context, *s3.GetBucketWebsiteInput, []request.Option) {
 	fake.getBucketWebsiteWithContextMutex.RLock()
 	defer fake.getBucketWebsiteWithContextMutex.RUnlock()
 	argsForCall := fake.getBucketWebsiteWithContextArgsForCall[i]
@@ -11495,15 +13062,16 @@ func (fake *FakeS3API) GetObject(arg1 *s3.GetObjectInput) (*s3.GetObjectOutput,
 	fake.getObjectArgsForCall = append(fake.getObjectArgsForCall, struct {
 		arg1 *s3.GetObjectInput
 	}{arg1})
+	stub := fake.GetObjectStub
+	fakeReturns := fake.getObjectReturns
 	fake.recordInvocation("GetObject", []interface{}{arg1})
 	fake.getObjectMutex.Unlock()
-	if fake.GetObjectStub != nil {
-		return fake.GetObjectStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11558,15 +13126,16 @@ func (fake *FakeS3API) GetObjectAcl(arg1 *s3.GetObjectAclInput) (*s3.GetObjectAc
 	fake.getObjectAclArgsForCall = append(fake.getObjectAclArgsForCall, struct {
 		arg1 *s3.GetObjectAclInput
 	}{arg1})
+	stub := fake.GetObjectAclStub
+	fakeReturns := fake.getObjectAclReturns
 	fake.recordInvocation("GetObjectAcl", []interface{}{arg1})
 	fake.getObjectAclMutex.Unlock()
-	if fake.GetObjectAclStub != nil {
-		return fake.GetObjectAclStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectAclReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11621,15 +13190,16 @@ func (fake *FakeS3API) GetObjectAclRequest(arg1 *s3.GetObjectAclInput) (*request
 	fake.getObjectAclRequestArgsForCall = append(fake.getObjectAclRequestArgsForCall, struct {
 		arg1 *s3.GetObjectAclInput
 	}{arg1})
+	stub := fake.GetObjectAclRequestStub
+	fakeReturns := fake.getObjectAclRequestReturns
 	fake.recordInvocation("GetObjectAclRequest", []interface{}{arg1})
 	fake.getObjectAclRequestMutex.Unlock()
-	if fake.GetObjectAclRequestStub != nil {
-		return fake.GetObjectAclRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectAclRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11678,23 +13248,24 @@ func (fake *FakeS3API) GetObjectAclRequestReturnsOnCall(i int, result1 *request.
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetObjectAclWithContext(arg1 context.Context, arg2 *s3.GetObjectAclInput, arg3 ...request.Option) (*s3.GetObjectAclOutput, error) {
+func (fake *FakeS3API) GetObjectAclWithContext(arg1 aws.Context, arg2 *s3.GetObjectAclInput, arg3 ...request.Option) (*s3.GetObjectAclOutput, error) {
 	fake.getObjectAclWithContextMutex.Lock()
 	ret, specificReturn := fake.getObjectAclWithContextReturnsOnCall[len(fake.getObjectAclWithContextArgsForCall)]
 	fake.getObjectAclWithContextArgsForCall = append(fake.getObjectAclWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectAclInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetObjectAclWithContextStub
+	fakeReturns := fake.getObjectAclWithContextReturns
 	fake.recordInvocation("GetObjectAclWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getObjectAclWithContextMutex.Unlock()
-	if fake.GetObjectAclWithContextStub != nil {
-		return fake.GetObjectAclWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectAclWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11704,13 +13275,13 @@ func (fake *FakeS3API) GetObjectAclWithContextCallCount() int {
 	return len(fake.getObjectAclWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetObjectAclWithContextCalls(stub func(context.Context, *s3.GetObjectAclInput, ...request.Option) (*s3.GetObjectAclOutput, error)) {
+func (fake *FakeS3API) GetObjectAclWithContextCalls(stub func(aws.Context, *s3.GetObjectAclInput, ...request.Option) (*s3.GetObjectAclOutput, error)) {
 	fake.getObjectAclWithContextMutex.Lock()
 	defer fake.getObjectAclWithContextMutex.Unlock()
 	fake.GetObjectAclWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetObjectAclWithContextArgsForCall(i int) (context.Context, *s3.GetObjectAclInput, []request.Option) {
+func (fake *FakeS3API) GetObjectAclWithContextArgsForCall(i int) (aws.Context, *s3.GetObjectAclInput, []request.Option) {
 	fake.getObjectAclWithContextMutex.RLock()
 	defer fake.getObjectAclWithContextMutex.RUnlock()
 	argsForCall := fake.getObjectAclWithContextArgsForCall[i]
@@ -11743,149 +13314,346 @@ func (fake *FakeS3API) GetObjectAclWithContextReturnsOnCall(i int, result1 *s3.G
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetObjectLegalHold(arg1 *s3.GetObjectLegalHoldInput) (*s3.GetObjectLegalHoldOutput, error) {
-	fake.getObjectLegalHoldMutex.Lock()
-	ret, specificReturn := fake.getObjectLegalHoldReturnsOnCall[len(fake.getObjectLegalHoldArgsForCall)]
-	fake.getObjectLegalHoldArgsForCall = append(fake.getObjectLegalHoldArgsForCall, struct {
-		arg1 *s3.GetObjectLegalHoldInput
+func (fake *FakeS3API) GetObjectAttributes(arg1 *s3.GetObjectAttributesInput) (*s3.GetObjectAttributesOutput, error) {
+	fake.getObjectAttributesMutex.Lock()
+	ret, specificReturn := fake.getObjectAttributesReturnsOnCall[len(fake.getObjectAttributesArgsForCall)]
+	fake.getObjectAttributesArgsForCall = append(fake.getObjectAttributesArgsForCall, struct {
+		arg1 *s3.GetObjectAttributesInput
 	}{arg1})
-	fake.recordInvocation("GetObjectLegalHold", []interface{}{arg1})
-	fake.getObjectLegalHoldMutex.Unlock()
-	if fake.GetObjectLegalHoldStub != nil {
-		return fake.GetObjectLegalHoldStub(arg1)
+	stub := fake.GetObjectAttributesStub
+	fakeReturns := fake.getObjectAttributesReturns
+	fake.recordInvocation("GetObjectAttributes", []interface{}{arg1})
+	fake.getObjectAttributesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectLegalHoldReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldCallCount() int {
-	fake.getObjectLegalHoldMutex.RLock()
-	defer fake.getObjectLegalHoldMutex.RUnlock()
-	return len(fake.getObjectLegalHoldArgsForCall)
+func (fake *FakeS3API) GetObjectAttributesCallCount() int {
+	fake.getObjectAttributesMutex.RLock()
+	defer fake.getObjectAttributesMutex.RUnlock()
+	return len(fake.getObjectAttributesArgsForCall)
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldCalls(stub func(*s3.GetObjectLegalHoldInput) (*s3.GetObjectLegalHoldOutput, error)) {
-	fake.getObjectLegalHoldMutex.Lock()
-	defer fake.getObjectLegalHoldMutex.Unlock()
-	fake.GetObjectLegalHoldStub = stub
+func (fake *FakeS3API) GetObjectAttributesCalls(stub func(*s3.GetObjectAttributesInput) (*s3.GetObjectAttributesOutput, error)) {
+	fake.getObjectAttributesMutex.Lock()
+	defer fake.getObjectAttributesMutex.Unlock()
+	fake.GetObjectAttributesStub = stub
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldArgsForCall(i int) *s3.GetObjectLegalHoldInput {
-	fake.getObjectLegalHoldMutex.RLock()
-	defer fake.getObjectLegalHoldMutex.RUnlock()
-	argsForCall := fake.getObjectLegalHoldArgsForCall[i]
+func (fake *FakeS3API) GetObjectAttributesArgsForCall(i int) *s3.GetObjectAttributesInput {
+	fake.getObjectAttributesMutex.RLock()
+	defer fake.getObjectAttributesMutex.RUnlock()
+	argsForCall := fake.getObjectAttributesArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldReturns(result1 *s3.GetObjectLegalHoldOutput, result2 error) {
-	fake.getObjectLegalHoldMutex.Lock()
-	defer fake.getObjectLegalHoldMutex.Unlock()
-	fake.GetObjectLegalHoldStub = nil
-	fake.getObjectLegalHoldReturns = struct {
-		result1 *s3.GetObjectLegalHoldOutput
+func (fake *FakeS3API) GetObjectAttributesReturns(result1 *s3.GetObjectAttributesOutput, result2 error) {
+	fake.getObjectAttributesMutex.Lock()
+	defer fake.getObjectAttributesMutex.Unlock()
+	fake.GetObjectAttributesStub = nil
+	fake.getObjectAttributesReturns = struct {
+		result1 *s3.GetObjectAttributesOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldReturnsOnCall(i int, result1 *s3.GetObjectLegalHoldOutput, result2 error) {
-	fake.getObjectLegalHoldMutex.Lock()
-	defer fake.getObjectLegalHoldMutex.Unlock()
-	fake.GetObjectLegalHoldStub = nil
-	if fake.getObjectLegalHoldReturnsOnCall == nil {
-		fake.getObjectLegalHoldReturnsOnCall = make(map[int]struct {
-			result1 *s3.GetObjectLegalHoldOutput
+func (fake *FakeS3API) GetObjectAttributesReturnsOnCall(i int, result1 *s3.GetObjectAttributesOutput, result2 error) {
+	fake.getObjectAttributesMutex.Lock()
+	defer fake.getObjectAttributesMutex.Unlock()
+	fake.GetObjectAttributesStub = nil
+	if fake.getObjectAttributesReturnsOnCall == nil {
+		fake.getObjectAttributesReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetObjectAttributesOutput
 			result2 error
 		})
 	}
-	fake.getObjectLegalHoldReturnsOnCall[i] = struct {
-		result1 *s3.GetObjectLegalHoldOutput
+	fake.getObjectAttributesReturnsOnCall[i] = struct {
+		result1 *s3.GetObjectAttributesOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldRequest(arg1 *s3.GetObjectLegalHoldInput) (*request.Request, *s3.GetObjectLegalHoldOutput) {
-	fake.getObjectLegalHoldRequestMutex.Lock()
-	ret, specificReturn := fake.getObjectLegalHoldRequestReturnsOnCall[len(fake.getObjectLegalHoldRequestArgsForCall)]
-	fake.getObjectLegalHoldRequestArgsForCall = append(fake.getObjectLegalHoldRequestArgsForCall, struct {
-		arg1 *s3.GetObjectLegalHoldInput
+func (fake *FakeS3API) GetObjectAttributesRequest(arg1 *s3.GetObjectAttributesInput) (*request.Request, *s3.GetObjectAttributesOutput) {
+	fake.getObjectAttributesRequestMutex.Lock()
+	ret, specificReturn := fake.getObjectAttributesRequestReturnsOnCall[len(fake.getObjectAttributesRequestArgsForCall)]
+	fake.getObjectAttributesRequestArgsForCall = append(fake.getObjectAttributesRequestArgsForCall, struct {
+		arg1 *s3.GetObjectAttributesInput
 	}{arg1})
-	fake.recordInvocation("GetObjectLegalHoldRequest", []interface{}{arg1})
-	fake.getObjectLegalHoldRequestMutex.Unlock()
-	if fake.GetObjectLegalHoldRequestStub != nil {
-		return fake.GetObjectLegalHoldRequestStub(arg1)
+	stub := fake.GetObjectAttributesRequestStub
+	fakeReturns := fake.getObjectAttributesRequestReturns
+	fake.recordInvocation("GetObjectAttributesRequest", []interface{}{arg1})
+	fake.getObjectAttributesRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectLegalHoldRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldRequestCallCount() int {
-	fake.getObjectLegalHoldRequestMutex.RLock()
-	defer fake.getObjectLegalHoldRequestMutex.RUnlock()
-	return len(fake.getObjectLegalHoldRequestArgsForCall)
+func (fake *FakeS3API) GetObjectAttributesRequestCallCount() int {
+	fake.getObjectAttributesRequestMutex.RLock()
+	defer fake.getObjectAttributesRequestMutex.RUnlock()
+	return len(fake.getObjectAttributesRequestArgsForCall)
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldRequestCalls(stub func(*s3.GetObjectLegalHoldInput) (*request.Request, *s3.GetObjectLegalHoldOutput)) {
-	fake.getObjectLegalHoldRequestMutex.Lock()
-	defer fake.getObjectLegalHoldRequestMutex.Unlock()
-	fake.GetObjectLegalHoldRequestStub = stub
+func (fake *FakeS3API) GetObjectAttributesRequestCalls(stub func(*s3.GetObjectAttributesInput) (*request.Request, *s3.GetObjectAttributesOutput)) {
+	fake.getObjectAttributesRequestMutex.Lock()
+	defer fake.getObjectAttributesRequestMutex.Unlock()
+	fake.GetObjectAttributesRequestStub = stub
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldRequestArgsForCall(i int) *s3.GetObjectLegalHoldInput {
-	fake.getObjectLegalHoldRequestMutex.RLock()
-	defer fake.getObjectLegalHoldRequestMutex.RUnlock()
-	argsForCall := fake.getObjectLegalHoldRequestArgsForCall[i]
+func (fake *FakeS3API) GetObjectAttributesRequestArgsForCall(i int) *s3.GetObjectAttributesInput {
+	fake.getObjectAttributesRequestMutex.RLock()
+	defer fake.getObjectAttributesRequestMutex.RUnlock()
+	argsForCall := fake.getObjectAttributesRequestArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldRequestReturns(result1 *request.Request, result2 *s3.GetObjectLegalHoldOutput) {
-	fake.getObjectLegalHoldRequestMutex.Lock()
-	defer fake.getObjectLegalHoldRequestMutex.Unlock()
-	fake.GetObjectLegalHoldRequestStub = nil
-	fake.getObjectLegalHoldRequestReturns = struct {
+func (fake *FakeS3API) GetObjectAttributesRequestReturns(result1 *request.Request, result2 *s3.GetObjectAttributesOutput) {
+	fake.getObjectAttributesRequestMutex.Lock()
+	defer fake.getObjectAttributesRequestMutex.Unlock()
+	fake.GetObjectAttributesRequestStub = nil
+	fake.getObjectAttributesRequestReturns = struct {
 		result1 *request.Request
-		result2 *s3.GetObjectLegalHoldOutput
+		result2 *s3.GetObjectAttributesOutput
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.GetObjectLegalHoldOutput) {
-	fake.getObjectLegalHoldRequestMutex.Lock()
-	defer fake.getObjectLegalHoldRequestMutex.Unlock()
-	fake.GetObjectLegalHoldRequestStub = nil
-	if fake.getObjectLegalHoldRequestReturnsOnCall == nil {
-		fake.getObjectLegalHoldRequestReturnsOnCall = make(map[int]struct {
+func (fake *FakeS3API) GetObjectAttributesRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.GetObjectAttributesOutput) {
+	fake.getObjectAttributesRequestMutex.Lock()
+	defer fake.getObjectAttributesRequestMutex.Unlock()
+	fake.GetObjectAttributesRequestStub = nil
+	if fake.getObjectAttributesRequestReturnsOnCall == nil {
+		fake.getObjectAttributesRequestReturnsOnCall = make(map[int]struct {
 			result1 *request.Request
-			result2 *s3.GetObjectLegalHoldOutput
+			result2 *s3.GetObjectAttributesOutput
 		})
 	}
-	fake.getObjectLegalHoldRequestReturnsOnCall[i] = struct {
+	fake.getObjectAttributesRequestReturnsOnCall[i] = struct {
 		result1 *request.Request
-		result2 *s3.GetObjectLegalHoldOutput
+		result2 *s3.GetObjectAttributesOutput
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldWithContext(arg1 context.Context, arg2 *s3.GetObjectLegalHoldInput, arg3 ...request.Option) (*s3.GetObjectLegalHoldOutput, error) {
-	fake.getObjectLegalHoldWithContextMutex.Lock()
-	ret, specificReturn := fake.getObjectLegalHoldWithContextReturnsOnCall[len(fake.getObjectLegalHoldWithContextArgsForCall)]
+func (fake *FakeS3API) GetObjectAttributesWithContext(arg1 aws.Context, arg2 *s3.GetObjectAttributesInput, arg3 ...request.Option) (*s3.GetObjectAttributesOutput, error) {
+	fake.getObjectAttributesWithContextMutex.Lock()
+	ret, specificReturn := fake.getObjectAttributesWithContextReturnsOnCall[len(fake.getObjectAttributesWithContextArgsForCall)]
+	fake.getObjectAttributesWithContextArgsForCall = append(fake.getObjectAttributesWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.GetObjectAttributesInput
+		arg3 []request.Option
+	}{arg1, arg2, arg3})
+	stub := fake.GetObjectAttributesWithContextStub
+	fakeReturns := fake.getObjectAttributesWithContextReturns
+	fake.recordInvocation("GetObjectAttributesWithContext", []interface{}{arg1, arg2, arg3})
+	fake.getObjectAttributesWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) GetObjectAttributesWithContextCallCount() int {
+	fake.getObjectAttributesWithContextMutex.RLock()
+	defer fake.getObjectAttributesWithContextMutex.RUnlock()
+	return len(fake.getObjectAttributesWithContextArgsForCall)
+}
+
+func (fake *FakeS3API) GetObjectAttributesWithContextCalls(stub func(aws.Context, *s3.GetObjectAttributesInput, ...request.Option) (*s3.GetObjectAttributesOutput, error)) {
+	fake.getObjectAttributesWithContextMutex.Lock()
+	defer fake.getObjectAttributesWithContextMutex.Unlock()
+	fake.GetObjectAttributesWithContextStub = stub
+}
+
+func (fake *FakeS3API) GetObjectAttributesWithContextArgsForCall(i int) (aws.Context, *s3.GetObjectAttributesInput, []request.Option) {
+	fake.getObjectAttributesWithContextMutex.RLock()
+	defer fake.getObjectAttributesWithContextMutex.RUnlock()
+	argsForCall := fake.getObjectAttributesWithContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3API) GetObjectAttributesWithContextReturns(result1 *s3.GetObjectAttributesOutput, result2 error) {
+	fake.getObjectAttributesWithContextMutex.Lock()
+	defer fake.getObjectAttributesWithContextMutex.Unlock()
+	fake.GetObjectAttributesWithContextStub = nil
+	fake.getObjectAttributesWithContextReturns = struct {
+		result1 *s3.GetObjectAttributesOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetObjectAttributesWithContextReturnsOnCall(i int, result1 *s3.GetObjectAttributesOutput, result2 error) {
+	fake.getObjectAttributesWithContextMutex.Lock()
+	defer fake.getObjectAttributesWithContextMutex.Unlock()
+	fake.GetObjectAttributesWithContextStub = nil
+	if fake.getObjectAttributesWithContextReturnsOnCall == nil {
+		fake.getObjectAttributesWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetObjectAttributesOutput
+			result2 error
+		})
+	}
+	fake.getObjectAttributesWithContextReturnsOnCall[i] = struct {
+		result1 *s3.GetObjectAttributesOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetObjectLegalHold(arg1 *s3.GetObjectLegalHoldInput) (*s3.GetObjectLegalHoldOutput, error) {
+	fake.getObjectLegalHoldMutex.Lock()
+	ret, specificReturn := fake.getObjectLegalHoldReturnsOnCall[len(fake.getObjectLegalHoldArgsForCall)]
+	fake.getObjectLegalHoldArgsForCall = append(fake.getObjectLegalHoldArgsForCall, struct {
+		arg1 *s3.GetObjectLegalHoldInput
+	}{arg1})
+	stub := fake.GetObjectLegalHoldStub
+	fakeReturns := fake.getObjectLegalHoldReturns
+	fake.recordInvocation("GetObjectLegalHold", []interface{}{arg1})
+	fake.getObjectLegalHoldMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) GetObjectLegalHoldCallCount() int {
+	fake.getObjectLegalHoldMutex.RLock()
+	defer fake.getObjectLegalHoldMutex.RUnlock()
+	return len(fake.getObjectLegalHoldArgsForCall)
+}
+
+func (fake *FakeS3API) GetObjectLegalHoldCalls(stub func(*s3.GetObjectLegalHoldInput) (*s3.GetObjectLegalHoldOutput, error)) {
+	fake.getObjectLegalHoldMutex.Lock()
+	defer fake.getObjectLegalHoldMutex.Unlock()
+	fake.GetObjectLegalHoldStub = stub
+}
+
+func (fake *FakeS3API) GetObjectLegalHoldArgsForCall(i int) *s3.GetObjectLegalHoldInput {
+	fake.getObjectLegalHoldMutex.RLock()
+	defer fake.getObjectLegalHoldMutex.RUnlock()
+	argsForCall := fake.getObjectLegalHoldArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) GetObjectLegalHoldReturns(result1 *s3.GetObjectLegalHoldOutput, result2 error) {
+	fake.getObjectLegalHoldMutex.Lock()
+	defer fake.getObjectLegalHoldMutex.Unlock()
+	fake.GetObjectLegalHoldStub = nil
+	fake.getObjectLegalHoldReturns = struct {
+		result1 *s3.GetObjectLegalHoldOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetObjectLegalHoldReturnsOnCall(i int, result1 *s3.GetObjectLegalHoldOutput, result2 error) {
+	fake.getObjectLegalHoldMutex.Lock()
+	defer fake.getObjectLegalHoldMutex.Unlock()
+	fake.GetObjectLegalHoldStub = nil
+	if fake.getObjectLegalHoldReturnsOnCall == nil {
+		fake.getObjectLegalHoldReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetObjectLegalHoldOutput
+			result2 error
+		})
+	}
+	fake.getObjectLegalHoldReturnsOnCall[i] = struct {
+		result1 *s3.GetObjectLegalHoldOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetObjectLegalHoldRequest(arg1 *s3.GetObjectLegalHoldInput) (*request.Request, *s3.GetObjectLegalHoldOutput) {
+	fake.getObjectLegalHoldRequestMutex.Lock()
+	ret, specificReturn := fake.getObjectLegalHoldRequestReturnsOnCall[len(fake.getObjectLegalHoldRequestArgsForCall)]
+	fake.getObjectLegalHoldRequestArgsForCall = append(fake.getObjectLegalHoldRequestArgsForCall, struct {
+		arg1 *s3.GetObjectLegalHoldInput
+	}{arg1})
+	stub := fake.GetObjectLegalHoldRequestStub
+	fakeReturns := fake.getObjectLegalHoldRequestReturns
+	fake.recordInvocation("GetObjectLegalHoldRequest", []interface{}{arg1})
+	fake.getObjectLegalHoldRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) GetObjectLegalHoldRequestCallCount() int {
+	fake.getObjectLegalHoldRequestMutex.RLock()
+	defer fake.getObjectLegalHoldRequestMutex.RUnlock()
+	return len(fake.getObjectLegalHoldRequestArgsForCall)
+}
+
+func (fake *FakeS3API) GetObjectLegalHoldRequestCalls(stub func(*s3.GetObjectLegalHoldInput) (*request.Request, *s3.GetObjectLegalHoldOutput)) {
+	fake.getObjectLegalHoldRequestMutex.Lock()
+	defer fake.getObjectLegalHoldRequestMutex.Unlock()
+	fake.GetObjectLegalHoldRequestStub = stub
+}
+
+func (fake *FakeS3API) GetObjectLegalHoldRequestArgsForCall(i int) *s3.GetObjectLegalHoldInput {
+	fake.getObjectLegalHoldRequestMutex.RLock()
+	defer fake.getObjectLegalHoldRequestMutex.RUnlock()
+	argsForCall := fake.getObjectLegalHoldRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) GetObjectLegalHoldRequestReturns(result1 *request.Request, result2 *s3.GetObjectLegalHoldOutput) {
+	fake.getObjectLegalHoldRequestMutex.Lock()
+	defer fake.getObjectLegalHoldRequestMutex.Unlock()
+	fake.GetObjectLegalHoldRequestStub = nil
+	fake.getObjectLegalHoldRequestReturns = struct {
+		result1 *request.Request
+		result2 *s3.GetObjectLegalHoldOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetObjectLegalHoldRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.GetObjectLegalHoldOutput) {
+	fake.getObjectLegalHoldRequestMutex.Lock()
+	defer fake.getObjectLegalHoldRequestMutex.Unlock()
+	fake.GetObjectLegalHoldRequestStub = nil
+	if fake.getObjectLegalHoldRequestReturnsOnCall == nil {
+		fake.getObjectLegalHoldRequestReturnsOnCall = make(map[int]struct {
+			result1 *request.Request
+			result2 *s3.GetObjectLegalHoldOutput
+		})
+	}
+	fake.getObjectLegalHoldRequestReturnsOnCall[i] = struct {
+		result1 *request.Request
+		result2 *s3.GetObjectLegalHoldOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) GetObjectLegalHoldWithContext(arg1 aws.Context, arg2 *s3.GetObjectLegalHoldInput, arg3 ...request.Option) (*s3.GetObjectLegalHoldOutput, error) {
+	fake.getObjectLegalHoldWithContextMutex.Lock()
+	ret, specificReturn := fake.getObjectLegalHoldWithContextReturnsOnCall[len(fake.getObjectLegalHoldWithContextArgsForCall)]
 	fake.getObjectLegalHoldWithContextArgsForCall = append(fake.getObjectLegalHoldWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectLegalHoldInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetObjectLegalHoldWithContextStub
+	fakeReturns := fake.getObjectLegalHoldWithContextReturns
 	fake.recordInvocation("GetObjectLegalHoldWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getObjectLegalHoldWithContextMutex.Unlock()
-	if fake.GetObjectLegalHoldWithContextStub != nil {
-		return fake.GetObjectLegalHoldWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectLegalHoldWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -11895,13 +13663,13 @@ func (fake *FakeS3API) GetObjectLegalHoldWithContextCallCount() int {
 	return len(fake.getObjectLegalHoldWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldWithContextCalls(stub func(context.Context, *s3.GetObjectLegalHoldInput, ...request.Option) (*s3.GetObjectLegalHoldOutput, error)) {
+func (fake *FakeS3API) GetObjectLegalHoldWithContextCalls(stub func(aws.Context, *s3.GetObjectLegalHoldInput, ...request.Option) (*s3.GetObjectLegalHoldOutput, error)) {
 	fake.getObjectLegalHoldWithContextMutex.Lock()
 	defer fake.getObjectLegalHoldWithContextMutex.Unlock()
 	fake.GetObjectLegalHoldWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetObjectLegalHoldWithContextArgsForCall(i int) (context.Context, *s3.GetObjectLegalHoldInput, []request.Option) {
+func (fake *FakeS3API) GetObjectLegalHoldWithContextArgsForCall(i int) (aws.Context, *s3.GetObjectLegalHoldInput, []request.Option) {
 	fake.getObjectLegalHoldWithContextMutex.RLock()
 	defer fake.getObjectLegalHoldWithContextMutex.RUnlock()
 	argsForCall := fake.getObjectLegalHoldWithContextArgsForCall[i]
@@ -11940,15 +13708,16 @@ func (fake *FakeS3API) GetObjectLockConfiguration(arg1 *s3.GetObjectLockConfigur
 	fake.getObjectLockConfigurationArgsForCall = append(fake.getObjectLockConfigurationArgsForCall, struct {
 		arg1 *s3.GetObjectLockConfigurationInput
 	}{arg1})
+	stub := fake.GetObjectLockConfigurationStub
+	fakeReturns := fake.getObjectLockConfigurationReturns
 	fake.recordInvocation("GetObjectLockConfiguration", []interface{}{arg1})
 	fake.getObjectLockConfigurationMutex.Unlock()
-	if fake.GetObjectLockConfigurationStub != nil {
-		return fake.GetObjectLockConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectLockConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12003,15 +13772,16 @@ func (fake *FakeS3API) GetObjectLockConfigurationRequest(arg1 *s3.GetObjectLockC
 	fake.getObjectLockConfigurationRequestArgsForCall = append(fake.getObjectLockConfigurationRequestArgsForCall, struct {
 		arg1 *s3.GetObjectLockConfigurationInput
 	}{arg1})
+	stub := fake.GetObjectLockConfigurationRequestStub
+	fakeReturns := fake.getObjectLockConfigurationRequestReturns
 	fake.recordInvocation("GetObjectLockConfigurationRequest", []interface{}{arg1})
 	fake.getObjectLockConfigurationRequestMutex.Unlock()
-	if fake.GetObjectLockConfigurationRequestStub != nil {
-		return fake.GetObjectLockConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectLockConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12060,23 +13830,24 @@ func (fake *FakeS3API) GetObjectLockConfigurationRequestReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetObjectLockConfigurationWithContext(arg1 context.Context, arg2 *s3.GetObjectLockConfigurationInput, arg3 ...request.Option) (*s3.GetObjectLockConfigurationOutput, error) {
+func (fake *FakeS3API) GetObjectLockConfigurationWithContext(arg1 aws.Context, arg2 *s3.GetObjectLockConfigurationInput, arg3 ...request.Option) (*s3.GetObjectLockConfigurationOutput, error) {
 	fake.getObjectLockConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.getObjectLockConfigurationWithContextReturnsOnCall[len(fake.getObjectLockConfigurationWithContextArgsForCall)]
 	fake.getObjectLockConfigurationWithContextArgsForCall = append(fake.getObjectLockConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectLockConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetObjectLockConfigurationWithContextStub
+	fakeReturns := fake.getObjectLockConfigurationWithContextReturns
 	fake.recordInvocation("GetObjectLockConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getObjectLockConfigurationWithContextMutex.Unlock()
-	if fake.GetObjectLockConfigurationWithContextStub != nil {
-		return fake.GetObjectLockConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectLockConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12086,13 +13857,13 @@ func (fake *FakeS3API) GetObjectLockConfigurationWithContextCallCount() int {
 	return len(fake.getObjectLockConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetObjectLockConfigurationWithContextCalls(stub func(context.Context, *s3.GetObjectLockConfigurationInput, ...request.Option) (*s3.GetObjectLockConfigurationOutput, error)) {
+func (fake *FakeS3API) GetObjectLockConfigurationWithContextCalls(stub func(aws.Context, *s3.GetObjectLockConfigurationInput, ...request.Option) (*s3.GetObjectLockConfigurationOutput, error)) {
 	fake.getObjectLockConfigurationWithContextMutex.Lock()
 	defer fake.getObjectLockConfigurationWithContextMutex.Unlock()
 	fake.GetObjectLockConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetObjectLockConfigurationWithContextArgsForCall(i int) (context.Context, *s3.GetObjectLockConfigurationInput, []request.Option) {
+func (fake *FakeS3API) GetObjectLockConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.GetObjectLockConfigurationInput, []request.Option) {
 	fake.getObjectLockConfigurationWithContextMutex.RLock()
 	defer fake.getObjectLockConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.getObjectLockConfigurationWithContextArgsForCall[i]
@@ -12131,15 +13902,16 @@ func (fake *FakeS3API) GetObjectRequest(arg1 *s3.GetObjectInput) (*request.Reque
 	fake.getObjectRequestArgsForCall = append(fake.getObjectRequestArgsForCall, struct {
 		arg1 *s3.GetObjectInput
 	}{arg1})
+	stub := fake.GetObjectRequestStub
+	fakeReturns := fake.getObjectRequestReturns
 	fake.recordInvocation("GetObjectRequest", []interface{}{arg1})
 	fake.getObjectRequestMutex.Unlock()
-	if fake.GetObjectRequestStub != nil {
-		return fake.GetObjectRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12194,15 +13966,16 @@ func (fake *FakeS3API) GetObjectRetention(arg1 *s3.GetObjectRetentionInput) (*s3
 	fake.getObjectRetentionArgsForCall = append(fake.getObjectRetentionArgsForCall, struct {
 		arg1 *s3.GetObjectRetentionInput
 	}{arg1})
+	stub := fake.GetObjectRetentionStub
+	fakeReturns := fake.getObjectRetentionReturns
 	fake.recordInvocation("GetObjectRetention", []interface{}{arg1})
 	fake.getObjectRetentionMutex.Unlock()
-	if fake.GetObjectRetentionStub != nil {
-		return fake.GetObjectRetentionStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectRetentionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12257,15 +14030,16 @@ func (fake *FakeS3API) GetObjectRetentionRequest(arg1 *s3.GetObjectRetentionInpu
 	fake.getObjectRetentionRequestArgsForCall = append(fake.getObjectRetentionRequestArgsForCall, struct {
 		arg1 *s3.GetObjectRetentionInput
 	}{arg1})
+	stub := fake.GetObjectRetentionRequestStub
+	fakeReturns := fake.getObjectRetentionRequestReturns
 	fake.recordInvocation("GetObjectRetentionRequest", []interface{}{arg1})
 	fake.getObjectRetentionRequestMutex.Unlock()
-	if fake.GetObjectRetentionRequestStub != nil {
-		return fake.GetObjectRetentionRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectRetentionRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12314,23 +14088,24 @@ func (fake *FakeS3API) GetObjectRetentionRequestReturnsOnCall(i int, result1 *re
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetObjectRetentionWithContext(arg1 context.Context, arg2 *s3.GetObjectRetentionInput, arg3 ...request.Option) (*s3.GetObjectRetentionOutput, error) {
+func (fake *FakeS3API) GetObjectRetentionWithContext(arg1 aws.Context, arg2 *s3.GetObjectRetentionInput, arg3 ...request.Option) (*s3.GetObjectRetentionOutput, error) {
 	fake.getObjectRetentionWithContextMutex.Lock()
 	ret, specificReturn := fake.getObjectRetentionWithContextReturnsOnCall[len(fake.getObjectRetentionWithContextArgsForCall)]
 	fake.getObjectRetentionWithContextArgsForCall = append(fake.getObjectRetentionWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectRetentionInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetObjectRetentionWithContextStub
+	fakeReturns := fake.getObjectRetentionWithContextReturns
 	fake.recordInvocation("GetObjectRetentionWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getObjectRetentionWithContextMutex.Unlock()
-	if fake.GetObjectRetentionWithContextStub != nil {
-		return fake.GetObjectRetentionWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectRetentionWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12340,13 +14115,13 @@ func (fake *FakeS3API) GetObjectRetentionWithContextCallCount() int {
 	return len(fake.getObjectRetentionWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetObjectRetentionWithContextCalls(stub func(context.Context, *s3.GetObjectRetentionInput, ...request.Option) (*s3.GetObjectRetentionOutput, error)) {
+func (fake *FakeS3API) GetObjectRetentionWithContextCalls(stub func(aws.Context, *s3.GetObjectRetentionInput, ...request.Option) (*s3.GetObjectRetentionOutput, error)) {
 	fake.getObjectRetentionWithContextMutex.Lock()
 	defer fake.getObjectRetentionWithContextMutex.Unlock()
 	fake.GetObjectRetentionWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetObjectRetentionWithContextArgsForCall(i int) (context.Context, *s3.GetObjectRetentionInput, []request.Option) {
+func (fake *FakeS3API) GetObjectRetentionWithContextArgsForCall(i int) (aws.Context, *s3.GetObjectRetentionInput, []request.Option) {
 	fake.getObjectRetentionWithContextMutex.RLock()
 	defer fake.getObjectRetentionWithContextMutex.RUnlock()
 	argsForCall := fake.getObjectRetentionWithContextArgsForCall[i]
@@ -12385,15 +14160,16 @@ func (fake *FakeS3API) GetObjectTagging(arg1 *s3.GetObjectTaggingInput) (*s3.Get
 	fake.getObjectTaggingArgsForCall = append(fake.getObjectTaggingArgsForCall, struct {
 		arg1 *s3.GetObjectTaggingInput
 	}{arg1})
+	stub := fake.GetObjectTaggingStub
+	fakeReturns := fake.getObjectTaggingReturns
 	fake.recordInvocation("GetObjectTagging", []interface{}{arg1})
 	fake.getObjectTaggingMutex.Unlock()
-	if fake.GetObjectTaggingStub != nil {
-		return fake.GetObjectTaggingStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectTaggingReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12448,15 +14224,16 @@ func (fake *FakeS3API) GetObjectTaggingRequest(arg1 *s3.GetObjectTaggingInput) (
 	fake.getObjectTaggingRequestArgsForCall = append(fake.getObjectTaggingRequestArgsForCall, struct {
 		arg1 *s3.GetObjectTaggingInput
 	}{arg1})
+	stub := fake.GetObjectTaggingRequestStub
+	fakeReturns := fake.getObjectTaggingRequestReturns
 	fake.recordInvocation("GetObjectTaggingRequest", []interface{}{arg1})
 	fake.getObjectTaggingRequestMutex.Unlock()
-	if fake.GetObjectTaggingRequestStub != nil {
-		return fake.GetObjectTaggingRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectTaggingRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12505,23 +14282,24 @@ func (fake *FakeS3API) GetObjectTaggingRequestReturnsOnCall(i int, result1 *requ
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetObjectTaggingWithContext(arg1 context.Context, arg2 *s3.GetObjectTaggingInput, arg3 ...request.Option) (*s3.GetObjectTaggingOutput, error) {
+func (fake *FakeS3API) GetObjectTaggingWithContext(arg1 aws.Context, arg2 *s3.GetObjectTaggingInput, arg3 ...request.Option) (*s3.GetObjectTaggingOutput, error) {
 	fake.getObjectTaggingWithContextMutex.Lock()
 	ret, specificReturn := fake.getObjectTaggingWithContextReturnsOnCall[len(fake.getObjectTaggingWithContextArgsForCall)]
 	fake.getObjectTaggingWithContextArgsForCall = append(fake.getObjectTaggingWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectTaggingInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetObjectTaggingWithContextStub
+	fakeReturns := fake.getObjectTaggingWithContextReturns
 	fake.recordInvocation("GetObjectTaggingWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getObjectTaggingWithContextMutex.Unlock()
-	if fake.GetObjectTaggingWithContextStub != nil {
-		return fake.GetObjectTaggingWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectTaggingWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12531,13 +14309,13 @@ func (fake *FakeS3API) GetObjectTaggingWithContextCallCount() int {
 	return len(fake.getObjectTaggingWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetObjectTaggingWithContextCalls(stub func(context.Context, *s3.GetObjectTaggingInput, ...request.Option) (*s3.GetObjectTaggingOutput, error)) {
+func (fake *FakeS3API) GetObjectTaggingWithContextCalls(stub func(aws.Context, *s3.GetObjectTaggingInput, ...request.Option) (*s3.GetObjectTaggingOutput, error)) {
 	fake.getObjectTaggingWithContextMutex.Lock()
 	defer fake.getObjectTaggingWithContextMutex.Unlock()
 	fake.GetObjectTaggingWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetObjectTaggingWithContextArgsForCall(i int) (context.Context, *s3.GetObjectTaggingInput, []request.Option) {
+func (fake *FakeS3API) GetObjectTaggingWithContextArgsForCall(i int) (aws.Context, *s3.GetObjectTaggingInput, []request.Option) {
 	fake.getObjectTaggingWithContextMutex.RLock()
 	defer fake.getObjectTaggingWithContextMutex.RUnlock()
 	argsForCall := fake.getObjectTaggingWithContextArgsForCall[i]
@@ -12576,15 +14354,16 @@ func (fake *FakeS3API) GetObjectTorrent(arg1 *s3.GetObjectTorrentInput) (*s3.Get
 	fake.getObjectTorrentArgsForCall = append(fake.getObjectTorrentArgsForCall, struct {
 		arg1 *s3.GetObjectTorrentInput
 	}{arg1})
+	stub := fake.GetObjectTorrentStub
+	fakeReturns := fake.getObjectTorrentReturns
 	fake.recordInvocation("GetObjectTorrent", []interface{}{arg1})
 	fake.getObjectTorrentMutex.Unlock()
-	if fake.GetObjectTorrentStub != nil {
-		return fake.GetObjectTorrentStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectTorrentReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12639,15 +14418,16 @@ func (fake *FakeS3API) GetObjectTorrentRequest(arg1 *s3.GetObjectTorrentInput) (
 	fake.getObjectTorrentRequestArgsForCall = append(fake.getObjectTorrentRequestArgsForCall, struct {
 		arg1 *s3.GetObjectTorrentInput
 	}{arg1})
+	stub := fake.GetObjectTorrentRequestStub
+	fakeReturns := fake.getObjectTorrentRequestReturns
 	fake.recordInvocation("GetObjectTorrentRequest", []interface{}{arg1})
 	fake.getObjectTorrentRequestMutex.Unlock()
-	if fake.GetObjectTorrentRequestStub != nil {
-		return fake.GetObjectTorrentRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectTorrentRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12696,23 +14476,24 @@ func (fake *FakeS3API) GetObjectTorrentRequestReturnsOnCall(i int, result1 *requ
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetObjectTorrentWithContext(arg1 context.Context, arg2 *s3.GetObjectTorrentInput, arg3 ...request.Option) (*s3.GetObjectTorrentOutput, error) {
+func (fake *FakeS3API) GetObjectTorrentWithContext(arg1 aws.Context, arg2 *s3.GetObjectTorrentInput, arg3 ...request.Option) (*s3.GetObjectTorrentOutput, error) {
 	fake.getObjectTorrentWithContextMutex.Lock()
 	ret, specificReturn := fake.getObjectTorrentWithContextReturnsOnCall[len(fake.getObjectTorrentWithContextArgsForCall)]
 	fake.getObjectTorrentWithContextArgsForCall = append(fake.getObjectTorrentWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectTorrentInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetObjectTorrentWithContextStub
+	fakeReturns := fake.getObjectTorrentWithContextReturns
 	fake.recordInvocation("GetObjectTorrentWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getObjectTorrentWithContextMutex.Unlock()
-	if fake.GetObjectTorrentWithContextStub != nil {
-		return fake.GetObjectTorrentWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectTorrentWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12722,13 +14503,13 @@ func (fake *FakeS3API) GetObjectTorrentWithContextCallCount() int {
 	return len(fake.getObjectTorrentWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetObjectTorrentWithContextCalls(stub func(context.Context, *s3.GetObjectTorrentInput, ...request.Option) (*s3.GetObjectTorrentOutput, error)) {
+func (fake *FakeS3API) GetObjectTorrentWithContextCalls(stub func(aws.Context, *s3.GetObjectTorrentInput, ...request.Option) (*s3.GetObjectTorrentOutput, error)) {
 	fake.getObjectTorrentWithContextMutex.Lock()
 	defer fake.getObjectTorrentWithContextMutex.Unlock()
 	fake.GetObjectTorrentWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetObjectTorrentWithContextArgsForCall(i int) (context.Context, *s3.GetObjectTorrentInput, []request.Option) {
+func (fake *FakeS3API) GetObjectTorrentWithContextArgsForCall(i int) (aws.Context, *s3.GetObjectTorrentInput, []request.Option) {
 	fake.getObjectTorrentWithContextMutex.RLock()
 	defer fake.getObjectTorrentWithContextMutex.RUnlock()
 	argsForCall := fake.getObjectTorrentWithContextArgsForCall[i]
@@ -12761,23 +14542,24 @@ func (fake *FakeS3API) GetObjectTorrentWithContextReturnsOnCall(i int, result1 *
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetObjectWithContext(arg1 context.Context, arg2 *s3.GetObjectInput, arg3 ...request.Option) (*s3.GetObjectOutput, error) {
+func (fake *FakeS3API) GetObjectWithContext(arg1 aws.Context, arg2 *s3.GetObjectInput, arg3 ...request.Option) (*s3.GetObjectOutput, error) {
 	fake.getObjectWithContextMutex.Lock()
 	ret, specificReturn := fake.getObjectWithContextReturnsOnCall[len(fake.getObjectWithContextArgsForCall)]
 	fake.getObjectWithContextArgsForCall = append(fake.getObjectWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetObjectInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetObjectWithContextStub
+	fakeReturns := fake.getObjectWithContextReturns
 	fake.recordInvocation("GetObjectWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getObjectWithContextMutex.Unlock()
-	if fake.GetObjectWithContextStub != nil {
-		return fake.GetObjectWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getObjectWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12787,13 +14569,13 @@ func (fake *FakeS3API) GetObjectWithContextCallCount() int {
 	return len(fake.getObjectWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetObjectWithContextCalls(stub func(context.Context, *s3.GetObjectInput, ...request.Option) (*s3.GetObjectOutput, error)) {
+func (fake *FakeS3API) GetObjectWithContextCalls(stub func(aws.Context, *s3.GetObjectInput, ...request.Option) (*s3.GetObjectOutput, error)) {
 	fake.getObjectWithContextMutex.Lock()
 	defer fake.getObjectWithContextMutex.Unlock()
 	fake.GetObjectWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetObjectWithContextArgsForCall(i int) (context.Context, *s3.GetObjectInput, []request.Option) {
+func (fake *FakeS3API) GetObjectWithContextArgsForCall(i int) (aws.Context, *s3.GetObjectInput, []request.Option) {
 	fake.getObjectWithContextMutex.RLock()
 	defer fake.getObjectWithContextMutex.RUnlock()
 	argsForCall := fake.getObjectWithContextArgsForCall[i]
@@ -12832,15 +14614,16 @@ func (fake *FakeS3API) GetPublicAccessBlock(arg1 *s3.GetPublicAccessBlockInput)
 	fake.getPublicAccessBlockArgsForCall = append(fake.getPublicAccessBlockArgsForCall, struct {
 		arg1 *s3.GetPublicAccessBlockInput
 	}{arg1})
+	stub := fake.GetPublicAccessBlockStub
+	fakeReturns := fake.getPublicAccessBlockReturns
 	fake.recordInvocation("GetPublicAccessBlock", []interface{}{arg1})
 	fake.getPublicAccessBlockMutex.Unlock()
-	if fake.GetPublicAccessBlockStub != nil {
-		return fake.GetPublicAccessBlockStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getPublicAccessBlockReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12895,15 +14678,16 @@ func (fake *FakeS3API) GetPublicAccessBlockRequest(arg1 *s3.GetPublicAccessBlock
 	fake.getPublicAccessBlockRequestArgsForCall = append(fake.getPublicAccessBlockRequestArgsForCall, struct {
 		arg1 *s3.GetPublicAccessBlockInput
 	}{arg1})
+	stub := fake.GetPublicAccessBlockRequestStub
+	fakeReturns := fake.getPublicAccessBlockRequestReturns
 	fake.recordInvocation("GetPublicAccessBlockRequest", []interface{}{arg1})
 	fake.getPublicAccessBlockRequestMutex.Unlock()
-	if fake.GetPublicAccessBlockRequestStub != nil {
-		return fake.GetPublicAccessBlockRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getPublicAccessBlockRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12952,23 +14736,24 @@ func (fake *FakeS3API) GetPublicAccessBlockRequestReturnsOnCall(i int, result1 *
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) GetPublicAccessBlockWithContext(arg1 context.Context, arg2 *s3.GetPublicAccessBlockInput, arg3 ...request.Option) (*s3.GetPublicAccessBlockOutput, error) {
+func (fake *FakeS3API) GetPublicAccessBlockWithContext(arg1 aws.Context, arg2 *s3.GetPublicAccessBlockInput, arg3 ...request.Option) (*s3.GetPublicAccessBlockOutput, error) {
 	fake.getPublicAccessBlockWithContextMutex.Lock()
 	ret, specificReturn := fake.getPublicAccessBlockWithContextReturnsOnCall[len(fake.getPublicAccessBlockWithContextArgsForCall)]
 	fake.getPublicAccessBlockWithContextArgsForCall = append(fake.getPublicAccessBlockWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.GetPublicAccessBlockInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.GetPublicAccessBlockWithContextStub
+	fakeReturns := fake.getPublicAccessBlockWithContextReturns
 	fake.recordInvocation("GetPublicAccessBlockWithContext", []interface{}{arg1, arg2, arg3})
 	fake.getPublicAccessBlockWithContextMutex.Unlock()
-	if fake.GetPublicAccessBlockWithContextStub != nil {
-		return fake.GetPublicAccessBlockWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getPublicAccessBlockWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -12978,13 +14763,13 @@ func (fake *FakeS3API) GetPublicAccessBlockWithContextCallCount() int {
 	return len(fake.getPublicAccessBlockWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) GetPublicAccessBlockWithContextCalls(stub func(context.Context, *s3.GetPublicAccessBlockInput, ...request.Option) (*s3.GetPublicAccessBlockOutput, error)) {
+func (fake *FakeS3API) GetPublicAccessBlockWithContextCalls(stub func(aws.Context, *s3.GetPublicAccessBlockInput, ...request.Option) (*s3.GetPublicAccessBlockOutput, error)) {
 	fake.getPublicAccessBlockWithContextMutex.Lock()
 	defer fake.getPublicAccessBlockWithContextMutex.Unlock()
 	fake.GetPublicAccessBlockWithContextStub = stub
 }
 
-func (fake *FakeS3API) GetPublicAccessBlockWithContextArgsForCall(i int) (context.Context, *s3.GetPublicAccessBlockInput, []request.Option) {
+func (fake *FakeS3API) GetPublicAccessBlockWithContextArgsForCall(i int) (aws.Context, *s3.GetPublicAccessBlockInput, []request.Option) {
 	fake.getPublicAccessBlockWithContextMutex.RLock()
 	defer fake.getPublicAccessBlockWithContextMutex.RUnlock()
 	argsForCall := fake.getPublicAccessBlockWithContextArgsForCall[i]
@@ -13023,15 +14808,16 @@ func (fake *FakeS3API) HeadBucket(arg1 *s3.HeadBucketInput) (*s3.HeadBucketOutpu
 	fake.headBucketArgsForCall = append(fake.headBucketArgsForCall, struct {
 		arg1 *s3.HeadBucketInput
 	}{arg1})
+	stub := fake.HeadBucketStub
+	fakeReturns := fake.headBucketReturns
 	fake.recordInvocation("HeadBucket", []interface{}{arg1})
 	fake.headBucketMutex.Unlock()
-	if fake.HeadBucketStub != nil {
-		return fake.HeadBucketStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.headBucketReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13086,15 +14872,16 @@ func (fake *FakeS3API) HeadBucketRequest(arg1 *s3.HeadBucketInput) (*request.Req
 	fake.headBucketRequestArgsForCall = append(fake.headBucketRequestArgsForCall, struct {
 		arg1 *s3.HeadBucketInput
 	}{arg1})
+	stub := fake.HeadBucketRequestStub
+	fakeReturns := fake.headBucketRequestReturns
 	fake.recordInvocation("HeadBucketRequest", []interface{}{arg1})
 	fake.headBucketRequestMutex.Unlock()
-	if fake.HeadBucketRequestStub != nil {
-		return fake.HeadBucketRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.headBucketRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13143,23 +14930,24 @@ func (fake *FakeS3API) HeadBucketRequestReturnsOnCall(i int, result1 *request.Re
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) HeadBucketWithContext(arg1 context.Context, arg2 *s3.HeadBucketInput, arg3 ...request.Option) (*s3.HeadBucketOutput, error) {
+func (fake *FakeS3API) HeadBucketWithContext(arg1 aws.Context, arg2 *s3.HeadBucketInput, arg3 ...request.Option) (*s3.HeadBucketOutput, error) {
 	fake.headBucketWithContextMutex.Lock()
 	ret, specificReturn := fake.headBucketWithContextReturnsOnCall[len(fake.headBucketWithContextArgsForCall)]
 	fake.headBucketWithContextArgsForCall = append(fake.headBucketWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.HeadBucketInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.HeadBucketWithContextStub
+	fakeReturns := fake.headBucketWithContextReturns
 	fake.recordInvocation("HeadBucketWithContext", []interface{}{arg1, arg2, arg3})
 	fake.headBucketWithContextMutex.Unlock()
-	if fake.HeadBucketWithContextStub != nil {
-		return fake.HeadBucketWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.headBucketWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13169,13 +14957,13 @@ func (fake *FakeS3API) HeadBucketWithContextCallCount() int {
 	return len(fake.headBucketWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) HeadBucketWithContextCalls(stub func(context.Context, *s3.HeadBucketInput, ...request.Option) (*s3.HeadBucketOutput, error)) {
+func (fake *FakeS3API) HeadBucketWithContextCalls(stub func(aws.Context, *s3.HeadBucketInput, ...request.Option) (*s3.HeadBucketOutput, error)) {
 	fake.headBucketWithContextMutex.Lock()
 	defer fake.headBucketWithContextMutex.Unlock()
 	fake.HeadBucketWithContextStub = stub
 }
 
-func (fake *FakeS3API) HeadBucketWithContextArgsForCall(i int) (context.Context, *s3.HeadBucketInput, []request.Option) {
+func (fake *FakeS3API) HeadBucketWithContextArgsForCall(i int) (aws.Context, *s3.HeadBucketInput, []request.Option) {
 	fake.headBucketWithContextMutex.RLock()
 	defer fake.headBucketWithContextMutex.RUnlock()
 	argsForCall := fake.headBucketWithContextArgsForCall[i]
@@ -13214,15 +15002,16 @@ func (fake *FakeS3API) HeadObject(arg1 *s3.HeadObjectInput) (*s3.HeadObjectOutpu
 	fake.headObjectArgsForCall = append(fake.headObjectArgsForCall, struct {
 		arg1 *s3.HeadObjectInput
 	}{arg1})
+	stub := fake.HeadObjectStub
+	fakeReturns := fake.headObjectReturns
 	fake.recordInvocation("HeadObject", []interface{}{arg1})
 	fake.headObjectMutex.Unlock()
-	if fake.HeadObjectStub != nil {
-		return fake.HeadObjectStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.headObjectReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13277,15 +15066,16 @@ func (fake *FakeS3API) HeadObjectRequest(arg1 *s3.HeadObjectInput) (*request.Req
 	fake.headObjectRequestArgsForCall = append(fake.headObjectRequestArgsForCall, struct {
 		arg1 *s3.HeadObjectInput
 	}{arg1})
+	stub := fake.HeadObjectRequestStub
+	fakeReturns := fake.headObjectRequestReturns
 	fake.recordInvocation("HeadObjectRequest", []interface{}{arg1})
 	fake.headObjectRequestMutex.Unlock()
-	if fake.HeadObjectRequestStub != nil {
-		return fake.HeadObjectRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.headObjectRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13334,23 +15124,24 @@ func (fake *FakeS3API) HeadObjectRequestReturnsOnCall(i int, result1 *request.Re
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) HeadObjectWithContext(arg1 context.Context, arg2 *s3.HeadObjectInput, arg3 ...request.Option) (*s3.HeadObjectOutput, error) {
+func (fake *FakeS3API) HeadObjectWithContext(arg1 aws.Context, arg2 *s3.HeadObjectInput, arg3 ...request.Option) (*s3.HeadObjectOutput, error) {
 	fake.headObjectWithContextMutex.Lock()
 	ret, specificReturn := fake.headObjectWithContextReturnsOnCall[len(fake.headObjectWithContextArgsForCall)]
 	fake.headObjectWithContextArgsForCall = append(fake.headObjectWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.HeadObjectInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.HeadObjectWithContextStub
+	fakeReturns := fake.headObjectWithContextReturns
 	fake.recordInvocation("HeadObjectWithContext", []interface{}{arg1, arg2, arg3})
 	fake.headObjectWithContextMutex.Unlock()
-	if fake.HeadObjectWithContextStub != nil {
-		return fake.HeadObjectWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.headObjectWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13360,13 +15151,13 @@ func (fake *FakeS3API) HeadObjectWithContextCallCount() int {
 	return len(fake.headObjectWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) HeadObjectWithContextCalls(stub func(context.Context, *s3.HeadObjectInput, ...request.Option) (*s3.HeadObjectOutput, error)) {
+func (fake *FakeS3API) HeadObjectWithContextCalls(stub func(aws.Context, *s3.HeadObjectInput, ...request.Option) (*s3.HeadObjectOutput, error)) {
 	fake.headObjectWithContextMutex.Lock()
 	defer fake.headObjectWithContextMutex.Unlock()
 	fake.HeadObjectWithContextStub = stub
 }
 
-func (fake *FakeS3API) HeadObjectWithContextArgsForCall(i int) (context.Context, *s3.HeadObjectInput, []request.Option) {
+func (fake *FakeS3API) HeadObjectWithContextArgsForCall(i int) (aws.Context, *s3.HeadObjectInput, []request.Option) {
 	fake.headObjectWithContextMutex.RLock()
 	defer fake.headObjectWithContextMutex.RUnlock()
 	argsForCall := fake.headObjectWithContextArgsForCall[i]
@@ -13405,15 +15196,16 @@ func (fake *FakeS3API) ListBucketAnalyticsConfigurations(arg1 *s3.ListBucketAnal
 	fake.listBucketAnalyticsConfigurationsArgsForCall = append(fake.listBucketAnalyticsConfigurationsArgsForCall, struct {
 		arg1 *s3.ListBucketAnalyticsConfigurationsInput
 	}{arg1})
+	stub := fake.ListBucketAnalyticsConfigurationsStub
+	fakeReturns := fake.listBucketAnalyticsConfigurationsReturns
 	fake.recordInvocation("ListBucketAnalyticsConfigurations", []interface{}{arg1})
 	fake.listBucketAnalyticsConfigurationsMutex.Unlock()
-	if fake.ListBucketAnalyticsConfigurationsStub != nil {
-		return fake.ListBucketAnalyticsConfigurationsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBucketAnalyticsConfigurationsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13468,15 +15260,16 @@ func (fake *FakeS3API) ListBucketAnalyticsConfigurationsRequest(arg1 *s3.ListBuc
 	fake.listBucketAnalyticsConfigurationsRequestArgsForCall = append(fake.listBucketAnalyticsConfigurationsRequestArgsForCall, struct {
 		arg1 *s3.ListBucketAnalyticsConfigurationsInput
 	}{arg1})
+	stub := fake.ListBucketAnalyticsConfigurationsRequestStub
+	fakeReturns := fake.listBucketAnalyticsConfigurationsRequestReturns
 	fake.recordInvocation("ListBucketAnalyticsConfigurationsRequest", []interface{}{arg1})
 	fake.listBucketAnalyticsConfigurationsRequestMutex.Unlock()
-	if fake.ListBucketAnalyticsConfigurationsRequestStub != nil {
-		return fake.ListBucketAnalyticsConfigurationsRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBucketAnalyticsConfigurationsRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13525,23 +15318,24 @@ func (fake *FakeS3API) ListBucketAnalyticsConfigurationsRequestReturnsOnCall(i i
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) ListBucketAnalyticsConfigurationsWithContext(arg1 context.Context, arg2 *s3.ListBucketAnalyticsConfigurationsInput, arg3 ...request.Option) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+func (fake *FakeS3API) ListBucketAnalyticsConfigurationsWithContext(arg1 aws.Context, arg2 *s3.ListBucketAnalyticsConfigurationsInput, arg3 ...request.Option) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
 	fake.listBucketAnalyticsConfigurationsWithContextMutex.Lock()
 	ret, specificReturn := fake.listBucketAnalyticsConfigurationsWithContextReturnsOnCall[len(fake.listBucketAnalyticsConfigurationsWithContextArgsForCall)]
 	fake.listBucketAnalyticsConfigurationsWithContextArgsForCall = append(fake.listBucketAnalyticsConfigurationsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListBucketAnalyticsConfigurationsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.ListBucketAnalyticsConfigurationsWithContextStub
+	fakeReturns := fake.listBucketAnalyticsConfigurationsWithContextReturns
 	fake.recordInvocation("ListBucketAnalyticsConfigurationsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.listBucketAnalyticsConfigurationsWithContextMutex.Unlock()
-	if fake.ListBucketAnalyticsConfigurationsWithContextStub != nil {
-		return fake.ListBucketAnalyticsConfigurationsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBucketAnalyticsConfigurationsWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13551,13 +15345,13 @@ func (fake *FakeS3API) ListBucketAnalyticsConfigurationsWithContextCallCount() i
 	return len(fake.listBucketAnalyticsConfigurationsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListBucketAnalyticsConfigurationsWithContextCalls(stub func(context.Context, *s3.ListBucketAnalyticsConfigurationsInput, ...request.Option) (*s3.ListBucketAnalyticsConfigurationsOutput, error)) {
+func (fake *FakeS3API) ListBucketAnalyticsConfigurationsWithContextCalls(stub func(aws.Context, *s3.ListBucketAnalyticsConfigurationsInput, ...request.Option) (*s3.ListBucketAnalyticsConfigurationsOutput, error)) {
 	fake.listBucketAnalyticsConfigurationsWithContextMutex.Lock()
 	defer fake.listBucketAnalyticsConfigurationsWithContextMutex.Unlock()
 	fake.ListBucketAnalyticsConfigurationsWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListBucketAnalyticsConfigurationsWithContextArgsForCall(i int) (context.Context, *s3.ListBucketAnalyticsConfigurationsInput, []request.Option) {
+func (fake *FakeS3API) ListBucketAnalyticsConfigurationsWithContextArgsForCall(i int) (aws.Context, *s3.ListBucketAnalyticsConfigurationsInput, []request.Option) {
 	fake.listBucketAnalyticsConfigurationsWithContextMutex.RLock()
 	defer fake.listBucketAnalyticsConfigurationsWithContextMutex.RUnlock()
 	argsForCall := fake.listBucketAnalyticsConfigurationsWithContextArgsForCall[i]
@@ -13590,21 +15384,216 @@ func (fake *FakeS3API) ListBucketAnalyticsConfigurationsWithContextReturnsOnCall
 	}{result1, result2}
 }
 
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurations(arg1 *s3.ListBucketIntelligentTieringConfigurationsInput) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	fake.listBucketIntelligentTieringConfigurationsMutex.Lock()
+	ret, specificReturn := fake.listBucketIntelligentTieringConfigurationsReturnsOnCall[len(fake.listBucketIntelligentTieringConfigurationsArgsForCall)]
+	fake.listBucketIntelligentTieringConfigurationsArgsForCall = append(fake.listBucketIntelligentTieringConfigurationsArgsForCall, struct {
+		arg1 *s3.ListBucketIntelligentTieringConfigurationsInput
+	}{arg1})
+	stub := fake.ListBucketIntelligentTieringConfigurationsStub
+	fakeReturns := fake.listBucketIntelligentTieringConfigurationsReturns
+	fake.recordInvocation("ListBucketIntelligentTieringConfigurations", []interface{}{arg1})
+	fake.listBucketIntelligentTieringConfigurationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsCallCount() int {
+	fake.listBucketIntelligentTieringConfigurationsMutex.RLock()
+	defer fake.listBucketIntelligentTieringConfigurationsMutex.RUnlock()
+	return len(fake.listBucketIntelligentTieringConfigurationsArgsForCall)
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsCalls(stub func(*s3.ListBucketIntelligentTieringConfigurationsInput) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)) {
+	fake.listBucketIntelligentTieringConfigurationsMutex.Lock()
+	defer fake.listBucketIntelligentTieringConfigurationsMutex.Unlock()
+	fake.ListBucketIntelligentTieringConfigurationsStub = stub
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsArgsForCall(i int) *s3.ListBucketIntelligentTieringConfigurationsInput {
+	fake.listBucketIntelligentTieringConfigurationsMutex.RLock()
+	defer fake.listBucketIntelligentTieringConfigurationsMutex.RUnlock()
+	argsForCall := fake.listBucketIntelligentTieringConfigurationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsReturns(result1 *s3.ListBucketIntelligentTieringConfigurationsOutput, result2 error) {
+	fake.listBucketIntelligentTieringConfigurationsMutex.Lock()
+	defer fake.listBucketIntelligentTieringConfigurationsMutex.Unlock()
+	fake.ListBucketIntelligentTieringConfigurationsStub = nil
+	fake.listBucketIntelligentTieringConfigurationsReturns = struct {
+		result1 *s3.ListBucketIntelligentTieringConfigurationsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsReturnsOnCall(i int, result1 *s3.ListBucketIntelligentTieringConfigurationsOutput, result2 error) {
+	fake.listBucketIntelligentTieringConfigurationsMutex.Lock()
+	defer fake.listBucketIntelligentTieringConfigurationsMutex.Unlock()
+	fake.ListBucketIntelligentTieringConfigurationsStub = nil
+	if fake.listBucketIntelligentTieringConfigurationsReturnsOnCall == nil {
+		fake.listBucketIntelligentTieringConfigurationsReturnsOnCall = make(map[int]struct {
+			result1 *s3.ListBucketIntelligentTieringConfigurationsOutput
+			result2 error
+		})
+	}
+	fake.listBucketIntelligentTieringConfigurationsReturnsOnCall[i] = struct {
+		result1 *s3.ListBucketIntelligentTieringConfigurationsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsRequest(arg1 *s3.ListBucketIntelligentTieringConfigurationsInput) (*request.Request, *s3.ListBucketIntelligentTieringConfigurationsOutput) {
+	fake.listBucketIntelligentTieringConfigurationsRequestMutex.Lock()
+	ret, specificReturn := fake.listBucketIntelligentTieringConfigurationsRequestReturnsOnCall[len(fake.listBucketIntelligentTieringConfigurationsRequestArgsForCall)]
+	fake.listBucketIntelligentTieringConfigurationsRequestArgsForCall = append(fake.listBucketIntelligentTieringConfigurationsRequestArgsForCall, struct {
+		arg1 *s3.ListBucketIntelligentTieringConfigurationsInput
+	}{arg1})
+	stub := fake.ListBucketIntelligentTieringConfigurationsRequestStub
+	fakeReturns := fake.listBucketIntelligentTieringConfigurationsRequestReturns
+	fake.recordInvocation("ListBucketIntelligentTieringConfigurationsRequest", []interface{}{arg1})
+	fake.listBucketIntelligentTieringConfigurationsRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsRequestCallCount() int {
+	fake.listBucketIntelligentTieringConfigurationsRequestMutex.RLock()
+	defer fake.listBucketIntelligentTieringConfigurationsRequestMutex.RUnlock()
+	return len(fake.listBucketIntelligentTieringConfigurationsRequestArgsForCall)
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsRequestCalls(stub func(*s3.ListBucketIntelligentTieringConfigurationsInput) (*request.Request, *s3.ListBucketIntelligentTieringConfigurationsOutput)) {
+	fake.listBucketIntelligentTieringConfigurationsRequestMutex.Lock()
+	defer fake.listBucketIntelligentTieringConfigurationsRequestMutex.Unlock()
+	fake.ListBucketIntelligentTieringConfigurationsRequestStub = stub
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsRequestArgsForCall(i int) *s3.ListBucketIntelligentTieringConfigurationsInput {
+	fake.listBucketIntelligentTieringConfigurationsRequestMutex.RLock()
+	defer fake.listBucketIntelligentTieringConfigurationsRequestMutex.RUnlock()
+	argsForCall := fake.listBucketIntelligentTieringConfigurationsRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsRequestReturns(result1 *request.Request, result2 *s3.ListBucketIntelligentTieringConfigurationsOutput) {
+	fake.listBucketIntelligentTieringConfigurationsRequestMutex.Lock()
+	defer fake.listBucketIntelligentTieringConfigurationsRequestMutex.Unlock()
+	fake.ListBucketIntelligentTieringConfigurationsRequestStub = nil
+	fake.listBucketIntelligentTieringConfigurationsRequestReturns = struct {
+		result1 *request.Request
+		result2 *s3.ListBucketIntelligentTieringConfigurationsOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.ListBucketIntelligentTieringConfigurationsOutput) {
+	fake.listBucketIntelligentTieringConfigurationsRequestMutex.Lock()
+	defer fake.listBucketIntelligentTieringConfigurationsRequestMutex.Unlock()
+	fake.ListBucketIntelligentTieringConfigurationsRequestStub = nil
+	if fake.listBucketIntelligentTieringConfigurationsRequestReturnsOnCall == nil {
+		fake.listBucketIntelligentTieringConfigurationsRequestReturnsOnCall = make(map[int]struct {
+			result1 *request.Request
+			result2 *s3.ListBucketIntelligentTieringConfigurationsOutput
+		})
+	}
+	fake.listBucketIntelligentTieringConfigurationsRequestReturnsOnCall[i] = struct {
+		result1 *request.Request
+		result2 *s3.ListBucketIntelligentTieringConfigurationsOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsWithContext(arg1 aws.Context, arg2 *s3.ListBucketIntelligentTieringConfigurationsInput, arg3 ...request.Option) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	fake.listBucketIntelligentTieringConfigurationsWithContextMutex.Lock()
+	ret, specificReturn := fake.listBucketIntelligentTieringConfigurationsWithContextReturnsOnCall[len(fake.listBucketIntelligentTieringConfigurationsWithContextArgsForCall)]
+	fake.listBucketIntelligentTieringConfigurationsWithContextArgsForCall = append(fake.listBucketIntelligentTieringConfigurationsWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.ListBucketIntelligentTieringConfigurationsInput
+		arg3 []request.Option
+	}{arg1, arg2, arg3})
+	stub := fake.ListBucketIntelligentTieringConfigurationsWithContextStub
+	fakeReturns := fake.listBucketIntelligentTieringConfigurationsWithContextReturns
+	fake.recordInvocation("ListBucketIntelligentTieringConfigurationsWithContext", []interface{}{arg1, arg2, arg3})
+	fake.listBucketIntelligentTieringConfigurationsWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsWithContextCallCount() int {
+	fake.listBucketIntelligentTieringConfigurationsWithContextMutex.RLock()
+	defer fake.listBucketIntelligentTieringConfigurationsWithContextMutex.RUnlock()
+	return len(fake.listBucketIntelligentTieringConfigurationsWithContextArgsForCall)
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsWithContextCalls(stub func(aws.Context, *s3.ListBucketIntelligentTieringConfigurationsInput, ...request.Option) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)) {
+	fake.listBucketIntelligentTieringConfigurationsWithContextMutex.Lock()
+	defer fake.listBucketIntelligentTieringConfigurationsWithContextMutex.Unlock()
+	fake.ListBucketIntelligentTieringConfigurationsWithContextStub = stub
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsWithContextArgsForCall(i int) (aws.Context, *s3.ListBucketIntelligentTieringConfigurationsInput, []request.Option) {
+	fake.listBucketIntelligentTieringConfigurationsWithContextMutex.RLock()
+	defer fake.listBucketIntelligentTieringConfigurationsWithContextMutex.RUnlock()
+	argsForCall := fake.listBucketIntelligentTieringConfigurationsWithContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsWithContextReturns(result1 *s3.ListBucketIntelligentTieringConfigurationsOutput, result2 error) {
+	fake.listBucketIntelligentTieringConfigurationsWithContextMutex.Lock()
+	defer fake.listBucketIntelligentTieringConfigurationsWithContextMutex.Unlock()
+	fake.ListBucketIntelligentTieringConfigurationsWithContextStub = nil
+	fake.listBucketIntelligentTieringConfigurationsWithContextReturns = struct {
+		result1 *s3.ListBucketIntelligentTieringConfigurationsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) ListBucketIntelligentTieringConfigurationsWithContextReturnsOnCall(i int, result1 *s3.ListBucketIntelligentTieringConfigurationsOutput, result2 error) {
+	fake.listBucketIntelligentTieringConfigurationsWithContextMutex.Lock()
+	defer fake.listBucketIntelligentTieringConfigurationsWithContextMutex.Unlock()
+	fake.ListBucketIntelligentTieringConfigurationsWithContextStub = nil
+	if fake.listBucketIntelligentTieringConfigurationsWithContextReturnsOnCall == nil {
+		fake.listBucketIntelligentTieringConfigurationsWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.ListBucketIntelligentTieringConfigurationsOutput
+			result2 error
+		})
+	}
+	fake.listBucketIntelligentTieringConfigurationsWithContextReturnsOnCall[i] = struct {
+		result1 *s3.ListBucketIntelligentTieringConfigurationsOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeS3API) ListBucketInventoryConfigurations(arg1 *s3.ListBucketInventoryConfigurationsInput) (*s3.ListBucketInventoryConfigurationsOutput, error) {
 	fake.listBucketInventoryConfigurationsMutex.Lock()
 	ret, specificReturn := fake.listBucketInventoryConfigurationsReturnsOnCall[len(fake.listBucketInventoryConfigurationsArgsForCall)]
 	fake.listBucketInventoryConfigurationsArgsForCall = append(fake.listBucketInventoryConfigurationsArgsForCall, struct {
 		arg1 *s3.ListBucketInventoryConfigurationsInput
 	}{arg1})
+	stub := fake.ListBucketInventoryConfigurationsStub
+	fakeReturns := fake.listBucketInventoryConfigurationsReturns
 	fake.recordInvocation("ListBucketInventoryConfigurations", []interface{}{arg1})
 	fake.listBucketInventoryConfigurationsMutex.Unlock()
-	if fake.ListBucketInventoryConfigurationsStub != nil {
-		return fake.ListBucketInventoryConfigurationsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBucketInventoryConfigurationsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13659,15 +15648,16 @@ func (fake *FakeS3API) ListBucketInventoryConfigurationsRequest(arg1 *s3.ListBuc
 	fake.listBucketInventoryConfigurationsRequestArgsForCall = append(fake.listBucketInventoryConfigurationsRequestArgsForCall, struct {
 		arg1 *s3.ListBucketInventoryConfigurationsInput
 	}{arg1})
+	stub := fake.ListBucketInventoryConfigurationsRequestStub
+	fakeReturns := fake.listBucketInventoryConfigurationsRequestReturns
 	fake.recordInvocation("ListBucketInventoryConfigurationsRequest", []interface{}{arg1})
 	fake.listBucketInventoryConfigurationsRequestMutex.Unlock()
-	if fake.ListBucketInventoryConfigurationsRequestStub != nil {
-		return fake.ListBucketInventoryConfigurationsRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBucketInventoryConfigurationsRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13716,23 +15706,24 @@ func (fake *FakeS3API) ListBucketInventoryConfigurationsRequestReturnsOnCall(i i
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) ListBucketInventoryConfigurationsWithContext(arg1 context.Context, arg2 *s3.ListBucketInventoryConfigurationsInput, arg3 ...request.Option) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+func (fake *FakeS3API) ListBucketInventoryConfigurationsWithContext(arg1 aws.Context, arg2 *s3.ListBucketInventoryConfigurationsInput, arg3 ...request.Option) (*s3.ListBucketInventoryConfigurationsOutput, error) {
 	fake.listBucketInventoryConfigurationsWithContextMutex.Lock()
 	ret, specificReturn := fake.listBucketInventoryConfigurationsWithContextReturnsOnCall[len(fake.listBucketInventoryConfigurationsWithContextArgsForCall)]
 	fake.listBucketInventoryConfigurationsWithContextArgsForCall = append(fake.listBucketInventoryConfigurationsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListBucketInventoryConfigurationsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.ListBucketInventoryConfigurationsWithContextStub
+	fakeReturns := fake.listBucketInventoryConfigurationsWithContextReturns
 	fake.recordInvocation("ListBucketInventoryConfigurationsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.listBucketInventoryConfigurationsWithContextMutex.Unlock()
-	if fake.ListBucketInventoryConfigurationsWithContextStub != nil {
-		return fake.ListBucketInventoryConfigurationsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBucketInventoryConfigurationsWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13742,13 +15733,13 @@ func (fake *FakeS3API) ListBucketInventoryConfigurationsWithContextCallCount() i
 	return len(fake.listBucketInventoryConfigurationsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListBucketInventoryConfigurationsWithContextCalls(stub func(context.Context, *s3.ListBucketInventoryConfigurationsInput, ...request.Option) (*s3.ListBucketInventoryConfigurationsOutput, error)) {
+func (fake *FakeS3API) ListBucketInventoryConfigurationsWithContextCalls(stub func(aws.Context, *s3.ListBucketInventoryConfigurationsInput, ...request.Option) (*s3.ListBucketInventoryConfigurationsOutput, error)) {
 	fake.listBucketInventoryConfigurationsWithContextMutex.Lock()
 	defer fake.listBucketInventoryConfigurationsWithContextMutex.Unlock()
 	fake.ListBucketInventoryConfigurationsWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListBucketInventoryConfigurationsWithContextArgsForCall(i int) (context.Context, *s3.ListBucketInventoryConfigurationsInput, []request.Option) {
+func (fake *FakeS3API) ListBucketInventoryConfigurationsWithContextArgsForCall(i int) (aws.Context, *s3.ListBucketInventoryConfigurationsInput, []request.Option) {
 	fake.listBucketInventoryConfigurationsWithContextMutex.RLock()
 	defer fake.listBucketInventoryConfigurationsWithContextMutex.RUnlock()
 	argsForCall := fake.listBucketInventoryConfigurationsWithContextArgsForCall[i]
@@ -13787,15 +15778,16 @@ func (fake *FakeS3API) ListBucketMetricsConfigurations(arg1 *s3.ListBucketMetric
 	fake.listBucketMetricsConfigurationsArgsForCall = append(fake.listBucketMetricsConfigurationsArgsForCall, struct {
 		arg1 *s3.ListBucketMetricsConfigurationsInput
 	}{arg1})
+	stub := fake.ListBucketMetricsConfigurationsStub
+	fakeReturns := fake.listBucketMetricsConfigurationsReturns
 	fake.recordInvocation("ListBucketMetricsConfigurations", []interface{}{arg1})
 	fake.listBucketMetricsConfigurationsMutex.Unlock()
-	if fake.ListBucketMetricsConfigurationsStub != nil {
-		return fake.ListBucketMetricsConfigurationsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBucketMetricsConfigurationsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13850,15 +15842,16 @@ func (fake *FakeS3API) ListBucketMetricsConfigurationsRequest(arg1 *s3.ListBucke
 	fake.listBucketMetricsConfigurationsRequestArgsForCall = append(fake.listBucketMetricsConfigurationsRequestArgsForCall, struct {
 		arg1 *s3.ListBucketMetricsConfigurationsInput
 	}{arg1})
+	stub := fake.ListBucketMetricsConfigurationsRequestStub
+	fakeReturns := fake.listBucketMetricsConfigurationsRequestReturns
 	fake.recordInvocation("ListBucketMetricsConfigurationsRequest", []interface{}{arg1})
 	fake.listBucketMetricsConfigurationsRequestMutex.Unlock()
-	if fake.ListBucketMetricsConfigurationsRequestStub != nil {
-		return fake.ListBucketMetricsConfigurationsRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBucketMetricsConfigurationsRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13907,23 +15900,24 @@ func (fake *FakeS3API) ListBucketMetricsConfigurationsRequestReturnsOnCall(i int
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) ListBucketMetricsConfigurationsWithContext(arg1 context.Context, arg2 *s3.ListBucketMetricsConfigurationsInput, arg3 ...request.Option) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+func (fake *FakeS3API) ListBucketMetricsConfigurationsWithContext(arg1 aws.Context, arg2 *s3.ListBucketMetricsConfigurationsInput, arg3 ...request.Option) (*s3.ListBucketMetricsConfigurationsOutput, error) {
 	fake.listBucketMetricsConfigurationsWithContextMutex.Lock()
 	ret, specificReturn := fake.listBucketMetricsConfigurationsWithContextReturnsOnCall[len(fake.listBucketMetricsConfigurationsWithContextArgsForCall)]
 	fake.listBucketMetricsConfigurationsWithContextArgsForCall = append(fake.listBucketMetricsConfigurationsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListBucketMetricsConfigurationsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.ListBucketMetricsConfigurationsWithContextStub
+	fakeReturns := fake.listBucketMetricsConfigurationsWithContextReturns
 	fake.recordInvocation("ListBucketMetricsConfigurationsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.listBucketMetricsConfigurationsWithContextMutex.Unlock()
-	if fake.ListBucketMetricsConfigurationsWithContextStub != nil {
-		return fake.ListBucketMetricsConfigurationsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBucketMetricsConfigurationsWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -13933,13 +15927,13 @@ func (fake *FakeS3API) ListBucketMetricsConfigurationsWithContextCallCount() int
 	return len(fake.listBucketMetricsConfigurationsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListBucketMetricsConfigurationsWithContextCalls(stub func(context.Context, *s3.ListBucketMetricsConfigurationsInput, ...request.Option) (*s3.ListBucketMetricsConfigurationsOutput, error)) {
+func (fake *FakeS3API) ListBucketMetricsConfigurationsWithContextCalls(stub func(aws.Context, *s3.ListBucketMetricsConfigurationsInput, ...request.Option) (*s3.ListBucketMetricsConfigurationsOutput, error)) {
 	fake.listBucketMetricsConfigurationsWithContextMutex.Lock()
 	defer fake.listBucketMetricsConfigurationsWithContextMutex.Unlock()
 	fake.ListBucketMetricsConfigurationsWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListBucketMetricsConfigurationsWithContextArgsForCall(i int) (context.Context, *s3.ListBucketMetricsConfigurationsInput, []request.Option) {
+func (fake *FakeS3API) ListBucketMetricsConfigurationsWithContextArgsForCall(i int) (aws.Context, *s3.ListBucketMetricsConfigurationsInput, []request.Option) {
 	fake.listBucketMetricsConfigurationsWithContextMutex.RLock()
 	defer fake.listBucketMetricsConfigurationsWithContextMutex.RUnlock()
 	argsForCall := fake.listBucketMetricsConfigurationsWithContextArgsForCall[i]
@@ -13978,15 +15972,16 @@ func (fake *FakeS3API) ListBuckets(arg1 *s3.ListBucketsInput) (*s3.ListBucketsOu
 	fake.listBucketsArgsForCall = append(fake.listBucketsArgsForCall, struct {
 		arg1 *s3.ListBucketsInput
 	}{arg1})
+	stub := fake.ListBucketsStub
+	fakeReturns := fake.listBucketsReturns
 	fake.recordInvocation("ListBuckets", []interface{}{arg1})
 	fake.listBucketsMutex.Unlock()
-	if fake.ListBucketsStub != nil {
-		return fake.ListBucketsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBucketsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -14041,15 +16036,16 @@ func (fake *FakeS3API) ListBucketsRequest(arg1 *s3.ListBucketsInput) (*request.R
 	fake.listBucketsRequestArgsForCall = append(fake.listBucketsRequestArgsForCall, struct {
 		arg1 *s3.ListBucketsInput
 	}{arg1})
+	stub := fake.ListBucketsRequestStub
+	fakeReturns := fake.listBucketsRequestReturns
 	fake.recordInvocation("ListBucketsRequest", []interface{}{arg1})
 	fake.listBucketsRequestMutex.Unlock()
-	if fake.ListBucketsRequestStub != nil {
-		return fake.ListBucketsRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBucketsRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -14098,67 +16094,388 @@ func (fake *FakeS3API) ListBucketsRequestReturnsOnCall(i int, result1 *request.R
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) ListBucketsWithContext(arg1 context.Context, arg2 *s3.ListBucketsInput, arg3 ...request.Option) (*s3.ListBucketsOutput, error) {
+func (fake *FakeS3API) ListBucketsWithContext(arg1 aws.Context, arg2 *s3.ListBucketsInput, arg3 ...request.Option) (*s3.ListBucketsOutput, error) {
 	fake.listBucketsWithContextMutex.Lock()
 	ret, specificReturn := fake.listBucketsWithContextReturnsOnCall[len(fake.listBucketsWithContextArgsForCall)]
 	fake.listBucketsWithContextArgsForCall = append(fake.listBucketsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListBucketsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.ListBucketsWithContextStub
+	fakeReturns := fake.listBucketsWithContextReturns
 	fake.recordInvocation("ListBucketsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.listBucketsWithContextMutex.Unlock()
-	if fake.ListBucketsWithContextStub != nil {
-		return fake.ListBucketsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) ListBucketsWithContextCallCount() int {
+	fake.listBucketsWithContextMutex.RLock()
+	defer fake.listBucketsWithContextMutex.RUnlock()
+	return len(fake.listBucketsWithContextArgsForCall)
+}
+
+func (fake *FakeS3API) ListBucketsWithContextCalls(stub func(aws.Context, *s3.ListBucketsInput, ...request.Option) (*s3.ListBucketsOutput, error)) {
+	fake.listBucketsWithContextMutex.Lock()
+	defer fake.listBucketsWithContextMutex.Unlock()
+	fake.ListBucketsWithContextStub = stub
+}
+
+func (fake *FakeS3API) ListBucketsWithContextArgsForCall(i int) (aws.Context, *s3.ListBucketsInput, []request.Option) {
+	fake.listBucketsWithContextMutex.RLock()
+	defer fake.listBucketsWithContextMutex.RUnlock()
+	argsForCall := fake.listBucketsWithContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3API) ListBucketsWithContextReturns(result1 *s3.ListBucketsOutput, result2 error) {
+	fake.listBucketsWithContextMutex.Lock()
+	defer fake.listBucketsWithContextMutex.Unlock()
+	fake.ListBucketsWithContextStub = nil
+	fake.listBucketsWithContextReturns = struct {
+		result1 *s3.ListBucketsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) ListBucketsWithContextReturnsOnCall(i int, result1 *s3.ListBucketsOutput, result2 error) {
+	fake.listBucketsWithContextMutex.Lock()
+	defer fake.listBucketsWithContextMutex.Unlock()
+	fake.ListBucketsWithContextStub = nil
+	if fake.listBucketsWithContextReturnsOnCall == nil {
+		fake.listBucketsWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.ListBucketsOutput
+			result2 error
+		})
+	}
+	fake.listBucketsWithContextReturnsOnCall[i] = struct {
+		result1 *s3.ListBucketsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) ListDirectoryBuckets(arg1 *s3.ListDirectoryBucketsInput) (*s3.ListDirectoryBucketsOutput, error) {
+	fake.listDirectoryBucketsMutex.Lock()
+	ret, specificReturn := fake.listDirectoryBucketsReturnsOnCall[len(fake.listDirectoryBucketsArgsForCall)]
+	fake.listDirectoryBucketsArgsForCall = append(fake.listDirectoryBucketsArgsForCall, struct {
+		arg1 *s3.ListDirectoryBucketsInput
+	}{arg1})
+	stub := fake.ListDirectoryBucketsStub
+	fakeReturns := fake.listDirectoryBucketsReturns
+	fake.recordInvocation("ListDirectoryBuckets", []interface{}{arg1})
+	fake.listDirectoryBucketsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsCallCount() int {
+	fake.listDirectoryBucketsMutex.RLock()
+	defer fake.listDirectoryBucketsMutex.RUnlock()
+	return len(fake.listDirectoryBucketsArgsForCall)
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsCalls(stub func(*s3.ListDirectoryBucketsInput) (*s3.ListDirectoryBucketsOutput, error)) {
+	fake.listDirectoryBucketsMutex.Lock()
+	defer fake.listDirectoryBucketsMutex.Unlock()
+	fake.ListDirectoryBucketsStub = stub
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsArgsForCall(i int) *s3.ListDirectoryBucketsInput {
+	fake.listDirectoryBucketsMutex.RLock()
+	defer fake.listDirectoryBucketsMutex.RUnlock()
+	argsForCall := fake.listDirectoryBucketsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsReturns(result1 *s3.ListDirectoryBucketsOutput, result2 error) {
+	fake.listDirectoryBucketsMutex.Lock()
+	defer fake.listDirectoryBucketsMutex.Unlock()
+	fake.ListDirectoryBucketsStub = nil
+	fake.listDirectoryBucketsReturns = struct {
+		result1 *s3.ListDirectoryBucketsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsReturnsOnCall(i int, result1 *s3.ListDirectoryBucketsOutput, result2 error) {
+	fake.listDirectoryBucketsMutex.Lock()
+	defer fake.listDirectoryBucketsMutex.Unlock()
+	fake.ListDirectoryBucketsStub = nil
+	if fake.listDirectoryBucketsReturnsOnCall == nil {
+		fake.listDirectoryBucketsReturnsOnCall = make(map[int]struct {
+			result1 *s3.ListDirectoryBucketsOutput
+			result2 error
+		})
+	}
+	fake.listDirectoryBucketsReturnsOnCall[i] = struct {
+		result1 *s3.ListDirectoryBucketsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsPages(arg1 *s3.ListDirectoryBucketsInput, arg2 func(*s3.ListDirectoryBucketsOutput, bool) bool) error {
+	fake.listDirectoryBucketsPagesMutex.Lock()
+	ret, specificReturn := fake.listDirectoryBucketsPagesReturnsOnCall[len(fake.listDirectoryBucketsPagesArgsForCall)]
+	fake.listDirectoryBucketsPagesArgsForCall = append(fake.listDirectoryBucketsPagesArgsForCall, struct {
+		arg1 *s3.ListDirectoryBucketsInput
+		arg2 func(*s3.ListDirectoryBucketsOutput, bool) bool
+	}{arg1, arg2})
+	stub := fake.ListDirectoryBucketsPagesStub
+	fakeReturns := fake.listDirectoryBucketsPagesReturns
+	fake.recordInvocation("ListDirectoryBucketsPages", []interface{}{arg1, arg2})
+	fake.listDirectoryBucketsPagesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsPagesCallCount() int {
+	fake.listDirectoryBucketsPagesMutex.RLock()
+	defer fake.listDirectoryBucketsPagesMutex.RUnlock()
+	return len(fake.listDirectoryBucketsPagesArgsForCall)
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsPagesCalls(stub func(*s3.ListDirectoryBucketsInput, func(*s3.ListDirectoryBucketsOutput, bool) bool) error) {
+	fake.listDirectoryBucketsPagesMutex.Lock()
+	defer fake.listDirectoryBucketsPagesMutex.Unlock()
+	fake.ListDirectoryBucketsPagesStub = stub
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsPagesArgsForCall(i int) (*s3.ListDirectoryBucketsInput, func(*s3.ListDirectoryBucketsOutput, bool) bool) {
+	fake.listDirectoryBucketsPagesMutex.RLock()
+	defer fake.listDirectoryBucketsPagesMutex.RUnlock()
+	argsForCall := fake.listDirectoryBucketsPagesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsPagesReturns(result1 error) {
+	fake.listDirectoryBucketsPagesMutex.Lock()
+	defer fake.listDirectoryBucketsPagesMutex.Unlock()
+	fake.ListDirectoryBucketsPagesStub = nil
+	fake.listDirectoryBucketsPagesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsPagesReturnsOnCall(i int, result1 error) {
+	fake.listDirectoryBucketsPagesMutex.Lock()
+	defer fake.listDirectoryBucketsPagesMutex.Unlock()
+	fake.ListDirectoryBucketsPagesStub = nil
+	if fake.listDirectoryBucketsPagesReturnsOnCall == nil {
+		fake.listDirectoryBucketsPagesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.listDirectoryBucketsPagesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsPagesWithContext(arg1 aws.Context, arg2 *s3.ListDirectoryBucketsInput, arg3 func(*s3.ListDirectoryBucketsOutput, bool) bool, arg4 ...request.Option) error {
+	fake.listDirectoryBucketsPagesWithContextMutex.Lock()
+	ret, specificReturn := fake.listDirectoryBucketsPagesWithContextReturnsOnCall[len(fake.listDirectoryBucketsPagesWithContextArgsForCall)]
+	fake.listDirectoryBucketsPagesWithContextArgsForCall = append(fake.listDirectoryBucketsPagesWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.ListDirectoryBucketsInput
+		arg3 func(*s3.ListDirectoryBucketsOutput, bool) bool
+		arg4 []request.Option
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListDirectoryBucketsPagesWithContextStub
+	fakeReturns := fake.listDirectoryBucketsPagesWithContextReturns
+	fake.recordInvocation("ListDirectoryBucketsPagesWithContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listDirectoryBucketsPagesWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsPagesWithContextCallCount() int {
+	fake.listDirectoryBucketsPagesWithContextMutex.RLock()
+	defer fake.listDirectoryBucketsPagesWithContextMutex.RUnlock()
+	return len(fake.listDirectoryBucketsPagesWithContextArgsForCall)
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsPagesWithContextCalls(stub func(aws.Context, *s3.ListDirectoryBucketsInput, func(*s3.ListDirectoryBucketsOutput, bool) bool, ...request.Option) error) {
+	fake.listDirectoryBucketsPagesWithContextMutex.Lock()
+	defer fake.listDirectoryBucketsPagesWithContextMutex.Unlock()
+	fake.ListDirectoryBucketsPagesWithContextStub = stub
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsPagesWithContextArgsForCall(i int) (aws.Context, *s3.ListDirectoryBucketsInput, func(*s3.ListDirectoryBucketsOutput, bool) bool, []request.Option) {
+	fake.listDirectoryBucketsPagesWithContextMutex.RLock()
+	defer fake.listDirectoryBucketsPagesWithContextMutex.RUnlock()
+	argsForCall := fake.listDirectoryBucketsPagesWithContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsPagesWithContextReturns(result1 error) {
+	fake.listDirectoryBucketsPagesWithContextMutex.Lock()
+	defer fake.listDirectoryBucketsPagesWithContextMutex.Unlock()
+	fake.ListDirectoryBucketsPagesWithContextStub = nil
+	fake.listDirectoryBucketsPagesWithContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsPagesWithContextReturnsOnCall(i int, result1 error) {
+	fake.listDirectoryBucketsPagesWithContextMutex.Lock()
+	defer fake.listDirectoryBucketsPagesWithContextMutex.Unlock()
+	fake.ListDirectoryBucketsPagesWithContextStub = nil
+	if fake.listDirectoryBucketsPagesWithContextReturnsOnCall == nil {
+		fake.listDirectoryBucketsPagesWithContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.listDirectoryBucketsPagesWithContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsRequest(arg1 *s3.ListDirectoryBucketsInput) (*request.Request, *s3.ListDirectoryBucketsOutput) {
+	fake.listDirectoryBucketsRequestMutex.Lock()
+	ret, specificReturn := fake.listDirectoryBucketsRequestReturnsOnCall[len(fake.listDirectoryBucketsRequestArgsForCall)]
+	fake.listDirectoryBucketsRequestArgsForCall = append(fake.listDirectoryBucketsRequestArgsForCall, struct {
+		arg1 *s3.ListDirectoryBucketsInput
+	}{arg1})
+	stub := fake.ListDirectoryBucketsRequestStub
+	fakeReturns := fake.listDirectoryBucketsRequestReturns
+	fake.recordInvocation("ListDirectoryBucketsRequest", []interface{}{arg1})
+	fake.listDirectoryBucketsRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsRequestCallCount() int {
+	fake.listDirectoryBucketsRequestMutex.RLock()
+	defer fake.listDirectoryBucketsRequestMutex.RUnlock()
+	return len(fake.listDirectoryBucketsRequestArgsForCall)
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsRequestCalls(stub func(*s3.ListDirectoryBucketsInput) (*request.Request, *s3.ListDirectoryBucketsOutput)) {
+	fake.listDirectoryBucketsRequestMutex.Lock()
+	defer fake.listDirectoryBucketsRequestMutex.Unlock()
+	fake.ListDirectoryBucketsRequestStub = stub
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsRequestArgsForCall(i int) *s3.ListDirectoryBucketsInput {
+	fake.listDirectoryBucketsRequestMutex.RLock()
+	defer fake.listDirectoryBucketsRequestMutex.RUnlock()
+	argsForCall := fake.listDirectoryBucketsRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsRequestReturns(result1 *request.Request, result2 *s3.ListDirectoryBucketsOutput) {
+	fake.listDirectoryBucketsRequestMutex.Lock()
+	defer fake.listDirectoryBucketsRequestMutex.Unlock()
+	fake.ListDirectoryBucketsRequestStub = nil
+	fake.listDirectoryBucketsRequestReturns = struct {
+		result1 *request.Request
+		result2 *s3.ListDirectoryBucketsOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.ListDirectoryBucketsOutput) {
+	fake.listDirectoryBucketsRequestMutex.Lock()
+	defer fake.listDirectoryBucketsRequestMutex.Unlock()
+	fake.ListDirectoryBucketsRequestStub = nil
+	if fake.listDirectoryBucketsRequestReturnsOnCall == nil {
+		fake.listDirectoryBucketsRequestReturnsOnCall = make(map[int]struct {
+			result1 *request.Request
+			result2 *s3.ListDirectoryBucketsOutput
+		})
+	}
+	fake.listDirectoryBucketsRequestReturnsOnCall[i] = struct {
+		result1 *request.Request
+		result2 *s3.ListDirectoryBucketsOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) ListDirectoryBucketsWithContext(arg1 aws.Context, arg2 *s3.ListDirectoryBucketsInput, arg3 ...request.Option) (*s3.ListDirectoryBucketsOutput, error) {
+	fake.listDirectoryBucketsWithContextMutex.Lock()
+	ret, specificReturn := fake.listDirectoryBucketsWithContextReturnsOnCall[len(fake.listDirectoryBucketsWithContextArgsForCall)]
+	fake.listDirectoryBucketsWithContextArgsForCall = append(fake.listDirectoryBucketsWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.ListDirectoryBucketsInput
+		arg3 []request.Option
+	}{arg1, arg2, arg3})
+	stub := fake.ListDirectoryBucketsWithContextStub
+	fakeReturns := fake.listDirectoryBucketsWithContextReturns
+	fake.recordInvocation("ListDirectoryBucketsWithContext", []interface{}{arg1, arg2, arg3})
+	fake.listDirectoryBucketsWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listBucketsWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) ListBucketsWithContextCallCount() int {
-	fake.listBucketsWithContextMutex.RLock()
-	defer fake.listBucketsWithContextMutex.RUnlock()
-	return len(fake.listBucketsWithContextArgsForCall)
+func (fake *FakeS3API) ListDirectoryBucketsWithContextCallCount() int {
+	fake.listDirectoryBucketsWithContextMutex.RLock()
+	defer fake.listDirectoryBucketsWithContextMutex.RUnlock()
+	return len(fake.listDirectoryBucketsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListBucketsWithContextCalls(stub func(context.Context, *s3.ListBucketsInput, ...request.Option) (*s3.ListBucketsOutput, error)) {
-	fake.listBucketsWithContextMutex.Lock()
-	defer fake.listBucketsWithContextMutex.Unlock()
-	fake.ListBucketsWithContextStub = stub
+func (fake *FakeS3API) ListDirectoryBucketsWithContextCalls(stub func(aws.Context, *s3.ListDirectoryBucketsInput, ...request.Option) (*s3.ListDirectoryBucketsOutput, error)) {
+	fake.listDirectoryBucketsWithContextMutex.Lock()
+	defer fake.listDirectoryBucketsWithContextMutex.Unlock()
+	fake.ListDirectoryBucketsWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListBucketsWithContextArgsForCall(i int) (context.Context, *s3.ListBucketsInput, []request.Option) {
-	fake.listBucketsWithContextMutex.RLock()
-	defer fake.listBucketsWithContextMutex.RUnlock()
-	argsForCall := fake.listBucketsWithContextArgsForCall[i]
+func (fake *FakeS3API) ListDirectoryBucketsWithContextArgsForCall(i int) (aws.Context, *s3.ListDirectoryBucketsInput, []request.Option) {
+	fake.listDirectoryBucketsWithContextMutex.RLock()
+	defer fake.listDirectoryBucketsWithContextMutex.RUnlock()
+	argsForCall := fake.listDirectoryBucketsWithContextArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeS3API) ListBucketsWithContextReturns(result1 *s3.ListBucketsOutput, result2 error) {
-	fake.listBucketsWithContextMutex.Lock()
-	defer fake.listBucketsWithContextMutex.Unlock()
-	fake.ListBucketsWithContextStub = nil
-	fake.listBucketsWithContextReturns = struct {
-		result1 *s3.ListBucketsOutput
+func (fake *FakeS3API) ListDirectoryBucketsWithContextReturns(result1 *s3.ListDirectoryBucketsOutput, result2 error) {
+	fake.listDirectoryBucketsWithContextMutex.Lock()
+	defer fake.listDirectoryBucketsWithContextMutex.Unlock()
+	fake.ListDirectoryBucketsWithContextStub = nil
+	fake.listDirectoryBucketsWithContextReturns = struct {
+		result1 *s3.ListDirectoryBucketsOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) ListBucketsWithContextReturnsOnCall(i int, result1 *s3.ListBucketsOutput, result2 error) {
-	fake.listBucketsWithContextMutex.Lock()
-	defer fake.listBucketsWithContextMutex.Unlock()
-	fake.ListBucketsWithContextStub = nil
-	if fake.listBucketsWithContextReturnsOnCall == nil {
-		fake.listBucketsWithContextReturnsOnCall = make(map[int]struct {
-			result1 *s3.ListBucketsOutput
+func (fake *FakeS3API) ListDirectoryBucketsWithContextReturnsOnCall(i int, result1 *s3.ListDirectoryBucketsOutput, result2 error) {
+	fake.listDirectoryBucketsWithContextMutex.Lock()
+	defer fake.listDirectoryBucketsWithContextMutex.Unlock()
+	fake.ListDirectoryBucketsWithContextStub = nil
+	if fake.listDirectoryBucketsWithContextReturnsOnCall == nil {
+		fake.listDirectoryBucketsWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.ListDirectoryBucketsOutput
 			result2 error
 		})
 	}
-	fake.listBucketsWithContextReturnsOnCall[i] = struct {
-		result1 *s3.ListBucketsOutput
+	fake.listDirectoryBucketsWithContextReturnsOnCall[i] = struct {
+		result1 *s3.ListDirectoryBucketsOutput
 		result2 error
 	}{result1, result2}
 }
@@ -14169,15 +16486,16 @@ func (fake *FakeS3API) ListMultipartUploads(arg1 *s3.ListMultipartUploadsInput)
 	fake.listMultipartUploadsArgsForCall = append(fake.listMultipartUploadsArgsForCall, struct {
 		arg1 *s3.ListMultipartUploadsInput
 	}{arg1})
+	stub := fake.ListMultipartUploadsStub
+	fakeReturns := fake.listMultipartUploadsReturns
 	fake.recordInvocation("ListMultipartUploads", []interface{}{arg1})
 	fake.listMultipartUploadsMutex.Unlock()
-	if fake.ListMultipartUploadsStub != nil {
-		return fake.ListMultipartUploadsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listMultipartUploadsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -14233,15 +16551,16 @@ func (fake *FakeS3API) ListMultipartUploadsPages(arg1 *s3.ListMultipartUploadsIn
 		arg1 *s3.ListMultipartUploadsInput
 		arg2 func(*s3.ListMultipartUploadsOutput, bool) bool
 	}{arg1, arg2})
+	stub := fake.ListMultipartUploadsPagesStub
+	fakeReturns := fake.listMultipartUploadsPagesReturns
 	fake.recordInvocation("ListMultipartUploadsPages", []interface{}{arg1, arg2})
 	fake.listMultipartUploadsPagesMutex.Unlock()
-	if fake.ListMultipartUploadsPagesStub != nil {
-		return fake.ListMultipartUploadsPagesStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listMultipartUploadsPagesReturns
 	return fakeReturns.result1
 }
 
@@ -14287,24 +16606,25 @@ func (fake *FakeS3API) ListMultipartUploadsPagesReturnsOnCall(i int, result1 err
 	}{result1}
 }
 
-func (fake *FakeS3API) ListMultipartUploadsPagesWithContext(arg1 context.Context, arg2 *s3.ListMultipartUploadsInput, arg3 func(*s3.ListMultipartUploadsOutput, bool) bool, arg4 ...request.Option) error {
+func (fake *FakeS3API) ListMultipartUploadsPagesWithContext(arg1 aws.Context, arg2 *s3.ListMultipartUploadsInput, arg3 func(*s3.ListMultipartUploadsOutput, bool) bool, arg4 ...request.Option) error {
 	fake.listMultipartUploadsPagesWithContextMutex.Lock()
 	ret, specificReturn := fake.listMultipartUploadsPagesWithContextReturnsOnCall[len(fake.listMultipartUploadsPagesWithContextArgsForCall)]
 	fake.listMultipartUploadsPagesWithContextArgsForCall = append(fake.listMultipartUploadsPagesWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListMultipartUploadsInput
 		arg3 func(*s3.ListMultipartUploadsOutput, bool) bool
 		arg4 []request.Option
 	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListMultipartUploadsPagesWithContextStub
+	fakeReturns := fake.listMultipartUploadsPagesWithContextReturns
 	fake.recordInvocation("ListMultipartUploadsPagesWithContext", []interface{}{arg1, arg2, arg3, arg4})
 	fake.listMultipartUploadsPagesWithContextMutex.Unlock()
-	if fake.ListMultipartUploadsPagesWithContextStub != nil {
-		return fake.ListMultipartUploadsPagesWithContextStub(arg1, arg2, arg3, arg4...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listMultipartUploadsPagesWithContextReturns
 	return fakeReturns.result1
 }
 
@@ -14314,13 +16634,13 @@ func (fake *FakeS3API) ListMultipartUploadsPagesWithContextCallCount() int {
 	return len(fake.listMultipartUploadsPagesWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListMultipartUploadsPagesWithContextCalls(stub func(context.Context, *s3.ListMultipartUploadsInput, func(*s3.ListMultipartUploadsOutput, bool) bool, ...request.Option) error) {
+func (fake *FakeS3API) ListMultipartUploadsPagesWithContextCalls(stub func(aws.Context, *s3.ListMultipartUploadsInput, func(*s3.ListMultipartUploadsOutput, bool) bool, ...request.Option) error) {
 	fake.listMultipartUploadsPagesWithContextMutex.Lock()
 	defer fake.listMultipartUploadsPagesWithContextMutex.Unlock()
 	fake.ListMultipartUploadsPagesWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListMultipartUploadsPagesWithContextArgsForCall(i int) (context.Context, *s3.ListMultipartUploadsInput, func(*s3.ListMultipartUploadsOutput, bool) bool, []request.Option) {
+func (fake *FakeS3API) ListMultipartUploadsPagesWithContextArgsForCall(i int) (aws.Context, *s3.ListMultipartUploadsInput, func(*s3.ListMultipartUploadsOutput, bool) bool, []request.Option) {
 	fake.listMultipartUploadsPagesWithContextMutex.RLock()
 	defer fake.listMultipartUploadsPagesWithContextMutex.RUnlock()
 	argsForCall := fake.listMultipartUploadsPagesWithContextArgsForCall[i]
@@ -14356,15 +16676,16 @@ func (fake *FakeS3API) ListMultipartUploadsRequest(arg1 *s3.ListMultipartUploads
 	fake.listMultipartUploadsRequestArgsForCall = append(fake.listMultipartUploadsRequestArgsForCall, struct {
 		arg1 *s3.ListMultipartUploadsInput
 	}{arg1})
+	stub := fake.ListMultipartUploadsRequestStub
+	fakeReturns := fake.listMultipartUploadsRequestReturns
 	fake.recordInvocation("ListMultipartUploadsRequest", []interface{}{arg1})
 	fake.listMultipartUploadsRequestMutex.Unlock()
-	if fake.ListMultipartUploadsRequestStub != nil {
-		return fake.ListMultipartUploadsRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listMultipartUploadsRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -14413,23 +16734,24 @@ func (fake *FakeS3API) ListMultipartUploadsRequestReturnsOnCall(i int, result1 *
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) ListMultipartUploadsWithContext(arg1 context.Context, arg2 *s3.ListMultipartUploadsInput, arg3 ...request.Option) (*s3.ListMultipartUploadsOutput, error) {
+func (fake *FakeS3API) ListMultipartUploadsWithContext(arg1 aws.Context, arg2 *s3.ListMultipartUploadsInput, arg3 ...request.Option) (*s3.ListMultipartUploadsOutput, error) {
 	fake.listMultipartUploadsWithContextMutex.Lock()
 	ret, specificReturn := fake.listMultipartUploadsWithContextReturnsOnCall[len(fake.listMultipartUploadsWithContextArgsForCall)]
 	fake.listMultipartUploadsWithContextArgsForCall = append(fake.listMultipartUploadsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListMultipartUploadsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.ListMultipartUploadsWithContextStub
+	fakeReturns := fake.listMultipartUploadsWithContextReturns
 	fake.recordInvocation("ListMultipartUploadsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.listMultipartUploadsWithContextMutex.Unlock()
-	if fake.ListMultipartUploadsWithContextStub != nil {
-		return fake.ListMultipartUploadsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listMultipartUploadsWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -14439,13 +16761,13 @@ func (fake *FakeS3API) ListMultipartUploadsWithContextCallCount() int {
 	return len(fake.listMultipartUploadsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListMultipartUploadsWithContextCalls(stub func(context.Context, *s3.ListMultipartUploadsInput, ...request.Option) (*s3.ListMultipartUploadsOutput, error)) {
+func (fake *FakeS3API) ListMultipartUploadsWithContextCalls(stub func(aws.Context, *s3.ListMultipartUploadsInput, ...request.Option) (*s3.ListMultipartUploadsOutput, error)) {
 	fake.listMultipartUploadsWithContextMutex.Lock()
 	defer fake.listMultipartUploadsWithContextMutex.Unlock()
 	fake.ListMultipartUploadsWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListMultipartUploadsWithContextArgsForCall(i int) (context.Context, *s3.ListMultipartUploadsInput, []request.Option) {
+func (fake *FakeS3API) ListMultipartUploadsWithContextArgsForCall(i int) (aws.Context, *s3.ListMultipartUploadsInput, []request.Option) {
 	fake.listMultipartUploadsWithContextMutex.RLock()
 	defer fake.listMultipartUploadsWithContextMutex.RUnlock()
 	argsForCall := fake.listMultipartUploadsWithContextArgsForCall[i]
@@ -14484,15 +16806,16 @@ func (fake *FakeS3API) ListObjectVersions(arg1 *s3.ListObjectVersionsInput) (*s3
 	fake.listObjectVersionsArgsForCall = append(fake.listObjectVersionsArgsForCall, struct {
 		arg1 *s3.ListObjectVersionsInput
 	}{arg1})
+	stub := fake.ListObjectVersionsStub
+	fakeReturns := fake.listObjectVersionsReturns
 	fake.recordInvocation("ListObjectVersions", []interface{}{arg1})
 	fake.listObjectVersionsMutex.Unlock()
-	if fake.ListObjectVersionsStub != nil {
-		return fake.ListObjectVersionsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listObjectVersionsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -14548,15 +16871,16 @@ func (fake *FakeS3API) ListObjectVersionsPages(arg1 *s3.ListObjectVersionsInput,
 		arg1 *s3.ListObjectVersionsInput
 		arg2 func(*s3.ListObjectVersionsOutput, bool) bool
 	}{arg1, arg2})
+	stub := fake.ListObjectVersionsPagesStub
+	fakeReturns := fake.listObjectVersionsPagesReturns
 	fake.recordInvocation("ListObjectVersionsPages", []interface{}{arg1, arg2})
 	fake.listObjectVersionsPagesMutex.Unlock()
-	if fake.ListObjectVersionsPagesStub != nil {
-		return fake.ListObjectVersionsPagesStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listObjectVersionsPagesReturns
 	return fakeReturns.result1
 }
 
@@ -14602,24 +16926,25 @@ func (fake *FakeS3API) ListObjectVersionsPagesReturnsOnCall(i int, result1 error
 	}{result1}
 }
 
-func (fake *FakeS3API) ListObjectVersionsPagesWithContext(arg1 context.Context, arg2 *s3.ListObjectVersionsInput, arg3 func(*s3.ListObjectVersionsOutput, bool) bool, arg4 ...request.Option) error {
+func (fake *FakeS3API) ListObjectVersionsPagesWithContext(arg1 aws.Context, arg2 *s3.ListObjectVersionsInput, arg3 func(*s3.ListObjectVersionsOutput, bool) bool, arg4 ...request.Option) error {
 	fake.listObjectVersionsPagesWithContextMutex.Lock()
 	ret, specificReturn := fake.listObjectVersionsPagesWithContextReturnsOnCall[len(fake.listObjectVersionsPagesWithContextArgsForCall)]
 	fake.listObjectVersionsPagesWithContextArgsForCall = append(fake.listObjectVersionsPagesWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListObjectVersionsInput
 		arg3 func(*s3.ListObjectVersionsOutput, bool) bool
 		arg4 []request.Option
 	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListObjectVersionsPagesWithContextStub
+	fakeReturns := fake.listObjectVersionsPagesWithContextReturns
 	fake.recordInvocation("ListObjectVersionsPagesWithContext", []interface{}{arg1, arg2, arg3, arg4})
 	fake.listObjectVersionsPagesWithContextMutex.Unlock()
-	if fake.ListObjectVersionsPagesWithContextStub != nil {
-		return fake.ListObjectVersionsPagesWithContextStub(arg1, arg2, arg3, arg4...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listObjectVersionsPagesWithContextReturns
 	return fakeReturns.result1
 }
 
@@ -14629,13 +16954,13 @@ func (fake *FakeS3API) ListObjectVersionsPagesWithContextCallCount() int {
 	return len(fake.listObjectVersionsPagesWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListObjectVersionsPagesWithContextCalls(stub func(context.Context, *s3.ListObjectVersionsInput, func(*s3.ListObjectVersionsOutput, bool) bool, ...request.Option) error) {
+func (fake *FakeS3API) ListObjectVersionsPagesWithContextCalls(stub func(aws.Context, *s3.ListObjectVersionsInput, func(*s3.ListObjectVersionsOutput, bool) bool, ...request.Option) error) {
 	fake.listObjectVersionsPagesWithContextMutex.Lock()
 	defer fake.listObjectVersionsPagesWithContextMutex.Unlock()
 	fake.ListObjectVersionsPagesWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListObjectVersionsPagesWithContextArgsForCall(i int) (context.Context, *s3.ListObjectVersionsInput, func(*s3.ListObjectVersionsOutput, bool) bool, []request.Option) {
+func (fake *FakeS3API) ListObjectVersionsPagesWithContextArgsForCall(i int) (aws.Context, *s3.ListObjectVersionsInput, func(*s3.ListObjectVersionsOutput, bool) bool, []request.Option) {
 	fake.listObjectVersionsPagesWithContextMutex.RLock()
 	defer fake.listObjectVersionsPagesWithContextMutex.RUnlock()
 	argsForCall := fake.listObjectVersionsPagesWithContextArgsForCall[i]
@@ -14671,15 +16996,16 @@ func (fake *FakeS3API) ListObjectVersionsRequest(arg1 *s3.ListObjectVersionsInpu
 	fake.listObjectVersionsRequestArgsForCall = append(fake.listObjectVersionsRequestArgsForCall, struct {
 		arg1 *s3.ListObjectVersionsInput
 	}{arg1})
+	stub := fake.ListObjectVersionsRequestStub
+	fakeReturns := fake.listObjectVersionsRequestReturns
 	fake.recordInvocation("ListObjectVersionsRequest", []interface{}{arg1})
 	fake.listObjectVersionsRequestMutex.Unlock()
-	if fake.ListObjectVersionsRequestStub != nil {
-		return fake.ListObjectVersionsRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listObjectVersionsRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -14728,23 +17054,24 @@ func (fake *FakeS3API) ListObjectVersionsRequestReturnsOnCall(i int, result1 *re
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) ListObjectVersionsWithContext(arg1 context.Context, arg2 *s3.ListObjectVersionsInput, arg3 ...request.Option) (*s3.ListObjectVersionsOutput, error) {
+func (fake *FakeS3API) ListObjectVersionsWithContext(arg1 aws.Context, arg2 *s3.ListObjectVersionsInput, arg3 ...request.Option) (*s3.ListObjectVersionsOutput, error) {
 	fake.listObjectVersionsWithContextMutex.Lock()
 	ret, specificReturn := fake.listObjectVersionsWithContextReturnsOnCall[len(fake.listObjectVersionsWithContextArgsForCall)]
 	fake.listObjectVersionsWithContextArgsForCall = append(fake.listObjectVersionsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListObjectVersionsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.ListObjectVersionsWithContextStub
+	fakeReturns := fake.listObjectVersionsWithContextReturns
 	fake.recordInvocation("ListObjectVersionsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.listObjectVersionsWithContextMutex.Unlock()
-	if fake.ListObjectVersionsWithContextStub != nil {
-		return fake.ListObjectVersionsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listObjectVersionsWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -14754,13 +17081,13 @@ func (fake *FakeS3API) ListObjectVersionsWithContextCallCount() int {
 	return len(fake.listObjectVersionsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListObjectVersionsWithContextCalls(stub func(context.Context, *s3.ListObjectVersionsInput, ...request.Option) (*s3.ListObjectVersionsOutput, error)) {
+func (fake *FakeS3API) ListObjectVersionsWithContextCalls(stub func(aws.Context, *s3.ListObjectVersionsInput, ...request.Option) (*s3.ListObjectVersionsOutput, error)) {
 	fake.listObjectVersionsWithContextMutex.Lock()
 	defer fake.listObjectVersionsWithContextMutex.Unlock()
 	fake.ListObjectVersionsWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListObjectVersionsWithContextArgsForCall(i int) (context.Context, *s3.ListObjectVersionsInput, []request.Option) {
+func (fake *FakeS3API) ListObjectVersionsWithContextArgsForCall(i int) (aws.Context, *s3.ListObjectVersionsInput, []request.Option) {
 	fake.listObjectVersionsWithContextMutex.RLock()
 	defer fake.listObjectVersionsWithContextMutex.RUnlock()
 	argsForCall := fake.listObjectVersionsWithContextArgsForCall[i]
@@ -14799,15 +17126,16 @@ func (fake *FakeS3API) ListObjects(arg1 *s3.ListObjectsInput) (*s3.ListObjectsOu
 	fake.listObjectsArgsForCall = append(fake.listObjectsArgsForCall, struct {
 		arg1 *s3.ListObjectsInput
 	}{arg1})
+	stub := fake.ListObjectsStub
+	fakeReturns := fake.listObjectsReturns
 	fake.recordInvocation("ListObjects", []interface{}{arg1})
 	fake.listObjectsMutex.Unlock()
-	if fake.ListObjectsStub != nil {
-		return fake.ListObjectsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listObjectsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -14863,15 +17191,16 @@ func (fake *FakeS3API) ListObjectsPages(arg1 *s3.ListObjectsInput, arg2 func(*s3
 		arg1 *s3.ListObjectsInput
 		arg2 func(*s3.ListObjectsOutput, bool) bool
 	}{arg1, arg2})
+	stub := fake.ListObjectsPagesStub
+	fakeReturns := fake.listObjectsPagesReturns
 	fake.recordInvocation("ListObjectsPages", []interface{}{arg1, arg2})
 	fake.listObjectsPagesMutex.Unlock()
-	if fake.ListObjectsPagesStub != nil {
-		return fake.ListObjectsPagesStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listObjectsPagesReturns
 	return fakeReturns.result1
 }
 
@@ -14917,24 +17246,25 @@ func (fake *FakeS3API) ListObjectsPagesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeS3API) ListObjectsPagesWithContext(arg1 context.Context, arg2 *s3.ListObjectsInput, arg3 func(*s3.ListObjectsOutput, bool) bool, arg4 ...request.Option) error {
+func (fake *FakeS3API) ListObjectsPagesWithContext(arg1 aws.Context, arg2 *s3.ListObjectsInput, arg3 func(*s3.ListObjectsOutput, bool) bool, arg4 ...request.Option) error {
 	fake.listObjectsPagesWithContextMutex.Lock()
 	ret, specificReturn := fake.listObjectsPagesWithContextReturnsOnCall[len(fake.listObjectsPagesWithContextArgsForCall)]
 	fake.listObjectsPagesWithContextArgsForCall = append(fake.listObjectsPagesWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListObjectsInput
 		arg3 func(*s3.ListObjectsOutput, bool) bool
 		arg4 []request.Option
 	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListObjectsPagesWithContextStub
+	fakeReturns := fake.listObjectsPagesWithContextReturns
 	fake.recordInvocation("ListObjectsPagesWithContext", []interface{}{arg1, arg2, arg3, arg4})
 	fake.listObjectsPagesWithContextMutex.Unlock()
-	if fake.ListObjectsPagesWithContextStub != nil {
-		return fake.ListObjectsPagesWithContextStub(arg1, arg2, arg3, arg4...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listObjectsPagesWithContextReturns
 	return fakeReturns.result1
 }
 
@@ -14944,13 +17274,13 @@ func (fake *FakeS3API) ListObjectsPagesWithContextCallCount() int {
 	return len(fake.listObjectsPagesWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListObjectsPagesWithContextCalls(stub func(context.Context, *s3.ListObjectsInput, func(*s3.ListObjectsOutput, bool) bool, ...request.Option) error) {
+func (fake *FakeS3API) ListObjectsPagesWithContextCalls(stub func(aws.Context, *s3.ListObjectsInput, func(*s3.ListObjectsOutput, bool) bool, ...request.Option) error) {
 	fake.listObjectsPagesWithContextMutex.Lock()
 	defer fake.listObjectsPagesWithContextMutex.Unlock()
 	fake.ListObjectsPagesWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListObjectsPagesWithContextArgsForCall(i int) (context.Context, *s3.ListObjectsInput, func(*s3.ListObjectsOutput, bool) bool, []request.Option) {
+func (fake *FakeS3API) ListObjectsPagesWithContextArgsForCall(i int) (aws.Context, *s3.ListObjectsInput, func(*s3.ListObjectsOutput, bool) bool, []request.Option) {
 	fake.listObjectsPagesWithContextMutex.RLock()
 	defer fake.listObjectsPagesWithContextMutex.RUnlock()
 	argsForCall := fake.listObjectsPagesWithContextArgsForCall[i]
@@ -14986,15 +17316,16 @@ func (fake *FakeS3API) ListObjectsRequest(arg1 *s3.ListObjectsInput) (*request.R
 	fake.listObjectsRequestArgsForCall = append(fake.listObjectsRequestArgsForCall, struct {
 		arg1 *s3.ListObjectsInput
 	}{arg1})
+	stub := fake.ListObjectsRequestStub
+	fakeReturns := fake.listObjectsRequestReturns
 	fake.recordInvocation("ListObjectsRequest", []interface{}{arg1})
 	fake.listObjectsRequestMutex.Unlock()
-	if fake.ListObjectsRequestStub != nil {
-		return fake.ListObjectsRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listObjectsRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -15049,15 +17380,16 @@ func (fake *FakeS3API) ListObjectsV2(arg1 *s3.ListObjectsV2Input) (*s3.ListObjec
 	fake.listObjectsV2ArgsForCall = append(fake.listObjectsV2ArgsForCall, struct {
 		arg1 *s3.ListObjectsV2Input
 	}{arg1})
+	stub := fake.ListObjectsV2Stub
+	fakeReturns := fake.listObjectsV2Returns
 	fake.recordInvocation("ListObjectsV2", []interface{}{arg1})
 	fake.listObjectsV2Mutex.Unlock()
-	if fake.ListObjectsV2Stub != nil {
-		return fake.ListObjectsV2Stub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listObjectsV2Returns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -15113,15 +17445,16 @@ func (fake *FakeS3API) ListObjectsV2Pages(arg1 *s3.ListObjectsV2Input, arg2 func
 		arg1 *s3.ListObjectsV2Input
 		arg2 func(*s3.ListObjectsV2Output, bool) bool
 	}{arg1, arg2})
+	stub := fake.ListObjectsV2PagesStub
+	fakeReturns := fake.listObjectsV2PagesReturns
 	fake.recordInvocation("ListObjectsV2Pages", []interface{}{arg1, arg2})
 	fake.listObjectsV2PagesMutex.Unlock()
-	if fake.ListObjectsV2PagesStub != nil {
-		return fake.ListObjectsV2PagesStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listObjectsV2PagesReturns
 	return fakeReturns.result1
 }
 
@@ -15167,24 +17500,25 @@ func (fake *FakeS3API) ListObjectsV2PagesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeS3API) ListObjectsV2PagesWithContext(arg1 context.Context, arg2 *s3.ListObjectsV2Input, arg3 func(*s3.ListObjectsV2Output, bool) bool, arg4 ...request.Option) error {
+func (fake *FakeS3API) ListObjectsV2PagesWithContext(arg1 aws.Context, arg2 *s3.ListObjectsV2Input, arg3 func(*s3.ListObjectsV2Output, bool) bool, arg4 ...request.Option) error {
 	fake.listObjectsV2PagesWithContextMutex.Lock()
 	ret, specificReturn := fake.listObjectsV2PagesWithContextReturnsOnCall[len(fake.listObjectsV2PagesWithContextArgsForCall)]
 	fake.listObjectsV2PagesWithContextArgsForCall = append(fake.listObjectsV2PagesWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListObjectsV2Input
 		arg3 func(*s3.ListObjectsV2Output, bool) bool
 		arg4 []request.Option
 	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListObjectsV2PagesWithContextStub
+	fakeReturns := fake.listObjectsV2PagesWithContextReturns
 	fake.recordInvocation("ListObjectsV2PagesWithContext", []interface{}{arg1, arg2, arg3, arg4})
 	fake.listObjectsV2PagesWithContextMutex.Unlock()
-	if fake.ListObjectsV2PagesWithContextStub != nil {
-		return fake.ListObjectsV2PagesWithContextStub(arg1, arg2, arg3, arg4...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listObjectsV2PagesWithContextReturns
 	return fakeReturns.result1
 }
 
@@ -15194,13 +17528,13 @@ func (fake *FakeS3API) ListObjectsV2PagesWithContextCallCount() int {
 	return len(fake.listObjectsV2PagesWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListObjectsV2PagesWithContextCalls(stub func(context.Context, *s3.ListObjectsV2Input, func(*s3.ListObjectsV2Output, bool) bool, ...request.Option) error) {
+func (fake *FakeS3API) ListObjectsV2PagesWithContextCalls(stub func(aws.Context, *s3.ListObjectsV2Input, func(*s3.ListObjectsV2Output, bool) bool, ...request.Option) error) {
 	fake.listObjectsV2PagesWithContextMutex.Lock()
 	defer fake.listObjectsV2PagesWithContextMutex.Unlock()
 	fake.ListObjectsV2PagesWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListObjectsV2PagesWithContextArgsForCall(i int) (context.Context, *s3.ListObjectsV2Input, func(*s3.ListObjectsV2Output, bool) bool, []request.Option) {
+func (fake *FakeS3API) ListObjectsV2PagesWithContextArgsForCall(i int) (aws.Context, *s3.ListObjectsV2Input, func(*s3.ListObjectsV2Output, bool) bool, []request.Option) {
 	fake.listObjectsV2PagesWithContextMutex.RLock()
 	defer fake.listObjectsV2PagesWithContextMutex.RUnlock()
 	argsForCall := fake.listObjectsV2PagesWithContextArgsForCall[i]
@@ -15236,15 +17570,16 @@ func (fake *FakeS3API) ListObjectsV2Request(arg1 *s3.ListObjectsV2Input) (*reque
 	fake.listObjectsV2RequestArgsForCall = append(fake.listObjectsV2RequestArgsForCall, struct {
 		arg1 *s3.ListObjectsV2Input
 	}{arg1})
+	stub := fake.ListObjectsV2RequestStub
+	fakeReturns := fake.listObjectsV2RequestReturns
 	fake.recordInvocation("ListObjectsV2Request", []interface{}{arg1})
 	fake.listObjectsV2RequestMutex.Unlock()
-	if fake.ListObjectsV2RequestStub != nil {
-		return fake.ListObjectsV2RequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listObjectsV2RequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -15293,23 +17628,24 @@ func (fake *FakeS3API) ListObjectsV2RequestReturnsOnCall(i int, result1 *request
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) ListObjectsV2WithContext(arg1 context.Context, arg2 *s3.ListObjectsV2Input, arg3 ...request.Option) (*s3.ListObjectsV2Output, error) {
+func (fake *FakeS3API) ListObjectsV2WithContext(arg1 aws.Context, arg2 *s3.ListObjectsV2Input, arg3 ...request.Option) (*s3.ListObjectsV2Output, error) {
 	fake.listObjectsV2WithContextMutex.Lock()
 	ret, specificReturn := fake.listObjectsV2WithContextReturnsOnCall[len(fake.listObjectsV2WithContextArgsForCall)]
 	fake.listObjectsV2WithContextArgsForCall = append(fake.listObjectsV2WithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListObjectsV2Input
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.ListObjectsV2WithContextStub
+	fakeReturns := fake.listObjectsV2WithContextReturns
 	fake.recordInvocation("ListObjectsV2WithContext", []interface{}{arg1, arg2, arg3})
 	fake.listObjectsV2WithContextMutex.Unlock()
-	if fake.ListObjectsV2WithContextStub != nil {
-		return fake.ListObjectsV2WithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listObjectsV2WithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -15319,13 +17655,13 @@ func (fake *FakeS3API) ListObjectsV2WithContextCallCount() int {
 	return len(fake.listObjectsV2WithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListObjectsV2WithContextCalls(stub func(context.Context, *s3.ListObjectsV2Input, ...request.Option) (*s3.ListObjectsV2Output, error)) {
+func (fake *FakeS3API) ListObjectsV2WithContextCalls(stub func(aws.Context, *s3.ListObjectsV2Input, ...request.Option) (*s3.ListObjectsV2Output, error)) {
 	fake.listObjectsV2WithContextMutex.Lock()
 	defer fake.listObjectsV2WithContextMutex.Unlock()
 	fake.ListObjectsV2WithContextStub = stub
 }
 
-func (fake *FakeS3API) ListObjectsV2WithContextArgsForCall(i int) (context.Context, *s3.ListObjectsV2Input, []request.Option) {
+func (fake *FakeS3API) ListObjectsV2WithContextArgsForCall(i int) (aws.Context, *s3.ListObjectsV2Input, []request.Option) {
 	fake.listObjectsV2WithContextMutex.RLock()
 	defer fake.listObjectsV2WithContextMutex.RUnlock()
 	argsForCall := fake.listObjectsV2WithContextArgsForCall[i]
@@ -15358,23 +17694,24 @@ func (fake *FakeS3API) ListObjectsV2WithContextReturnsOnCall(i int, result1 *s3.
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) ListObjectsWithContext(arg1 context.Context, arg2 *s3.ListObjectsInput, arg3 ...request.Option) (*s3.ListObjectsOutput, error) {
+func (fake *FakeS3API) ListObjectsWithContext(arg1 aws.Context, arg2 *s3.ListObjectsInput, arg3 ...request.Option) (*s3.ListObjectsOutput, error) {
 	fake.listObjectsWithContextMutex.Lock()
 	ret, specificReturn := fake.listObjectsWithContextReturnsOnCall[len(fake.listObjectsWithContextArgsForCall)]
 	fake.listObjectsWithContextArgsForCall = append(fake.listObjectsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListObjectsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.ListObjectsWithContextStub
+	fakeReturns := fake.listObjectsWithContextReturns
 	fake.recordInvocation("ListObjectsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.listObjectsWithContextMutex.Unlock()
-	if fake.ListObjectsWithContextStub != nil {
-		return fake.ListObjectsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listObjectsWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -15384,13 +17721,13 @@ func (fake *FakeS3API) ListObjectsWithContextCallCount() int {
 	return len(fake.listObjectsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListObjectsWithContextCalls(stub func(context.Context, *s3.ListObjectsInput, ...request.Option) (*s3.ListObjectsOutput, error)) {
+func (fake *FakeS3API) ListObjectsWithContextCalls(stub func(aws.Context, *s3.ListObjectsInput, ...request.Option) (*s3.ListObjectsOutput, error)) {
 	fake.listObjectsWithContextMutex.Lock()
 	defer fake.listObjectsWithContextMutex.Unlock()
 	fake.ListObjectsWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListObjectsWithContextArgsForCall(i int) (context.Context, *s3.ListObjectsInput, []request.Option) {
+func (fake *FakeS3API) ListObjectsWithContextArgsForCall(i int) (aws.Context, *s3.ListObjectsInput, []request.Option) {
 	fake.listObjectsWithContextMutex.RLock()
 	defer fake.listObjectsWithContextMutex.RUnlock()
 	argsForCall := fake.listObjectsWithContextArgsForCall[i]
@@ -15429,15 +17766,16 @@ func (fake *FakeS3API) ListParts(arg1 *s3.ListPartsInput) (*s3.ListPartsOutput,
 	fake.listPartsArgsForCall = append(fake.listPartsArgsForCall, struct {
 		arg1 *s3.ListPartsInput
 	}{arg1})
+	stub := fake.ListPartsStub
+	fakeReturns := fake.listPartsReturns
 	fake.recordInvocation("ListParts", []interface{}{arg1})
 	fake.listPartsMutex.Unlock()
-	if fake.ListPartsStub != nil {
-		return fake.ListPartsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listPartsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -15493,15 +17831,16 @@ func (fake *FakeS3API) ListPartsPages(arg1 *s3.ListPartsInput, arg2 func(*s3.Lis
 		arg1 *s3.ListPartsInput
 		arg2 func(*s3.ListPartsOutput, bool) bool
 	}{arg1, arg2})
+	stub := fake.ListPartsPagesStub
+	fakeReturns := fake.listPartsPagesReturns
 	fake.recordInvocation("ListPartsPages", []interface{}{arg1, arg2})
 	fake.listPartsPagesMutex.Unlock()
-	if fake.ListPartsPagesStub != nil {
-		return fake.ListPartsPagesStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listPartsPagesReturns
 	return fakeReturns.result1
 }
 
@@ -15547,24 +17886,25 @@ func (fake *FakeS3API) ListPartsPagesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeS3API) ListPartsPagesWithContext(arg1 context.Context, arg2 *s3.ListPartsInput, arg3 func(*s3.ListPartsOutput, bool) bool, arg4 ...request.Option) error {
+func (fake *FakeS3API) ListPartsPagesWithContext(arg1 aws.Context, arg2 *s3.ListPartsInput, arg3 func(*s3.ListPartsOutput, bool) bool, arg4 ...request.Option) error {
 	fake.listPartsPagesWithContextMutex.Lock()
 	ret, specificReturn := fake.listPartsPagesWithContextReturnsOnCall[len(fake.listPartsPagesWithContextArgsForCall)]
 	fake.listPartsPagesWithContextArgsForCall = append(fake.listPartsPagesWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListPartsInput
 		arg3 func(*s3.ListPartsOutput, bool) bool
 		arg4 []request.Option
 	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListPartsPagesWithContextStub
+	fakeReturns := fake.listPartsPagesWithContextReturns
 	fake.recordInvocation("ListPartsPagesWithContext", []interface{}{arg1, arg2, arg3, arg4})
 	fake.listPartsPagesWithContextMutex.Unlock()
-	if fake.ListPartsPagesWithContextStub != nil {
-		return fake.ListPartsPagesWithContextStub(arg1, arg2, arg3, arg4...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.listPartsPagesWithContextReturns
 	return fakeReturns.result1
 }
 
@@ -15574,13 +17914,13 @@ func (fake *FakeS3API) ListPartsPagesWithContextCallCount() int {
 	return len(fake.listPartsPagesWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListPartsPagesWithContextCalls(stub func(context.Context, *s3.ListPartsInput, func(*s3.ListPartsOutput, bool) bool, ...request.Option) error) {
+func (fake *FakeS3API) ListPartsPagesWithContextCalls(stub func(aws.Context, *s3.ListPartsInput, func(*s3.ListPartsOutput, bool) bool, ...request.Option) error) {
 	fake.listPartsPagesWithContextMutex.Lock()
 	defer fake.listPartsPagesWithContextMutex.Unlock()
 	fake.ListPartsPagesWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListPartsPagesWithContextArgsForCall(i int) (context.Context, *s3.ListPartsInput, func(*s3.ListPartsOutput, bool) bool, []request.Option) {
+func (fake *FakeS3API) ListPartsPagesWithContextArgsForCall(i int) (aws.Context, *s3.ListPartsInput, func(*s3.ListPartsOutput, bool) bool, []request.Option) {
 	fake.listPartsPagesWithContextMutex.RLock()
 	defer fake.listPartsPagesWithContextMutex.RUnlock()
 	argsForCall := fake.listPartsPagesWithContextArgsForCall[i]
@@ -15616,15 +17956,16 @@ func (fake *FakeS3API) ListPartsRequest(arg1 *s3.ListPartsInput) (*request.Reque
 	fake.listPartsRequestArgsForCall = append(fake.listPartsRequestArgsForCall, struct {
 		arg1 *s3.ListPartsInput
 	}{arg1})
+	stub := fake.ListPartsRequestStub
+	fakeReturns := fake.listPartsRequestReturns
 	fake.recordInvocation("ListPartsRequest", []interface{}{arg1})
 	fake.listPartsRequestMutex.Unlock()
-	if fake.ListPartsRequestStub != nil {
-		return fake.ListPartsRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listPartsRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -15673,23 +18014,24 @@ func (fake *FakeS3API) ListPartsRequestReturnsOnCall(i int, result1 *request.Req
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) ListPartsWithContext(arg1 context.Context, arg2 *s3.ListPartsInput, arg3 ...request.Option) (*s3.ListPartsOutput, error) {
+func (fake *FakeS3API) ListPartsWithContext(arg1 aws.Context, arg2 *s3.ListPartsInput, arg3 ...request.Option) (*s3.ListPartsOutput, error) {
 	fake.listPartsWithContextMutex.Lock()
 	ret, specificReturn := fake.listPartsWithContextReturnsOnCall[len(fake.listPartsWithContextArgsForCall)]
 	fake.listPartsWithContextArgsForCall = append(fake.listPartsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.ListPartsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.ListPartsWithContextStub
+	fakeReturns := fake.listPartsWithContextReturns
 	fake.recordInvocation("ListPartsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.listPartsWithContextMutex.Unlock()
-	if fake.ListPartsWithContextStub != nil {
-		return fake.ListPartsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listPartsWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -15699,13 +18041,13 @@ func (fake *FakeS3API) ListPartsWithContextCallCount() int {
 	return len(fake.listPartsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) ListPartsWithContextCalls(stub func(context.Context, *s3.ListPartsInput, ...request.Option) (*s3.ListPartsOutput, error)) {
+func (fake *FakeS3API) ListPartsWithContextCalls(stub func(aws.Context, *s3.ListPartsInput, ...request.Option) (*s3.ListPartsOutput, error)) {
 	fake.listPartsWithContextMutex.Lock()
 	defer fake.listPartsWithContextMutex.Unlock()
 	fake.ListPartsWithContextStub = stub
 }
 
-func (fake *FakeS3API) ListPartsWithContextArgsForCall(i int) (context.Context, *s3.ListPartsInput, []request.Option) {
+func (fake *FakeS3API) ListPartsWithContextArgsForCall(i int) (aws.Context, *s3.ListPartsInput, []request.Option) {
 	fake.listPartsWithContextMutex.RLock()
 	defer fake.listPartsWithContextMutex.RUnlock()
 	argsForCall := fake.listPartsWithContextArgsForCall[i]
@@ -15744,15 +18086,16 @@ func (fake *FakeS3API) PutBucketAccelerateConfiguration(arg1 *s3.PutBucketAccele
 	fake.putBucketAccelerateConfigurationArgsForCall = append(fake.putBucketAccelerateConfigurationArgsForCall, struct {
 		arg1 *s3.PutBucketAccelerateConfigurationInput
 	}{arg1})
+	stub := fake.PutBucketAccelerateConfigurationStub
+	fakeReturns := fake.putBucketAccelerateConfigurationReturns
 	fake.recordInvocation("PutBucketAccelerateConfiguration", []interface{}{arg1})
 	fake.putBucketAccelerateConfigurationMutex.Unlock()
-	if fake.PutBucketAccelerateConfigurationStub != nil {
-		return fake.PutBucketAccelerateConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketAccelerateConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -15807,15 +18150,16 @@ func (fake *FakeS3API) PutBucketAccelerateConfigurationRequest(arg1 *s3.PutBucke
 	fake.putBucketAccelerateConfigurationRequestArgsForCall = append(fake.putBucketAccelerateConfigurationRequestArgsForCall, struct {
 		arg1 *s3.PutBucketAccelerateConfigurationInput
 	}{arg1})
+	stub := fake.PutBucketAccelerateConfigurationRequestStub
+	fakeReturns := fake.putBucketAccelerateConfigurationRequestReturns
 	fake.recordInvocation("PutBucketAccelerateConfigurationRequest", []interface{}{arg1})
 	fake.putBucketAccelerateConfigurationRequestMutex.Unlock()
-	if fake.PutBucketAccelerateConfigurationRequestStub != nil {
-		return fake.PutBucketAccelerateConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketAccelerateConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -15864,23 +18208,24 @@ func (fake *FakeS3API) PutBucketAccelerateConfigurationRequestReturnsOnCall(i in
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketAccelerateConfigurationWithContext(arg1 context.Context, arg2 *s3.PutBucketAccelerateConfigurationInput, arg3 ...request.Option) (*s3.PutBucketAccelerateConfigurationOutput, error) {
+func (fake *FakeS3API) PutBucketAccelerateConfigurationWithContext(arg1 aws.Context, arg2 *s3.PutBucketAccelerateConfigurationInput, arg3 ...request.Option) (*s3.PutBucketAccelerateConfigurationOutput, error) {
 	fake.putBucketAccelerateConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketAccelerateConfigurationWithContextReturnsOnCall[len(fake.putBucketAccelerateConfigurationWithContextArgsForCall)]
 	fake.putBucketAccelerateConfigurationWithContextArgsForCall = append(fake.putBucketAccelerateConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketAccelerateConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketAccelerateConfigurationWithContextStub
+	fakeReturns := fake.putBucketAccelerateConfigurationWithContextReturns
 	fake.recordInvocation("PutBucketAccelerateConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketAccelerateConfigurationWithContextMutex.Unlock()
-	if fake.PutBucketAccelerateConfigurationWithContextStub != nil {
-		return fake.PutBucketAccelerateConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketAccelerateConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -15890,13 +18235,13 @@ func (fake *FakeS3API) PutBucketAccelerateConfigurationWithContextCallCount() in
 	return len(fake.putBucketAccelerateConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketAccelerateConfigurationWithContextCalls(stub func(context.Context, *s3.PutBucketAccelerateConfigurationInput, ...request.Option) (*s3.PutBucketAccelerateConfigurationOutput, error)) {
+func (fake *FakeS3API) PutBucketAccelerateConfigurationWithContextCalls(stub func(aws.Context, *s3.PutBucketAccelerateConfigurationInput, ...request.Option) (*s3.PutBucketAccelerateConfigurationOutput, error)) {
 	fake.putBucketAccelerateConfigurationWithContextMutex.Lock()
 	defer fake.putBucketAccelerateConfigurationWithContextMutex.Unlock()
 	fake.PutBucketAccelerateConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketAccelerateConfigurationWithContextArgsForCall(i int) (context.Context, *s3.PutBucketAccelerateConfigurationInput, []request.Option) {
+func (fake *FakeS3API) PutBucketAccelerateConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketAccelerateConfigurationInput, []request.Option) {
 	fake.putBucketAccelerateConfigurationWithContextMutex.RLock()
 	defer fake.putBucketAccelerateConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketAccelerateConfigurationWithContextArgsForCall[i]
@@ -15935,15 +18280,16 @@ func (fake *FakeS3API) PutBucketAcl(arg1 *s3.PutBucketAclInput) (*s3.PutBucketAc
 	fake.putBucketAclArgsForCall = append(fake.putBucketAclArgsForCall, struct {
 		arg1 *s3.PutBucketAclInput
 	}{arg1})
+	stub := fake.PutBucketAclStub
+	fakeReturns := fake.putBucketAclReturns
 	fake.recordInvocation("PutBucketAcl", []interface{}{arg1})
 	fake.putBucketAclMutex.Unlock()
-	if fake.PutBucketAclStub != nil {
-		return fake.PutBucketAclStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketAclReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -15998,15 +18344,16 @@ func (fake *FakeS3API) PutBucketAclRequest(arg1 *s3.PutBucketAclInput) (*request
 	fake.putBucketAclRequestArgsForCall = append(fake.putBucketAclRequestArgsForCall, struct {
 		arg1 *s3.PutBucketAclInput
 	}{arg1})
+	stub := fake.PutBucketAclRequestStub
+	fakeReturns := fake.putBucketAclRequestReturns
 	fake.recordInvocation("PutBucketAclRequest", []interface{}{arg1})
 	fake.putBucketAclRequestMutex.Unlock()
-	if fake.PutBucketAclRequestStub != nil {
-		return fake.PutBucketAclRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketAclRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16055,23 +18402,24 @@ func (fake *FakeS3API) PutBucketAclRequestReturnsOnCall(i int, result1 *request.
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketAclWithContext(arg1 context.Context, arg2 *s3.PutBucketAclInput, arg3 ...request.Option) (*s3.PutBucketAclOutput, error) {
+func (fake *FakeS3API) PutBucketAclWithContext(arg1 aws.Context, arg2 *s3.PutBucketAclInput, arg3 ...request.Option) (*s3.PutBucketAclOutput, error) {
 	fake.putBucketAclWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketAclWithContextReturnsOnCall[len(fake.putBucketAclWithContextArgsForCall)]
 	fake.putBucketAclWithContextArgsForCall = append(fake.putBucketAclWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketAclInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketAclWithContextStub
+	fakeReturns := fake.putBucketAclWithContextReturns
 	fake.recordInvocation("PutBucketAclWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketAclWithContextMutex.Unlock()
-	if fake.PutBucketAclWithContextStub != nil {
-		return fake.PutBucketAclWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketAclWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16081,13 +18429,13 @@ func (fake *FakeS3API) PutBucketAclWithContextCallCount() int {
 	return len(fake.putBucketAclWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketAclWithContextCalls(stub func(context.Context, *s3.PutBucketAclInput, ...request.Option) (*s3.PutBucketAclOutput, error)) {
+func (fake *FakeS3API) PutBucketAclWithContextCalls(stub func(aws.Context, *s3.PutBucketAclInput, ...request.Option) (*s3.PutBucketAclOutput, error)) {
 	fake.putBucketAclWithContextMutex.Lock()
 	defer fake.putBucketAclWithContextMutex.Unlock()
 	fake.PutBucketAclWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketAclWithContextArgsForCall(i int) (context.Context, *s3.PutBucketAclInput, []request.Option) {
+func (fake *FakeS3API) PutBucketAclWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketAclInput, []request.Option) {
 	fake.putBucketAclWithContextMutex.RLock()
 	defer fake.putBucketAclWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketAclWithContextArgsForCall[i]
@@ -16126,15 +18474,16 @@ func (fake *FakeS3API) PutBucketAnalyticsConfiguration(arg1 *s3.PutBucketAnalyti
 	fake.putBucketAnalyticsConfigurationArgsForCall = append(fake.putBucketAnalyticsConfigurationArgsForCall, struct {
 		arg1 *s3.PutBucketAnalyticsConfigurationInput
 	}{arg1})
+	stub := fake.PutBucketAnalyticsConfigurationStub
+	fakeReturns := fake.putBucketAnalyticsConfigurationReturns
 	fake.recordInvocation("PutBucketAnalyticsConfiguration", []interface{}{arg1})
 	fake.putBucketAnalyticsConfigurationMutex.Unlock()
-	if fake.PutBucketAnalyticsConfigurationStub != nil {
-		return fake.PutBucketAnalyticsConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketAnalyticsConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16189,15 +18538,16 @@ func (fake *FakeS3API) PutBucketAnalyticsConfigurationRequest(arg1 *s3.PutBucket
 	fake.putBucketAnalyticsConfigurationRequestArgsForCall = append(fake.putBucketAnalyticsConfigurationRequestArgsForCall, struct {
 		arg1 *s3.PutBucketAnalyticsConfigurationInput
 	}{arg1})
+	stub := fake.PutBucketAnalyticsConfigurationRequestStub
+	fakeReturns := fake.putBucketAnalyticsConfigurationRequestReturns
 	fake.recordInvocation("PutBucketAnalyticsConfigurationRequest", []interface{}{arg1})
 	fake.putBucketAnalyticsConfigurationRequestMutex.Unlock()
-	if fake.PutBucketAnalyticsConfigurationRequestStub != nil {
-		return fake.PutBucketAnalyticsConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketAnalyticsConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16246,23 +18596,24 @@ func (fake *FakeS3API) PutBucketAnalyticsConfigurationRequestReturnsOnCall(i int
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketAnalyticsConfigurationWithContext(arg1 context.Context, arg2 *s3.PutBucketAnalyticsConfigurationInput, arg3 ...request.Option) (*s3.PutBucketAnalyticsConfigurationOutput, error) {
+func (fake *FakeS3API) PutBucketAnalyticsConfigurationWithContext(arg1 aws.Context, arg2 *s3.PutBucketAnalyticsConfigurationInput, arg3 ...request.Option) (*s3.PutBucketAnalyticsConfigurationOutput, error) {
 	fake.putBucketAnalyticsConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketAnalyticsConfigurationWithContextReturnsOnCall[len(fake.putBucketAnalyticsConfigurationWithContextArgsForCall)]
 	fake.putBucketAnalyticsConfigurationWithContextArgsForCall = append(fake.putBucketAnalyticsConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketAnalyticsConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketAnalyticsConfigurationWithContextStub
+	fakeReturns := fake.putBucketAnalyticsConfigurationWithContextReturns
 	fake.recordInvocation("PutBucketAnalyticsConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketAnalyticsConfigurationWithContextMutex.Unlock()
-	if fake.PutBucketAnalyticsConfigurationWithContextStub != nil {
-		return fake.PutBucketAnalyticsConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketAnalyticsConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16272,13 +18623,13 @@ func (fake *FakeS3API) PutBucketAnalyticsConfigurationWithContextCallCount() int
 	return len(fake.putBucketAnalyticsConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketAnalyticsConfigurationWithContextCalls(stub func(context.Context, *s3.PutBucketAnalyticsConfigurationInput, ...request.Option) (*s3.PutBucketAnalyticsConfigurationOutput, error)) {
+func (fake *FakeS3API) PutBucketAnalyticsConfigurationWithContextCalls(stub func(aws.Context, *s3.PutBucketAnalyticsConfigurationInput, ...request.Option) (*s3.PutBucketAnalyticsConfigurationOutput, error)) {
 	fake.putBucketAnalyticsConfigurationWithContextMutex.Lock()
 	defer fake.putBucketAnalyticsConfigurationWithContextMutex.Unlock()
 	fake.PutBucketAnalyticsConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketAnalyticsConfigurationWithContextArgsForCall(i int) (context.Context, *s3.PutBucketAnalyticsConfigurationInput, []request.Option) {
+func (fake *FakeS3API) PutBucketAnalyticsConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketAnalyticsConfigurationInput, []request.Option) {
 	fake.putBucketAnalyticsConfigurationWithContextMutex.RLock()
 	defer fake.putBucketAnalyticsConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketAnalyticsConfigurationWithContextArgsForCall[i]
@@ -16317,15 +18668,16 @@ func (fake *FakeS3API) PutBucketCors(arg1 *s3.PutBucketCorsInput) (*s3.PutBucket
 	fake.putBucketCorsArgsForCall = append(fake.putBucketCorsArgsForCall, struct {
 		arg1 *s3.PutBucketCorsInput
 	}{arg1})
+	stub := fake.PutBucketCorsStub
+	fakeReturns := fake.putBucketCorsReturns
 	fake.recordInvocation("PutBucketCors", []interface{}{arg1})
 	fake.putBucketCorsMutex.Unlock()
-	if fake.PutBucketCorsStub != nil {
-		return fake.PutBucketCorsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketCorsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16380,15 +18732,16 @@ func (fake *FakeS3API) PutBucketCorsRequest(arg1 *s3.PutBucketCorsInput) (*reque
 	fake.putBucketCorsRequestArgsForCall = append(fake.putBucketCorsRequestArgsForCall, struct {
 		arg1 *s3.PutBucketCorsInput
 	}{arg1})
+	stub := fake.PutBucketCorsRequestStub
+	fakeReturns := fake.putBucketCorsRequestReturns
 	fake.recordInvocation("PutBucketCorsRequest", []interface{}{arg1})
 	fake.putBucketCorsRequestMutex.Unlock()
-	if fake.PutBucketCorsRequestStub != nil {
-		return fake.PutBucketCorsRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketCorsRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16437,23 +18790,24 @@ func (fake *FakeS3API) PutBucketCorsRequestReturnsOnCall(i int, result1 *request
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketCorsWithContext(arg1 context.Context, arg2 *s3.PutBucketCorsInput, arg3 ...request.Option) (*s3.PutBucketCorsOutput, error) {
+func (fake *FakeS3API) PutBucketCorsWithContext(arg1 aws.Context, arg2 *s3.PutBucketCorsInput, arg3 ...request.Option) (*s3.PutBucketCorsOutput, error) {
 	fake.putBucketCorsWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketCorsWithContextReturnsOnCall[len(fake.putBucketCorsWithContextArgsForCall)]
 	fake.putBucketCorsWithContextArgsForCall = append(fake.putBucketCorsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketCorsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketCorsWithContextStub
+	fakeReturns := fake.putBucketCorsWithContextReturns
 	fake.recordInvocation("PutBucketCorsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketCorsWithContextMutex.Unlock()
-	if fake.PutBucketCorsWithContextStub != nil {
-		return fake.PutBucketCorsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketCorsWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16463,13 +18817,13 @@ func (fake *FakeS3API) PutBucketCorsWithContextCallCount() int {
 	return len(fake.putBucketCorsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketCorsWithContextCalls(stub func(context.Context, *s3.PutBucketCorsInput, ...request.Option) (*s3.PutBucketCorsOutput, error)) {
+func (fake *FakeS3API) PutBucketCorsWithContextCalls(stub func(aws.Context, *s3.PutBucketCorsInput, ...request.Option) (*s3.PutBucketCorsOutput, error)) {
 	fake.putBucketCorsWithContextMutex.Lock()
 	defer fake.putBucketCorsWithContextMutex.Unlock()
 	fake.PutBucketCorsWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketCorsWithContextArgsForCall(i int) (context.Context, *s3.PutBucketCorsInput, []request.Option) {
+func (fake *FakeS3API) PutBucketCorsWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketCorsInput, []request.Option) {
 	fake.putBucketCorsWithContextMutex.RLock()
 	defer fake.putBucketCorsWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketCorsWithContextArgsForCall[i]
@@ -16508,15 +18862,16 @@ func (fake *FakeS3API) PutBucketEncryption(arg1 *s3.PutBucketEncryptionInput) (*
 	fake.putBucketEncryptionArgsForCall = append(fake.putBucketEncryptionArgsForCall, struct {
 		arg1 *s3.PutBucketEncryptionInput
 	}{arg1})
+	stub := fake.PutBucketEncryptionStub
+	fakeReturns := fake.putBucketEncryptionReturns
 	fake.recordInvocation("PutBucketEncryption", []interface{}{arg1})
 	fake.putBucketEncryptionMutex.Unlock()
-	if fake.PutBucketEncryptionStub != nil {
-		return fake.PutBucketEncryptionStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketEncryptionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16549,146 +18904,342 @@ func (fake *FakeS3API) PutBucketEncryptionReturns(result1 *s3.PutBucketEncryptio
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketEncryptionReturnsOnCall(i int, result1 *s3.PutBucketEncryptionOutput, result2 error) {
-	fake.putBucketEncryptionMutex.Lock()
-	defer fake.putBucketEncryptionMutex.Unlock()
-	fake.PutBucketEncryptionStub = nil
-	if fake.putBucketEncryptionReturnsOnCall == nil {
-		fake.putBucketEncryptionReturnsOnCall = make(map[int]struct {
-			result1 *s3.PutBucketEncryptionOutput
+func (fake *FakeS3API) PutBucketEncryptionReturnsOnCall(i int, result1 *s3.PutBucketEncryptionOutput, result2 error) {
+	fake.putBucketEncryptionMutex.Lock()
+	defer fake.putBucketEncryptionMutex.Unlock()
+	fake.PutBucketEncryptionStub = nil
+	if fake.putBucketEncryptionReturnsOnCall == nil {
+		fake.putBucketEncryptionReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketEncryptionOutput
+			result2 error
+		})
+	}
+	fake.putBucketEncryptionReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketEncryptionOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) PutBucketEncryptionRequest(arg1 *s3.PutBucketEncryptionInput) (*request.Request, *s3.PutBucketEncryptionOutput) {
+	fake.putBucketEncryptionRequestMutex.Lock()
+	ret, specificReturn := fake.putBucketEncryptionRequestReturnsOnCall[len(fake.putBucketEncryptionRequestArgsForCall)]
+	fake.putBucketEncryptionRequestArgsForCall = append(fake.putBucketEncryptionRequestArgsForCall, struct {
+		arg1 *s3.PutBucketEncryptionInput
+	}{arg1})
+	stub := fake.PutBucketEncryptionRequestStub
+	fakeReturns := fake.putBucketEncryptionRequestReturns
+	fake.recordInvocation("PutBucketEncryptionRequest", []interface{}{arg1})
+	fake.putBucketEncryptionRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) PutBucketEncryptionRequestCallCount() int {
+	fake.putBucketEncryptionRequestMutex.RLock()
+	defer fake.putBucketEncryptionRequestMutex.RUnlock()
+	return len(fake.putBucketEncryptionRequestArgsForCall)
+}
+
+func (fake *FakeS3API) PutBucketEncryptionRequestCalls(stub func(*s3.PutBucketEncryptionInput) (*request.Request, *s3.PutBucketEncryptionOutput)) {
+	fake.putBucketEncryptionRequestMutex.Lock()
+	defer fake.putBucketEncryptionRequestMutex.Unlock()
+	fake.PutBucketEncryptionRequestStub = stub
+}
+
+func (fake *FakeS3API) PutBucketEncryptionRequestArgsForCall(i int) *s3.PutBucketEncryptionInput {
+	fake.putBucketEncryptionRequestMutex.RLock()
+	defer fake.putBucketEncryptionRequestMutex.RUnlock()
+	argsForCall := fake.putBucketEncryptionRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) PutBucketEncryptionRequestReturns(result1 *request.Request, result2 *s3.PutBucketEncryptionOutput) {
+	fake.putBucketEncryptionRequestMutex.Lock()
+	defer fake.putBucketEncryptionRequestMutex.Unlock()
+	fake.PutBucketEncryptionRequestStub = nil
+	fake.putBucketEncryptionRequestReturns = struct {
+		result1 *request.Request
+		result2 *s3.PutBucketEncryptionOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) PutBucketEncryptionRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.PutBucketEncryptionOutput) {
+	fake.putBucketEncryptionRequestMutex.Lock()
+	defer fake.putBucketEncryptionRequestMutex.Unlock()
+	fake.PutBucketEncryptionRequestStub = nil
+	if fake.putBucketEncryptionRequestReturnsOnCall == nil {
+		fake.putBucketEncryptionRequestReturnsOnCall = make(map[int]struct {
+			result1 *request.Request
+			result2 *s3.PutBucketEncryptionOutput
+		})
+	}
+	fake.putBucketEncryptionRequestReturnsOnCall[i] = struct {
+		result1 *request.Request
+		result2 *s3.PutBucketEncryptionOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) PutBucketEncryptionWithContext(arg1 aws.Context, arg2 *s3.PutBucketEncryptionInput, arg3 ...request.Option) (*s3.PutBucketEncryptionOutput, error) {
+	fake.putBucketEncryptionWithContextMutex.Lock()
+	ret, specificReturn := fake.putBucketEncryptionWithContextReturnsOnCall[len(fake.putBucketEncryptionWithContextArgsForCall)]
+	fake.putBucketEncryptionWithContextArgsForCall = append(fake.putBucketEncryptionWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.PutBucketEncryptionInput
+		arg3 []request.Option
+	}{arg1, arg2, arg3})
+	stub := fake.PutBucketEncryptionWithContextStub
+	fakeReturns := fake.putBucketEncryptionWithContextReturns
+	fake.recordInvocation("PutBucketEncryptionWithContext", []interface{}{arg1, arg2, arg3})
+	fake.putBucketEncryptionWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) PutBucketEncryptionWithContextCallCount() int {
+	fake.putBucketEncryptionWithContextMutex.RLock()
+	defer fake.putBucketEncryptionWithContextMutex.RUnlock()
+	return len(fake.putBucketEncryptionWithContextArgsForCall)
+}
+
+func (fake *FakeS3API) PutBucketEncryptionWithContextCalls(stub func(aws.Context, *s3.PutBucketEncryptionInput, ...request.Option) (*s3.PutBucketEncryptionOutput, error)) {
+	fake.putBucketEncryptionWithContextMutex.Lock()
+	defer fake.putBucketEncryptionWithContextMutex.Unlock()
+	fake.PutBucketEncryptionWithContextStub = stub
+}
+
+func (fake *FakeS3API) PutBucketEncryptionWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketEncryptionInput, []request.Option) {
+	fake.putBucketEncryptionWithContextMutex.RLock()
+	defer fake.putBucketEncryptionWithContextMutex.RUnlock()
+	argsForCall := fake.putBucketEncryptionWithContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3API) PutBucketEncryptionWithContextReturns(result1 *s3.PutBucketEncryptionOutput, result2 error) {
+	fake.putBucketEncryptionWithContextMutex.Lock()
+	defer fake.putBucketEncryptionWithContextMutex.Unlock()
+	fake.PutBucketEncryptionWithContextStub = nil
+	fake.putBucketEncryptionWithContextReturns = struct {
+		result1 *s3.PutBucketEncryptionOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) PutBucketEncryptionWithContextReturnsOnCall(i int, result1 *s3.PutBucketEncryptionOutput, result2 error) {
+	fake.putBucketEncryptionWithContextMutex.Lock()
+	defer fake.putBucketEncryptionWithContextMutex.Unlock()
+	fake.PutBucketEncryptionWithContextStub = nil
+	if fake.putBucketEncryptionWithContextReturnsOnCall == nil {
+		fake.putBucketEncryptionWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketEncryptionOutput
+			result2 error
+		})
+	}
+	fake.putBucketEncryptionWithContextReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketEncryptionOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) PutBucketIntelligentTieringConfiguration(arg1 *s3.PutBucketIntelligentTieringConfigurationInput) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+	fake.putBucketIntelligentTieringConfigurationMutex.Lock()
+	ret, specificReturn := fake.putBucketIntelligentTieringConfigurationReturnsOnCall[len(fake.putBucketIntelligentTieringConfigurationArgsForCall)]
+	fake.putBucketIntelligentTieringConfigurationArgsForCall = append(fake.putBucketIntelligentTieringConfigurationArgsForCall, struct {
+		arg1 *s3.PutBucketIntelligentTieringConfigurationInput
+	}{arg1})
+	stub := fake.PutBucketIntelligentTieringConfigurationStub
+	fakeReturns := fake.putBucketIntelligentTieringConfigurationReturns
+	fake.recordInvocation("PutBucketIntelligentTieringConfiguration", []interface{}{arg1})
+	fake.putBucketIntelligentTieringConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationCallCount() int {
+	fake.putBucketIntelligentTieringConfigurationMutex.RLock()
+	defer fake.putBucketIntelligentTieringConfigurationMutex.RUnlock()
+	return len(fake.putBucketIntelligentTieringConfigurationArgsForCall)
+}
+
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationCalls(stub func(*s3.PutBucketIntelligentTieringConfigurationInput) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)) {
+	fake.putBucketIntelligentTieringConfigurationMutex.Lock()
+	defer fake.putBucketIntelligentTieringConfigurationMutex.Unlock()
+	fake.PutBucketIntelligentTieringConfigurationStub = stub
+}
+
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationArgsForCall(i int) *s3.PutBucketIntelligentTieringConfigurationInput {
+	fake.putBucketIntelligentTieringConfigurationMutex.RLock()
+	defer fake.putBucketIntelligentTieringConfigurationMutex.RUnlock()
+	argsForCall := fake.putBucketIntelligentTieringConfigurationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationReturns(result1 *s3.PutBucketIntelligentTieringConfigurationOutput, result2 error) {
+	fake.putBucketIntelligentTieringConfigurationMutex.Lock()
+	defer fake.putBucketIntelligentTieringConfigurationMutex.Unlock()
+	fake.PutBucketIntelligentTieringConfigurationStub = nil
+	fake.putBucketIntelligentTieringConfigurationReturns = struct {
+		result1 *s3.PutBucketIntelligentTieringConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationReturnsOnCall(i int, result1 *s3.PutBucketIntelligentTieringConfigurationOutput, result2 error) {
+	fake.putBucketIntelligentTieringConfigurationMutex.Lock()
+	defer fake.putBucketIntelligentTieringConfigurationMutex.Unlock()
+	fake.PutBucketIntelligentTieringConfigurationStub = nil
+	if fake.putBucketIntelligentTieringConfigurationReturnsOnCall == nil {
+		fake.putBucketIntelligentTieringConfigurationReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketIntelligentTieringConfigurationOutput
 			result2 error
 		})
 	}
-	fake.putBucketEncryptionReturnsOnCall[i] = struct {
-		result1 *s3.PutBucketEncryptionOutput
+	fake.putBucketIntelligentTieringConfigurationReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketIntelligentTieringConfigurationOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketEncryptionRequest(arg1 *s3.PutBucketEncryptionInput) (*request.Request, *s3.PutBucketEncryptionOutput) {
-	fake.putBucketEncryptionRequestMutex.Lock()
-	ret, specificReturn := fake.putBucketEncryptionRequestReturnsOnCall[len(fake.putBucketEncryptionRequestArgsForCall)]
-	fake.putBucketEncryptionRequestArgsForCall = append(fake.putBucketEncryptionRequestArgsForCall, struct {
-		arg1 *s3.PutBucketEncryptionInput
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationRequest(arg1 *s3.PutBucketIntelligentTieringConfigurationInput) (*request.Request, *s3.PutBucketIntelligentTieringConfigurationOutput) {
+	fake.putBucketIntelligentTieringConfigurationRequestMutex.Lock()
+	ret, specificReturn := fake.putBucketIntelligentTieringConfigurationRequestReturnsOnCall[len(fake.putBucketIntelligentTieringConfigurationRequestArgsForCall)]
+	fake.putBucketIntelligentTieringConfigurationRequestArgsForCall = append(fake.putBucketIntelligentTieringConfigurationRequestArgsForCall, struct {
+		arg1 *s3.PutBucketIntelligentTieringConfigurationInput
 	}{arg1})
-	fake.recordInvocation("PutBucketEncryptionRequest", []interface{}{arg1})
-	fake.putBucketEncryptionRequestMutex.Unlock()
-	if fake.PutBucketEncryptionRequestStub != nil {
-		return fake.PutBucketEncryptionRequestStub(arg1)
+	stub := fake.PutBucketIntelligentTieringConfigurationRequestStub
+	fakeReturns := fake.putBucketIntelligentTieringConfigurationRequestReturns
+	fake.recordInvocation("PutBucketIntelligentTieringConfigurationRequest", []interface{}{arg1})
+	fake.putBucketIntelligentTieringConfigurationRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketEncryptionRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) PutBucketEncryptionRequestCallCount() int {
-	fake.putBucketEncryptionRequestMutex.RLock()
-	defer fake.putBucketEncryptionRequestMutex.RUnlock()
-	return len(fake.putBucketEncryptionRequestArgsForCall)
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationRequestCallCount() int {
+	fake.putBucketIntelligentTieringConfigurationRequestMutex.RLock()
+	defer fake.putBucketIntelligentTieringConfigurationRequestMutex.RUnlock()
+	return len(fake.putBucketIntelligentTieringConfigurationRequestArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketEncryptionRequestCalls(stub func(*s3.PutBucketEncryptionInput) (*request.Request, *s3.PutBucketEncryptionOutput)) {
-	fake.putBucketEncryptionRequestMutex.Lock()
-	defer fake.putBucketEncryptionRequestMutex.Unlock()
-	fake.PutBucketEncryptionRequestStub = stub
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationRequestCalls(stub func(*s3.PutBucketIntelligentTieringConfigurationInput) (*request.Request, *s3.PutBucketIntelligentTieringConfigurationOutput)) {
+	fake.putBucketIntelligentTieringConfigurationRequestMutex.Lock()
+	defer fake.putBucketIntelligentTieringConfigurationRequestMutex.Unlock()
+	fake.PutBucketIntelligentTieringConfigurationRequestStub = stub
 }
 
-func (fake *FakeS3API) PutBucketEncryptionRequestArgsForCall(i int) *s3.PutBucketEncryptionInput {
-	fake.putBucketEncryptionRequestMutex.RLock()
-	defer fake.putBucketEncryptionRequestMutex.RUnlock()
-	argsForCall := fake.putBucketEncryptionRequestArgsForCall[i]
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationRequestArgsForCall(i int) *s3.PutBucketIntelligentTieringConfigurationInput {
+	fake.putBucketIntelligentTieringConfigurationRequestMutex.RLock()
+	defer fake.putBucketIntelligentTieringConfigurationRequestMutex.RUnlock()
+	argsForCall := fake.putBucketIntelligentTieringConfigurationRequestArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeS3API) PutBucketEncryptionRequestReturns(result1 *request.Request, result2 *s3.PutBucketEncryptionOutput) {
-	fake.putBucketEncryptionRequestMutex.Lock()
-	defer fake.putBucketEncryptionRequestMutex.Unlock()
-	fake.PutBucketEncryptionRequestStub = nil
-	fake.putBucketEncryptionRequestReturns = struct {
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationRequestReturns(result1 *request.Request, result2 *s3.PutBucketIntelligentTieringConfigurationOutput) {
+	fake.putBucketIntelligentTieringConfigurationRequestMutex.Lock()
+	defer fake.putBucketIntelligentTieringConfigurationRequestMutex.Unlock()
+	fake.PutBucketIntelligentTieringConfigurationRequestStub = nil
+	fake.putBucketIntelligentTieringConfigurationRequestReturns = struct {
 		result1 *request.Request
-		result2 *s3.PutBucketEncryptionOutput
+		result2 *s3.PutBucketIntelligentTieringConfigurationOutput
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketEncryptionRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.PutBucketEncryptionOutput) {
-	fake.putBucketEncryptionRequestMutex.Lock()
-	defer fake.putBucketEncryptionRequestMutex.Unlock()
-	fake.PutBucketEncryptionRequestStub = nil
-	if fake.putBucketEncryptionRequestReturnsOnCall == nil {
-		fake.putBucketEncryptionRequestReturnsOnCall = make(map[int]struct {
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.PutBucketIntelligentTieringConfigurationOutput) {
+	fake.putBucketIntelligentTieringConfigurationRequestMutex.Lock()
+	defer fake.putBucketIntelligentTieringConfigurationRequestMutex.Unlock()
+	fake.PutBucketIntelligentTieringConfigurationRequestStub = nil
+	if fake.putBucketIntelligentTieringConfigurationRequestReturnsOnCall == nil {
+		fake.putBucketIntelligentTieringConfigurationRequestReturnsOnCall = make(map[int]struct {
 			result1 *request.Request
-			result2 *s3.PutBucketEncryptionOutput
+			result2 *s3.PutBucketIntelligentTieringConfigurationOutput
 		})
 	}
-	fake.putBucketEncryptionRequestReturnsOnCall[i] = struct {
+	fake.putBucketIntelligentTieringConfigurationRequestReturnsOnCall[i] = struct {
 		result1 *request.Request
-		result2 *s3.PutBucketEncryptionOutput
+		result2 *s3.PutBucketIntelligentTieringConfigurationOutput
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketEncryptionWithContext(arg1 context.Context, arg2 *s3.PutBucketEncryptionInput, arg3 ...request.Option) (*s3.PutBucketEncryptionOutput, error) {
-	fake.putBucketEncryptionWithContextMutex.Lock()
-	ret, specificReturn := fake.putBucketEncryptionWithContextReturnsOnCall[len(fake.putBucketEncryptionWithContextArgsForCall)]
-	fake.putBucketEncryptionWithContextArgsForCall = append(fake.putBucketEncryptionWithContextArgsForCall, struct {
-		arg1 context.Context
-		arg2 *s3.PutBucketEncryptionInput
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationWithContext(arg1 aws.Context, arg2 *s3.PutBucketIntelligentTieringConfigurationInput, arg3 ...request.Option) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+	fake.putBucketIntelligentTieringConfigurationWithContextMutex.Lock()
+	ret, specificReturn := fake.putBucketIntelligentTieringConfigurationWithContextReturnsOnCall[len(fake.putBucketIntelligentTieringConfigurationWithContextArgsForCall)]
+	fake.putBucketIntelligentTieringConfigurationWithContextArgsForCall = append(fake.putBucketIntelligentTieringConfigurationWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.PutBucketIntelligentTieringConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
-	fake.recordInvocation("PutBucketEncryptionWithContext", []interface{}{arg1, arg2, arg3})
-	fake.putBucketEncryptionWithContextMutex.Unlock()
-	if fake.PutBucketEncryptionWithContextStub != nil {
-		return fake.PutBucketEncryptionWithContextStub(arg1, arg2, arg3...)
+	stub := fake.PutBucketIntelligentTieringConfigurationWithContextStub
+	fakeReturns := fake.putBucketIntelligentTieringConfigurationWithContextReturns
+	fake.recordInvocation("PutBucketIntelligentTieringConfigurationWithContext", []interface{}{arg1, arg2, arg3})
+	fake.putBucketIntelligentTieringConfigurationWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketEncryptionWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) PutBucketEncryptionWithContextCallCount() int {
-	fake.putBucketEncryptionWithContextMutex.RLock()
-	defer fake.putBucketEncryptionWithContextMutex.RUnlock()
-	return len(fake.putBucketEncryptionWithContextArgsForCall)
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationWithContextCallCount() int {
+	fake.putBucketIntelligentTieringConfigurationWithContextMutex.RLock()
+	defer fake.putBucketIntelligentTieringConfigurationWithContextMutex.RUnlock()
+	return len(fake.putBucketIntelligentTieringConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketEncryptionWithContextCalls(stub func(context.Context, *s3.PutBucketEncryptionInput, ...request.Option) (*s3.PutBucketEncryptionOutput, error)) {
-	fake.putBucketEncryptionWithContextMutex.Lock()
-	defer fake.putBucketEncryptionWithContextMutex.Unlock()
-	fake.PutBucketEncryptionWithContextStub = stub
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationWithContextCalls(stub func(aws.Context, *s3.PutBucketIntelligentTieringConfigurationInput, ...request.Option) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)) {
+	fake.putBucketIntelligentTieringConfigurationWithContextMutex.Lock()
+	defer fake.putBucketIntelligentTieringConfigurationWithContextMutex.Unlock()
+	fake.PutBucketIntelligentTieringConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketEncryptionWithContextArgsForCall(i int) (context.Context, *s3.PutBucketEncryptionInput, []request.Option) {
-	fake.putBucketEncryptionWithContextMutex.RLock()
-	defer fake.putBucketEncryptionWithContextMutex.RUnlock()
-	argsForCall := fake.putBucketEncryptionWithContextArgsForCall[i]
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketIntelligentTieringConfigurationInput, []request.Option) {
+	fake.putBucketIntelligentTieringConfigurationWithContextMutex.RLock()
+	defer fake.putBucketIntelligentTieringConfigurationWithContextMutex.RUnlock()
+	argsForCall := fake.putBucketIntelligentTieringConfigurationWithContextArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeS3API) PutBucketEncryptionWithContextReturns(result1 *s3.PutBucketEncryptionOutput, result2 error) {
-	fake.putBucketEncryptionWithContextMutex.Lock()
-	defer fake.putBucketEncryptionWithContextMutex.Unlock()
-	fake.PutBucketEncryptionWithContextStub = nil
-	fake.putBucketEncryptionWithContextReturns = struct {
-		result1 *s3.PutBucketEncryptionOutput
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationWithContextReturns(result1 *s3.PutBucketIntelligentTieringConfigurationOutput, result2 error) {
+	fake.putBucketIntelligentTieringConfigurationWithContextMutex.Lock()
+	defer fake.putBucketIntelligentTieringConfigurationWithContextMutex.Unlock()
+	fake.PutBucketIntelligentTieringConfigurationWithContextStub = nil
+	fake.putBucketIntelligentTieringConfigurationWithContextReturns = struct {
+		result1 *s3.PutBucketIntelligentTieringConfigurationOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketEncryptionWithContextReturnsOnCall(i int, result1 *s3.PutBucketEncryptionOutput, result2 error) {
-	fake.putBucketEncryptionWithContextMutex.Lock()
-	defer fake.putBucketEncryptionWithContextMutex.Unlock()
-	fake.PutBucketEncryptionWithContextStub = nil
-	if fake.putBucketEncryptionWithContextReturnsOnCall == nil {
-		fake.putBucketEncryptionWithContextReturnsOnCall = make(map[int]struct {
-			result1 *s3.PutBucketEncryptionOutput
+func (fake *FakeS3API) PutBucketIntelligentTieringConfigurationWithContextReturnsOnCall(i int, result1 *s3.PutBucketIntelligentTieringConfigurationOutput, result2 error) {
+	fake.putBucketIntelligentTieringConfigurationWithContextMutex.Lock()
+	defer fake.putBucketIntelligentTieringConfigurationWithContextMutex.Unlock()
+	fake.PutBucketIntelligentTieringConfigurationWithContextStub = nil
+	if fake.putBucketIntelligentTieringConfigurationWithContextReturnsOnCall == nil {
+		fake.putBucketIntelligentTieringConfigurationWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketIntelligentTieringConfigurationOutput
 			result2 error
 		})
 	}
-	fake.putBucketEncryptionWithContextReturnsOnCall[i] = struct {
-		result1 *s3.PutBucketEncryptionOutput
+	fake.putBucketIntelligentTieringConfigurationWithContextReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketIntelligentTieringConfigurationOutput
 		result2 error
 	}{result1, result2}
 }
@@ -16699,15 +19250,16 @@ func (fake *FakeS3API) PutBucketInventoryConfiguration(arg1 *s3.PutBucketInvento
 	fake.putBucketInventoryConfigurationArgsForCall = append(fake.putBucketInventoryConfigurationArgsForCall, struct {
 		arg1 *s3.PutBucketInventoryConfigurationInput
 	}{arg1})
+	stub := fake.PutBucketInventoryConfigurationStub
+	fakeReturns := fake.putBucketInventoryConfigurationReturns
 	fake.recordInvocation("PutBucketInventoryConfiguration", []interface{}{arg1})
 	fake.putBucketInventoryConfigurationMutex.Unlock()
-	if fake.PutBucketInventoryConfigurationStub != nil {
-		return fake.PutBucketInventoryConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketInventoryConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16762,15 +19314,16 @@ func (fake *FakeS3API) PutBucketInventoryConfigurationRequest(arg1 *s3.PutBucket
 	fake.putBucketInventoryConfigurationRequestArgsForCall = append(fake.putBucketInventoryConfigurationRequestArgsForCall, struct {
 		arg1 *s3.PutBucketInventoryConfigurationInput
 	}{arg1})
+	stub := fake.PutBucketInventoryConfigurationRequestStub
+	fakeReturns := fake.putBucketInventoryConfigurationRequestReturns
 	fake.recordInvocation("PutBucketInventoryConfigurationRequest", []interface{}{arg1})
 	fake.putBucketInventoryConfigurationRequestMutex.Unlock()
-	if fake.PutBucketInventoryConfigurationRequestStub != nil {
-		return fake.PutBucketInventoryConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketInventoryConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16819,23 +19372,24 @@ func (fake *FakeS3API) PutBucketInventoryConfigurationRequestReturnsOnCall(i int
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketInventoryConfigurationWithContext(arg1 context.Context, arg2 *s3.PutBucketInventoryConfigurationInput, arg3 ...request.Option) (*s3.PutBucketInventoryConfigurationOutput, error) {
+func (fake *FakeS3API) PutBucketInventoryConfigurationWithContext(arg1 aws.Context, arg2 *s3.PutBucketInventoryConfigurationInput, arg3 ...request.Option) (*s3.PutBucketInventoryConfigurationOutput, error) {
 	fake.putBucketInventoryConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketInventoryConfigurationWithContextReturnsOnCall[len(fake.putBucketInventoryConfigurationWithContextArgsForCall)]
 	fake.putBucketInventoryConfigurationWithContextArgsForCall = append(fake.putBucketInventoryConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketInventoryConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketInventoryConfigurationWithContextStub
+	fakeReturns := fake.putBucketInventoryConfigurationWithContextReturns
 	fake.recordInvocation("PutBucketInventoryConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketInventoryConfigurationWithContextMutex.Unlock()
-	if fake.PutBucketInventoryConfigurationWithContextStub != nil {
-		return fake.PutBucketInventoryConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketInventoryConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16845,13 +19399,13 @@ func (fake *FakeS3API) PutBucketInventoryConfigurationWithContextCallCount() int
 	return len(fake.putBucketInventoryConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketInventoryConfigurationWithContextCalls(stub func(context.Context, *s3.PutBucketInventoryConfigurationInput, ...request.Option) (*s3.PutBucketInventoryConfigurationOutput, error)) {
+func (fake *FakeS3API) PutBucketInventoryConfigurationWithContextCalls(stub func(aws.Context, *s3.PutBucketInventoryConfigurationInput, ...request.Option) (*s3.PutBucketInventoryConfigurationOutput, error)) {
 	fake.putBucketInventoryConfigurationWithContextMutex.Lock()
 	defer fake.putBucketInventoryConfigurationWithContextMutex.Unlock()
 	fake.PutBucketInventoryConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketInventoryConfigurationWithContextArgsForCall(i int) (context.Context, *s3.PutBucketInventoryConfigurationInput, []request.Option) {
+func (fake *FakeS3API) PutBucketInventoryConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketInventoryConfigurationInput, []request.Option) {
 	fake.putBucketInventoryConfigurationWithContextMutex.RLock()
 	defer fake.putBucketInventoryConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketInventoryConfigurationWithContextArgsForCall[i]
@@ -16890,15 +19444,16 @@ func (fake *FakeS3API) PutBucketLifecycle(arg1 *s3.PutBucketLifecycleInput) (*s3
 	fake.putBucketLifecycleArgsForCall = append(fake.putBucketLifecycleArgsForCall, struct {
 		arg1 *s3.PutBucketLifecycleInput
 	}{arg1})
+	stub := fake.PutBucketLifecycleStub
+	fakeReturns := fake.putBucketLifecycleReturns
 	fake.recordInvocation("PutBucketLifecycle", []interface{}{arg1})
 	fake.putBucketLifecycleMutex.Unlock()
-	if fake.PutBucketLifecycleStub != nil {
-		return fake.PutBucketLifecycleStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketLifecycleReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -16953,15 +19508,16 @@ func (fake *FakeS3API) PutBucketLifecycleConfiguration(arg1 *s3.PutBucketLifecyc
 	fake.putBucketLifecycleConfigurationArgsForCall = append(fake.putBucketLifecycleConfigurationArgsForCall, struct {
 		arg1 *s3.PutBucketLifecycleConfigurationInput
 	}{arg1})
+	stub := fake.PutBucketLifecycleConfigurationStub
+	fakeReturns := fake.putBucketLifecycleConfigurationReturns
 	fake.recordInvocation("PutBucketLifecycleConfiguration", []interface{}{arg1})
 	fake.putBucketLifecycleConfigurationMutex.Unlock()
-	if fake.PutBucketLifecycleConfigurationStub != nil {
-		return fake.PutBucketLifecycleConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketLifecycleConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17016,15 +19572,16 @@ func (fake *FakeS3API) PutBucketLifecycleConfigurationRequest(arg1 *s3.PutBucket
 	fake.putBucketLifecycleConfigurationRequestArgsForCall = append(fake.putBucketLifecycleConfigurationRequestArgsForCall, struct {
 		arg1 *s3.PutBucketLifecycleConfigurationInput
 	}{arg1})
+	stub := fake.PutBucketLifecycleConfigurationRequestStub
+	fakeReturns := fake.putBucketLifecycleConfigurationRequestReturns
 	fake.recordInvocation("PutBucketLifecycleConfigurationRequest", []interface{}{arg1})
 	fake.putBucketLifecycleConfigurationRequestMutex.Unlock()
-	if fake.PutBucketLifecycleConfigurationRequestStub != nil {
-		return fake.PutBucketLifecycleConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketLifecycleConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17073,23 +19630,24 @@ func (fake *FakeS3API) PutBucketLifecycleConfigurationRequestReturnsOnCall(i int
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketLifecycleConfigurationWithContext(arg1 context.Context, arg2 *s3.PutBucketLifecycleConfigurationInput, arg3 ...request.Option) (*s3.PutBucketLifecycleConfigurationOutput, error) {
+func (fake *FakeS3API) PutBucketLifecycleConfigurationWithContext(arg1 aws.Context, arg2 *s3.PutBucketLifecycleConfigurationInput, arg3 ...request.Option) (*s3.PutBucketLifecycleConfigurationOutput, error) {
 	fake.putBucketLifecycleConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketLifecycleConfigurationWithContextReturnsOnCall[len(fake.putBucketLifecycleConfigurationWithContextArgsForCall)]
 	fake.putBucketLifecycleConfigurationWithContextArgsForCall = append(fake.putBucketLifecycleConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketLifecycleConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketLifecycleConfigurationWithContextStub
+	fakeReturns := fake.putBucketLifecycleConfigurationWithContextReturns
 	fake.recordInvocation("PutBucketLifecycleConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketLifecycleConfigurationWithContextMutex.Unlock()
-	if fake.PutBucketLifecycleConfigurationWithContextStub != nil {
-		return fake.PutBucketLifecycleConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketLifecycleConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17099,13 +19657,13 @@ func (fake *FakeS3API) PutBucketLifecycleConfigurationWithContextCallCount() int
 	return len(fake.putBucketLifecycleConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketLifecycleConfigurationWithContextCalls(stub func(context.Context, *s3.PutBucketLifecycleConfigurationInput, ...request.Option) (*s3.PutBucketLifecycleConfigurationOutput, error)) {
+func (fake *FakeS3API) PutBucketLifecycleConfigurationWithContextCalls(stub func(aws.Context, *s3.PutBucketLifecycleConfigurationInput, ...request.Option) (*s3.PutBucketLifecycleConfigurationOutput, error)) {
 	fake.putBucketLifecycleConfigurationWithContextMutex.Lock()
 	defer fake.putBucketLifecycleConfigurationWithContextMutex.Unlock()
 	fake.PutBucketLifecycleConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketLifecycleConfigurationWithContextArgsForCall(i int) (context.Context, *s3.PutBucketLifecycleConfigurationInput, []request.Option) {
+func (fake *FakeS3API) PutBucketLifecycleConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketLifecycleConfigurationInput, []request.Option) {
 	fake.putBucketLifecycleConfigurationWithContextMutex.RLock()
 	defer fake.putBucketLifecycleConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketLifecycleConfigurationWithContextArgsForCall[i]
@@ -17144,15 +19702,16 @@ func (fake *FakeS3API) PutBucketLifecycleRequest(arg1 *s3.PutBucketLifecycleInpu
 	fake.putBucketLifecycleRequestArgsForCall = append(fake.putBucketLifecycleRequestArgsForCall, struct {
 		arg1 *s3.PutBucketLifecycleInput
 	}{arg1})
+	stub := fake.PutBucketLifecycleRequestStub
+	fakeReturns := fake.putBucketLifecycleRequestReturns
 	fake.recordInvocation("PutBucketLifecycleRequest", []interface{}{arg1})
 	fake.putBucketLifecycleRequestMutex.Unlock()
-	if fake.PutBucketLifecycleRequestStub != nil {
-		return fake.PutBucketLifecycleRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketLifecycleRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17201,23 +19760,24 @@ func (fake *FakeS3API) PutBucketLifecycleRequestReturnsOnCall(i int, result1 *re
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketLifecycleWithContext(arg1 context.Context, arg2 *s3.PutBucketLifecycleInput, arg3 ...request.Option) (*s3.PutBucketLifecycleOutput, error) {
+func (fake *FakeS3API) PutBucketLifecycleWithContext(arg1 aws.Context, arg2 *s3.PutBucketLifecycleInput, arg3 ...request.Option) (*s3.PutBucketLifecycleOutput, error) {
 	fake.putBucketLifecycleWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketLifecycleWithContextReturnsOnCall[len(fake.putBucketLifecycleWithContextArgsForCall)]
 	fake.putBucketLifecycleWithContextArgsForCall = append(fake.putBucketLifecycleWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketLifecycleInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketLifecycleWithContextStub
+	fakeReturns := fake.putBucketLifecycleWithContextReturns
 	fake.recordInvocation("PutBucketLifecycleWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketLifecycleWithContextMutex.Unlock()
-	if fake.PutBucketLifecycleWithContextStub != nil {
-		return fake.PutBucketLifecycleWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketLifecycleWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17227,13 +19787,13 @@ func (fake *FakeS3API) PutBucketLifecycleWithContextCallCount() int {
 	return len(fake.putBucketLifecycleWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketLifecycleWithContextCalls(stub func(context.Context, *s3.PutBucketLifecycleInput, ...request.Option) (*s3.PutBucketLifecycleOutput, error)) {
+func (fake *FakeS3API) PutBucketLifecycleWithContextCalls(stub func(aws.Context, *s3.PutBucketLifecycleInput, ...request.Option) (*s3.PutBucketLifecycleOutput, error)) {
 	fake.putBucketLifecycleWithContextMutex.Lock()
 	defer fake.putBucketLifecycleWithContextMutex.Unlock()
 	fake.PutBucketLifecycleWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketLifecycleWithContextArgsForCall(i int) (context.Context, *s3.PutBucketLifecycleInput, []request.Option) {
+func (fake *FakeS3API) PutBucketLifecycleWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketLifecycleInput, []request.Option) {
 	fake.putBucketLifecycleWithContextMutex.RLock()
 	defer fake.putBucketLifecycleWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketLifecycleWithContextArgsForCall[i]
@@ -17272,15 +19832,16 @@ func (fake *FakeS3API) PutBucketLogging(arg1 *s3.PutBucketLoggingInput) (*s3.Put
 	fake.putBucketLoggingArgsForCall = append(fake.putBucketLoggingArgsForCall, struct {
 		arg1 *s3.PutBucketLoggingInput
 	}{arg1})
+	stub := fake.PutBucketLoggingStub
+	fakeReturns := fake.putBucketLoggingReturns
 	fake.recordInvocation("PutBucketLogging", []interface{}{arg1})
 	fake.putBucketLoggingMutex.Unlock()
-	if fake.PutBucketLoggingStub != nil {
-		return fake.PutBucketLoggingStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketLoggingReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17335,15 +19896,16 @@ func (fake *FakeS3API) PutBucketLoggingRequest(arg1 *s3.PutBucketLoggingInput) (
 	fake.putBucketLoggingRequestArgsForCall = append(fake.putBucketLoggingRequestArgsForCall, struct {
 		arg1 *s3.PutBucketLoggingInput
 	}{arg1})
+	stub := fake.PutBucketLoggingRequestStub
+	fakeReturns := fake.putBucketLoggingRequestReturns
 	fake.recordInvocation("PutBucketLoggingRequest", []interface{}{arg1})
 	fake.putBucketLoggingRequestMutex.Unlock()
-	if fake.PutBucketLoggingRequestStub != nil {
-		return fake.PutBucketLoggingRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketLoggingRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17392,23 +19954,24 @@ func (fake *FakeS3API) PutBucketLoggingRequestReturnsOnCall(i int, result1 *requ
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketLoggingWithContext(arg1 context.Context, arg2 *s3.PutBucketLoggingInput, arg3 ...request.Option) (*s3.PutBucketLoggingOutput, error) {
+func (fake *FakeS3API) PutBucketLoggingWithContext(arg1 aws.Context, arg2 *s3.PutBucketLoggingInput, arg3 ...request.Option) (*s3.PutBucketLoggingOutput, error) {
 	fake.putBucketLoggingWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketLoggingWithContextReturnsOnCall[len(fake.putBucketLoggingWithContextArgsForCall)]
 	fake.putBucketLoggingWithContextArgsForCall = append(fake.putBucketLoggingWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketLoggingInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketLoggingWithContextStub
+	fakeReturns := fake.putBucketLoggingWithContextReturns
 	fake.recordInvocation("PutBucketLoggingWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketLoggingWithContextMutex.Unlock()
-	if fake.PutBucketLoggingWithContextStub != nil {
-		return fake.PutBucketLoggingWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketLoggingWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17418,13 +19981,13 @@ func (fake *FakeS3API) PutBucketLoggingWithContextCallCount() int {
 	return len(fake.putBucketLoggingWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketLoggingWithContextCalls(stub func(context.Context, *s3.PutBucketLoggingInput, ...request.Option) (*s3.PutBucketLoggingOutput, error)) {
+func (fake *FakeS3API) PutBucketLoggingWithContextCalls(stub func(aws.Context, *s3.PutBucketLoggingInput, ...request.Option) (*s3.PutBucketLoggingOutput, error)) {
 	fake.putBucketLoggingWithContextMutex.Lock()
 	defer fake.putBucketLoggingWithContextMutex.Unlock()
 	fake.PutBucketLoggingWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketLoggingWithContextArgsForCall(i int) (context.Context, *s3.PutBucketLoggingInput, []request.Option) {
+func (fake *FakeS3API) PutBucketLoggingWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketLoggingInput, []request.Option) {
 	fake.putBucketLoggingWithContextMutex.RLock()
 	defer fake.putBucketLoggingWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketLoggingWithContextArgsForCall[i]
@@ -17463,15 +20026,16 @@ func (fake *FakeS3API) PutBucketMetricsConfiguration(arg1 *s3.PutBucketMetricsCo
 	fake.putBucketMetricsConfigurationArgsForCall = append(fake.putBucketMetricsConfigurationArgsForCall, struct {
 		arg1 *s3.PutBucketMetricsConfigurationInput
 	}{arg1})
+	stub := fake.PutBucketMetricsConfigurationStub
+	fakeReturns := fake.putBucketMetricsConfigurationReturns
 	fake.recordInvocation("PutBucketMetricsConfiguration", []interface{}{arg1})
 	fake.putBucketMetricsConfigurationMutex.Unlock()
-	if fake.PutBucketMetricsConfigurationStub != nil {
-		return fake.PutBucketMetricsConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketMetricsConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17526,15 +20090,16 @@ func (fake *FakeS3API) PutBucketMetricsConfigurationRequest(arg1 *s3.PutBucketMe
 	fake.putBucketMetricsConfigurationRequestArgsForCall = append(fake.putBucketMetricsConfigurationRequestArgsForCall, struct {
 		arg1 *s3.PutBucketMetricsConfigurationInput
 	}{arg1})
+	stub := fake.PutBucketMetricsConfigurationRequestStub
+	fakeReturns := fake.putBucketMetricsConfigurationRequestReturns
 	fake.recordInvocation("PutBucketMetricsConfigurationRequest", []interface{}{arg1})
 	fake.putBucketMetricsConfigurationRequestMutex.Unlock()
-	if fake.PutBucketMetricsConfigurationRequestStub != nil {
-		return fake.PutBucketMetricsConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketMetricsConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17583,23 +20148,24 @@ func (fake *FakeS3API) PutBucketMetricsConfigurationRequestReturnsOnCall(i int,
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketMetricsConfigurationWithContext(arg1 context.Context, arg2 *s3.PutBucketMetricsConfigurationInput, arg3 ...request.Option) (*s3.PutBucketMetricsConfigurationOutput, error) {
+func (fake *FakeS3API) PutBucketMetricsConfigurationWithContext(arg1 aws.Context, arg2 *s3.PutBucketMetricsConfigurationInput, arg3 ...request.Option) (*s3.PutBucketMetricsConfigurationOutput, error) {
 	fake.putBucketMetricsConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketMetricsConfigurationWithContextReturnsOnCall[len(fake.putBucketMetricsConfigurationWithContextArgsForCall)]
 	fake.putBucketMetricsConfigurationWithContextArgsForCall = append(fake.putBucketMetricsConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketMetricsConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketMetricsConfigurationWithContextStub
+	fakeReturns := fake.putBucketMetricsConfigurationWithContextReturns
 	fake.recordInvocation("PutBucketMetricsConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketMetricsConfigurationWithContextMutex.Unlock()
-	if fake.PutBucketMetricsConfigurationWithContextStub != nil {
-		return fake.PutBucketMetricsConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketMetricsConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17609,13 +20175,13 @@ func (fake *FakeS3API) PutBucketMetricsConfigurationWithContextCallCount() int {
 	return len(fake.putBucketMetricsConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketMetricsConfigurationWithContextCalls(stub func(context.Context, *s3.PutBucketMetricsConfigurationInput, ...request.Option) (*s3.PutBucketMetricsConfigurationOutput, error)) {
+func (fake *FakeS3API) PutBucketMetricsConfigurationWithContextCalls(stub func(aws.Context, *s3.PutBucketMetricsConfigurationInput, ...request.Option) (*s3.PutBucketMetricsConfigurationOutput, error)) {
 	fake.putBucketMetricsConfigurationWithContextMutex.Lock()
 	defer fake.putBucketMetricsConfigurationWithContextMutex.Unlock()
 	fake.PutBucketMetricsConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketMetricsConfigurationWithContextArgsForCall(i int) (context.Context, *s3.PutBucketMetricsConfigurationInput, []request.Option) {
+func (fake *FakeS3API) PutBucketMetricsConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketMetricsConfigurationInput, []request.Option) {
 	fake.putBucketMetricsConfigurationWithContextMutex.RLock()
 	defer fake.putBucketMetricsConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketMetricsConfigurationWithContextArgsForCall[i]
@@ -17654,15 +20220,16 @@ func (fake *FakeS3API) PutBucketNotification(arg1 *s3.PutBucketNotificationInput
 	fake.putBucketNotificationArgsForCall = append(fake.putBucketNotificationArgsForCall, struct {
 		arg1 *s3.PutBucketNotificationInput
 	}{arg1})
+	stub := fake.PutBucketNotificationStub
+	fakeReturns := fake.putBucketNotificationReturns
 	fake.recordInvocation("PutBucketNotification", []interface{}{arg1})
 	fake.putBucketNotificationMutex.Unlock()
-	if fake.PutBucketNotificationStub != nil {
-		return fake.PutBucketNotificationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketNotificationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17717,15 +20284,16 @@ func (fake *FakeS3API) PutBucketNotificationConfiguration(arg1 *s3.PutBucketNoti
 	fake.putBucketNotificationConfigurationArgsForCall = append(fake.putBucketNotificationConfigurationArgsForCall, struct {
 		arg1 *s3.PutBucketNotificationConfigurationInput
 	}{arg1})
+	stub := fake.PutBucketNotificationConfigurationStub
+	fakeReturns := fake.putBucketNotificationConfigurationReturns
 	fake.recordInvocation("PutBucketNotificationConfiguration", []interface{}{arg1})
 	fake.putBucketNotificationConfigurationMutex.Unlock()
-	if fake.PutBucketNotificationConfigurationStub != nil {
-		return fake.PutBucketNotificationConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketNotificationConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17780,15 +20348,16 @@ func (fake *FakeS3API) PutBucketNotificationConfigurationRequest(arg1 *s3.PutBuc
 	fake.putBucketNotificationConfigurationRequestArgsForCall = append(fake.putBucketNotificationConfigurationRequestArgsForCall, struct {
 		arg1 *s3.PutBucketNotificationConfigurationInput
 	}{arg1})
+	stub := fake.PutBucketNotificationConfigurationRequestStub
+	fakeReturns := fake.putBucketNotificationConfigurationRequestReturns
 	fake.recordInvocation("PutBucketNotificationConfigurationRequest", []interface{}{arg1})
 	fake.putBucketNotificationConfigurationRequestMutex.Unlock()
-	if fake.PutBucketNotificationConfigurationRequestStub != nil {
-		return fake.PutBucketNotificationConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketNotificationConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17837,23 +20406,24 @@ func (fake *FakeS3API) PutBucketNotificationConfigurationRequestReturnsOnCall(i
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketNotificationConfigurationWithContext(arg1 context.Context, arg2 *s3.PutBucketNotificationConfigurationInput, arg3 ...request.Option) (*s3.PutBucketNotificationConfigurationOutput, error) {
+func (fake *FakeS3API) PutBucketNotificationConfigurationWithContext(arg1 aws.Context, arg2 *s3.PutBucketNotificationConfigurationInput, arg3 ...request.Option) (*s3.PutBucketNotificationConfigurationOutput, error) {
 	fake.putBucketNotificationConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketNotificationConfigurationWithContextReturnsOnCall[len(fake.putBucketNotificationConfigurationWithContextArgsForCall)]
 	fake.putBucketNotificationConfigurationWithContextArgsForCall = append(fake.putBucketNotificationConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketNotificationConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketNotificationConfigurationWithContextStub
+	fakeReturns := fake.putBucketNotificationConfigurationWithContextReturns
 	fake.recordInvocation("PutBucketNotificationConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketNotificationConfigurationWithContextMutex.Unlock()
-	if fake.PutBucketNotificationConfigurationWithContextStub != nil {
-		return fake.PutBucketNotificationConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketNotificationConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -17863,13 +20433,13 @@ func (fake *FakeS3API) PutBucketNotificationConfigurationWithContextCallCount()
 	return len(fake.putBucketNotificationConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketNotificationConfigurationWithContextCalls(stub func(context.Context, *s3.PutBucketNotificationConfigurationInput, ...request.Option) (*s3.PutBucketNotificationConfigurationOutput, error)) {
+func (fake *FakeS3API) PutBucketNotificationConfigurationWithContextCalls(stub func(aws.Context, *s3.PutBucketNotificationConfigurationInput, ...request.Option) (*s3.PutBucketNotificationConfigurationOutput, error)) {
 	fake.putBucketNotificationConfigurationWithContextMutex.Lock()
 	defer fake.putBucketNotificationConfigurationWithContextMutex.Unlock()
 	fake.PutBucketNotificationConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketNotificationConfigurationWithContextArgsForCall(i int) (context.Context, *s3.PutBucketNotificationConfigurationInput, []request.Option) {
+func (fake *FakeS3API) PutBucketNotificationConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketNotificationConfigurationInput, []request.Option) {
 	fake.putBucketNotificationConfigurationWithContextMutex.RLock()
 	defer fake.putBucketNotificationConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketNotificationConfigurationWithContextArgsForCall[i]
@@ -17908,124 +20478,320 @@ func (fake *FakeS3API) PutBucketNotificationRequest(arg1 *s3.PutBucketNotificati
 	fake.putBucketNotificationRequestArgsForCall = append(fake.putBucketNotificationRequestArgsForCall, struct {
 		arg1 *s3.PutBucketNotificationInput
 	}{arg1})
+	stub := fake.PutBucketNotificationRequestStub
+	fakeReturns := fake.putBucketNotificationRequestReturns
 	fake.recordInvocation("PutBucketNotificationRequest", []interface{}{arg1})
 	fake.putBucketNotificationRequestMutex.Unlock()
-	if fake.PutBucketNotificationRequestStub != nil {
-		return fake.PutBucketNotificationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) PutBucketNotificationRequestCallCount() int {
+	fake.putBucketNotificationRequestMutex.RLock()
+	defer fake.putBucketNotificationRequestMutex.RUnlock()
+	return len(fake.putBucketNotificationRequestArgsForCall)
+}
+
+func (fake *FakeS3API) PutBucketNotificationRequestCalls(stub func(*s3.PutBucketNotificationInput) (*request.Request, *s3.PutBucketNotificationOutput)) {
+	fake.putBucketNotificationRequestMutex.Lock()
+	defer fake.putBucketNotificationRequestMutex.Unlock()
+	fake.PutBucketNotificationRequestStub = stub
+}
+
+func (fake *FakeS3API) PutBucketNotificationRequestArgsForCall(i int) *s3.PutBucketNotificationInput {
+	fake.putBucketNotificationRequestMutex.RLock()
+	defer fake.putBucketNotificationRequestMutex.RUnlock()
+	argsForCall := fake.putBucketNotificationRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) PutBucketNotificationRequestReturns(result1 *request.Request, result2 *s3.PutBucketNotificationOutput) {
+	fake.putBucketNotificationRequestMutex.Lock()
+	defer fake.putBucketNotificationRequestMutex.Unlock()
+	fake.PutBucketNotificationRequestStub = nil
+	fake.putBucketNotificationRequestReturns = struct {
+		result1 *request.Request
+		result2 *s3.PutBucketNotificationOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) PutBucketNotificationRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.PutBucketNotificationOutput) {
+	fake.putBucketNotificationRequestMutex.Lock()
+	defer fake.putBucketNotificationRequestMutex.Unlock()
+	fake.PutBucketNotificationRequestStub = nil
+	if fake.putBucketNotificationRequestReturnsOnCall == nil {
+		fake.putBucketNotificationRequestReturnsOnCall = make(map[int]struct {
+			result1 *request.Request
+			result2 *s3.PutBucketNotificationOutput
+		})
+	}
+	fake.putBucketNotificationRequestReturnsOnCall[i] = struct {
+		result1 *request.Request
+		result2 *s3.PutBucketNotificationOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) PutBucketNotificationWithContext(arg1 aws.Context, arg2 *s3.PutBucketNotificationInput, arg3 ...request.Option) (*s3.PutBucketNotificationOutput, error) {
+	fake.putBucketNotificationWithContextMutex.Lock()
+	ret, specificReturn := fake.putBucketNotificationWithContextReturnsOnCall[len(fake.putBucketNotificationWithContextArgsForCall)]
+	fake.putBucketNotificationWithContextArgsForCall = append(fake.putBucketNotificationWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.PutBucketNotificationInput
+		arg3 []request.Option
+	}{arg1, arg2, arg3})
+	stub := fake.PutBucketNotificationWithContextStub
+	fakeReturns := fake.putBucketNotificationWithContextReturns
+	fake.recordInvocation("PutBucketNotificationWithContext", []interface{}{arg1, arg2, arg3})
+	fake.putBucketNotificationWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) PutBucketNotificationWithContextCallCount() int {
+	fake.putBucketNotificationWithContextMutex.RLock()
+	defer fake.putBucketNotificationWithContextMutex.RUnlock()
+	return len(fake.putBucketNotificationWithContextArgsForCall)
+}
+
+func (fake *FakeS3API) PutBucketNotificationWithContextCalls(stub func(aws.Context, *s3.PutBucketNotificationInput, ...request.Option) (*s3.PutBucketNotificationOutput, error)) {
+	fake.putBucketNotificationWithContextMutex.Lock()
+	defer fake.putBucketNotificationWithContextMutex.Unlock()
+	fake.PutBucketNotificationWithContextStub = stub
+}
+
+func (fake *FakeS3API) PutBucketNotificationWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketNotificationInput, []request.Option) {
+	fake.putBucketNotificationWithContextMutex.RLock()
+	defer fake.putBucketNotificationWithContextMutex.RUnlock()
+	argsForCall := fake.putBucketNotificationWithContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3API) PutBucketNotificationWithContextReturns(result1 *s3.PutBucketNotificationOutput, result2 error) {
+	fake.putBucketNotificationWithContextMutex.Lock()
+	defer fake.putBucketNotificationWithContextMutex.Unlock()
+	fake.PutBucketNotificationWithContextStub = nil
+	fake.putBucketNotificationWithContextReturns = struct {
+		result1 *s3.PutBucketNotificationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) PutBucketNotificationWithContextReturnsOnCall(i int, result1 *s3.PutBucketNotificationOutput, result2 error) {
+	fake.putBucketNotificationWithContextMutex.Lock()
+	defer fake.putBucketNotificationWithContextMutex.Unlock()
+	fake.PutBucketNotificationWithContextStub = nil
+	if fake.putBucketNotificationWithContextReturnsOnCall == nil {
+		fake.putBucketNotificationWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketNotificationOutput
+			result2 error
+		})
+	}
+	fake.putBucketNotificationWithContextReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketNotificationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) PutBucketOwnershipControls(arg1 *s3.PutBucketOwnershipControlsInput) (*s3.PutBucketOwnershipControlsOutput, error) {
+	fake.putBucketOwnershipControlsMutex.Lock()
+	ret, specificReturn := fake.putBucketOwnershipControlsReturnsOnCall[len(fake.putBucketOwnershipControlsArgsForCall)]
+	fake.putBucketOwnershipControlsArgsForCall = append(fake.putBucketOwnershipControlsArgsForCall, struct {
+		arg1 *s3.PutBucketOwnershipControlsInput
+	}{arg1})
+	stub := fake.PutBucketOwnershipControlsStub
+	fakeReturns := fake.putBucketOwnershipControlsReturns
+	fake.recordInvocation("PutBucketOwnershipControls", []interface{}{arg1})
+	fake.putBucketOwnershipControlsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) PutBucketOwnershipControlsCallCount() int {
+	fake.putBucketOwnershipControlsMutex.RLock()
+	defer fake.putBucketOwnershipControlsMutex.RUnlock()
+	return len(fake.putBucketOwnershipControlsArgsForCall)
+}
+
+func (fake *FakeS3API) PutBucketOwnershipControlsCalls(stub func(*s3.PutBucketOwnershipControlsInput) (*s3.PutBucketOwnershipControlsOutput, error)) {
+	fake.putBucketOwnershipControlsMutex.Lock()
+	defer fake.putBucketOwnershipControlsMutex.Unlock()
+	fake.PutBucketOwnershipControlsStub = stub
+}
+
+func (fake *FakeS3API) PutBucketOwnershipControlsArgsForCall(i int) *s3.PutBucketOwnershipControlsInput {
+	fake.putBucketOwnershipControlsMutex.RLock()
+	defer fake.putBucketOwnershipControlsMutex.RUnlock()
+	argsForCall := fake.putBucketOwnershipControlsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) PutBucketOwnershipControlsReturns(result1 *s3.PutBucketOwnershipControlsOutput, result2 error) {
+	fake.putBucketOwnershipControlsMutex.Lock()
+	defer fake.putBucketOwnershipControlsMutex.Unlock()
+	fake.PutBucketOwnershipControlsStub = nil
+	fake.putBucketOwnershipControlsReturns = struct {
+		result1 *s3.PutBucketOwnershipControlsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) PutBucketOwnershipControlsReturnsOnCall(i int, result1 *s3.PutBucketOwnershipControlsOutput, result2 error) {
+	fake.putBucketOwnershipControlsMutex.Lock()
+	defer fake.putBucketOwnershipControlsMutex.Unlock()
+	fake.PutBucketOwnershipControlsStub = nil
+	if fake.putBucketOwnershipControlsReturnsOnCall == nil {
+		fake.putBucketOwnershipControlsReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketOwnershipControlsOutput
+			result2 error
+		})
+	}
+	fake.putBucketOwnershipControlsReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketOwnershipControlsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) PutBucketOwnershipControlsRequest(arg1 *s3.PutBucketOwnershipControlsInput) (*request.Request, *s3.PutBucketOwnershipControlsOutput) {
+	fake.putBucketOwnershipControlsRequestMutex.Lock()
+	ret, specificReturn := fake.putBucketOwnershipControlsRequestReturnsOnCall[len(fake.putBucketOwnershipControlsRequestArgsForCall)]
+	fake.putBucketOwnershipControlsRequestArgsForCall = append(fake.putBucketOwnershipControlsRequestArgsForCall, struct {
+		arg1 *s3.PutBucketOwnershipControlsInput
+	}{arg1})
+	stub := fake.PutBucketOwnershipControlsRequestStub
+	fakeReturns := fake.putBucketOwnershipControlsRequestReturns
+	fake.recordInvocation("PutBucketOwnershipControlsRequest", []interface{}{arg1})
+	fake.putBucketOwnershipControlsRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketNotificationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) PutBucketNotificationRequestCallCount() int {
-	fake.putBucketNotificationRequestMutex.RLock()
-	defer fake.putBucketNotificationRequestMutex.RUnlock()
-	return len(fake.putBucketNotificationRequestArgsForCall)
+func (fake *FakeS3API) PutBucketOwnershipControlsRequestCallCount() int {
+	fake.putBucketOwnershipControlsRequestMutex.RLock()
+	defer fake.putBucketOwnershipControlsRequestMutex.RUnlock()
+	return len(fake.putBucketOwnershipControlsRequestArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketNotificationRequestCalls(stub func(*s3.PutBucketNotificationInput) (*request.Request, *s3.PutBucketNotificationOutput)) {
-	fake.putBucketNotificationRequestMutex.Lock()
-	defer fake.putBucketNotificationRequestMutex.Unlock()
-	fake.PutBucketNotificationRequestStub = stub
+func (fake *FakeS3API) PutBucketOwnershipControlsRequestCalls(stub func(*s3.PutBucketOwnershipControlsInput) (*request.Request, *s3.PutBucketOwnershipControlsOutput)) {
+	fake.putBucketOwnershipControlsRequestMutex.Lock()
+	defer fake.putBucketOwnershipControlsRequestMutex.Unlock()
+	fake.PutBucketOwnershipControlsRequestStub = stub
 }
 
-func (fake *FakeS3API) PutBucketNotificationRequestArgsForCall(i int) *s3.PutBucketNotificationInput {
-	fake.putBucketNotificationRequestMutex.RLock()
-	defer fake.putBucketNotificationRequestMutex.RUnlock()
-	argsForCall := fake.putBucketNotificationRequestArgsForCall[i]
+func (fake *FakeS3API) PutBucketOwnershipControlsRequestArgsForCall(i int) *s3.PutBucketOwnershipControlsInput {
+	fake.putBucketOwnershipControlsRequestMutex.RLock()
+	defer fake.putBucketOwnershipControlsRequestMutex.RUnlock()
+	argsForCall := fake.putBucketOwnershipControlsRequestArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeS3API) PutBucketNotificationRequestReturns(result1 *request.Request, result2 *s3.PutBucketNotificationOutput) {
-	fake.putBucketNotificationRequestMutex.Lock()
-	defer fake.putBucketNotificationRequestMutex.Unlock()
-	fake.PutBucketNotificationRequestStub = nil
-	fake.putBucketNotificationRequestReturns = struct {
+func (fake *FakeS3API) PutBucketOwnershipControlsRequestReturns(result1 *request.Request, result2 *s3.PutBucketOwnershipControlsOutput) {
+	fake.putBucketOwnershipControlsRequestMutex.Lock()
+	defer fake.putBucketOwnershipControlsRequestMutex.Unlock()
+	fake.PutBucketOwnershipControlsRequestStub = nil
+	fake.putBucketOwnershipControlsRequestReturns = struct {
 		result1 *request.Request
-		result2 *s3.PutBucketNotificationOutput
+		result2 *s3.PutBucketOwnershipControlsOutput
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketNotificationRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.PutBucketNotificationOutput) {
-	fake.putBucketNotificationRequestMutex.Lock()
-	defer fake.putBucketNotificationRequestMutex.Unlock()
-	fake.PutBucketNotificationRequestStub = nil
-	if fake.putBucketNotificationRequestReturnsOnCall == nil {
-		fake.putBucketNotificationRequestReturnsOnCall = make(map[int]struct {
+func (fake *FakeS3API) PutBucketOwnershipControlsRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.PutBucketOwnershipControlsOutput) {
+	fake.putBucketOwnershipControlsRequestMutex.Lock()
+	defer fake.putBucketOwnershipControlsRequestMutex.Unlock()
+	fake.PutBucketOwnershipControlsRequestStub = nil
+	if fake.putBucketOwnershipControlsRequestReturnsOnCall == nil {
+		fake.putBucketOwnershipControlsRequestReturnsOnCall = make(map[int]struct {
 			result1 *request.Request
-			result2 *s3.PutBucketNotificationOutput
+			result2 *s3.PutBucketOwnershipControlsOutput
 		})
 	}
-	fake.putBucketNotificationRequestReturnsOnCall[i] = struct {
+	fake.putBucketOwnershipControlsRequestReturnsOnCall[i] = struct {
 		result1 *request.Request
-		result2 *s3.PutBucketNotificationOutput
+		result2 *s3.PutBucketOwnershipControlsOutput
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketNotificationWithContext(arg1 context.Context, arg2 *s3.PutBucketNotificationInput, arg3 ...request.Option) (*s3.PutBucketNotificationOutput, error) {
-	fake.putBucketNotificationWithContextMutex.Lock()
-	ret, specificReturn := fake.putBucketNotificationWithContextReturnsOnCall[len(fake.putBucketNotificationWithContextArgsForCall)]
-	fake.putBucketNotificationWithContextArgsForCall = append(fake.putBucketNotificationWithContextArgsForCall, struct {
-		arg1 context.Context
-		arg2 *s3.PutBucketNotificationInput
+func (fake *FakeS3API) PutBucketOwnershipControlsWithContext(arg1 aws.Context, arg2 *s3.PutBucketOwnershipControlsInput, arg3 ...request.Option) (*s3.PutBucketOwnershipControlsOutput, error) {
+	fake.putBucketOwnershipControlsWithContextMutex.Lock()
+	ret, specificReturn := fake.putBucketOwnershipControlsWithContextReturnsOnCall[len(fake.putBucketOwnershipControlsWithContextArgsForCall)]
+	fake.putBucketOwnershipControlsWithContextArgsForCall = append(fake.putBucketOwnershipControlsWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.PutBucketOwnershipControlsInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
-	fake.recordInvocation("PutBucketNotificationWithContext", []interface{}{arg1, arg2, arg3})
-	fake.putBucketNotificationWithContextMutex.Unlock()
-	if fake.PutBucketNotificationWithContextStub != nil {
-		return fake.PutBucketNotificationWithContextStub(arg1, arg2, arg3...)
+	stub := fake.PutBucketOwnershipControlsWithContextStub
+	fakeReturns := fake.putBucketOwnershipControlsWithContextReturns
+	fake.recordInvocation("PutBucketOwnershipControlsWithContext", []interface{}{arg1, arg2, arg3})
+	fake.putBucketOwnershipControlsWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketNotificationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3API) PutBucketNotificationWithContextCallCount() int {
-	fake.putBucketNotificationWithContextMutex.RLock()
-	defer fake.putBucketNotificationWithContextMutex.RUnlock()
-	return len(fake.putBucketNotificationWithContextArgsForCall)
+func (fake *FakeS3API) PutBucketOwnershipControlsWithContextCallCount() int {
+	fake.putBucketOwnershipControlsWithContextMutex.RLock()
+	defer fake.putBucketOwnershipControlsWithContextMutex.RUnlock()
+	return len(fake.putBucketOwnershipControlsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketNotificationWithContextCalls(stub func(context.Context, *s3.PutBucketNotificationInput, ...request.Option) (*s3.PutBucketNotificationOutput, error)) {
-	fake.putBucketNotificationWithContextMutex.Lock()
-	defer fake.putBucketNotificationWithContextMutex.Unlock()
-	fake.PutBucketNotificationWithContextStub = stub
+func (fake *FakeS3API) PutBucketOwnershipControlsWithContextCalls(stub func(aws.Context, *s3.PutBucketOwnershipControlsInput, ...request.Option) (*s3.PutBucketOwnershipControlsOutput, error)) {
+	fake.putBucketOwnershipControlsWithContextMutex.Lock()
+	defer fake.putBucketOwnershipControlsWithContextMutex.Unlock()
+	fake.PutBucketOwnershipControlsWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketNotificationWithContextArgsForCall(i int) (context.Context, *s3.PutBucketNotificationInput, []request.Option) {
-	fake.putBucketNotificationWithContextMutex.RLock()
-	defer fake.putBucketNotificationWithContextMutex.RUnlock()
-	argsForCall := fake.putBucketNotificationWithContextArgsForCall[i]
+func (fake *FakeS3API) PutBucketOwnershipControlsWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketOwnershipControlsInput, []request.Option) {
+	fake.putBucketOwnershipControlsWithContextMutex.RLock()
+	defer fake.putBucketOwnershipControlsWithContextMutex.RUnlock()
+	argsForCall := fake.putBucketOwnershipControlsWithContextArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeS3API) PutBucketNotificationWithContextReturns(result1 *s3.PutBucketNotificationOutput, result2 error) {
-	fake.putBucketNotificationWithContextMutex.Lock()
-	defer fake.putBucketNotificationWithContextMutex.Unlock()
-	fake.PutBucketNotificationWithContextStub = nil
-	fake.putBucketNotificationWithContextReturns = struct {
-		result1 *s3.PutBucketNotificationOutput
+func (fake *FakeS3API) PutBucketOwnershipControlsWithContextReturns(result1 *s3.PutBucketOwnershipControlsOutput, result2 error) {
+	fake.putBucketOwnershipControlsWithContextMutex.Lock()
+	defer fake.putBucketOwnershipControlsWithContextMutex.Unlock()
+	fake.PutBucketOwnershipControlsWithContextStub = nil
+	fake.putBucketOwnershipControlsWithContextReturns = struct {
+		result1 *s3.PutBucketOwnershipControlsOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketNotificationWithContextReturnsOnCall(i int, result1 *s3.PutBucketNotificationOutput, result2 error) {
-	fake.putBucketNotificationWithContextMutex.Lock()
-	defer fake.putBucketNotificationWithContextMutex.Unlock()
-	fake.PutBucketNotificationWithContextStub = nil
-	if fake.putBucketNotificationWithContextReturnsOnCall == nil {
-		fake.putBucketNotificationWithContextReturnsOnCall = make(map[int]struct {
-			result1 *s3.PutBucketNotificationOutput
+func (fake *FakeS3API) PutBucketOwnershipControlsWithContextReturnsOnCall(i int, result1 *s3.PutBucketOwnershipControlsOutput, result2 error) {
+	fake.putBucketOwnershipControlsWithContextMutex.Lock()
+	defer fake.putBucketOwnershipControlsWithContextMutex.Unlock()
+	fake.PutBucketOwnershipControlsWithContextStub = nil
+	if fake.putBucketOwnershipControlsWithContextReturnsOnCall == nil {
+		fake.putBucketOwnershipControlsWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketOwnershipControlsOutput
 			result2 error
 		})
 	}
-	fake.putBucketNotificationWithContextReturnsOnCall[i] = struct {
-		result1 *s3.PutBucketNotificationOutput
+	fake.putBucketOwnershipControlsWithContextReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketOwnershipControlsOutput
 		result2 error
 	}{result1, result2}
 }
@@ -18036,15 +20802,16 @@ func (fake *FakeS3API) PutBucketPolicy(arg1 *s3.PutBucketPolicyInput) (*s3.PutBu
 	fake.putBucketPolicyArgsForCall = append(fake.putBucketPolicyArgsForCall, struct {
 		arg1 *s3.PutBucketPolicyInput
 	}{arg1})
+	stub := fake.PutBucketPolicyStub
+	fakeReturns := fake.putBucketPolicyReturns
 	fake.recordInvocation("PutBucketPolicy", []interface{}{arg1})
 	fake.putBucketPolicyMutex.Unlock()
-	if fake.PutBucketPolicyStub != nil {
-		return fake.PutBucketPolicyStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketPolicyReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18099,15 +20866,16 @@ func (fake *FakeS3API) PutBucketPolicyRequest(arg1 *s3.PutBucketPolicyInput) (*r
 	fake.putBucketPolicyRequestArgsForCall = append(fake.putBucketPolicyRequestArgsForCall, struct {
 		arg1 *s3.PutBucketPolicyInput
 	}{arg1})
+	stub := fake.PutBucketPolicyRequestStub
+	fakeReturns := fake.putBucketPolicyRequestReturns
 	fake.recordInvocation("PutBucketPolicyRequest", []interface{}{arg1})
 	fake.putBucketPolicyRequestMutex.Unlock()
-	if fake.PutBucketPolicyRequestStub != nil {
-		return fake.PutBucketPolicyRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketPolicyRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18156,23 +20924,24 @@ func (fake *FakeS3API) PutBucketPolicyRequestReturnsOnCall(i int, result1 *reque
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketPolicyWithContext(arg1 context.Context, arg2 *s3.PutBucketPolicyInput, arg3 ...request.Option) (*s3.PutBucketPolicyOutput, error) {
+func (fake *FakeS3API) PutBucketPolicyWithContext(arg1 aws.Context, arg2 *s3.PutBucketPolicyInput, arg3 ...request.Option) (*s3.PutBucketPolicyOutput, error) {
 	fake.putBucketPolicyWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketPolicyWithContextReturnsOnCall[len(fake.putBucketPolicyWithContextArgsForCall)]
 	fake.putBucketPolicyWithContextArgsForCall = append(fake.putBucketPolicyWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketPolicyInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketPolicyWithContextStub
+	fakeReturns := fake.putBucketPolicyWithContextReturns
 	fake.recordInvocation("PutBucketPolicyWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketPolicyWithContextMutex.Unlock()
-	if fake.PutBucketPolicyWithContextStub != nil {
-		return fake.PutBucketPolicyWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketPolicyWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18182,13 +20951,13 @@ func (fake *FakeS3API) PutBucketPolicyWithContextCallCount() int {
 	return len(fake.putBucketPolicyWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketPolicyWithContextCalls(stub func(context.Context, *s3.PutBucketPolicyInput, ...request.Option) (*s3.PutBucketPolicyOutput, error)) {
+func (fake *FakeS3API) PutBucketPolicyWithContextCalls(stub func(aws.Context, *s3.PutBucketPolicyInput, ...request.Option) (*s3.PutBucketPolicyOutput, error)) {
 	fake.putBucketPolicyWithContextMutex.Lock()
 	defer fake.putBucketPolicyWithContextMutex.Unlock()
 	fake.PutBucketPolicyWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketPolicyWithContextArgsForCall(i int) (context.Context, *s3.PutBucketPolicyInput, []request.Option) {
+func (fake *FakeS3API) PutBucketPolicyWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketPolicyInput, []request.Option) {
 	fake.putBucketPolicyWithContextMutex.RLock()
 	defer fake.putBucketPolicyWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketPolicyWithContextArgsForCall[i]
@@ -18227,15 +20996,16 @@ func (fake *FakeS3API) PutBucketReplication(arg1 *s3.PutBucketReplicationInput)
 	fake.putBucketReplicationArgsForCall = append(fake.putBucketReplicationArgsForCall, struct {
 		arg1 *s3.PutBucketReplicationInput
 	}{arg1})
+	stub := fake.PutBucketReplicationStub
+	fakeReturns := fake.putBucketReplicationReturns
 	fake.recordInvocation("PutBucketReplication", []interface{}{arg1})
 	fake.putBucketReplicationMutex.Unlock()
-	if fake.PutBucketReplicationStub != nil {
-		return fake.PutBucketReplicationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketReplicationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18290,15 +21060,16 @@ func (fake *FakeS3API) PutBucketReplicationRequest(arg1 *s3.PutBucketReplication
 	fake.putBucketReplicationRequestArgsForCall = append(fake.putBucketReplicationRequestArgsForCall, struct {
 		arg1 *s3.PutBucketReplicationInput
 	}{arg1})
+	stub := fake.PutBucketReplicationRequestStub
+	fakeReturns := fake.putBucketReplicationRequestReturns
 	fake.recordInvocation("PutBucketReplicationRequest", []interface{}{arg1})
 	fake.putBucketReplicationRequestMutex.Unlock()
-	if fake.PutBucketReplicationRequestStub != nil {
-		return fake.PutBucketReplicationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketReplicationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18347,23 +21118,24 @@ func (fake *FakeS3API) PutBucketReplicationRequestReturnsOnCall(i int, result1 *
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketReplicationWithContext(arg1 context.Context, arg2 *s3.PutBucketReplicationInput, arg3 ...request.Option) (*s3.PutBucketReplicationOutput, error) {
+func (fake *FakeS3API) PutBucketReplicationWithContext(arg1 aws.Context, arg2 *s3.PutBucketReplicationInput, arg3 ...request.Option) (*s3.PutBucketReplicationOutput, error) {
 	fake.putBucketReplicationWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketReplicationWithContextReturnsOnCall[len(fake.putBucketReplicationWithContextArgsForCall)]
 	fake.putBucketReplicationWithContextArgsForCall = append(fake.putBucketReplicationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketReplicationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketReplicationWithContextStub
+	fakeReturns := fake.putBucketReplicationWithContextReturns
 	fake.recordInvocation("PutBucketReplicationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketReplicationWithContextMutex.Unlock()
-	if fake.PutBucketReplicationWithContextStub != nil {
-		return fake.PutBucketReplicationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketReplicationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18373,13 +21145,13 @@ func (fake *FakeS3API) PutBucketReplicationWithContextCallCount() int {
 	return len(fake.putBucketReplicationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketReplicationWithContextCalls(stub func(context.Context, *s3.PutBucketReplicationInput, ...request.Option) (*s3.PutBucketReplicationOutput, error)) {
+func (fake *FakeS3API) PutBucketReplicationWithContextCalls(stub func(aws.Context, *s3.PutBucketReplicationInput, ...request.Option) (*s3.PutBucketReplicationOutput, error)) {
 	fake.putBucketReplicationWithContextMutex.Lock()
 	defer fake.putBucketReplicationWithContextMutex.Unlock()
 	fake.PutBucketReplicationWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketReplicationWithContextArgsForCall(i int) (context.Context, *s3.PutBucketReplicationInput, []request.Option) {
+func (fake *FakeS3API) PutBucketReplicationWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketReplicationInput, []request.Option) {
 	fake.putBucketReplicationWithContextMutex.RLock()
 	defer fake.putBucketReplicationWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketReplicationWithContextArgsForCall[i]
@@ -18418,15 +21190,16 @@ func (fake *FakeS3API) PutBucketRequestPayment(arg1 *s3.PutBucketRequestPaymentI
 	fake.putBucketRequestPaymentArgsForCall = append(fake.putBucketRequestPaymentArgsForCall, struct {
 		arg1 *s3.PutBucketRequestPaymentInput
 	}{arg1})
+	stub := fake.PutBucketRequestPaymentStub
+	fakeReturns := fake.putBucketRequestPaymentReturns
 	fake.recordInvocation("PutBucketRequestPayment", []interface{}{arg1})
 	fake.putBucketRequestPaymentMutex.Unlock()
-	if fake.PutBucketRequestPaymentStub != nil {
-		return fake.PutBucketRequestPaymentStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketRequestPaymentReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18481,15 +21254,16 @@ func (fake *FakeS3API) PutBucketRequestPaymentRequest(arg1 *s3.PutBucketRequestP
 	fake.putBucketRequestPaymentRequestArgsForCall = append(fake.putBucketRequestPaymentRequestArgsForCall, struct {
 		arg1 *s3.PutBucketRequestPaymentInput
 	}{arg1})
+	stub := fake.PutBucketRequestPaymentRequestStub
+	fakeReturns := fake.putBucketRequestPaymentRequestReturns
 	fake.recordInvocation("PutBucketRequestPaymentRequest", []interface{}{arg1})
 	fake.putBucketRequestPaymentRequestMutex.Unlock()
-	if fake.PutBucketRequestPaymentRequestStub != nil {
-		return fake.PutBucketRequestPaymentRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketRequestPaymentRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18538,23 +21312,24 @@ func (fake *FakeS3API) PutBucketRequestPaymentRequestReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketRequestPaymentWithContext(arg1 context.Context, arg2 *s3.PutBucketRequestPaymentInput, arg3 ...request.Option) (*s3.PutBucketRequestPaymentOutput, error) {
+func (fake *FakeS3API) PutBucketRequestPaymentWithContext(arg1 aws.Context, arg2 *s3.PutBucketRequestPaymentInput, arg3 ...request.Option) (*s3.PutBucketRequestPaymentOutput, error) {
 	fake.putBucketRequestPaymentWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketRequestPaymentWithContextReturnsOnCall[len(fake.putBucketRequestPaymentWithContextArgsForCall)]
 	fake.putBucketRequestPaymentWithContextArgsForCall = append(fake.putBucketRequestPaymentWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketRequestPaymentInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketRequestPaymentWithContextStub
+	fakeReturns := fake.putBucketRequestPaymentWithContextReturns
 	fake.recordInvocation("PutBucketRequestPaymentWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketRequestPaymentWithContextMutex.Unlock()
-	if fake.PutBucketRequestPaymentWithContextStub != nil {
-		return fake.PutBucketRequestPaymentWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketRequestPaymentWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18564,13 +21339,13 @@ func (fake *FakeS3API) PutBucketRequestPaymentWithContextCallCount() int {
 	return len(fake.putBucketRequestPaymentWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketRequestPaymentWithContextCalls(stub func(context.Context, *s3.PutBucketRequestPaymentInput, ...request.Option) (*s3.PutBucketRequestPaymentOutput, error)) {
+func (fake *FakeS3API) PutBucketRequestPaymentWithContextCalls(stub func(aws.Context, *s3.PutBucketRequestPaymentInput, ...request.Option) (*s3.PutBucketRequestPaymentOutput, error)) {
 	fake.putBucketRequestPaymentWithContextMutex.Lock()
 	defer fake.putBucketRequestPaymentWithContextMutex.Unlock()
 	fake.PutBucketRequestPaymentWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketRequestPaymentWithContextArgsForCall(i int) (context.Context, *s3.PutBucketRequestPaymentInput, []request.Option) {
+func (fake *FakeS3API) PutBucketRequestPaymentWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketRequestPaymentInput, []request.Option) {
 	fake.putBucketRequestPaymentWithContextMutex.RLock()
 	defer fake.putBucketRequestPaymentWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketRequestPaymentWithContextArgsForCall[i]
@@ -18609,15 +21384,16 @@ func (fake *FakeS3API) PutBucketTagging(arg1 *s3.PutBucketTaggingInput) (*s3.Put
 	fake.putBucketTaggingArgsForCall = append(fake.putBucketTaggingArgsForCall, struct {
 		arg1 *s3.PutBucketTaggingInput
 	}{arg1})
+	stub := fake.PutBucketTaggingStub
+	fakeReturns := fake.putBucketTaggingReturns
 	fake.recordInvocation("PutBucketTagging", []interface{}{arg1})
 	fake.putBucketTaggingMutex.Unlock()
-	if fake.PutBucketTaggingStub != nil {
-		return fake.PutBucketTaggingStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketTaggingReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18672,15 +21448,16 @@ func (fake *FakeS3API) PutBucketTaggingRequest(arg1 *s3.PutBucketTaggingInput) (
 	fake.putBucketTaggingRequestArgsForCall = append(fake.putBucketTaggingRequestArgsForCall, struct {
 		arg1 *s3.PutBucketTaggingInput
 	}{arg1})
+	stub := fake.PutBucketTaggingRequestStub
+	fakeReturns := fake.putBucketTaggingRequestReturns
 	fake.recordInvocation("PutBucketTaggingRequest", []interface{}{arg1})
 	fake.putBucketTaggingRequestMutex.Unlock()
-	if fake.PutBucketTaggingRequestStub != nil {
-		return fake.PutBucketTaggingRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketTaggingRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18729,23 +21506,24 @@ func (fake *FakeS3API) PutBucketTaggingRequestReturnsOnCall(i int, result1 *requ
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketTaggingWithContext(arg1 context.Context, arg2 *s3.PutBucketTaggingInput, arg3 ...request.Option) (*s3.PutBucketTaggingOutput, error) {
+func (fake *FakeS3API) PutBucketTaggingWithContext(arg1 aws.Context, arg2 *s3.PutBucketTaggingInput, arg3 ...request.Option) (*s3.PutBucketTaggingOutput, error) {
 	fake.putBucketTaggingWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketTaggingWithContextReturnsOnCall[len(fake.putBucketTaggingWithContextArgsForCall)]
 	fake.putBucketTaggingWithContextArgsForCall = append(fake.putBucketTaggingWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketTaggingInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketTaggingWithContextStub
+	fakeReturns := fake.putBucketTaggingWithContextReturns
 	fake.recordInvocation("PutBucketTaggingWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketTaggingWithContextMutex.Unlock()
-	if fake.PutBucketTaggingWithContextStub != nil {
-		return fake.PutBucketTaggingWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketTaggingWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18755,13 +21533,13 @@ func (fake *FakeS3API) PutBucketTaggingWithContextCallCount() int {
 	return len(fake.putBucketTaggingWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketTaggingWithContextCalls(stub func(context.Context, *s3.PutBucketTaggingInput, ...request.Option) (*s3.PutBucketTaggingOutput, error)) {
+func (fake *FakeS3API) PutBucketTaggingWithContextCalls(stub func(aws.Context, *s3.PutBucketTaggingInput, ...request.Option) (*s3.PutBucketTaggingOutput, error)) {
 	fake.putBucketTaggingWithContextMutex.Lock()
 	defer fake.putBucketTaggingWithContextMutex.Unlock()
 	fake.PutBucketTaggingWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketTaggingWithContextArgsForCall(i int) (context.Context, *s3.PutBucketTaggingInput, []request.Option) {
+func (fake *FakeS3API) PutBucketTaggingWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketTaggingInput, []request.Option) {
 	fake.putBucketTaggingWithContextMutex.RLock()
 	defer fake.putBucketTaggingWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketTaggingWithContextArgsForCall[i]
@@ -18800,15 +21578,16 @@ func (fake *FakeS3API) PutBucketVersioning(arg1 *s3.PutBucketVersioningInput) (*
 	fake.putBucketVersioningArgsForCall = append(fake.putBucketVersioningArgsForCall, struct {
 		arg1 *s3.PutBucketVersioningInput
 	}{arg1})
+	stub := fake.PutBucketVersioningStub
+	fakeReturns := fake.putBucketVersioningReturns
 	fake.recordInvocation("PutBucketVersioning", []interface{}{arg1})
 	fake.putBucketVersioningMutex.Unlock()
-	if fake.PutBucketVersioningStub != nil {
-		return fake.PutBucketVersioningStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketVersioningReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18863,15 +21642,16 @@ func (fake *FakeS3API) PutBucketVersioningRequest(arg1 *s3.PutBucketVersioningIn
 	fake.putBucketVersioningRequestArgsForCall = append(fake.putBucketVersioningRequestArgsForCall, struct {
 		arg1 *s3.PutBucketVersioningInput
 	}{arg1})
+	stub := fake.PutBucketVersioningRequestStub
+	fakeReturns := fake.putBucketVersioningRequestReturns
 	fake.recordInvocation("PutBucketVersioningRequest", []interface{}{arg1})
 	fake.putBucketVersioningRequestMutex.Unlock()
-	if fake.PutBucketVersioningRequestStub != nil {
-		return fake.PutBucketVersioningRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketVersioningRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18920,23 +21700,24 @@ func (fake *FakeS3API) PutBucketVersioningRequestReturnsOnCall(i int, result1 *r
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketVersioningWithContext(arg1 context.Context, arg2 *s3.PutBucketVersioningInput, arg3 ...request.Option) (*s3.PutBucketVersioningOutput, error) {
+func (fake *FakeS3API) PutBucketVersioningWithContext(arg1 aws.Context, arg2 *s3.PutBucketVersioningInput, arg3 ...request.Option) (*s3.PutBucketVersioningOutput, error) {
 	fake.putBucketVersioningWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketVersioningWithContextReturnsOnCall[len(fake.putBucketVersioningWithContextArgsForCall)]
 	fake.putBucketVersioningWithContextArgsForCall = append(fake.putBucketVersioningWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketVersioningInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketVersioningWithContextStub
+	fakeReturns := fake.putBucketVersioningWithContextReturns
 	fake.recordInvocation("PutBucketVersioningWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketVersioningWithContextMutex.Unlock()
-	if fake.PutBucketVersioningWithContextStub != nil {
-		return fake.PutBucketVersioningWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketVersioningWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -18946,13 +21727,13 @@ func (fake *FakeS3API) PutBucketVersioningWithContextCallCount() int {
 	return len(fake.putBucketVersioningWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketVersioningWithContextCalls(stub func(context.Context, *s3.PutBucketVersioningInput, ...request.Option) (*s3.PutBucketVersioningOutput, error)) {
+func (fake *FakeS3API) PutBucketVersioningWithContextCalls(stub func(aws.Context, *s3.PutBucketVersioningInput, ...request.Option) (*s3.PutBucketVersioningOutput, error)) {
 	fake.putBucketVersioningWithContextMutex.Lock()
 	defer fake.putBucketVersioningWithContextMutex.Unlock()
 	fake.PutBucketVersioningWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketVersioningWithContextArgsForCall(i int) (context.Context, *s3.PutBucketVersioningInput, []request.Option) {
+func (fake *FakeS3API) PutBucketVersioningWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketVersioningInput, []request.Option) {
 	fake.putBucketVersioningWithContextMutex.RLock()
 	defer fake.putBucketVersioningWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketVersioningWithContextArgsForCall[i]
@@ -18991,15 +21772,16 @@ func (fake *FakeS3API) PutBucketWebsite(arg1 *s3.PutBucketWebsiteInput) (*s3.Put
 	fake.putBucketWebsiteArgsForCall = append(fake.putBucketWebsiteArgsForCall, struct {
 		arg1 *s3.PutBucketWebsiteInput
 	}{arg1})
+	stub := fake.PutBucketWebsiteStub
+	fakeReturns := fake.putBucketWebsiteReturns
 	fake.recordInvocation("PutBucketWebsite", []interface{}{arg1})
 	fake.putBucketWebsiteMutex.Unlock()
-	if fake.PutBucketWebsiteStub != nil {
-		return fake.PutBucketWebsiteStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketWebsiteReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19054,15 +21836,16 @@ func (fake *FakeS3API) PutBucketWebsiteRequest(arg1 *s3.PutBucketWebsiteInput) (
 	fake.putBucketWebsiteRequestArgsForCall = append(fake.putBucketWebsiteRequestArgsForCall, struct {
 		arg1 *s3.PutBucketWebsiteInput
 	}{arg1})
+	stub := fake.PutBucketWebsiteRequestStub
+	fakeReturns := fake.putBucketWebsiteRequestReturns
 	fake.recordInvocation("PutBucketWebsiteRequest", []interface{}{arg1})
 	fake.putBucketWebsiteRequestMutex.Unlock()
-	if fake.PutBucketWebsiteRequestStub != nil {
-		return fake.PutBucketWebsiteRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketWebsiteRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19111,23 +21894,24 @@ func (fake *FakeS3API) PutBucketWebsiteRequestReturnsOnCall(i int, result1 *requ
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutBucketWebsiteWithContext(arg1 context.Context, arg2 *s3.PutBucketWebsiteInput, arg3 ...request.Option) (*s3.PutBucketWebsiteOutput, error) {
+func (fake *FakeS3API) PutBucketWebsiteWithContext(arg1 aws.Context, arg2 *s3.PutBucketWebsiteInput, arg3 ...request.Option) (*s3.PutBucketWebsiteOutput, error) {
 	fake.putBucketWebsiteWithContextMutex.Lock()
 	ret, specificReturn := fake.putBucketWebsiteWithContextReturnsOnCall[len(fake.putBucketWebsiteWithContextArgsForCall)]
 	fake.putBucketWebsiteWithContextArgsForCall = append(fake.putBucketWebsiteWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutBucketWebsiteInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutBucketWebsiteWithContextStub
+	fakeReturns := fake.putBucketWebsiteWithContextReturns
 	fake.recordInvocation("PutBucketWebsiteWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putBucketWebsiteWithContextMutex.Unlock()
-	if fake.PutBucketWebsiteWithContextStub != nil {
-		return fake.PutBucketWebsiteWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putBucketWebsiteWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19137,13 +21921,13 @@ func (fake *FakeS3API) PutBucketWebsiteWithContextCallCount() int {
 	return len(fake.putBucketWebsiteWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutBucketWebsiteWithContextCalls(stub func(context.Context, *s3.PutBucketWebsiteInput, ...request.Option) (*s3.PutBucketWebsiteOutput, error)) {
+func (fake *FakeS3API) PutBucketWebsiteWithContextCalls(stub func(aws.Context, *s3.PutBucketWebsiteInput, ...request.Option) (*s3.PutBucketWebsiteOutput, error)) {
 	fake.putBucketWebsiteWithContextMutex.Lock()
 	defer fake.putBucketWebsiteWithContextMutex.Unlock()
 	fake.PutBucketWebsiteWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutBucketWebsiteWithContextArgsForCall(i int) (context.Context, *s3.PutBucketWebsiteInput, []request.Option) {
+func (fake *FakeS3API) PutBucketWebsiteWithContextArgsForCall(i int) (aws.Context, *s3.PutBucketWebsiteInput, []request.Option) {
 	fake.putBucketWebsiteWithContextMutex.RLock()
 	defer fake.putBucketWebsiteWithContextMutex.RUnlock()
 	argsForCall := fake.putBucketWebsiteWithContextArgsForCall[i]
@@ -19182,15 +21966,16 @@ func (fake *FakeS3API) PutObject(arg1 *s3.PutObjectInput) (*s3.PutObjectOutput,
 	fake.putObjectArgsForCall = append(fake.putObjectArgsForCall, struct {
 		arg1 *s3.PutObjectInput
 	}{arg1})
+	stub := fake.PutObjectStub
+	fakeReturns := fake.putObjectReturns
 	fake.recordInvocation("PutObject", []interface{}{arg1})
 	fake.putObjectMutex.Unlock()
-	if fake.PutObjectStub != nil {
-		return fake.PutObjectStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19245,15 +22030,16 @@ func (fake *FakeS3API) PutObjectAcl(arg1 *s3.PutObjectAclInput) (*s3.PutObjectAc
 	fake.putObjectAclArgsForCall = append(fake.putObjectAclArgsForCall, struct {
 		arg1 *s3.PutObjectAclInput
 	}{arg1})
+	stub := fake.PutObjectAclStub
+	fakeReturns := fake.putObjectAclReturns
 	fake.recordInvocation("PutObjectAcl", []interface{}{arg1})
 	fake.putObjectAclMutex.Unlock()
-	if fake.PutObjectAclStub != nil {
-		return fake.PutObjectAclStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectAclReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19308,15 +22094,16 @@ func (fake *FakeS3API) PutObjectAclRequest(arg1 *s3.PutObjectAclInput) (*request
 	fake.putObjectAclRequestArgsForCall = append(fake.putObjectAclRequestArgsForCall, struct {
 		arg1 *s3.PutObjectAclInput
 	}{arg1})
+	stub := fake.PutObjectAclRequestStub
+	fakeReturns := fake.putObjectAclRequestReturns
 	fake.recordInvocation("PutObjectAclRequest", []interface{}{arg1})
 	fake.putObjectAclRequestMutex.Unlock()
-	if fake.PutObjectAclRequestStub != nil {
-		return fake.PutObjectAclRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectAclRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19365,23 +22152,24 @@ func (fake *FakeS3API) PutObjectAclRequestReturnsOnCall(i int, result1 *request.
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutObjectAclWithContext(arg1 context.Context, arg2 *s3.PutObjectAclInput, arg3 ...request.Option) (*s3.PutObjectAclOutput, error) {
+func (fake *FakeS3API) PutObjectAclWithContext(arg1 aws.Context, arg2 *s3.PutObjectAclInput, arg3 ...request.Option) (*s3.PutObjectAclOutput, error) {
 	fake.putObjectAclWithContextMutex.Lock()
 	ret, specificReturn := fake.putObjectAclWithContextReturnsOnCall[len(fake.putObjectAclWithContextArgsForCall)]
 	fake.putObjectAclWithContextArgsForCall = append(fake.putObjectAclWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutObjectAclInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutObjectAclWithContextStub
+	fakeReturns := fake.putObjectAclWithContextReturns
 	fake.recordInvocation("PutObjectAclWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putObjectAclWithContextMutex.Unlock()
-	if fake.PutObjectAclWithContextStub != nil {
-		return fake.PutObjectAclWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectAclWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19391,13 +22179,13 @@ func (fake *FakeS3API) PutObjectAclWithContextCallCount() int {
 	return len(fake.putObjectAclWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutObjectAclWithContextCalls(stub func(context.Context, *s3.PutObjectAclInput, ...request.Option) (*s3.PutObjectAclOutput, error)) {
+func (fake *FakeS3API) PutObjectAclWithContextCalls(stub func(aws.Context, *s3.PutObjectAclInput, ...request.Option) (*s3.PutObjectAclOutput, error)) {
 	fake.putObjectAclWithContextMutex.Lock()
 	defer fake.putObjectAclWithContextMutex.Unlock()
 	fake.PutObjectAclWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutObjectAclWithContextArgsForCall(i int) (context.Context, *s3.PutObjectAclInput, []request.Option) {
+func (fake *FakeS3API) PutObjectAclWithContextArgsForCall(i int) (aws.Context, *s3.PutObjectAclInput, []request.Option) {
 	fake.putObjectAclWithContextMutex.RLock()
 	defer fake.putObjectAclWithContextMutex.RUnlock()
 	argsForCall := fake.putObjectAclWithContextArgsForCall[i]
@@ -19436,15 +22224,16 @@ func (fake *FakeS3API) PutObjectLegalHold(arg1 *s3.PutObjectLegalHoldInput) (*s3
 	fake.putObjectLegalHoldArgsForCall = append(fake.putObjectLegalHoldArgsForCall, struct {
 		arg1 *s3.PutObjectLegalHoldInput
 	}{arg1})
+	stub := fake.PutObjectLegalHoldStub
+	fakeReturns := fake.putObjectLegalHoldReturns
 	fake.recordInvocation("PutObjectLegalHold", []interface{}{arg1})
 	fake.putObjectLegalHoldMutex.Unlock()
-	if fake.PutObjectLegalHoldStub != nil {
-		return fake.PutObjectLegalHoldStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectLegalHoldReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19499,15 +22288,16 @@ func (fake *FakeS3API) PutObjectLegalHoldRequest(arg1 *s3.PutObjectLegalHoldInpu
 	fake.putObjectLegalHoldRequestArgsForCall = append(fake.putObjectLegalHoldRequestArgsForCall, struct {
 		arg1 *s3.PutObjectLegalHoldInput
 	}{arg1})
+	stub := fake.PutObjectLegalHoldRequestStub
+	fakeReturns := fake.putObjectLegalHoldRequestReturns
 	fake.recordInvocation("PutObjectLegalHoldRequest", []interface{}{arg1})
 	fake.putObjectLegalHoldRequestMutex.Unlock()
-	if fake.PutObjectLegalHoldRequestStub != nil {
-		return fake.PutObjectLegalHoldRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectLegalHoldRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19556,23 +22346,24 @@ func (fake *FakeS3API) PutObjectLegalHoldRequestReturnsOnCall(i int, result1 *re
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutObjectLegalHoldWithContext(arg1 context.Context, arg2 *s3.PutObjectLegalHoldInput, arg3 ...request.Option) (*s3.PutObjectLegalHoldOutput, error) {
+func (fake *FakeS3API) PutObjectLegalHoldWithContext(arg1 aws.Context, arg2 *s3.PutObjectLegalHoldInput, arg3 ...request.Option) (*s3.PutObjectLegalHoldOutput, error) {
 	fake.putObjectLegalHoldWithContextMutex.Lock()
 	ret, specificReturn := fake.putObjectLegalHoldWithContextReturnsOnCall[len(fake.putObjectLegalHoldWithContextArgsForCall)]
 	fake.putObjectLegalHoldWithContextArgsForCall = append(fake.putObjectLegalHoldWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutObjectLegalHoldInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutObjectLegalHoldWithContextStub
+	fakeReturns := fake.putObjectLegalHoldWithContextReturns
 	fake.recordInvocation("PutObjectLegalHoldWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putObjectLegalHoldWithContextMutex.Unlock()
-	if fake.PutObjectLegalHoldWithContextStub != nil {
-		return fake.PutObjectLegalHoldWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectLegalHoldWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19582,13 +22373,13 @@ func (fake *FakeS3API) PutObjectLegalHoldWithContextCallCount() int {
 	return len(fake.putObjectLegalHoldWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutObjectLegalHoldWithContextCalls(stub func(context.Context, *s3.PutObjectLegalHoldInput, ...request.Option) (*s3.PutObjectLegalHoldOutput, error)) {
+func (fake *FakeS3API) PutObjectLegalHoldWithContextCalls(stub func(aws.Context, *s3.PutObjectLegalHoldInput, ...request.Option) (*s3.PutObjectLegalHoldOutput, error)) {
 	fake.putObjectLegalHoldWithContextMutex.Lock()
 	defer fake.putObjectLegalHoldWithContextMutex.Unlock()
 	fake.PutObjectLegalHoldWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutObjectLegalHoldWithContextArgsForCall(i int) (context.Context, *s3.PutObjectLegalHoldInput, []request.Option) {
+func (fake *FakeS3API) PutObjectLegalHoldWithContextArgsForCall(i int) (aws.Context, *s3.PutObjectLegalHoldInput, []request.Option) {
 	fake.putObjectLegalHoldWithContextMutex.RLock()
 	defer fake.putObjectLegalHoldWithContextMutex.RUnlock()
 	argsForCall := fake.putObjectLegalHoldWithContextArgsForCall[i]
@@ -19627,15 +22418,16 @@ func (fake *FakeS3API) PutObjectLockConfiguration(arg1 *s3.PutObjectLockConfigur
 	fake.putObjectLockConfigurationArgsForCall = append(fake.putObjectLockConfigurationArgsForCall, struct {
 		arg1 *s3.PutObjectLockConfigurationInput
 	}{arg1})
+	stub := fake.PutObjectLockConfigurationStub
+	fakeReturns := fake.putObjectLockConfigurationReturns
 	fake.recordInvocation("PutObjectLockConfiguration", []interface{}{arg1})
 	fake.putObjectLockConfigurationMutex.Unlock()
-	if fake.PutObjectLockConfigurationStub != nil {
-		return fake.PutObjectLockConfigurationStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectLockConfigurationReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19690,15 +22482,16 @@ func (fake *FakeS3API) PutObjectLockConfigurationRequest(arg1 *s3.PutObjectLockC
 	fake.putObjectLockConfigurationRequestArgsForCall = append(fake.putObjectLockConfigurationRequestArgsForCall, struct {
 		arg1 *s3.PutObjectLockConfigurationInput
 	}{arg1})
+	stub := fake.PutObjectLockConfigurationRequestStub
+	fakeReturns := fake.putObjectLockConfigurationRequestReturns
 	fake.recordInvocation("PutObjectLockConfigurationRequest", []interface{}{arg1})
 	fake.putObjectLockConfigurationRequestMutex.Unlock()
-	if fake.PutObjectLockConfigurationRequestStub != nil {
-		return fake.PutObjectLockConfigurationRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectLockConfigurationRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19747,23 +22540,24 @@ func (fake *FakeS3API) PutObjectLockConfigurationRequestReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutObjectLockConfigurationWithContext(arg1 context.Context, arg2 *s3.PutObjectLockConfigurationInput, arg3 ...request.Option) (*s3.PutObjectLockConfigurationOutput, error) {
+func (fake *FakeS3API) PutObjectLockConfigurationWithContext(arg1 aws.Context, arg2 *s3.PutObjectLockConfigurationInput, arg3 ...request.Option) (*s3.PutObjectLockConfigurationOutput, error) {
 	fake.putObjectLockConfigurationWithContextMutex.Lock()
 	ret, specificReturn := fake.putObjectLockConfigurationWithContextReturnsOnCall[len(fake.putObjectLockConfigurationWithContextArgsForCall)]
 	fake.putObjectLockConfigurationWithContextArgsForCall = append(fake.putObjectLockConfigurationWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutObjectLockConfigurationInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutObjectLockConfigurationWithContextStub
+	fakeReturns := fake.putObjectLockConfigurationWithContextReturns
 	fake.recordInvocation("PutObjectLockConfigurationWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putObjectLockConfigurationWithContextMutex.Unlock()
-	if fake.PutObjectLockConfigurationWithContextStub != nil {
-		return fake.PutObjectLockConfigurationWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectLockConfigurationWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19773,13 +22567,13 @@ func (fake *FakeS3API) PutObjectLockConfigurationWithContextCallCount() int {
 	return len(fake.putObjectLockConfigurationWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutObjectLockConfigurationWithContextCalls(stub func(context.Context, *s3.PutObjectLockConfigurationInput, ...request.Option) (*s3.PutObjectLockConfigurationOutput, error)) {
+func (fake *FakeS3API) PutObjectLockConfigurationWithContextCalls(stub func(aws.Context, *s3.PutObjectLockConfigurationInput, ...request.Option) (*s3.PutObjectLockConfigurationOutput, error)) {
 	fake.putObjectLockConfigurationWithContextMutex.Lock()
 	defer fake.putObjectLockConfigurationWithContextMutex.Unlock()
 	fake.PutObjectLockConfigurationWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutObjectLockConfigurationWithContextArgsForCall(i int) (context.Context, *s3.PutObjectLockConfigurationInput, []request.Option) {
+func (fake *FakeS3API) PutObjectLockConfigurationWithContextArgsForCall(i int) (aws.Context, *s3.PutObjectLockConfigurationInput, []request.Option) {
 	fake.putObjectLockConfigurationWithContextMutex.RLock()
 	defer fake.putObjectLockConfigurationWithContextMutex.RUnlock()
 	argsForCall := fake.putObjectLockConfigurationWithContextArgsForCall[i]
@@ -19818,15 +22612,16 @@ func (fake *FakeS3API) PutObjectRequest(arg1 *s3.PutObjectInput) (*request.Reque
 	fake.putObjectRequestArgsForCall = append(fake.putObjectRequestArgsForCall, struct {
 		arg1 *s3.PutObjectInput
 	}{arg1})
+	stub := fake.PutObjectRequestStub
+	fakeReturns := fake.putObjectRequestReturns
 	fake.recordInvocation("PutObjectRequest", []interface{}{arg1})
 	fake.putObjectRequestMutex.Unlock()
-	if fake.PutObjectRequestStub != nil {
-		return fake.PutObjectRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19881,15 +22676,16 @@ func (fake *FakeS3API) PutObjectRetention(arg1 *s3.PutObjectRetentionInput) (*s3
 	fake.putObjectRetentionArgsForCall = append(fake.putObjectRetentionArgsForCall, struct {
 		arg1 *s3.PutObjectRetentionInput
 	}{arg1})
+	stub := fake.PutObjectRetentionStub
+	fakeReturns := fake.putObjectRetentionReturns
 	fake.recordInvocation("PutObjectRetention", []interface{}{arg1})
 	fake.putObjectRetentionMutex.Unlock()
-	if fake.PutObjectRetentionStub != nil {
-		return fake.PutObjectRetentionStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectRetentionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -19944,15 +22740,16 @@ func (fake *FakeS3API) PutObjectRetentionRequest(arg1 *s3.PutObjectRetentionInpu
 	fake.putObjectRetentionRequestArgsForCall = append(fake.putObjectRetentionRequestArgsForCall, struct {
 		arg1 *s3.PutObjectRetentionInput
 	}{arg1})
+	stub := fake.PutObjectRetentionRequestStub
+	fakeReturns := fake.putObjectRetentionRequestReturns
 	fake.recordInvocation("PutObjectRetentionRequest", []interface{}{arg1})
 	fake.putObjectRetentionRequestMutex.Unlock()
-	if fake.PutObjectRetentionRequestStub != nil {
-		return fake.PutObjectRetentionRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectRetentionRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20001,23 +22798,24 @@ func (fake *FakeS3API) PutObjectRetentionRequestReturnsOnCall(i int, result1 *re
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutObjectRetentionWithContext(arg1 context.Context, arg2 *s3.PutObjectRetentionInput, arg3 ...request.Option) (*s3.PutObjectRetentionOutput, error) {
+func (fake *FakeS3API) PutObjectRetentionWithContext(arg1 aws.Context, arg2 *s3.PutObjectRetentionInput, arg3 ...request.Option) (*s3.PutObjectRetentionOutput, error) {
 	fake.putObjectRetentionWithContextMutex.Lock()
 	ret, specificReturn := fake.putObjectRetentionWithContextReturnsOnCall[len(fake.putObjectRetentionWithContextArgsForCall)]
 	fake.putObjectRetentionWithContextArgsForCall = append(fake.putObjectRetentionWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutObjectRetentionInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutObjectRetentionWithContextStub
+	fakeReturns := fake.putObjectRetentionWithContextReturns
 	fake.recordInvocation("PutObjectRetentionWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putObjectRetentionWithContextMutex.Unlock()
-	if fake.PutObjectRetentionWithContextStub != nil {
-		return fake.PutObjectRetentionWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectRetentionWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20027,13 +22825,13 @@ func (fake *FakeS3API) PutObjectRetentionWithContextCallCount() int {
 	return len(fake.putObjectRetentionWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutObjectRetentionWithContextCalls(stub func(context.Context, *s3.PutObjectRetentionInput, ...request.Option) (*s3.PutObjectRetentionOutput, error)) {
+func (fake *FakeS3API) PutObjectRetentionWithContextCalls(stub func(aws.Context, *s3.PutObjectRetentionInput, ...request.Option) (*s3.PutObjectRetentionOutput, error)) {
 	fake.putObjectRetentionWithContextMutex.Lock()
 	defer fake.putObjectRetentionWithContextMutex.Unlock()
 	fake.PutObjectRetentionWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutObjectRetentionWithContextArgsForCall(i int) (context.Context, *s3.PutObjectRetentionInput, []request.Option) {
+func (fake *FakeS3API) PutObjectRetentionWithContextArgsForCall(i int) (aws.Context, *s3.PutObjectRetentionInput, []request.Option) {
 	fake.putObjectRetentionWithContextMutex.RLock()
 	defer fake.putObjectRetentionWithContextMutex.RUnlock()
 	argsForCall := fake.putObjectRetentionWithContextArgsForCall[i]
@@ -20072,15 +22870,16 @@ func (fake *FakeS3API) PutObjectTagging(arg1 *s3.PutObjectTaggingInput) (*s3.Put
 	fake.putObjectTaggingArgsForCall = append(fake.putObjectTaggingArgsForCall, struct {
 		arg1 *s3.PutObjectTaggingInput
 	}{arg1})
+	stub := fake.PutObjectTaggingStub
+	fakeReturns := fake.putObjectTaggingReturns
 	fake.recordInvocation("PutObjectTagging", []interface{}{arg1})
 	fake.putObjectTaggingMutex.Unlock()
-	if fake.PutObjectTaggingStub != nil {
-		return fake.PutObjectTaggingStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectTaggingReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20135,15 +22934,16 @@ func (fake *FakeS3API) PutObjectTaggingRequest(arg1 *s3.PutObjectTaggingInput) (
 	fake.putObjectTaggingRequestArgsForCall = append(fake.putObjectTaggingRequestArgsForCall, struct {
 		arg1 *s3.PutObjectTaggingInput
 	}{arg1})
+	stub := fake.PutObjectTaggingRequestStub
+	fakeReturns := fake.putObjectTaggingRequestReturns
 	fake.recordInvocation("PutObjectTaggingRequest", []interface{}{arg1})
 	fake.putObjectTaggingRequestMutex.Unlock()
-	if fake.PutObjectTaggingRequestStub != nil {
-		return fake.PutObjectTaggingRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectTaggingRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20192,23 +22992,24 @@ func (fake *FakeS3API) PutObjectTaggingRequestReturnsOnCall(i int, result1 *requ
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutObjectTaggingWithContext(arg1 context.Context, arg2 *s3.PutObjectTaggingInput, arg3 ...request.Option) (*s3.PutObjectTaggingOutput, error) {
+func (fake *FakeS3API) PutObjectTaggingWithContext(arg1 aws.Context, arg2 *s3.PutObjectTaggingInput, arg3 ...request.Option) (*s3.PutObjectTaggingOutput, error) {
 	fake.putObjectTaggingWithContextMutex.Lock()
 	ret, specificReturn := fake.putObjectTaggingWithContextReturnsOnCall[len(fake.putObjectTaggingWithContextArgsForCall)]
 	fake.putObjectTaggingWithContextArgsForCall = append(fake.putObjectTaggingWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutObjectTaggingInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutObjectTaggingWithContextStub
+	fakeReturns := fake.putObjectTaggingWithContextReturns
 	fake.recordInvocation("PutObjectTaggingWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putObjectTaggingWithContextMutex.Unlock()
-	if fake.PutObjectTaggingWithContextStub != nil {
-		return fake.PutObjectTaggingWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectTaggingWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20218,13 +23019,13 @@ func (fake *FakeS3API) PutObjectTaggingWithContextCallCount() int {
 	return len(fake.putObjectTaggingWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutObjectTaggingWithContextCalls(stub func(context.Context, *s3.PutObjectTaggingInput, ...request.Option) (*s3.PutObjectTaggingOutput, error)) {
+func (fake *FakeS3API) PutObjectTaggingWithContextCalls(stub func(aws.Context, *s3.PutObjectTaggingInput, ...request.Option) (*s3.PutObjectTaggingOutput, error)) {
 	fake.putObjectTaggingWithContextMutex.Lock()
 	defer fake.putObjectTaggingWithContextMutex.Unlock()
 	fake.PutObjectTaggingWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutObjectTaggingWithContextArgsForCall(i int) (context.Context, *s3.PutObjectTaggingInput, []request.Option) {
+func (fake *FakeS3API) PutObjectTaggingWithContextArgsForCall(i int) (aws.Context, *s3.PutObjectTaggingInput, []request.Option) {
 	fake.putObjectTaggingWithContextMutex.RLock()
 	defer fake.putObjectTaggingWithContextMutex.RUnlock()
 	argsForCall := fake.putObjectTaggingWithContextArgsForCall[i]
@@ -20257,23 +23058,24 @@ func (fake *FakeS3API) PutObjectTaggingWithContextReturnsOnCall(i int, result1 *
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutObjectWithContext(arg1 context.Context, arg2 *s3.PutObjectInput, arg3 ...request.Option) (*s3.PutObjectOutput, error) {
+func (fake *FakeS3API) PutObjectWithContext(arg1 aws.Context, arg2 *s3.PutObjectInput, arg3 ...request.Option) (*s3.PutObjectOutput, error) {
 	fake.putObjectWithContextMutex.Lock()
 	ret, specificReturn := fake.putObjectWithContextReturnsOnCall[len(fake.putObjectWithContextArgsForCall)]
 	fake.putObjectWithContextArgsForCall = append(fake.putObjectWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutObjectInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutObjectWithContextStub
+	fakeReturns := fake.putObjectWithContextReturns
 	fake.recordInvocation("PutObjectWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putObjectWithContextMutex.Unlock()
-	if fake.PutObjectWithContextStub != nil {
-		return fake.PutObjectWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putObjectWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20283,13 +23085,13 @@ func (fake *FakeS3API) PutObjectWithContextCallCount() int {
 	return len(fake.putObjectWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutObjectWithContextCalls(stub func(context.Context, *s3.PutObjectInput, ...request.Option) (*s3.PutObjectOutput, error)) {
+func (fake *FakeS3API) PutObjectWithContextCalls(stub func(aws.Context, *s3.PutObjectInput, ...request.Option) (*s3.PutObjectOutput, error)) {
 	fake.putObjectWithContextMutex.Lock()
 	defer fake.putObjectWithContextMutex.Unlock()
 	fake.PutObjectWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutObjectWithContextArgsForCall(i int) (context.Context, *s3.PutObjectInput, []request.Option) {
+func (fake *FakeS3API) PutObjectWithContextArgsForCall(i int) (aws.Context, *s3.PutObjectInput, []request.Option) {
 	fake.putObjectWithContextMutex.RLock()
 	defer fake.putObjectWithContextMutex.RUnlock()
 	argsForCall := fake.putObjectWithContextArgsForCall[i]
@@ -20328,15 +23130,16 @@ func (fake *FakeS3API) PutPublicAccessBlock(arg1 *s3.PutPublicAccessBlockInput)
 	fake.putPublicAccessBlockArgsForCall = append(fake.putPublicAccessBlockArgsForCall, struct {
 		arg1 *s3.PutPublicAccessBlockInput
 	}{arg1})
+	stub := fake.PutPublicAccessBlockStub
+	fakeReturns := fake.putPublicAccessBlockReturns
 	fake.recordInvocation("PutPublicAccessBlock", []interface{}{arg1})
 	fake.putPublicAccessBlockMutex.Unlock()
-	if fake.PutPublicAccessBlockStub != nil {
-		return fake.PutPublicAccessBlockStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putPublicAccessBlockReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20391,15 +23194,16 @@ func (fake *FakeS3API) PutPublicAccessBlockRequest(arg1 *s3.PutPublicAccessBlock
 	fake.putPublicAccessBlockRequestArgsForCall = append(fake.putPublicAccessBlockRequestArgsForCall, struct {
 		arg1 *s3.PutPublicAccessBlockInput
 	}{arg1})
+	stub := fake.PutPublicAccessBlockRequestStub
+	fakeReturns := fake.putPublicAccessBlockRequestReturns
 	fake.recordInvocation("PutPublicAccessBlockRequest", []interface{}{arg1})
 	fake.putPublicAccessBlockRequestMutex.Unlock()
-	if fake.PutPublicAccessBlockRequestStub != nil {
-		return fake.PutPublicAccessBlockRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putPublicAccessBlockRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20448,23 +23252,24 @@ func (fake *FakeS3API) PutPublicAccessBlockRequestReturnsOnCall(i int, result1 *
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) PutPublicAccessBlockWithContext(arg1 context.Context, arg2 *s3.PutPublicAccessBlockInput, arg3 ...request.Option) (*s3.PutPublicAccessBlockOutput, error) {
+func (fake *FakeS3API) PutPublicAccessBlockWithContext(arg1 aws.Context, arg2 *s3.PutPublicAccessBlockInput, arg3 ...request.Option) (*s3.PutPublicAccessBlockOutput, error) {
 	fake.putPublicAccessBlockWithContextMutex.Lock()
 	ret, specificReturn := fake.putPublicAccessBlockWithContextReturnsOnCall[len(fake.putPublicAccessBlockWithContextArgsForCall)]
 	fake.putPublicAccessBlockWithContextArgsForCall = append(fake.putPublicAccessBlockWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.PutPublicAccessBlockInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.PutPublicAccessBlockWithContextStub
+	fakeReturns := fake.putPublicAccessBlockWithContextReturns
 	fake.recordInvocation("PutPublicAccessBlockWithContext", []interface{}{arg1, arg2, arg3})
 	fake.putPublicAccessBlockWithContextMutex.Unlock()
-	if fake.PutPublicAccessBlockWithContextStub != nil {
-		return fake.PutPublicAccessBlockWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.putPublicAccessBlockWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20474,13 +23279,13 @@ func (fake *FakeS3API) PutPublicAccessBlockWithContextCallCount() int {
 	return len(fake.putPublicAccessBlockWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) PutPublicAccessBlockWithContextCalls(stub func(context.Context, *s3.PutPublicAccessBlockInput, ...request.Option) (*s3.PutPublicAccessBlockOutput, error)) {
+func (fake *FakeS3API) PutPublicAccessBlockWithContextCalls(stub func(aws.Context, *s3.PutPublicAccessBlockInput, ...request.Option) (*s3.PutPublicAccessBlockOutput, error)) {
 	fake.putPublicAccessBlockWithContextMutex.Lock()
 	defer fake.putPublicAccessBlockWithContextMutex.Unlock()
 	fake.PutPublicAccessBlockWithContextStub = stub
 }
 
-func (fake *FakeS3API) PutPublicAccessBlockWithContextArgsForCall(i int) (context.Context, *s3.PutPublicAccessBlockInput, []request.Option) {
+func (fake *FakeS3API) PutPublicAccessBlockWithContextArgsForCall(i int) (aws.Context, *s3.PutPublicAccessBlockInput, []request.Option) {
 	fake.putPublicAccessBlockWithContextMutex.RLock()
 	defer fake.putPublicAccessBlockWithContextMutex.RUnlock()
 	argsForCall := fake.putPublicAccessBlockWithContextArgsForCall[i]
@@ -20519,15 +23324,16 @@ func (fake *FakeS3API) RestoreObject(arg1 *s3.RestoreObjectInput) (*s3.RestoreOb
 	fake.restoreObjectArgsForCall = append(fake.restoreObjectArgsForCall, struct {
 		arg1 *s3.RestoreObjectInput
 	}{arg1})
+	stub := fake.RestoreObjectStub
+	fakeReturns := fake.restoreObjectReturns
 	fake.recordInvocation("RestoreObject", []interface{}{arg1})
 	fake.restoreObjectMutex.Unlock()
-	if fake.RestoreObjectStub != nil {
-		return fake.RestoreObjectStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.restoreObjectReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20582,15 +23388,16 @@ func (fake *FakeS3API) RestoreObjectRequest(arg1 *s3.RestoreObjectInput) (*reque
 	fake.restoreObjectRequestArgsForCall = append(fake.restoreObjectRequestArgsForCall, struct {
 		arg1 *s3.RestoreObjectInput
 	}{arg1})
+	stub := fake.RestoreObjectRequestStub
+	fakeReturns := fake.restoreObjectRequestReturns
 	fake.recordInvocation("RestoreObjectRequest", []interface{}{arg1})
 	fake.restoreObjectRequestMutex.Unlock()
-	if fake.RestoreObjectRequestStub != nil {
-		return fake.RestoreObjectRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.restoreObjectRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20639,23 +23446,24 @@ func (fake *FakeS3API) RestoreObjectRequestReturnsOnCall(i int, result1 *request
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) RestoreObjectWithContext(arg1 context.Context, arg2 *s3.RestoreObjectInput, arg3 ...request.Option) (*s3.RestoreObjectOutput, error) {
+func (fake *FakeS3API) RestoreObjectWithContext(arg1 aws.Context, arg2 *s3.RestoreObjectInput, arg3 ...request.Option) (*s3.RestoreObjectOutput, error) {
 	fake.restoreObjectWithContextMutex.Lock()
 	ret, specificReturn := fake.restoreObjectWithContextReturnsOnCall[len(fake.restoreObjectWithContextArgsForCall)]
 	fake.restoreObjectWithContextArgsForCall = append(fake.restoreObjectWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.RestoreObjectInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.RestoreObjectWithContextStub
+	fakeReturns := fake.restoreObjectWithContextReturns
 	fake.recordInvocation("RestoreObjectWithContext", []interface{}{arg1, arg2, arg3})
 	fake.restoreObjectWithContextMutex.Unlock()
-	if fake.RestoreObjectWithContextStub != nil {
-		return fake.RestoreObjectWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.restoreObjectWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20665,13 +23473,13 @@ func (fake *FakeS3API) RestoreObjectWithContextCallCount() int {
 	return len(fake.restoreObjectWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) RestoreObjectWithContextCalls(stub func(context.Context, *s3.RestoreObjectInput, ...request.Option) (*s3.RestoreObjectOutput, error)) {
+func (fake *FakeS3API) RestoreObjectWithContextCalls(stub func(aws.Context, *s3.RestoreObjectInput, ...request.Option) (*s3.RestoreObjectOutput, error)) {
 	fake.restoreObjectWithContextMutex.Lock()
 	defer fake.restoreObjectWithContextMutex.Unlock()
 	fake.RestoreObjectWithContextStub = stub
 }
 
-func (fake *FakeS3API) RestoreObjectWithContextArgsForCall(i int) (context.Context, *s3.RestoreObjectInput, []request.Option) {
+func (fake *FakeS3API) RestoreObjectWithContextArgsForCall(i int) (aws.Context, *s3.RestoreObjectInput, []request.Option) {
 	fake.restoreObjectWithContextMutex.RLock()
 	defer fake.restoreObjectWithContextMutex.RUnlock()
 	argsForCall := fake.restoreObjectWithContextArgsForCall[i]
@@ -20710,15 +23518,16 @@ func (fake *FakeS3API) SelectObjectContent(arg1 *s3.SelectObjectContentInput) (*
 	fake.selectObjectContentArgsForCall = append(fake.selectObjectContentArgsForCall, struct {
 		arg1 *s3.SelectObjectContentInput
 	}{arg1})
+	stub := fake.SelectObjectContentStub
+	fakeReturns := fake.selectObjectContentReturns
 	fake.recordInvocation("SelectObjectContent", []interface{}{arg1})
 	fake.selectObjectContentMutex.Unlock()
-	if fake.SelectObjectContentStub != nil {
-		return fake.SelectObjectContentStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.selectObjectContentReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20773,15 +23582,16 @@ func (fake *FakeS3API) SelectObjectContentRequest(arg1 *s3.SelectObjectContentIn
 	fake.selectObjectContentRequestArgsForCall = append(fake.selectObjectContentRequestArgsForCall, struct {
 		arg1 *s3.SelectObjectContentInput
 	}{arg1})
+	stub := fake.SelectObjectContentRequestStub
+	fakeReturns := fake.selectObjectContentRequestReturns
 	fake.recordInvocation("SelectObjectContentRequest", []interface{}{arg1})
 	fake.selectObjectContentRequestMutex.Unlock()
-	if fake.SelectObjectContentRequestStub != nil {
-		return fake.SelectObjectContentRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.selectObjectContentRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20830,23 +23640,24 @@ func (fake *FakeS3API) SelectObjectContentRequestReturnsOnCall(i int, result1 *r
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) SelectObjectContentWithContext(arg1 context.Context, arg2 *s3.SelectObjectContentInput, arg3 ...request.Option) (*s3.SelectObjectContentOutput, error) {
+func (fake *FakeS3API) SelectObjectContentWithContext(arg1 aws.Context, arg2 *s3.SelectObjectContentInput, arg3 ...request.Option) (*s3.SelectObjectContentOutput, error) {
 	fake.selectObjectContentWithContextMutex.Lock()
 	ret, specificReturn := fake.selectObjectContentWithContextReturnsOnCall[len(fake.selectObjectContentWithContextArgsForCall)]
 	fake.selectObjectContentWithContextArgsForCall = append(fake.selectObjectContentWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.SelectObjectContentInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.SelectObjectContentWithContextStub
+	fakeReturns := fake.selectObjectContentWithContextReturns
 	fake.recordInvocation("SelectObjectContentWithContext", []interface{}{arg1, arg2, arg3})
 	fake.selectObjectContentWithContextMutex.Unlock()
-	if fake.SelectObjectContentWithContextStub != nil {
-		return fake.SelectObjectContentWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.selectObjectContentWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20856,13 +23667,13 @@ func (fake *FakeS3API) SelectObjectContentWithContextCallCount() int {
 	return len(fake.selectObjectContentWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) SelectObjectContentWithContextCalls(stub func(context.Context, *s3.SelectObjectContentInput, ...request.Option) (*s3.SelectObjectContentOutput, error)) {
+func (fake *FakeS3API) SelectObjectContentWithContextCalls(stub func(aws.Context, *s3.SelectObjectContentInput, ...request.Option) (*s3.SelectObjectContentOutput, error)) {
 	fake.selectObjectContentWithContextMutex.Lock()
 	defer fake.selectObjectContentWithContextMutex.Unlock()
 	fake.SelectObjectContentWithContextStub = stub
 }
 
-func (fake *FakeS3API) SelectObjectContentWithContextArgsForCall(i int) (context.Context, *s3.SelectObjectContentInput, []request.Option) {
+func (fake *FakeS3API) SelectObjectContentWithContextArgsForCall(i int) (aws.Context, *s3.SelectObjectContentInput, []request.Option) {
 	fake.selectObjectContentWithContextMutex.RLock()
 	defer fake.selectObjectContentWithContextMutex.RUnlock()
 	argsForCall := fake.selectObjectContentWithContextArgsForCall[i]
@@ -20901,15 +23712,16 @@ func (fake *FakeS3API) UploadPart(arg1 *s3.UploadPartInput) (*s3.UploadPartOutpu
 	fake.uploadPartArgsForCall = append(fake.uploadPartArgsForCall, struct {
 		arg1 *s3.UploadPartInput
 	}{arg1})
+	stub := fake.UploadPartStub
+	fakeReturns := fake.uploadPartReturns
 	fake.recordInvocation("UploadPart", []interface{}{arg1})
 	fake.uploadPartMutex.Unlock()
-	if fake.UploadPartStub != nil {
-		return fake.UploadPartStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.uploadPartReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -20964,15 +23776,16 @@ func (fake *FakeS3API) UploadPartCopy(arg1 *s3.UploadPartCopyInput) (*s3.UploadP
 	fake.uploadPartCopyArgsForCall = append(fake.uploadPartCopyArgsForCall, struct {
 		arg1 *s3.UploadPartCopyInput
 	}{arg1})
+	stub := fake.UploadPartCopyStub
+	fakeReturns := fake.uploadPartCopyReturns
 	fake.recordInvocation("UploadPartCopy", []interface{}{arg1})
 	fake.uploadPartCopyMutex.Unlock()
-	if fake.UploadPartCopyStub != nil {
-		return fake.UploadPartCopyStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.uploadPartCopyReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -21027,15 +23840,16 @@ func (fake *FakeS3API) UploadPartCopyRequest(arg1 *s3.UploadPartCopyInput) (*req
 	fake.uploadPartCopyRequestArgsForCall = append(fake.uploadPartCopyRequestArgsForCall, struct {
 		arg1 *s3.UploadPartCopyInput
 	}{arg1})
+	stub := fake.UploadPartCopyRequestStub
+	fakeReturns := fake.uploadPartCopyRequestReturns
 	fake.recordInvocation("UploadPartCopyRequest", []interface{}{arg1})
 	fake.uploadPartCopyRequestMutex.Unlock()
-	if fake.UploadPartCopyRequestStub != nil {
-		return fake.UploadPartCopyRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.uploadPartCopyRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -21084,23 +23898,24 @@ func (fake *FakeS3API) UploadPartCopyRequestReturnsOnCall(i int, result1 *reques
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) UploadPartCopyWithContext(arg1 context.Context, arg2 *s3.UploadPartCopyInput, arg3 ...request.Option) (*s3.UploadPartCopyOutput, error) {
+func (fake *FakeS3API) UploadPartCopyWithContext(arg1 aws.Context, arg2 *s3.UploadPartCopyInput, arg3 ...request.Option) (*s3.UploadPartCopyOutput, error) {
 	fake.uploadPartCopyWithContextMutex.Lock()
 	ret, specificReturn := fake.uploadPartCopyWithContextReturnsOnCall[len(fake.uploadPartCopyWithContextArgsForCall)]
 	fake.uploadPartCopyWithContextArgsForCall = append(fake.uploadPartCopyWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.UploadPartCopyInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.UploadPartCopyWithContextStub
+	fakeReturns := fake.uploadPartCopyWithContextReturns
 	fake.recordInvocation("UploadPartCopyWithContext", []interface{}{arg1, arg2, arg3})
 	fake.uploadPartCopyWithContextMutex.Unlock()
-	if fake.UploadPartCopyWithContextStub != nil {
-		return fake.UploadPartCopyWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.uploadPartCopyWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -21110,13 +23925,13 @@ func (fake *FakeS3API) UploadPartCopyWithContextCallCount() int {
 	return len(fake.uploadPartCopyWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) UploadPartCopyWithContextCalls(stub func(context.Context, *s3.UploadPartCopyInput, ...request.Option) (*s3.UploadPartCopyOutput, error)) {
+func (fake *FakeS3API) UploadPartCopyWithContextCalls(stub func(aws.Context, *s3.UploadPartCopyInput, ...request.Option) (*s3.UploadPartCopyOutput, error)) {
 	fake.uploadPartCopyWithContextMutex.Lock()
 	defer fake.uploadPartCopyWithContextMutex.Unlock()
 	fake.UploadPartCopyWithContextStub = stub
 }
 
-func (fake *FakeS3API) UploadPartCopyWithContextArgsForCall(i int) (context.Context, *s3.UploadPartCopyInput, []request.Option) {
+func (fake *FakeS3API) UploadPartCopyWithContextArgsForCall(i int) (aws.Context, *s3.UploadPartCopyInput, []request.Option) {
 	fake.uploadPartCopyWithContextMutex.RLock()
 	defer fake.uploadPartCopyWithContextMutex.RUnlock()
 	argsForCall := fake.uploadPartCopyWithContextArgsForCall[i]
@@ -21155,15 +23970,16 @@ func (fake *FakeS3API) UploadPartRequest(arg1 *s3.UploadPartInput) (*request.Req
 	fake.uploadPartRequestArgsForCall = append(fake.uploadPartRequestArgsForCall, struct {
 		arg1 *s3.UploadPartInput
 	}{arg1})
+	stub := fake.UploadPartRequestStub
+	fakeReturns := fake.uploadPartRequestReturns
 	fake.recordInvocation("UploadPartRequest", []interface{}{arg1})
 	fake.uploadPartRequestMutex.Unlock()
-	if fake.UploadPartRequestStub != nil {
-		return fake.UploadPartRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.uploadPartRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -21212,23 +24028,24 @@ func (fake *FakeS3API) UploadPartRequestReturnsOnCall(i int, result1 *request.Re
 	}{result1, result2}
 }
 
-func (fake *FakeS3API) UploadPartWithContext(arg1 context.Context, arg2 *s3.UploadPartInput, arg3 ...request.Option) (*s3.UploadPartOutput, error) {
+func (fake *FakeS3API) UploadPartWithContext(arg1 aws.Context, arg2 *s3.UploadPartInput, arg3 ...request.Option) (*s3.UploadPartOutput, error) {
 	fake.uploadPartWithContextMutex.Lock()
 	ret, specificReturn := fake.uploadPartWithContextReturnsOnCall[len(fake.uploadPartWithContextArgsForCall)]
 	fake.uploadPartWithContextArgsForCall = append(fake.uploadPartWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.UploadPartInput
 		arg3 []request.Option
 	}{arg1, arg2, arg3})
+	stub := fake.UploadPartWithContextStub
+	fakeReturns := fake.uploadPartWithContextReturns
 	fake.recordInvocation("UploadPartWithContext", []interface{}{arg1, arg2, arg3})
 	fake.uploadPartWithContextMutex.Unlock()
-	if fake.UploadPartWithContextStub != nil {
-		return fake.UploadPartWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.uploadPartWithContextReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -21238,13 +24055,13 @@ func (fake *FakeS3API) UploadPartWithContextCallCount() int {
 	return len(fake.uploadPartWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) UploadPartWithContextCalls(stub func(context.Context, *s3.UploadPartInput, ...request.Option) (*s3.UploadPartOutput, error)) {
+func (fake *FakeS3API) UploadPartWithContextCalls(stub func(aws.Context, *s3.UploadPartInput, ...request.Option) (*s3.UploadPartOutput, error)) {
 	fake.uploadPartWithContextMutex.Lock()
 	defer fake.uploadPartWithContextMutex.Unlock()
 	fake.UploadPartWithContextStub = stub
 }
 
-func (fake *FakeS3API) UploadPartWithContextArgsForCall(i int) (context.Context, *s3.UploadPartInput, []request.Option) {
+func (fake *FakeS3API) UploadPartWithContextArgsForCall(i int) (aws.Context, *s3.UploadPartInput, []request.Option) {
 	fake.uploadPartWithContextMutex.RLock()
 	defer fake.uploadPartWithContextMutex.RUnlock()
 	argsForCall := fake.uploadPartWithContextArgsForCall[i]
@@ -21283,15 +24100,16 @@ func (fake *FakeS3API) WaitUntilBucketExists(arg1 *s3.HeadBucketInput) error {
 	fake.waitUntilBucketExistsArgsForCall = append(fake.waitUntilBucketExistsArgsForCall, struct {
 		arg1 *s3.HeadBucketInput
 	}{arg1})
+	stub := fake.WaitUntilBucketExistsStub
+	fakeReturns := fake.waitUntilBucketExistsReturns
 	fake.recordInvocation("WaitUntilBucketExists", []interface{}{arg1})
 	fake.waitUntilBucketExistsMutex.Unlock()
-	if fake.WaitUntilBucketExistsStub != nil {
-		return fake.WaitUntilBucketExistsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.waitUntilBucketExistsReturns
 	return fakeReturns.result1
 }
 
@@ -21337,23 +24155,24 @@ func (fake *FakeS3API) WaitUntilBucketExistsReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
-func (fake *FakeS3API) WaitUntilBucketExistsWithContext(arg1 context.Context, arg2 *s3.HeadBucketInput, arg3 ...request.WaiterOption) error {
+func (fake *FakeS3API) WaitUntilBucketExistsWithContext(arg1 aws.Context, arg2 *s3.HeadBucketInput, arg3 ...request.WaiterOption) error {
 	fake.waitUntilBucketExistsWithContextMutex.Lock()
 	ret, specificReturn := fake.waitUntilBucketExistsWithContextReturnsOnCall[len(fake.waitUntilBucketExistsWithContextArgsForCall)]
 	fake.waitUntilBucketExistsWithContextArgsForCall = append(fake.waitUntilBucketExistsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.HeadBucketInput
 		arg3 []request.WaiterOption
 	}{arg1, arg2, arg3})
+	stub := fake.WaitUntilBucketExistsWithContextStub
+	fakeReturns := fake.waitUntilBucketExistsWithContextReturns
 	fake.recordInvocation("WaitUntilBucketExistsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.waitUntilBucketExistsWithContextMutex.Unlock()
-	if fake.WaitUntilBucketExistsWithContextStub != nil {
-		return fake.WaitUntilBucketExistsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.waitUntilBucketExistsWithContextReturns
 	return fakeReturns.result1
 }
 
@@ -21363,13 +24182,13 @@ func (fake *FakeS3API) WaitUntilBucketExistsWithContextCallCount() int {
 	return len(fake.waitUntilBucketExistsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) WaitUntilBucketExistsWithContextCalls(stub func(context.Context, *s3.HeadBucketInput, ...request.WaiterOption) error) {
+func (fake *FakeS3API) WaitUntilBucketExistsWithContextCalls(stub func(aws.Context, *s3.HeadBucketInput, ...request.WaiterOption) error) {
 	fake.waitUntilBucketExistsWithContextMutex.Lock()
 	defer fake.waitUntilBucketExistsWithContextMutex.Unlock()
 	fake.WaitUntilBucketExistsWithContextStub = stub
 }
 
-func (fake *FakeS3API) WaitUntilBucketExistsWithContextArgsForCall(i int) (context.Context, *s3.HeadBucketInput, []request.WaiterOption) {
+func (fake *FakeS3API) WaitUntilBucketExistsWithContextArgsForCall(i int) (aws.Context, *s3.HeadBucketInput, []request.WaiterOption) {
 	fake.waitUntilBucketExistsWithContextMutex.RLock()
 	defer fake.waitUntilBucketExistsWithContextMutex.RUnlock()
 	argsForCall := fake.waitUntilBucketExistsWithContextArgsForCall[i]
@@ -21405,15 +24224,16 @@ func (fake *FakeS3API) WaitUntilBucketNotExists(arg1 *s3.HeadBucketInput) error
 	fake.waitUntilBucketNotExistsArgsForCall = append(fake.waitUntilBucketNotExistsArgsForCall, struct {
 		arg1 *s3.HeadBucketInput
 	}{arg1})
+	stub := fake.WaitUntilBucketNotExistsStub
+	fakeReturns := fake.waitUntilBucketNotExistsReturns
 	fake.recordInvocation("WaitUntilBucketNotExists", []interface{}{arg1})
 	fake.waitUntilBucketNotExistsMutex.Unlock()
-	if fake.WaitUntilBucketNotExistsStub != nil {
-		return fake.WaitUntilBucketNotExistsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.waitUntilBucketNotExistsReturns
 	return fakeReturns.result1
 }
 
@@ -21459,23 +24279,24 @@ func (fake *FakeS3API) WaitUntilBucketNotExistsReturnsOnCall(i int, result1 erro
 	}{result1}
 }
 
-func (fake *FakeS3API) WaitUntilBucketNotExistsWithContext(arg1 context.Context, arg2 *s3.HeadBucketInput, arg3 ...request.WaiterOption) error {
+func (fake *FakeS3API) WaitUntilBucketNotExistsWithContext(arg1 aws.Context, arg2 *s3.HeadBucketInput, arg3 ...request.WaiterOption) error {
 	fake.waitUntilBucketNotExistsWithContextMutex.Lock()
 	ret, specificReturn := fake.waitUntilBucketNotExistsWithContextReturnsOnCall[len(fake.waitUntilBucketNotExistsWithContextArgsForCall)]
 	fake.waitUntilBucketNotExistsWithContextArgsForCall = append(fake.waitUntilBucketNotExistsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.HeadBucketInput
 		arg3 []request.WaiterOption
 	}{arg1, arg2, arg3})
+	stub := fake.WaitUntilBucketNotExistsWithContextStub
+	fakeReturns := fake.waitUntilBucketNotExistsWithContextReturns
 	fake.recordInvocation("WaitUntilBucketNotExistsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.waitUntilBucketNotExistsWithContextMutex.Unlock()
-	if fake.WaitUntilBucketNotExistsWithContextStub != nil {
-		return fake.WaitUntilBucketNotExistsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.waitUntilBucketNotExistsWithContextReturns
 	return fakeReturns.result1
 }
 
@@ -21485,13 +24306,13 @@ func (fake *FakeS3API) WaitUntilBucketNotExistsWithContextCallCount() int {
 	return len(fake.waitUntilBucketNotExistsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) WaitUntilBucketNotExistsWithContextCalls(stub func(context.Context, *s3.HeadBucketInput, ...request.WaiterOption) error) {
+func (fake *FakeS3API) WaitUntilBucketNotExistsWithContextCalls(stub func(aws.Context, *s3.HeadBucketInput, ...request.WaiterOption) error) {
 	fake.waitUntilBucketNotExistsWithContextMutex.Lock()
 	defer fake.waitUntilBucketNotExistsWithContextMutex.Unlock()
 	fake.WaitUntilBucketNotExistsWithContextStub = stub
 }
 
-func (fake *FakeS3API) WaitUntilBucketNotExistsWithContextArgsForCall(i int) (context.Context, *s3.HeadBucketInput, []request.WaiterOption) {
+func (fake *FakeS3API) WaitUntilBucketNotExistsWithContextArgsForCall(i int) (aws.Context, *s3.HeadBucketInput, []request.WaiterOption) {
 	fake.waitUntilBucketNotExistsWithContextMutex.RLock()
 	defer fake.waitUntilBucketNotExistsWithContextMutex.RUnlock()
 	argsForCall := fake.waitUntilBucketNotExistsWithContextArgsForCall[i]
@@ -21527,15 +24348,16 @@ func (fake *FakeS3API) WaitUntilObjectExists(arg1 *s3.HeadObjectInput) error {
 	fake.waitUntilObjectExistsArgsForCall = append(fake.waitUntilObjectExistsArgsForCall, struct {
 		arg1 *s3.HeadObjectInput
 	}{arg1})
+	stub := fake.WaitUntilObjectExistsStub
+	fakeReturns := fake.waitUntilObjectExistsReturns
 	fake.recordInvocation("WaitUntilObjectExists", []interface{}{arg1})
 	fake.waitUntilObjectExistsMutex.Unlock()
-	if fake.WaitUntilObjectExistsStub != nil {
-		return fake.WaitUntilObjectExistsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.waitUntilObjectExistsReturns
 	return fakeReturns.result1
 }
 
@@ -21581,23 +24403,24 @@ func (fake *FakeS3API) WaitUntilObjectExistsReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
-func (fake *FakeS3API) WaitUntilObjectExistsWithContext(arg1 context.Context, arg2 *s3.HeadObjectInput, arg3 ...request.WaiterOption) error {
+func (fake *FakeS3API) WaitUntilObjectExistsWithContext(arg1 aws.Context, arg2 *s3.HeadObjectInput, arg3 ...request.WaiterOption) error {
 	fake.waitUntilObjectExistsWithContextMutex.Lock()
 	ret, specificReturn := fake.waitUntilObjectExistsWithContextReturnsOnCall[len(fake.waitUntilObjectExistsWithContextArgsForCall)]
 	fake.waitUntilObjectExistsWithContextArgsForCall = append(fake.waitUntilObjectExistsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.HeadObjectInput
 		arg3 []request.WaiterOption
 	}{arg1, arg2, arg3})
+	stub := fake.WaitUntilObjectExistsWithContextStub
+	fakeReturns := fake.waitUntilObjectExistsWithContextReturns
 	fake.recordInvocation("WaitUntilObjectExistsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.waitUntilObjectExistsWithContextMutex.Unlock()
-	if fake.WaitUntilObjectExistsWithContextStub != nil {
-		return fake.WaitUntilObjectExistsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.waitUntilObjectExistsWithContextReturns
 	return fakeReturns.result1
 }
 
@@ -21607,13 +24430,13 @@ func (fake *FakeS3API) WaitUntilObjectExistsWithContextCallCount() int {
 	return len(fake.waitUntilObjectExistsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) WaitUntilObjectExistsWithContextCalls(stub func(context.Context, *s3.HeadObjectInput, ...request.WaiterOption) error) {
+func (fake *FakeS3API) WaitUntilObjectExistsWithContextCalls(stub func(aws.Context, *s3.HeadObjectInput, ...request.WaiterOption) error) {
 	fake.waitUntilObjectExistsWithContextMutex.Lock()
 	defer fake.waitUntilObjectExistsWithContextMutex.Unlock()
 	fake.WaitUntilObjectExistsWithContextStub = stub
 }
 
-func (fake *FakeS3API) WaitUntilObjectExistsWithContextArgsForCall(i int) (context.Context, *s3.HeadObjectInput, []request.WaiterOption) {
+func (fake *FakeS3API) WaitUntilObjectExistsWithContextArgsForCall(i int) (aws.Context, *s3.HeadObjectInput, []request.WaiterOption) {
 	fake.waitUntilObjectExistsWithContextMutex.RLock()
 	defer fake.waitUntilObjectExistsWithContextMutex.RUnlock()
 	argsForCall := fake.waitUntilObjectExistsWithContextArgsForCall[i]
@@ -21649,15 +24472,16 @@ func (fake *FakeS3API) WaitUntilObjectNotExists(arg1 *s3.HeadObjectInput) error
 	fake.waitUntilObjectNotExistsArgsForCall = append(fake.waitUntilObjectNotExistsArgsForCall, struct {
 		arg1 *s3.HeadObjectInput
 	}{arg1})
+	stub := fake.WaitUntilObjectNotExistsStub
+	fakeReturns := fake.waitUntilObjectNotExistsReturns
 	fake.recordInvocation("WaitUntilObjectNotExists", []interface{}{arg1})
 	fake.waitUntilObjectNotExistsMutex.Unlock()
-	if fake.WaitUntilObjectNotExistsStub != nil {
-		return fake.WaitUntilObjectNotExistsStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.waitUntilObjectNotExistsReturns
 	return fakeReturns.result1
 }
 
@@ -21703,23 +24527,24 @@ func (fake *FakeS3API) WaitUntilObjectNotExistsReturnsOnCall(i int, result1 erro
 	}{result1}
 }
 
-func (fake *FakeS3API) WaitUntilObjectNotExistsWithContext(arg1 context.Context, arg2 *s3.HeadObjectInput, arg3 ...request.WaiterOption) error {
+func (fake *FakeS3API) WaitUntilObjectNotExistsWithContext(arg1 aws.Context, arg2 *s3.HeadObjectInput, arg3 ...request.WaiterOption) error {
 	fake.waitUntilObjectNotExistsWithContextMutex.Lock()
 	ret, specificReturn := fake.waitUntilObjectNotExistsWithContextReturnsOnCall[len(fake.waitUntilObjectNotExistsWithContextArgsForCall)]
 	fake.waitUntilObjectNotExistsWithContextArgsForCall = append(fake.waitUntilObjectNotExistsWithContextArgsForCall, struct {
-		arg1 context.Context
+		arg1 aws.Context
 		arg2 *s3.HeadObjectInput
 		arg3 []request.WaiterOption
 	}{arg1, arg2, arg3})
+	stub := fake.WaitUntilObjectNotExistsWithContextStub
+	fakeReturns := fake.waitUntilObjectNotExistsWithContextReturns
 	fake.recordInvocation("WaitUntilObjectNotExistsWithContext", []interface{}{arg1, arg2, arg3})
 	fake.waitUntilObjectNotExistsWithContextMutex.Unlock()
-	if fake.WaitUntilObjectNotExistsWithContextStub != nil {
-		return fake.WaitUntilObjectNotExistsWithContextStub(arg1, arg2, arg3...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.waitUntilObjectNotExistsWithContextReturns
 	return fakeReturns.result1
 }
 
@@ -21729,13 +24554,13 @@ func (fake *FakeS3API) WaitUntilObjectNotExistsWithContextCallCount() int {
 	return len(fake.waitUntilObjectNotExistsWithContextArgsForCall)
 }
 
-func (fake *FakeS3API) WaitUntilObjectNotExistsWithContextCalls(stub func(context.Context, *s3.HeadObjectInput, ...request.WaiterOption) error) {
+func (fake *FakeS3API) WaitUntilObjectNotExistsWithContextCalls(stub func(aws.Context, *s3.HeadObjectInput, ...request.WaiterOption) error) {
 	fake.waitUntilObjectNotExistsWithContextMutex.Lock()
 	defer fake.waitUntilObjectNotExistsWithContextMutex.Unlock()
 	fake.WaitUntilObjectNotExistsWithContextStub = stub
 }
 
-func (fake *FakeS3API) WaitUntilObjectNotExistsWithContextArgsForCall(i int) (context.Context, *s3.HeadObjectInput, []request.WaiterOption) {
+func (fake *FakeS3API) WaitUntilObjectNotExistsWithContextArgsForCall(i int) (aws.Context, *s3.HeadObjectInput, []request.WaiterOption) {
 	fake.waitUntilObjectNotExistsWithContextMutex.RLock()
 	defer fake.waitUntilObjectNotExistsWithContextMutex.RUnlock()
 	argsForCall := fake.waitUntilObjectNotExistsWithContextArgsForCall[i]
@@ -21765,6 +24590,200 @@ func (fake *FakeS3API) WaitUntilObjectNotExistsWithContextReturnsOnCall(i int, r
 	}{result1}
 }
 
+func (fake *FakeS3API) WriteGetObjectResponse(arg1 *s3.WriteGetObjectResponseInput) (*s3.WriteGetObjectResponseOutput, error) {
+	fake.writeGetObjectResponseMutex.Lock()
+	ret, specificReturn := fake.writeGetObjectResponseReturnsOnCall[len(fake.writeGetObjectResponseArgsForCall)]
+	fake.writeGetObjectResponseArgsForCall = append(fake.writeGetObjectResponseArgsForCall, struct {
+		arg1 *s3.WriteGetObjectResponseInput
+	}{arg1})
+	stub := fake.WriteGetObjectResponseStub
+	fakeReturns := fake.writeGetObjectResponseReturns
+	fake.recordInvocation("WriteGetObjectResponse", []interface{}{arg1})
+	fake.writeGetObjectResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseCallCount() int {
+	fake.writeGetObjectResponseMutex.RLock()
+	defer fake.writeGetObjectResponseMutex.RUnlock()
+	return len(fake.writeGetObjectResponseArgsForCall)
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseCalls(stub func(*s3.WriteGetObjectResponseInput) (*s3.WriteGetObjectResponseOutput, error)) {
+	fake.writeGetObjectResponseMutex.Lock()
+	defer fake.writeGetObjectResponseMutex.Unlock()
+	fake.WriteGetObjectResponseStub = stub
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseArgsForCall(i int) *s3.WriteGetObjectResponseInput {
+	fake.writeGetObjectResponseMutex.RLock()
+	defer fake.writeGetObjectResponseMutex.RUnlock()
+	argsForCall := fake.writeGetObjectResponseArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseReturns(result1 *s3.WriteGetObjectResponseOutput, result2 error) {
+	fake.writeGetObjectResponseMutex.Lock()
+	defer fake.writeGetObjectResponseMutex.Unlock()
+	fake.WriteGetObjectResponseStub = nil
+	fake.writeGetObjectResponseReturns = struct {
+		result1 *s3.WriteGetObjectResponseOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseReturnsOnCall(i int, result1 *s3.WriteGetObjectResponseOutput, result2 error) {
+	fake.writeGetObjectResponseMutex.Lock()
+	defer fake.writeGetObjectResponseMutex.Unlock()
+	fake.WriteGetObjectResponseStub = nil
+	if fake.writeGetObjectResponseReturnsOnCall == nil {
+		fake.writeGetObjectResponseReturnsOnCall = make(map[int]struct {
+			result1 *s3.WriteGetObjectResponseOutput
+			result2 error
+		})
+	}
+	fake.writeGetObjectResponseReturnsOnCall[i] = struct {
+		result1 *s3.WriteGetObjectResponseOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseRequest(arg1 *s3.WriteGetObjectResponseInput) (*request.Request, *s3.WriteGetObjectResponseOutput) {
+	fake.writeGetObjectResponseRequestMutex.Lock()
+	ret, specificReturn := fake.writeGetObjectResponseRequestReturnsOnCall[len(fake.writeGetObjectResponseRequestArgsForCall)]
+	fake.writeGetObjectResponseRequestArgsForCall = append(fake.writeGetObjectResponseRequestArgsForCall, struct {
+		arg1 *s3.WriteGetObjectResponseInput
+	}{arg1})
+	stub := fake.WriteGetObjectResponseRequestStub
+	fakeReturns := fake.writeGetObjectResponseRequestReturns
+	fake.recordInvocation("WriteGetObjectResponseRequest", []interface{}{arg1})
+	fake.writeGetObjectResponseRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseRequestCallCount() int {
+	fake.writeGetObjectResponseRequestMutex.RLock()
+	defer fake.writeGetObjectResponseRequestMutex.RUnlock()
+	return len(fake.writeGetObjectResponseRequestArgsForCall)
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseRequestCalls(stub func(*s3.WriteGetObjectResponseInput) (*request.Request, *s3.WriteGetObjectResponseOutput)) {
+	fake.writeGetObjectResponseRequestMutex.Lock()
+	defer fake.writeGetObjectResponseRequestMutex.Unlock()
+	fake.WriteGetObjectResponseRequestStub = stub
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseRequestArgsForCall(i int) *s3.WriteGetObjectResponseInput {
+	fake.writeGetObjectResponseRequestMutex.RLock()
+	defer fake.writeGetObjectResponseRequestMutex.RUnlock()
+	argsForCall := fake.writeGetObjectResponseRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseRequestReturns(result1 *request.Request, result2 *s3.WriteGetObjectResponseOutput) {
+	fake.writeGetObjectResponseRequestMutex.Lock()
+	defer fake.writeGetObjectResponseRequestMutex.Unlock()
+	fake.WriteGetObjectResponseRequestStub = nil
+	fake.writeGetObjectResponseRequestReturns = struct {
+		result1 *request.Request
+		result2 *s3.WriteGetObjectResponseOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseRequestReturnsOnCall(i int, result1 *request.Request, result2 *s3.WriteGetObjectResponseOutput) {
+	fake.writeGetObjectResponseRequestMutex.Lock()
+	defer fake.writeGetObjectResponseRequestMutex.Unlock()
+	fake.WriteGetObjectResponseRequestStub = nil
+	if fake.writeGetObjectResponseRequestReturnsOnCall == nil {
+		fake.writeGetObjectResponseRequestReturnsOnCall = make(map[int]struct {
+			result1 *request.Request
+			result2 *s3.WriteGetObjectResponseOutput
+		})
+	}
+	fake.writeGetObjectResponseRequestReturnsOnCall[i] = struct {
+		result1 *request.Request
+		result2 *s3.WriteGetObjectResponseOutput
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseWithContext(arg1 aws.Context, arg2 *s3.WriteGetObjectResponseInput, arg3 ...request.Option) (*s3.WriteGetObjectResponseOutput, error) {
+	fake.writeGetObjectResponseWithContextMutex.Lock()
+	ret, specificReturn := fake.writeGetObjectResponseWithContextReturnsOnCall[len(fake.writeGetObjectResponseWithContextArgsForCall)]
+	fake.writeGetObjectResponseWithContextArgsForCall = append(fake.writeGetObjectResponseWithContextArgsForCall, struct {
+		arg1 aws.Context
+		arg2 *s3.WriteGetObjectResponseInput
+		arg3 []request.Option
+	}{arg1, arg2, arg3})
+	stub := fake.WriteGetObjectResponseWithContextStub
+	fakeReturns := fake.writeGetObjectResponseWithContextReturns
+	fake.recordInvocation("WriteGetObjectResponseWithContext", []interface{}{arg1, arg2, arg3})
+	fake.writeGetObjectResponseWithContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseWithContextCallCount() int {
+	fake.writeGetObjectResponseWithContextMutex.RLock()
+	defer fake.writeGetObjectResponseWithContextMutex.RUnlock()
+	return len(fake.writeGetObjectResponseWithContextArgsForCall)
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseWithContextCalls(stub func(aws.Context, *s3.WriteGetObjectResponseInput, ...request.Option) (*s3.WriteGetObjectResponseOutput, error)) {
+	fake.writeGetObjectResponseWithContextMutex.Lock()
+	defer fake.writeGetObjectResponseWithContextMutex.Unlock()
+	fake.WriteGetObjectResponseWithContextStub = stub
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseWithContextArgsForCall(i int) (aws.Context, *s3.WriteGetObjectResponseInput, []request.Option) {
+	fake.writeGetObjectResponseWithContextMutex.RLock()
+	defer fake.writeGetObjectResponseWithContextMutex.RUnlock()
+	argsForCall := fake.writeGetObjectResponseWithContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseWithContextReturns(result1 *s3.WriteGetObjectResponseOutput, result2 error) {
+	fake.writeGetObjectResponseWithContextMutex.Lock()
+	defer fake.writeGetObjectResponseWithContextMutex.Unlock()
+	fake.WriteGetObjectResponseWithContextStub = nil
+	fake.writeGetObjectResponseWithContextReturns = struct {
+		result1 *s3.WriteGetObjectResponseOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3API) WriteGetObjectResponseWithContextReturnsOnCall(i int, result1 *s3.WriteGetObjectResponseOutput, result2 error) {
+	fake.writeGetObjectResponseWithContextMutex.Lock()
+	defer fake.writeGetObjectResponseWithContextMutex.Unlock()
+	fake.WriteGetObjectResponseWithContextStub = nil
+	if fake.writeGetObjectResponseWithContextReturnsOnCall == nil {
+		fake.writeGetObjectResponseWithContextReturnsOnCall = make(map[int]struct {
+			result1 *s3.WriteGetObjectResponseOutput
+			result2 error
+		})
+	}
+	fake.writeGetObjectResponseWithContextReturnsOnCall[i] = struct {
+		result1 *s3.WriteGetObjectResponseOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeS3API) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -21798,6 +24817,12 @@ func (fake *FakeS3API) Invocations() map[string][][]interface{} {
 	defer fake.createMultipartUploadRequestMutex.RUnlock()
 	fake.createMultipartUploadWithContextMutex.RLock()
 	defer fake.createMultipartUploadWithContextMutex.RUnlock()
+	fake.createSessionMutex.RLock()
+	defer fake.createSessionMutex.RUnlock()
+	fake.createSessionRequestMutex.RLock()
+	defer fake.createSessionRequestMutex.RUnlock()
+	fake.createSessionWithContextMutex.RLock()
+	defer fake.createSessionWithContextMutex.RUnlock()
 	fake.deleteBucketMutex.RLock()
 	defer fake.deleteBucketMutex.RUnlock()
 	fake.deleteBucketAnalyticsConfigurationMutex.RLock()
@@ -21818,6 +24843,12 @@ func (fake *FakeS3API) Invocations() map[string][][]interface{} {
 	defer fake.deleteBucketEncryptionRequestMutex.RUnlock()
 	fake.deleteBucketEncryptionWithContextMutex.RLock()
 	defer fake.deleteBucketEncryptionWithContextMutex.RUnlock()
+	fake.deleteBucketIntelligentTieringConfigurationMutex.RLock()
+	defer fake.deleteBucketIntelligentTieringConfigurationMutex.RUnlock()
+	fake.deleteBucketIntelligentTieringConfigurationRequestMutex.RLock()
+	defer fake.deleteBucketIntelligentTieringConfigurationRequestMutex.RUnlock()
+	fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.RLock()
+	defer fake.deleteBucketIntelligentTieringConfigurationWithContextMutex.RUnlock()
 	fake.deleteBucketInventoryConfigurationMutex.RLock()
 	defer fake.deleteBucketInventoryConfigurationMutex.RUnlock()
 	fake.deleteBucketInventoryConfigurationRequestMutex.RLock()
@@ -21836,6 +24867,12 @@ func (fake *FakeS3API) Invocations() map[string][][]interface{} {
 	defer fake.deleteBucketMetricsConfigurationRequestMutex.RUnlock()
 	fake.deleteBucketMetricsConfigurationWithContextMutex.RLock()
 	defer fake.deleteBucketMetricsConfigurationWithContextMutex.RUnlock()
+	fake.deleteBucketOwnershipControlsMutex.RLock()
+	defer fake.deleteBucketOwnershipControlsMutex.RUnlock()
+	fake.deleteBucketOwnershipControlsRequestMutex.RLock()
+	defer fake.deleteBucketOwnershipControlsRequestMutex.RUnlock()
+	fake.deleteBucketOwnershipControlsWithContextMutex.RLock()
+	defer fake.deleteBucketOwnershipControlsWithContextMutex.RUnlock()
 	fake.deleteBucketPolicyMutex.RLock()
 	defer fake.deleteBucketPolicyMutex.RUnlock()
 	fake.deleteBucketPolicyRequestMutex.RLock()
@@ -21918,6 +24955,12 @@ func (fake *FakeS3API) Invocations() map[string][][]interface{} {
 	defer fake.getBucketEncryptionRequestMutex.RUnlock()
 	fake.getBucketEncryptionWithContextMutex.RLock()
 	defer fake.getBucketEncryptionWithContextMutex.RUnlock()
+	fake.getBucketIntelligentTieringConfigurationMutex.RLock()
+	defer fake.getBucketIntelligentTieringConfigurationMutex.RUnlock()
+	fake.getBucketIntelligentTieringConfigurationRequestMutex.RLock()
+	defer fake.getBucketIntelligentTieringConfigurationRequestMutex.RUnlock()
+	fake.getBucketIntelligentTieringConfigurationWithContextMutex.RLock()
+	defer fake.getBucketIntelligentTieringConfigurationWithContextMutex.RUnlock()
 	fake.getBucketInventoryConfigurationMutex.RLock()
 	defer fake.getBucketInventoryConfigurationMutex.RUnlock()
 	fake.getBucketInventoryConfigurationRequestMutex.RLock()
@@ -21966,6 +25009,12 @@ func (fake *FakeS3API) Invocations() map[string][][]interface{} {
 	defer fake.getBucketNotificationRequestMutex.RUnlock()
 	fake.getBucketNotificationWithContextMutex.RLock()
 	defer fake.getBucketNotificationWithContextMutex.RUnlock()
+	fake.getBucketOwnershipControlsMutex.RLock()
+	defer fake.getBucketOwnershipControlsMutex.RUnlock()
+	fake.getBucketOwnershipControlsRequestMutex.RLock()
+	defer fake.getBucketOwnershipControlsRequestMutex.RUnlock()
+	fake.getBucketOwnershipControlsWithContextMutex.RLock()
+	defer fake.getBucketOwnershipControlsWithContextMutex.RUnlock()
 	fake.getBucketPolicyMutex.RLock()
 	defer fake.getBucketPolicyMutex.RUnlock()
 	fake.getBucketPolicyRequestMutex.RLock()
@@ -22016,6 +25065,12 @@ func (fake *FakeS3API) Invocations() map[string][][]interface{} {
 	defer fake.getObjectAclRequestMutex.RUnlock()
 	fake.getObjectAclWithContextMutex.RLock()
 	defer fake.getObjectAclWithContextMutex.RUnlock()
+	fake.getObjectAttributesMutex.RLock()
+	defer fake.getObjectAttributesMutex.RUnlock()
+	fake.getObjectAttributesRequestMutex.RLock()
+	defer fake.getObjectAttributesRequestMutex.RUnlock()
+	fake.getObjectAttributesWithContextMutex.RLock()
+	defer fake.getObjectAttributesWithContextMutex.RUnlock()
 	fake.getObjectLegalHoldMutex.RLock()
 	defer fake.getObjectLegalHoldMutex.RUnlock()
 	fake.getObjectLegalHoldRequestMutex.RLock()
@@ -22074,6 +25129,12 @@ func (fake *FakeS3API) Invocations() map[string][][]interface{} {
 	defer fake.listBucketAnalyticsConfigurationsRequestMutex.RUnlock()
 	fake.listBucketAnalyticsConfigurationsWithContextMutex.RLock()
 	defer fake.listBucketAnalyticsConfigurationsWithContextMutex.RUnlock()
+	fake.listBucketIntelligentTieringConfigurationsMutex.RLock()
+	defer fake.listBucketIntelligentTieringConfigurationsMutex.RUnlock()
+	fake.listBucketIntelligentTieringConfigurationsRequestMutex.RLock()
+	defer fake.listBucketIntelligentTieringConfigurationsRequestMutex.RUnlock()
+	fake.listBucketIntelligentTieringConfigurationsWithContextMutex.RLock()
+	defer fake.listBucketIntelligentTieringConfigurationsWithContextMutex.RUnlock()
 	fake.listBucketInventoryConfigurationsMutex.RLock()
 	defer fake.listBucketInventoryConfigurationsMutex.RUnlock()
 	fake.listBucketInventoryConfigurationsRequestMutex.RLock()
@@ -22092,6 +25153,16 @@ func (fake *FakeS3API) Invocations() map[string][][]interface{} {
 	defer fake.listBucketsRequestMutex.RUnlock()
 	fake.listBucketsWithContextMutex.RLock()
 	defer fake.listBucketsWithContextMutex.RUnlock()
+	fake.listDirectoryBucketsMutex.RLock()
+	defer fake.listDirectoryBucketsMutex.RUnlock()
+	fake.listDirectoryBucketsPagesMutex.RLock()
+	defer fake.listDirectoryBucketsPagesMutex.RUnlock()
+	fake.listDirectoryBucketsPagesWithContextMutex.RLock()
+	defer fake.listDirectoryBucketsPagesWithContextMutex.RUnlock()
+	fake.listDirectoryBucketsRequestMutex.RLock()
+	defer fake.listDirectoryBucketsRequestMutex.RUnlock()
+	fake.listDirectoryBucketsWithContextMutex.RLock()
+	defer fake.listDirectoryBucketsWithContextMutex.RUnlock()
 	fake.listMultipartUploadsMutex.RLock()
 	defer fake.listMultipartUploadsMutex.RUnlock()
 	fake.listMultipartUploadsPagesMutex.RLock()
@@ -22172,6 +25243,12 @@ func (fake *FakeS3API) Invocations() map[string][][]interface{} {
 	defer fake.putBucketEncryptionRequestMutex.RUnlock()
 	fake.putBucketEncryptionWithContextMutex.RLock()
 	defer fake.putBucketEncryptionWithContextMutex.RUnlock()
+	fake.putBucketIntelligentTieringConfigurationMutex.RLock()
+	defer fake.putBucketIntelligentTieringConfigurationMutex.RUnlock()
+	fake.putBucketIntelligentTieringConfigurationRequestMutex.RLock()
+	defer fake.putBucketIntelligentTieringConfigurationRequestMutex.RUnlock()
+	fake.putBucketIntelligentTieringConfigurationWithContextMutex.RLock()
+	defer fake.putBucketIntelligentTieringConfigurationWithContextMutex.RUnlock()
 	fake.putBucketInventoryConfigurationMutex.RLock()
 	defer fake.putBucketInventoryConfigurationMutex.RUnlock()
 	fake.putBucketInventoryConfigurationRequestMutex.RLock()
@@ -22214,6 +25291,12 @@ func (fake *FakeS3API) Invocations() map[string][][]interface{} {
 	defer fake.putBucketNotificationRequestMutex.RUnlock()
 	fake.putBucketNotificationWithContextMutex.RLock()
 	defer fake.putBucketNotificationWithContextMutex.RUnlock()
+	fake.putBucketOwnershipControlsMutex.RLock()
+	defer fake.putBucketOwnershipControlsMutex.RUnlock()
+	fake.putBucketOwnershipControlsRequestMutex.RLock()
+	defer fake.putBucketOwnershipControlsRequestMutex.RUnlock()
+	fake.putBucketOwnershipControlsWithContextMutex.RLock()
+	defer fake.putBucketOwnershipControlsWithContextMutex.RUnlock()
 	fake.putBucketPolicyMutex.RLock()
 	defer fake.putBucketPolicyMutex.RUnlock()
 	fake.putBucketPolicyRequestMutex.RLock()
@@ -22332,6 +25415,12 @@ func (fake *FakeS3API) Invocations() map[string][][]interface{} {
 	defer fake.waitUntilObjectNotExistsMutex.RUnlock()
 	fake.waitUntilObjectNotExistsWithContextMutex.RLock()
 	defer fake.waitUntilObjectNotExistsWithContextMutex.RUnlock()
+	fake.writeGetObjectResponseMutex.RLock()
+	defer fake.writeGetObjectResponseMutex.RUnlock()
+	fake.writeGetObjectResponseRequestMutex.RLock()
+	defer fake.writeGetObjectResponseRequestMutex.RUnlock()
+	fake.writeGetObjectResponseWithContextMutex.RLock()
+	defer fake.writeGetObjectResponseWithContextMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
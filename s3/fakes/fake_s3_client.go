@@ -2,6 +2,7 @@
 package fakes
 
 import (
+	"context"
 	"sync"
 
 	"github.com/alphagov/paas-s3-broker/s3"
@@ -9,10 +10,11 @@ import (
 )
 
 type FakeClient struct {
-	AddUserToBucketStub        func(provider.BindData) (s3.BucketCredentials, error)
+	AddUserToBucketStub        func(context.Context, provider.BindData) (s3.BucketCredentials, error)
 	addUserToBucketMutex       sync.RWMutex
 	addUserToBucketArgsForCall []struct {
-		arg1 provider.BindData
+		arg1 context.Context
+		arg2 provider.BindData
 	}
 	addUserToBucketReturns struct {
 		result1 s3.BucketCredentials
@@ -22,10 +24,11 @@ type FakeClient struct {
 		result1 s3.BucketCredentials
 		result2 error
 	}
-	CreateBucketStub        func(provider.ProvisionData) error
+	CreateBucketStub        func(context.Context, provider.ProvisionData) error
 	createBucketMutex       sync.RWMutex
 	createBucketArgsForCall []struct {
-		arg1 provider.ProvisionData
+		arg1 context.Context
+		arg2 provider.ProvisionData
 	}
 	createBucketReturns struct {
 		result1 error
@@ -33,10 +36,11 @@ type FakeClient struct {
 	createBucketReturnsOnCall map[int]struct {
 		result1 error
 	}
-	DeleteBucketStub        func(string) error
+	DeleteBucketStub        func(context.Context, string) error
 	deleteBucketMutex       sync.RWMutex
 	deleteBucketArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	deleteBucketReturns struct {
 		result1 error
@@ -44,11 +48,82 @@ type FakeClient struct {
 	deleteBucketReturnsOnCall map[int]struct {
 		result1 error
 	}
-	RemoveUserFromBucketAndDeleteUserStub        func(string, string) error
+	EmptyBucketStub        func(context.Context, string, func(objectsDeleted int)) error
+	emptyBucketMutex       sync.RWMutex
+	emptyBucketArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 func(objectsDeleted int)
+	}
+	emptyBucketReturns struct {
+		result1 error
+	}
+	emptyBucketReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ForceDeleteEnabledStub        func(context.Context, string) (bool, error)
+	forceDeleteEnabledMutex       sync.RWMutex
+	forceDeleteEnabledArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	forceDeleteEnabledReturns struct {
+		result1 bool
+		result2 error
+	}
+	forceDeleteEnabledReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	GetBindingCredentialsStub        func(context.Context, string, string) (s3.BucketCredentials, error)
+	getBindingCredentialsMutex       sync.RWMutex
+	getBindingCredentialsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	getBindingCredentialsReturns struct {
+		result1 s3.BucketCredentials
+		result2 error
+	}
+	getBindingCredentialsReturnsOnCall map[int]struct {
+		result1 s3.BucketCredentials
+		result2 error
+	}
+	GetBucketDetailsStub        func(context.Context, string) (s3.BucketDetails, error)
+	getBucketDetailsMutex       sync.RWMutex
+	getBucketDetailsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getBucketDetailsReturns struct {
+		result1 s3.BucketDetails
+		result2 error
+	}
+	getBucketDetailsReturnsOnCall map[int]struct {
+		result1 s3.BucketDetails
+		result2 error
+	}
+	GetBucketStateStub        func(context.Context, string) (s3.BucketState, error)
+	getBucketStateMutex       sync.RWMutex
+	getBucketStateArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getBucketStateReturns struct {
+		result1 s3.BucketState
+		result2 error
+	}
+	getBucketStateReturnsOnCall map[int]struct {
+		result1 s3.BucketState
+		result2 error
+	}
+	RemoveUserFromBucketAndDeleteUserStub        func(context.Context, string, string) error
 	removeUserFromBucketAndDeleteUserMutex       sync.RWMutex
 	removeUserFromBucketAndDeleteUserArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
+		arg3 string
 	}
 	removeUserFromBucketAndDeleteUserReturns struct {
 		result1 error
@@ -56,25 +131,85 @@ type FakeClient struct {
 	removeUserFromBucketAndDeleteUserReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SoftDeleteBucketStub        func(context.Context, string) error
+	softDeleteBucketMutex       sync.RWMutex
+	softDeleteBucketArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	softDeleteBucketReturns struct {
+		result1 error
+	}
+	softDeleteBucketReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SoftDeleteEnabledStub        func() bool
+	softDeleteEnabledMutex       sync.RWMutex
+	softDeleteEnabledArgsForCall []struct {
+	}
+	softDeleteEnabledReturns struct {
+		result1 bool
+	}
+	softDeleteEnabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	UndoAccessKeyRotationStub        func(context.Context, string, string) error
+	undoAccessKeyRotationMutex       sync.RWMutex
+	undoAccessKeyRotationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	undoAccessKeyRotationReturns struct {
+		result1 error
+	}
+	undoAccessKeyRotationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateBucketStub        func(context.Context, provider.UpdateData) error
+	updateBucketMutex       sync.RWMutex
+	updateBucketArgsForCall []struct {
+		arg1 context.Context
+		arg2 provider.UpdateData
+	}
+	updateBucketReturns struct {
+		result1 error
+	}
+	updateBucketReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateProvisionParamsStub        func(provider.ProvisionData) error
+	validateProvisionParamsMutex       sync.RWMutex
+	validateProvisionParamsArgsForCall []struct {
+		arg1 provider.ProvisionData
+	}
+	validateProvisionParamsReturns struct {
+		result1 error
+	}
+	validateProvisionParamsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeClient) AddUserToBucket(arg1 provider.BindData) (s3.BucketCredentials, error) {
+func (fake *FakeClient) AddUserToBucket(arg1 context.Context, arg2 provider.BindData) (s3.BucketCredentials, error) {
 	fake.addUserToBucketMutex.Lock()
 	ret, specificReturn := fake.addUserToBucketReturnsOnCall[len(fake.addUserToBucketArgsForCall)]
 	fake.addUserToBucketArgsForCall = append(fake.addUserToBucketArgsForCall, struct {
-		arg1 provider.BindData
-	}{arg1})
-	fake.recordInvocation("AddUserToBucket", []interface{}{arg1})
+		arg1 context.Context
+		arg2 provider.BindData
+	}{arg1, arg2})
+	stub := fake.AddUserToBucketStub
+	fakeReturns := fake.addUserToBucketReturns
+	fake.recordInvocation("AddUserToBucket", []interface{}{arg1, arg2})
 	fake.addUserToBucketMutex.Unlock()
-	if fake.AddUserToBucketStub != nil {
-		return fake.AddUserToBucketStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.addUserToBucketReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -84,17 +219,17 @@ func (fake *FakeClient) AddUserToBucketCallCount() int {
 	return len(fake.addUserToBucketArgsForCall)
 }
 
-func (fake *FakeClient) AddUserToBucketCalls(stub func(provider.BindData) (s3.BucketCredentials, error)) {
+func (fake *FakeClient) AddUserToBucketCalls(stub func(context.Context, provider.BindData) (s3.BucketCredentials, error)) {
 	fake.addUserToBucketMutex.Lock()
 	defer fake.addUserToBucketMutex.Unlock()
 	fake.AddUserToBucketStub = stub
 }
 
-func (fake *FakeClient) AddUserToBucketArgsForCall(i int) provider.BindData {
+func (fake *FakeClient) AddUserToBucketArgsForCall(i int) (context.Context, provider.BindData) {
 	fake.addUserToBucketMutex.RLock()
 	defer fake.addUserToBucketMutex.RUnlock()
 	argsForCall := fake.addUserToBucketArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) AddUserToBucketReturns(result1 s3.BucketCredentials, result2 error) {
@@ -123,21 +258,23 @@ func (fake *FakeClient) AddUserToBucketReturnsOnCall(i int, result1 s3.BucketCre
 	}{result1, result2}
 }
 
-func (fake *FakeClient) CreateBucket(arg1 provider.ProvisionData) error {
+func (fake *FakeClient) CreateBucket(arg1 context.Context, arg2 provider.ProvisionData) error {
 	fake.createBucketMutex.Lock()
 	ret, specificReturn := fake.createBucketReturnsOnCall[len(fake.createBucketArgsForCall)]
 	fake.createBucketArgsForCall = append(fake.createBucketArgsForCall, struct {
-		arg1 provider.ProvisionData
-	}{arg1})
-	fake.recordInvocation("CreateBucket", []interface{}{arg1})
+		arg1 context.Context
+		arg2 provider.ProvisionData
+	}{arg1, arg2})
+	stub := fake.CreateBucketStub
+	fakeReturns := fake.createBucketReturns
+	fake.recordInvocation("CreateBucket", []interface{}{arg1, arg2})
 	fake.createBucketMutex.Unlock()
-	if fake.CreateBucketStub != nil {
-		return fake.CreateBucketStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.createBucketReturns
 	return fakeReturns.result1
 }
 
@@ -147,17 +284,17 @@ func (fake *FakeClient) CreateBucketCallCount() int {
 	return len(fake.createBucketArgsForCall)
 }
 
-func (fake *FakeClient) CreateBucketCalls(stub func(provider.ProvisionData) error) {
+func (fake *FakeClient) CreateBucketCalls(stub func(context.Context, provider.ProvisionData) error) {
 	fake.createBucketMutex.Lock()
 	defer fake.createBucketMutex.Unlock()
 	fake.CreateBucketStub = stub
 }
 
-func (fake *FakeClient) CreateBucketArgsForCall(i int) provider.ProvisionData {
+func (fake *FakeClient) CreateBucketArgsForCall(i int) (context.Context, provider.ProvisionData) {
 	fake.createBucketMutex.RLock()
 	defer fake.createBucketMutex.RUnlock()
 	argsForCall := fake.createBucketArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) CreateBucketReturns(result1 error) {
@@ -183,21 +320,23 @@ func (fake *FakeClient) CreateBucketReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) DeleteBucket(arg1 string) error {
+func (fake *FakeClient) DeleteBucket(arg1 context.Context, arg2 string) error {
 	fake.deleteBucketMutex.Lock()
 	ret, specificReturn := fake.deleteBucketReturnsOnCall[len(fake.deleteBucketArgsForCall)]
 	fake.deleteBucketArgsForCall = append(fake.deleteBucketArgsForCall, struct {
-		arg1 string
-	}{arg1})
-	fake.recordInvocation("DeleteBucket", []interface{}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteBucketStub
+	fakeReturns := fake.deleteBucketReturns
+	fake.recordInvocation("DeleteBucket", []interface{}{arg1, arg2})
 	fake.deleteBucketMutex.Unlock()
-	if fake.DeleteBucketStub != nil {
-		return fake.DeleteBucketStub(arg1)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.deleteBucketReturns
 	return fakeReturns.result1
 }
 
@@ -207,17 +346,17 @@ func (fake *FakeClient) DeleteBucketCallCount() int {
 	return len(fake.deleteBucketArgsForCall)
 }
 
-func (fake *FakeClient) DeleteBucketCalls(stub func(string) error) {
+func (fake *FakeClient) DeleteBucketCalls(stub func(context.Context, string) error) {
 	fake.deleteBucketMutex.Lock()
 	defer fake.deleteBucketMutex.Unlock()
 	fake.DeleteBucketStub = stub
 }
 
-func (fake *FakeClient) DeleteBucketArgsForCall(i int) string {
+func (fake *FakeClient) DeleteBucketArgsForCall(i int) (context.Context, string) {
 	fake.deleteBucketMutex.RLock()
 	defer fake.deleteBucketMutex.RUnlock()
 	argsForCall := fake.deleteBucketArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeClient) DeleteBucketReturns(result1 error) {
@@ -243,22 +382,348 @@ func (fake *FakeClient) DeleteBucketReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) RemoveUserFromBucketAndDeleteUser(arg1 string, arg2 string) error {
+func (fake *FakeClient) EmptyBucket(arg1 context.Context, arg2 string, arg3 func(objectsDeleted int)) error {
+	fake.emptyBucketMutex.Lock()
+	ret, specificReturn := fake.emptyBucketReturnsOnCall[len(fake.emptyBucketArgsForCall)]
+	fake.emptyBucketArgsForCall = append(fake.emptyBucketArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 func(objectsDeleted int)
+	}{arg1, arg2, arg3})
+	stub := fake.EmptyBucketStub
+	fakeReturns := fake.emptyBucketReturns
+	fake.recordInvocation("EmptyBucket", []interface{}{arg1, arg2, arg3})
+	fake.emptyBucketMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) EmptyBucketCallCount() int {
+	fake.emptyBucketMutex.RLock()
+	defer fake.emptyBucketMutex.RUnlock()
+	return len(fake.emptyBucketArgsForCall)
+}
+
+func (fake *FakeClient) EmptyBucketCalls(stub func(context.Context, string, func(objectsDeleted int)) error) {
+	fake.emptyBucketMutex.Lock()
+	defer fake.emptyBucketMutex.Unlock()
+	fake.EmptyBucketStub = stub
+}
+
+func (fake *FakeClient) EmptyBucketArgsForCall(i int) (context.Context, string, func(objectsDeleted int)) {
+	fake.emptyBucketMutex.RLock()
+	defer fake.emptyBucketMutex.RUnlock()
+	argsForCall := fake.emptyBucketArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) EmptyBucketReturns(result1 error) {
+	fake.emptyBucketMutex.Lock()
+	defer fake.emptyBucketMutex.Unlock()
+	fake.EmptyBucketStub = nil
+	fake.emptyBucketReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) EmptyBucketReturnsOnCall(i int, result1 error) {
+	fake.emptyBucketMutex.Lock()
+	defer fake.emptyBucketMutex.Unlock()
+	fake.EmptyBucketStub = nil
+	if fake.emptyBucketReturnsOnCall == nil {
+		fake.emptyBucketReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.emptyBucketReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ForceDeleteEnabled(arg1 context.Context, arg2 string) (bool, error) {
+	fake.forceDeleteEnabledMutex.Lock()
+	ret, specificReturn := fake.forceDeleteEnabledReturnsOnCall[len(fake.forceDeleteEnabledArgsForCall)]
+	fake.forceDeleteEnabledArgsForCall = append(fake.forceDeleteEnabledArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ForceDeleteEnabledStub
+	fakeReturns := fake.forceDeleteEnabledReturns
+	fake.recordInvocation("ForceDeleteEnabled", []interface{}{arg1, arg2})
+	fake.forceDeleteEnabledMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ForceDeleteEnabledCallCount() int {
+	fake.forceDeleteEnabledMutex.RLock()
+	defer fake.forceDeleteEnabledMutex.RUnlock()
+	return len(fake.forceDeleteEnabledArgsForCall)
+}
+
+func (fake *FakeClient) ForceDeleteEnabledCalls(stub func(context.Context, string) (bool, error)) {
+	fake.forceDeleteEnabledMutex.Lock()
+	defer fake.forceDeleteEnabledMutex.Unlock()
+	fake.ForceDeleteEnabledStub = stub
+}
+
+func (fake *FakeClient) ForceDeleteEnabledArgsForCall(i int) (context.Context, string) {
+	fake.forceDeleteEnabledMutex.RLock()
+	defer fake.forceDeleteEnabledMutex.RUnlock()
+	argsForCall := fake.forceDeleteEnabledArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ForceDeleteEnabledReturns(result1 bool, result2 error) {
+	fake.forceDeleteEnabledMutex.Lock()
+	defer fake.forceDeleteEnabledMutex.Unlock()
+	fake.ForceDeleteEnabledStub = nil
+	fake.forceDeleteEnabledReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ForceDeleteEnabledReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.forceDeleteEnabledMutex.Lock()
+	defer fake.forceDeleteEnabledMutex.Unlock()
+	fake.ForceDeleteEnabledStub = nil
+	if fake.forceDeleteEnabledReturnsOnCall == nil {
+		fake.forceDeleteEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.forceDeleteEnabledReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetBindingCredentials(arg1 context.Context, arg2 string, arg3 string) (s3.BucketCredentials, error) {
+	fake.getBindingCredentialsMutex.Lock()
+	ret, specificReturn := fake.getBindingCredentialsReturnsOnCall[len(fake.getBindingCredentialsArgsForCall)]
+	fake.getBindingCredentialsArgsForCall = append(fake.getBindingCredentialsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetBindingCredentialsStub
+	fakeReturns := fake.getBindingCredentialsReturns
+	fake.recordInvocation("GetBindingCredentials", []interface{}{arg1, arg2, arg3})
+	fake.getBindingCredentialsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetBindingCredentialsCallCount() int {
+	fake.getBindingCredentialsMutex.RLock()
+	defer fake.getBindingCredentialsMutex.RUnlock()
+	return len(fake.getBindingCredentialsArgsForCall)
+}
+
+func (fake *FakeClient) GetBindingCredentialsCalls(stub func(context.Context, string, string) (s3.BucketCredentials, error)) {
+	fake.getBindingCredentialsMutex.Lock()
+	defer fake.getBindingCredentialsMutex.Unlock()
+	fake.GetBindingCredentialsStub = stub
+}
+
+func (fake *FakeClient) GetBindingCredentialsArgsForCall(i int) (context.Context, string, string) {
+	fake.getBindingCredentialsMutex.RLock()
+	defer fake.getBindingCredentialsMutex.RUnlock()
+	argsForCall := fake.getBindingCredentialsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) GetBindingCredentialsReturns(result1 s3.BucketCredentials, result2 error) {
+	fake.getBindingCredentialsMutex.Lock()
+	defer fake.getBindingCredentialsMutex.Unlock()
+	fake.GetBindingCredentialsStub = nil
+	fake.getBindingCredentialsReturns = struct {
+		result1 s3.BucketCredentials
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetBindingCredentialsReturnsOnCall(i int, result1 s3.BucketCredentials, result2 error) {
+	fake.getBindingCredentialsMutex.Lock()
+	defer fake.getBindingCredentialsMutex.Unlock()
+	fake.GetBindingCredentialsStub = nil
+	if fake.getBindingCredentialsReturnsOnCall == nil {
+		fake.getBindingCredentialsReturnsOnCall = make(map[int]struct {
+			result1 s3.BucketCredentials
+			result2 error
+		})
+	}
+	fake.getBindingCredentialsReturnsOnCall[i] = struct {
+		result1 s3.BucketCredentials
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetBucketDetails(arg1 context.Context, arg2 string) (s3.BucketDetails, error) {
+	fake.getBucketDetailsMutex.Lock()
+	ret, specificReturn := fake.getBucketDetailsReturnsOnCall[len(fake.getBucketDetailsArgsForCall)]
+	fake.getBucketDetailsArgsForCall = append(fake.getBucketDetailsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetBucketDetailsStub
+	fakeReturns := fake.getBucketDetailsReturns
+	fake.recordInvocation("GetBucketDetails", []interface{}{arg1, arg2})
+	fake.getBucketDetailsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetBucketDetailsCallCount() int {
+	fake.getBucketDetailsMutex.RLock()
+	defer fake.getBucketDetailsMutex.RUnlock()
+	return len(fake.getBucketDetailsArgsForCall)
+}
+
+func (fake *FakeClient) GetBucketDetailsCalls(stub func(context.Context, string) (s3.BucketDetails, error)) {
+	fake.getBucketDetailsMutex.Lock()
+	defer fake.getBucketDetailsMutex.Unlock()
+	fake.GetBucketDetailsStub = stub
+}
+
+func (fake *FakeClient) GetBucketDetailsArgsForCall(i int) (context.Context, string) {
+	fake.getBucketDetailsMutex.RLock()
+	defer fake.getBucketDetailsMutex.RUnlock()
+	argsForCall := fake.getBucketDetailsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) GetBucketDetailsReturns(result1 s3.BucketDetails, result2 error) {
+	fake.getBucketDetailsMutex.Lock()
+	defer fake.getBucketDetailsMutex.Unlock()
+	fake.GetBucketDetailsStub = nil
+	fake.getBucketDetailsReturns = struct {
+		result1 s3.BucketDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetBucketDetailsReturnsOnCall(i int, result1 s3.BucketDetails, result2 error) {
+	fake.getBucketDetailsMutex.Lock()
+	defer fake.getBucketDetailsMutex.Unlock()
+	fake.GetBucketDetailsStub = nil
+	if fake.getBucketDetailsReturnsOnCall == nil {
+		fake.getBucketDetailsReturnsOnCall = make(map[int]struct {
+			result1 s3.BucketDetails
+			result2 error
+		})
+	}
+	fake.getBucketDetailsReturnsOnCall[i] = struct {
+		result1 s3.BucketDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetBucketState(arg1 context.Context, arg2 string) (s3.BucketState, error) {
+	fake.getBucketStateMutex.Lock()
+	ret, specificReturn := fake.getBucketStateReturnsOnCall[len(fake.getBucketStateArgsForCall)]
+	fake.getBucketStateArgsForCall = append(fake.getBucketStateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetBucketStateStub
+	fakeReturns := fake.getBucketStateReturns
+	fake.recordInvocation("GetBucketState", []interface{}{arg1, arg2})
+	fake.getBucketStateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetBucketStateCallCount() int {
+	fake.getBucketStateMutex.RLock()
+	defer fake.getBucketStateMutex.RUnlock()
+	return len(fake.getBucketStateArgsForCall)
+}
+
+func (fake *FakeClient) GetBucketStateCalls(stub func(context.Context, string) (s3.BucketState, error)) {
+	fake.getBucketStateMutex.Lock()
+	defer fake.getBucketStateMutex.Unlock()
+	fake.GetBucketStateStub = stub
+}
+
+func (fake *FakeClient) GetBucketStateArgsForCall(i int) (context.Context, string) {
+	fake.getBucketStateMutex.RLock()
+	defer fake.getBucketStateMutex.RUnlock()
+	argsForCall := fake.getBucketStateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) GetBucketStateReturns(result1 s3.BucketState, result2 error) {
+	fake.getBucketStateMutex.Lock()
+	defer fake.getBucketStateMutex.Unlock()
+	fake.GetBucketStateStub = nil
+	fake.getBucketStateReturns = struct {
+		result1 s3.BucketState
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetBucketStateReturnsOnCall(i int, result1 s3.BucketState, result2 error) {
+	fake.getBucketStateMutex.Lock()
+	defer fake.getBucketStateMutex.Unlock()
+	fake.GetBucketStateStub = nil
+	if fake.getBucketStateReturnsOnCall == nil {
+		fake.getBucketStateReturnsOnCall = make(map[int]struct {
+			result1 s3.BucketState
+			result2 error
+		})
+	}
+	fake.getBucketStateReturnsOnCall[i] = struct {
+		result1 s3.BucketState
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RemoveUserFromBucketAndDeleteUser(arg1 context.Context, arg2 string, arg3 string) error {
 	fake.removeUserFromBucketAndDeleteUserMutex.Lock()
 	ret, specificReturn := fake.removeUserFromBucketAndDeleteUserReturnsOnCall[len(fake.removeUserFromBucketAndDeleteUserArgsForCall)]
 	fake.removeUserFromBucketAndDeleteUserArgsForCall = append(fake.removeUserFromBucketAndDeleteUserArgsForCall, struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("RemoveUserFromBucketAndDeleteUser", []interface{}{arg1, arg2})
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveUserFromBucketAndDeleteUserStub
+	fakeReturns := fake.removeUserFromBucketAndDeleteUserReturns
+	fake.recordInvocation("RemoveUserFromBucketAndDeleteUser", []interface{}{arg1, arg2, arg3})
 	fake.removeUserFromBucketAndDeleteUserMutex.Unlock()
-	if fake.RemoveUserFromBucketAndDeleteUserStub != nil {
-		return fake.RemoveUserFromBucketAndDeleteUserStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.removeUserFromBucketAndDeleteUserReturns
 	return fakeReturns.result1
 }
 
@@ -268,17 +733,17 @@ func (fake *FakeClient) RemoveUserFromBucketAndDeleteUserCallCount() int {
 	return len(fake.removeUserFromBucketAndDeleteUserArgsForCall)
 }
 
-func (fake *FakeClient) RemoveUserFromBucketAndDeleteUserCalls(stub func(string, string) error) {
+func (fake *FakeClient) RemoveUserFromBucketAndDeleteUserCalls(stub func(context.Context, string, string) error) {
 	fake.removeUserFromBucketAndDeleteUserMutex.Lock()
 	defer fake.removeUserFromBucketAndDeleteUserMutex.Unlock()
 	fake.RemoveUserFromBucketAndDeleteUserStub = stub
 }
 
-func (fake *FakeClient) RemoveUserFromBucketAndDeleteUserArgsForCall(i int) (string, string) {
+func (fake *FakeClient) RemoveUserFromBucketAndDeleteUserArgsForCall(i int) (context.Context, string, string) {
 	fake.removeUserFromBucketAndDeleteUserMutex.RLock()
 	defer fake.removeUserFromBucketAndDeleteUserMutex.RUnlock()
 	argsForCall := fake.removeUserFromBucketAndDeleteUserArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) RemoveUserFromBucketAndDeleteUserReturns(result1 error) {
@@ -304,6 +769,307 @@ func (fake *FakeClient) RemoveUserFromBucketAndDeleteUserReturnsOnCall(i int, re
 	}{result1}
 }
 
+func (fake *FakeClient) SoftDeleteBucket(arg1 context.Context, arg2 string) error {
+	fake.softDeleteBucketMutex.Lock()
+	ret, specificReturn := fake.softDeleteBucketReturnsOnCall[len(fake.softDeleteBucketArgsForCall)]
+	fake.softDeleteBucketArgsForCall = append(fake.softDeleteBucketArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SoftDeleteBucketStub
+	fakeReturns := fake.softDeleteBucketReturns
+	fake.recordInvocation("SoftDeleteBucket", []interface{}{arg1, arg2})
+	fake.softDeleteBucketMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) SoftDeleteBucketCallCount() int {
+	fake.softDeleteBucketMutex.RLock()
+	defer fake.softDeleteBucketMutex.RUnlock()
+	return len(fake.softDeleteBucketArgsForCall)
+}
+
+func (fake *FakeClient) SoftDeleteBucketCalls(stub func(context.Context, string) error) {
+	fake.softDeleteBucketMutex.Lock()
+	defer fake.softDeleteBucketMutex.Unlock()
+	fake.SoftDeleteBucketStub = stub
+}
+
+func (fake *FakeClient) SoftDeleteBucketArgsForCall(i int) (context.Context, string) {
+	fake.softDeleteBucketMutex.RLock()
+	defer fake.softDeleteBucketMutex.RUnlock()
+	argsForCall := fake.softDeleteBucketArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) SoftDeleteBucketReturns(result1 error) {
+	fake.softDeleteBucketMutex.Lock()
+	defer fake.softDeleteBucketMutex.Unlock()
+	fake.SoftDeleteBucketStub = nil
+	fake.softDeleteBucketReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) SoftDeleteBucketReturnsOnCall(i int, result1 error) {
+	fake.softDeleteBucketMutex.Lock()
+	defer fake.softDeleteBucketMutex.Unlock()
+	fake.SoftDeleteBucketStub = nil
+	if fake.softDeleteBucketReturnsOnCall == nil {
+		fake.softDeleteBucketReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.softDeleteBucketReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) SoftDeleteEnabled() bool {
+	fake.softDeleteEnabledMutex.Lock()
+	ret, specificReturn := fake.softDeleteEnabledReturnsOnCall[len(fake.softDeleteEnabledArgsForCall)]
+	fake.softDeleteEnabledArgsForCall = append(fake.softDeleteEnabledArgsForCall, struct {
+	}{})
+	stub := fake.SoftDeleteEnabledStub
+	fakeReturns := fake.softDeleteEnabledReturns
+	fake.recordInvocation("SoftDeleteEnabled", []interface{}{})
+	fake.softDeleteEnabledMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) SoftDeleteEnabledCallCount() int {
+	fake.softDeleteEnabledMutex.RLock()
+	defer fake.softDeleteEnabledMutex.RUnlock()
+	return len(fake.softDeleteEnabledArgsForCall)
+}
+
+func (fake *FakeClient) SoftDeleteEnabledCalls(stub func() bool) {
+	fake.softDeleteEnabledMutex.Lock()
+	defer fake.softDeleteEnabledMutex.Unlock()
+	fake.SoftDeleteEnabledStub = stub
+}
+
+func (fake *FakeClient) SoftDeleteEnabledReturns(result1 bool) {
+	fake.softDeleteEnabledMutex.Lock()
+	defer fake.softDeleteEnabledMutex.Unlock()
+	fake.SoftDeleteEnabledStub = nil
+	fake.softDeleteEnabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeClient) SoftDeleteEnabledReturnsOnCall(i int, result1 bool) {
+	fake.softDeleteEnabledMutex.Lock()
+	defer fake.softDeleteEnabledMutex.Unlock()
+	fake.SoftDeleteEnabledStub = nil
+	if fake.softDeleteEnabledReturnsOnCall == nil {
+		fake.softDeleteEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.softDeleteEnabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeClient) UndoAccessKeyRotation(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.undoAccessKeyRotationMutex.Lock()
+	ret, specificReturn := fake.undoAccessKeyRotationReturnsOnCall[len(fake.undoAccessKeyRotationArgsForCall)]
+	fake.undoAccessKeyRotationArgsForCall = append(fake.undoAccessKeyRotationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UndoAccessKeyRotationStub
+	fakeReturns := fake.undoAccessKeyRotationReturns
+	fake.recordInvocation("UndoAccessKeyRotation", []interface{}{arg1, arg2, arg3})
+	fake.undoAccessKeyRotationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UndoAccessKeyRotationCallCount() int {
+	fake.undoAccessKeyRotationMutex.RLock()
+	defer fake.undoAccessKeyRotationMutex.RUnlock()
+	return len(fake.undoAccessKeyRotationArgsForCall)
+}
+
+func (fake *FakeClient) UndoAccessKeyRotationCalls(stub func(context.Context, string, string) error) {
+	fake.undoAccessKeyRotationMutex.Lock()
+	defer fake.undoAccessKeyRotationMutex.Unlock()
+	fake.UndoAccessKeyRotationStub = stub
+}
+
+func (fake *FakeClient) UndoAccessKeyRotationArgsForCall(i int) (context.Context, string, string) {
+	fake.undoAccessKeyRotationMutex.RLock()
+	defer fake.undoAccessKeyRotationMutex.RUnlock()
+	argsForCall := fake.undoAccessKeyRotationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) UndoAccessKeyRotationReturns(result1 error) {
+	fake.undoAccessKeyRotationMutex.Lock()
+	defer fake.undoAccessKeyRotationMutex.Unlock()
+	fake.UndoAccessKeyRotationStub = nil
+	fake.undoAccessKeyRotationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UndoAccessKeyRotationReturnsOnCall(i int, result1 error) {
+	fake.undoAccessKeyRotationMutex.Lock()
+	defer fake.undoAccessKeyRotationMutex.Unlock()
+	fake.UndoAccessKeyRotationStub = nil
+	if fake.undoAccessKeyRotationReturnsOnCall == nil {
+		fake.undoAccessKeyRotationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.undoAccessKeyRotationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpdateBucket(arg1 context.Context, arg2 provider.UpdateData) error {
+	fake.updateBucketMutex.Lock()
+	ret, specificReturn := fake.updateBucketReturnsOnCall[len(fake.updateBucketArgsForCall)]
+	fake.updateBucketArgsForCall = append(fake.updateBucketArgsForCall, struct {
+		arg1 context.Context
+		arg2 provider.UpdateData
+	}{arg1, arg2})
+	stub := fake.UpdateBucketStub
+	fakeReturns := fake.updateBucketReturns
+	fake.recordInvocation("UpdateBucket", []interface{}{arg1, arg2})
+	fake.updateBucketMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UpdateBucketCallCount() int {
+	fake.updateBucketMutex.RLock()
+	defer fake.updateBucketMutex.RUnlock()
+	return len(fake.updateBucketArgsForCall)
+}
+
+func (fake *FakeClient) UpdateBucketCalls(stub func(context.Context, provider.UpdateData) error) {
+	fake.updateBucketMutex.Lock()
+	defer fake.updateBucketMutex.Unlock()
+	fake.UpdateBucketStub = stub
+}
+
+func (fake *FakeClient) UpdateBucketArgsForCall(i int) (context.Context, provider.UpdateData) {
+	fake.updateBucketMutex.RLock()
+	defer fake.updateBucketMutex.RUnlock()
+	argsForCall := fake.updateBucketArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) UpdateBucketReturns(result1 error) {
+	fake.updateBucketMutex.Lock()
+	defer fake.updateBucketMutex.Unlock()
+	fake.UpdateBucketStub = nil
+	fake.updateBucketReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpdateBucketReturnsOnCall(i int, result1 error) {
+	fake.updateBucketMutex.Lock()
+	defer fake.updateBucketMutex.Unlock()
+	fake.UpdateBucketStub = nil
+	if fake.updateBucketReturnsOnCall == nil {
+		fake.updateBucketReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateBucketReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ValidateProvisionParams(arg1 provider.ProvisionData) error {
+	fake.validateProvisionParamsMutex.Lock()
+	ret, specificReturn := fake.validateProvisionParamsReturnsOnCall[len(fake.validateProvisionParamsArgsForCall)]
+	fake.validateProvisionParamsArgsForCall = append(fake.validateProvisionParamsArgsForCall, struct {
+		arg1 provider.ProvisionData
+	}{arg1})
+	stub := fake.ValidateProvisionParamsStub
+	fakeReturns := fake.validateProvisionParamsReturns
+	fake.recordInvocation("ValidateProvisionParams", []interface{}{arg1})
+	fake.validateProvisionParamsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ValidateProvisionParamsCallCount() int {
+	fake.validateProvisionParamsMutex.RLock()
+	defer fake.validateProvisionParamsMutex.RUnlock()
+	return len(fake.validateProvisionParamsArgsForCall)
+}
+
+func (fake *FakeClient) ValidateProvisionParamsCalls(stub func(provider.ProvisionData) error) {
+	fake.validateProvisionParamsMutex.Lock()
+	defer fake.validateProvisionParamsMutex.Unlock()
+	fake.ValidateProvisionParamsStub = stub
+}
+
+func (fake *FakeClient) ValidateProvisionParamsArgsForCall(i int) provider.ProvisionData {
+	fake.validateProvisionParamsMutex.RLock()
+	defer fake.validateProvisionParamsMutex.RUnlock()
+	argsForCall := fake.validateProvisionParamsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ValidateProvisionParamsReturns(result1 error) {
+	fake.validateProvisionParamsMutex.Lock()
+	defer fake.validateProvisionParamsMutex.Unlock()
+	fake.ValidateProvisionParamsStub = nil
+	fake.validateProvisionParamsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ValidateProvisionParamsReturnsOnCall(i int, result1 error) {
+	fake.validateProvisionParamsMutex.Lock()
+	defer fake.validateProvisionParamsMutex.Unlock()
+	fake.ValidateProvisionParamsStub = nil
+	if fake.validateProvisionParamsReturnsOnCall == nil {
+		fake.validateProvisionParamsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateProvisionParamsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -313,8 +1079,28 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.createBucketMutex.RUnlock()
 	fake.deleteBucketMutex.RLock()
 	defer fake.deleteBucketMutex.RUnlock()
+	fake.emptyBucketMutex.RLock()
+	defer fake.emptyBucketMutex.RUnlock()
+	fake.forceDeleteEnabledMutex.RLock()
+	defer fake.forceDeleteEnabledMutex.RUnlock()
+	fake.getBindingCredentialsMutex.RLock()
+	defer fake.getBindingCredentialsMutex.RUnlock()
+	fake.getBucketDetailsMutex.RLock()
+	defer fake.getBucketDetailsMutex.RUnlock()
+	fake.getBucketStateMutex.RLock()
+	defer fake.getBucketStateMutex.RUnlock()
 	fake.removeUserFromBucketAndDeleteUserMutex.RLock()
 	defer fake.removeUserFromBucketAndDeleteUserMutex.RUnlock()
+	fake.softDeleteBucketMutex.RLock()
+	defer fake.softDeleteBucketMutex.RUnlock()
+	fake.softDeleteEnabledMutex.RLock()
+	defer fake.softDeleteEnabledMutex.RUnlock()
+	fake.undoAccessKeyRotationMutex.RLock()
+	defer fake.undoAccessKeyRotationMutex.RUnlock()
+	fake.updateBucketMutex.RLock()
+	defer fake.updateBucketMutex.RUnlock()
+	fake.validateProvisionParamsMutex.RLock()
+	defer fake.validateProvisionParamsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,56 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// CheckReadiness makes cheap, read-only AWS calls to check that the broker
+// can reach S3 and IAM with its credentials, and that the IAM policies (and
+// KMS key, if any) named in its configuration exist. Calls are not retried:
+// the caller is expected to check again later. Every problem found is
+// returned, not just the first.
+func (s *S3Client) CheckReadiness(ctx context.Context) error {
+	var errs []error
+	if s.bucketPrefix == "" {
+		errs = append(errs, errors.New("resource_prefix is not configured"))
+	}
+	if s.awsRegion == "" {
+		errs = append(errs, errors.New("aws_region is not configured"))
+	}
+
+	_, err := s.s3Client.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("listing S3 buckets: %w", err))
+	}
+
+	policies := []struct{ field, arn string }{
+		{"iam_ip_restriction_policy_arn", s.ipRestrictionPolicyArn},
+		{"iam_common_user_policy_arn", s.commonUserPolicyArn},
+		{"iam_user_permissions_boundary_arn", s.permissionsBoundaryArn},
+	}
+	for _, p := range policies {
+		if p.arn == "" {
+			continue
+		}
+		_, err := s.iamClient.GetPolicyWithContext(ctx, &iam.GetPolicyInput{PolicyArn: aws.String(p.arn)})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("getting IAM policy %s from %s: %w", p.arn, p.field, err))
+		}
+	}
+
+	if s.kmsKeyARN != "" {
+		_, err := s.kmsClient.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{KeyId: aws.String(s.kmsKeyARN)})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("describing KMS key %s from kms_key_arn: %w", s.kmsKeyARN, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,90 @@
+package s3_test
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckReadiness", func() {
+	var (
+		s3API          *fakeClient.FakeS3API
+		iamAPI         *fakeClient.FakeIAMAPI
+		kmsAPI         *fakeClient.FakeKMSAPI
+		s3Client       *s3.S3Client
+		s3ClientConfig *s3.Config
+	)
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
+		s3ClientConfig = &s3.Config{
+			AWSRegion:              "eu-west-2",
+			ResourcePrefix:         "test-bucket-prefix-",
+			IpRestrictionPolicyARN: "arn:aws:iam::123456789012:policy/ip-restriction",
+			CommonUserPolicyARN:    "arn:aws:iam::123456789012:policy/common-user",
+		}
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			s3ClientConfig,
+			s3API,
+			iamAPI,
+			kmsAPI,
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	It("is ready when S3 can be reached and the configured policies exist", func() {
+		Expect(s3Client.CheckReadiness(context.Background())).To(Succeed())
+
+		Expect(s3API.ListBucketsWithContextCallCount()).To(Equal(1))
+		Expect(iamAPI.GetPolicyWithContextCallCount()).To(Equal(2))
+		Expect(aws.StringValue(inputOf(iamAPI.GetPolicyWithContextArgsForCall(0)).PolicyArn)).To(Equal("arn:aws:iam::123456789012:policy/ip-restriction"))
+		Expect(aws.StringValue(inputOf(iamAPI.GetPolicyWithContextArgsForCall(1)).PolicyArn)).To(Equal("arn:aws:iam::123456789012:policy/common-user"))
+		Expect(kmsAPI.DescribeKeyWithContextCallCount()).To(Equal(0))
+	})
+
+	It("reports every problem it finds", func() {
+		s3API.ListBucketsWithContextReturns(nil, awserr.New("AccessDenied", "Access Denied", nil))
+		iamAPI.GetPolicyWithContextReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "Policy not found", nil))
+
+		err := s3Client.CheckReadiness(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("listing S3 buckets: AccessDenied")))
+		Expect(err).To(MatchError(ContainSubstring("iam_ip_restriction_policy_arn")))
+		Expect(err).To(MatchError(ContainSubstring("iam_common_user_policy_arn")))
+	})
+
+	Context("when the resource prefix is not configured", func() {
+		BeforeEach(func() {
+			s3ClientConfig.ResourcePrefix = ""
+		})
+
+		It("is not ready", func() {
+			Expect(s3Client.CheckReadiness(context.Background())).To(MatchError(ContainSubstring("resource_prefix is not configured")))
+		})
+	})
+
+	Context("when a KMS key is configured", func() {
+		BeforeEach(func() {
+			s3ClientConfig.KMSKeyARN = "arn:aws:kms:eu-west-2:123456789012:key/operator-key-id"
+		})
+
+		It("checks the key exists", func() {
+			kmsAPI.DescribeKeyWithContextReturns(nil, awserr.New(kms.ErrCodeNotFoundException, "Key not found", nil))
+
+			Expect(s3Client.CheckReadiness(context.Background())).To(MatchError(ContainSubstring("kms_key_arn")))
+			Expect(aws.StringValue(inputOf(kmsAPI.DescribeKeyWithContextArgsForCall(0)).KeyId)).To(Equal("arn:aws:kms:eu-west-2:123456789012:key/operator-key-id"))
+		})
+	})
+})
@@ -0,0 +1,157 @@
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// BucketDetails is a bucket's configuration as it is in AWS, which may have
+// drifted from the parameters it was provisioned with.
+type BucketDetails struct {
+	BucketName   string            `json:"bucket_name"`
+	AWSRegion    string            `json:"aws_region"`
+	PublicBucket bool              `json:"public_bucket"`
+	Encryption   string            `json:"encryption"`
+	KMSKeyARN    string            `json:"kms_key_arn,omitempty"`
+	Versioning   string            `json:"versioning"`
+	Tags         map[string]string `json:"tags"`
+	Bindings     int               `json:"bindings"`
+}
+
+// GetBucketDetails reads the configuration of an instance's bucket. It
+// returns ErrNoSuchResources if the bucket does not exist.
+func (s *S3Client) GetBucketDetails(ctx context.Context, instanceID string) (BucketDetails, error) {
+	logger := s.logger.Session("get-bucket-details")
+	bucketName := s.buildBucketName(instanceID)
+	details := BucketDetails{BucketName: bucketName, Tags: map[string]string{}}
+
+	logger.Info("get-bucket-location", lager.Data{"bucket": bucketName})
+	locationOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.GetBucketLocationOutput, error) {
+		return s.s3Client.GetBucketLocationWithContext(ctx, &s3.GetBucketLocationInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucket" {
+			return BucketDetails{}, ErrNoSuchResources
+		}
+		logger.Error("get-bucket-location", err)
+		return BucketDetails{}, err
+	}
+	details.AWSRegion = s3.NormalizeBucketLocation(aws.StringValue(locationOutput.LocationConstraint))
+
+	logger.Info("get-bucket-tagging", lager.Data{"bucket": bucketName})
+	taggingOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.GetBucketTaggingOutput, error) {
+		return s.s3Client.GetBucketTaggingWithContext(ctx, &s3.GetBucketTaggingInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "NoSuchTagSet" {
+			logger.Error("get-bucket-tagging", err)
+			return BucketDetails{}, err
+		}
+	} else {
+		for _, tag := range taggingOutput.TagSet {
+			details.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+
+	details.Encryption, details.KMSKeyARN, err = s.getBucketEncryption(ctx, logger, bucketName)
+	if err != nil {
+		return BucketDetails{}, err
+	}
+
+	logger.Info("get-bucket-versioning", lager.Data{"bucket": bucketName})
+	versioningOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.GetBucketVersioningOutput, error) {
+		return s.s3Client.GetBucketVersioningWithContext(ctx, &s3.GetBucketVersioningInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		logger.Error("get-bucket-versioning", err)
+		return BucketDetails{}, err
+	}
+	// A bucket which has never had versioning turned on has no status.
+	details.Versioning = strings.ToLower(aws.StringValue(versioningOutput.Status))
+
+	bucketPolicy, err := s.getBucketPolicy(ctx, logger, bucketName)
+	if err != nil {
+		return BucketDetails{}, err
+	}
+	details.PublicBucket, details.Bindings, err = s.summariseBucketPolicy(bucketPolicy)
+	if err != nil {
+		logger.Error("parse-bucket-policy", err)
+		return BucketDetails{}, err
+	}
+
+	return details, nil
+}
+
+// getBucketEncryption returns the bucket's default encryption, as one of
+// EncryptionAES256 or EncryptionKMS, and the KMS key it uses, if any.
+func (s *S3Client) getBucketEncryption(ctx context.Context, logger lager.Logger, bucketName string) (encryption, kmsKeyARN string, err error) {
+	logger.Info("get-bucket-encryption", lager.Data{"bucket": bucketName})
+	output, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.GetBucketEncryptionOutput, error) {
+		return s.s3Client.GetBucketEncryptionWithContext(ctx, &s3.GetBucketEncryptionInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ServerSideEncryptionConfigurationNotFoundError" {
+			return "", "", nil
+		}
+		logger.Error("get-bucket-encryption", err)
+		return "", "", err
+	}
+	if output.ServerSideEncryptionConfiguration == nil {
+		return "", "", nil
+	}
+
+	for _, rule := range output.ServerSideEncryptionConfiguration.Rules {
+		sse := rule.ApplyServerSideEncryptionByDefault
+		if sse == nil {
+			continue
+		}
+		switch aws.StringValue(sse.SSEAlgorithm) {
+		case s3.ServerSideEncryptionAes256:
+			return EncryptionAES256, "", nil
+		case s3.ServerSideEncryptionAwsKms:
+			return EncryptionKMS, aws.StringValue(sse.KMSMasterKeyID), nil
+		}
+	}
+	return "", "", nil
+}
+
+// summariseBucketPolicy reports whether the policy makes the bucket public,
+// and how many of the broker's users and roles it grants access to.
+func (s *S3Client) summariseBucketPolicy(bucketPolicy string) (public bool, bindings int, err error) {
+	if bucketPolicy == "" {
+		return false, 0, nil
+	}
+	policyDoc := policy.PolicyDocument{}
+	err = json.Unmarshal([]byte(bucketPolicy), &policyDoc)
+	if err != nil {
+		return false, 0, err
+	}
+
+	principals := map[string]bool{}
+	for _, stmt := range policyDoc.Statement {
+		if policy.IsPublicStatement(stmt) {
+			public = true
+			continue
+		}
+		principal := stmt.Principal.AWS
+		if strings.Contains(principal, ":user"+s.iamUserPath) || strings.Contains(principal, ":role"+s.iamUserPath) {
+			principals[principal] = true
+		}
+	}
+	return public, len(principals), nil
+}
@@ -0,0 +1,145 @@
+package s3_test
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetBucketDetails", func() {
+	var (
+		s3API    *fakeClient.FakeS3API
+		s3Client *s3.S3Client
+	)
+
+	const bucketName = "test-bucket-prefix-test-instance-id"
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		s3Client = s3.NewS3Client(
+			&s3.Config{
+				AWSRegion:      "eu-west-2",
+				ResourcePrefix: "test-bucket-prefix-",
+				IAMUserPath:    "/test-iam-path/",
+			},
+			s3API,
+			&fakeClient.FakeIAMAPI{},
+			&fakeClient.FakeKMSAPI{},
+			lager.NewLogger("s3-service-broker-test"),
+		)
+
+		s3API.GetBucketLocationWithContextReturns(&awsS3.GetBucketLocationOutput{
+			LocationConstraint: aws.String("eu-west-2"),
+		}, nil)
+		s3API.GetBucketTaggingWithContextReturns(&awsS3.GetBucketTaggingOutput{
+			TagSet: []*awsS3.Tag{
+				{Key: aws.String("service_instance_guid"), Value: aws.String("test-instance-id")},
+				{Key: aws.String("plan_guid"), Value: aws.String("test-plan-id")},
+			},
+		}, nil)
+		s3API.GetBucketEncryptionWithContextReturns(&awsS3.GetBucketEncryptionOutput{
+			ServerSideEncryptionConfiguration: &awsS3.ServerSideEncryptionConfiguration{
+				Rules: []*awsS3.ServerSideEncryptionRule{{
+					ApplyServerSideEncryptionByDefault: &awsS3.ServerSideEncryptionByDefault{
+						SSEAlgorithm: aws.String(awsS3.ServerSideEncryptionAes256),
+					},
+				}},
+			},
+		}, nil)
+		s3API.GetBucketVersioningWithContextReturns(&awsS3.GetBucketVersioningOutput{
+			Status: aws.String(awsS3.BucketVersioningStatusEnabled),
+		}, nil)
+		s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
+			Policy: aws.String(`{
+				"Version": "2012-10-17",
+				"Statement": [
+					{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::123456789012:user/test-iam-path/test-bucket-prefix-binding-1"}, "Action": ["s3:GetObject"], "Resource": ["arn:aws:s3:::` + bucketName + `/*"]},
+					{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::123456789012:user/test-iam-path/test-bucket-prefix-binding-1"}, "Action": ["s3:ListBucket"], "Resource": ["arn:aws:s3:::` + bucketName + `"]},
+					{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::123456789012:role/test-iam-path/test-bucket-prefix-binding-2"}, "Action": ["s3:GetObject"], "Resource": ["arn:aws:s3:::` + bucketName + `/*"]}
+				]
+			}`),
+		}, nil)
+	})
+
+	It("reads the bucket's configuration from AWS", func() {
+		details, err := s3Client.GetBucketDetails(context.Background(), "test-instance-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(details).To(Equal(s3.BucketDetails{
+			BucketName:   bucketName,
+			AWSRegion:    "eu-west-2",
+			PublicBucket: false,
+			Encryption:   s3.EncryptionAES256,
+			Versioning:   s3.VersioningEnabled,
+			Tags: map[string]string{
+				"service_instance_guid": "test-instance-id",
+				"plan_guid":             "test-plan-id",
+			},
+			Bindings: 2,
+		}))
+
+		Expect(aws.StringValue(inputOf(s3API.GetBucketLocationWithContextArgsForCall(0)).Bucket)).To(Equal(bucketName))
+	})
+
+	It("reports the region of buckets in us-east-1, which have no location constraint", func() {
+		s3API.GetBucketLocationWithContextReturns(&awsS3.GetBucketLocationOutput{}, nil)
+
+		details, err := s3Client.GetBucketDetails(context.Background(), "test-instance-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(details.AWSRegion).To(Equal("us-east-1"))
+	})
+
+	It("reports public buckets", func() {
+		s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
+			Policy: aws.String(`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"AWS": "*"}, "Action": ["s3:GetObject"], "Resource": ["arn:aws:s3:::` + bucketName + `/*"]}]}`),
+		}, nil)
+
+		details, err := s3Client.GetBucketDetails(context.Background(), "test-instance-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(details.PublicBucket).To(BeTrue())
+		Expect(details.Bindings).To(Equal(0))
+	})
+
+	It("reports the key of SSE-KMS buckets", func() {
+		s3API.GetBucketEncryptionWithContextReturns(&awsS3.GetBucketEncryptionOutput{
+			ServerSideEncryptionConfiguration: &awsS3.ServerSideEncryptionConfiguration{
+				Rules: []*awsS3.ServerSideEncryptionRule{{
+					ApplyServerSideEncryptionByDefault: &awsS3.ServerSideEncryptionByDefault{
+						SSEAlgorithm:   aws.String(awsS3.ServerSideEncryptionAwsKms),
+						KMSMasterKeyID: aws.String("arn:aws:kms:eu-west-2:123456789012:key/key-id"),
+					},
+				}},
+			},
+		}, nil)
+
+		details, err := s3Client.GetBucketDetails(context.Background(), "test-instance-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(details.Encryption).To(Equal(s3.EncryptionKMS))
+		Expect(details.KMSKeyARN).To(Equal("arn:aws:kms:eu-west-2:123456789012:key/key-id"))
+	})
+
+	It("copes with a bucket which has no tags, policy or versioning yet", func() {
+		s3API.GetBucketTaggingWithContextReturns(nil, awserr.New("NoSuchTagSet", "The TagSet does not exist", nil))
+		s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+		s3API.GetBucketVersioningWithContextReturns(&awsS3.GetBucketVersioningOutput{}, nil)
+
+		details, err := s3Client.GetBucketDetails(context.Background(), "test-instance-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(details.Tags).To(BeEmpty())
+		Expect(details.Bindings).To(Equal(0))
+		Expect(details.Versioning).To(BeEmpty())
+	})
+
+	It("returns ErrNoSuchResources if the bucket does not exist", func() {
+		s3API.GetBucketLocationWithContextReturns(nil, awserr.New("NoSuchBucket", "The specified bucket does not exist", nil))
+
+		_, err := s3Client.GetBucketDetails(context.Background(), "test-instance-id")
+		Expect(err).To(Equal(s3.ErrNoSuchResources))
+	})
+})
@@ -0,0 +1,231 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	EncryptionAES256 = "aes256"
+	EncryptionKMS    = "kms"
+
+	kmsKeyUserPolicyName     = "kms-key-access"
+	kmsKeyDeletionWindowDays = 30
+)
+
+// useKMS decides whether a new bucket should be encrypted with SSE-KMS.
+// Plans listed in `kms_plan_ids` always use KMS; otherwise tenants may opt
+// in with the `encryption` parameter.
+func (s *S3Client) useKMS(planID, encryption string) (bool, error) {
+	planRequiresKMS := containsString(s.kmsPlanIDs, planID)
+	switch encryption {
+	case "":
+		return planRequiresKMS, nil
+	case EncryptionKMS:
+		return true, nil
+	case EncryptionAES256:
+		if planRequiresKMS {
+			return false, fmt.Errorf("this plan requires %q encryption", EncryptionKMS)
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("unknown encryption %q: must be one of %q or %q", encryption, EncryptionAES256, EncryptionKMS)
+	}
+}
+
+// kmsConfigured reports whether the operator has set up SSE-KMS, with a
+// shared key or plans which require it.
+func (s *S3Client) kmsConfigured() bool {
+	return s.kmsKeyARN != "" || len(s.kmsPlanIDs) > 0
+}
+
+// kmsKeyAlias is used to find a broker-created key again when the bucket is
+// deleted.
+func (s *S3Client) kmsKeyAlias(bucketName string) string {
+	return fmt.Sprintf("alias/%s", bucketName)
+}
+
+// getOrCreateKMSKey returns the ARN of the key a new bucket should be
+// encrypted with: the operator-supplied key if there is one, or else a new
+// key dedicated to this bucket.
+func (s *S3Client) getOrCreateKMSKey(ctx context.Context, logger lager.Logger, bucketName string, tags []*s3.Tag) (string, error) {
+	if s.kmsKeyARN != "" {
+		return s.kmsKeyARN, nil
+	}
+
+	kmsTags := []*kms.Tag{}
+	for _, tag := range tags {
+		kmsTags = append(kmsTags, &kms.Tag{
+			TagKey:   tag.Key,
+			TagValue: tag.Value,
+		})
+	}
+
+	logger.Info("create-kms-key", lager.Data{"bucket": bucketName})
+	createKeyOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*kms.CreateKeyOutput, error) {
+		return s.kmsClient.CreateKeyWithContext(ctx, &kms.CreateKeyInput{
+			Description: aws.String(fmt.Sprintf("Encryption key for S3 bucket %s", bucketName)),
+			KeyUsage:    aws.String(kms.KeyUsageTypeEncryptDecrypt),
+			Tags:        kmsTags,
+		})
+	})
+	if err != nil {
+		logger.Error("create-kms-key", err)
+		return "", err
+	}
+	keyARN := aws.StringValue(createKeyOutput.KeyMetadata.Arn)
+
+	logger.Info("create-kms-alias", lager.Data{"bucket": bucketName, "key": keyARN})
+	_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*kms.CreateAliasOutput, error) {
+		return s.kmsClient.CreateAliasWithContext(ctx, &kms.CreateAliasInput{
+			AliasName:   aws.String(s.kmsKeyAlias(bucketName)),
+			TargetKeyId: createKeyOutput.KeyMetadata.KeyId,
+		})
+	})
+	if err != nil {
+		logger.Error("create-kms-alias", err)
+		s.scheduleKMSKeyDeletionWithoutError(ctx, logger, aws.StringValue(createKeyOutput.KeyMetadata.KeyId))
+		return "", err
+	}
+
+	return keyARN, nil
+}
+
+// describeBucketKMSKey returns the key created for a bucket, or nil if the
+// broker did not create one.
+func (s *S3Client) describeBucketKMSKey(ctx context.Context, logger lager.Logger, bucketName string) (*kms.KeyMetadata, error) {
+	alias := s.kmsKeyAlias(bucketName)
+
+	logger.Info("describe-kms-key", lager.Data{"alias": alias})
+	describeKeyOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*kms.DescribeKeyOutput, error) {
+		return s.kmsClient.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{
+			KeyId: aws.String(alias),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == kms.ErrCodeNotFoundException {
+			return nil, nil
+		}
+		logger.Error("describe-kms-key", err)
+		return nil, err
+	}
+	return describeKeyOutput.KeyMetadata, nil
+}
+
+// deleteKMSKey schedules the deletion of the key created for a bucket, if
+// there is one. Operator-supplied keys are never deleted.
+func (s *S3Client) deleteKMSKey(ctx context.Context, logger lager.Logger, bucketName string) error {
+	alias := s.kmsKeyAlias(bucketName)
+
+	keyMetadata, err := s.describeBucketKMSKey(ctx, logger, bucketName)
+	if err != nil || keyMetadata == nil {
+		return err
+	}
+	keyID := aws.StringValue(keyMetadata.KeyId)
+
+	logger.Info("delete-kms-alias", lager.Data{"alias": alias})
+	_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*kms.DeleteAliasOutput, error) {
+		return s.kmsClient.DeleteAliasWithContext(ctx, &kms.DeleteAliasInput{
+			AliasName: aws.String(alias),
+		})
+	})
+	if err != nil {
+		logger.Error("delete-kms-alias", err)
+		return err
+	}
+
+	logger.Info("schedule-kms-key-deletion", lager.Data{"key": keyID})
+	_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*kms.ScheduleKeyDeletionOutput, error) {
+		return s.kmsClient.ScheduleKeyDeletionWithContext(ctx, &kms.ScheduleKeyDeletionInput{
+			KeyId:               aws.String(keyID),
+			PendingWindowInDays: aws.Int64(kmsKeyDeletionWindowDays),
+		})
+	})
+	if err != nil {
+		logger.Error("schedule-kms-key-deletion", err)
+		return err
+	}
+	return nil
+}
+
+func (s *S3Client) scheduleKMSKeyDeletionWithoutError(ctx context.Context, logger lager.Logger, keyID string) {
+	ctx = context.WithoutCancel(ctx)
+	_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*kms.ScheduleKeyDeletionOutput, error) {
+		return s.kmsClient.ScheduleKeyDeletionWithContext(ctx, &kms.ScheduleKeyDeletionInput{
+			KeyId:               aws.String(keyID),
+			PendingWindowInDays: aws.Int64(kmsKeyDeletionWindowDays),
+		})
+	})
+	if err != nil {
+		logger.Error(fmt.Sprintf("Scheduled deletion of KMS key %s, and suppressed error", keyID), err)
+	}
+}
+
+// putBucketEncryption sets the bucket's default encryption: SSE-KMS with
+// the given key, or AES256 if kmsKeyARN is empty. SSE-KMS buckets use an S3
+// Bucket Key, so that S3 does not call KMS for every object.
+func (s *S3Client) putBucketEncryption(ctx context.Context, logger lager.Logger, bucketName, kmsKeyARN string) error {
+	rule := &s3.ServerSideEncryptionRule{
+		ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+			SSEAlgorithm: aws.String(s3.ServerSideEncryptionAes256),
+		},
+	}
+	if kmsKeyARN != "" {
+		rule = &s3.ServerSideEncryptionRule{
+			ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+				SSEAlgorithm:   aws.String(s3.ServerSideEncryptionAwsKms),
+				KMSMasterKeyID: aws.String(kmsKeyARN),
+			},
+			BucketKeyEnabled: aws.Bool(true),
+		}
+	}
+
+	logger.Info("put-bucket-encryption", lager.Data{"bucket": bucketName, "sse-algorithm": rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm})
+	_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.PutBucketEncryptionOutput, error) {
+		return s.s3Client.PutBucketEncryptionWithContext(ctx, &s3.PutBucketEncryptionInput{
+			Bucket: aws.String(bucketName),
+			ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+				Rules: []*s3.ServerSideEncryptionRule{rule},
+			},
+		})
+	})
+	if err != nil {
+		logger.Error("put-bucket-encryption", err)
+	}
+	return err
+}
+
+// getBucketKMSKey returns the ARN of the KMS key the bucket is encrypted
+// with by default, or an empty string if it does not use SSE-KMS.
+func (s *S3Client) getBucketKMSKey(ctx context.Context, logger lager.Logger, bucketName string) (string, error) {
+	logger.Info("get-bucket-encryption", lager.Data{"bucket": bucketName})
+	getBucketEncryptionOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.GetBucketEncryptionOutput, error) {
+		return s.s3Client.GetBucketEncryptionWithContext(ctx, &s3.GetBucketEncryptionInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ServerSideEncryptionConfigurationNotFoundError" {
+			return "", nil
+		}
+		logger.Error("get-bucket-encryption", err)
+		return "", err
+	}
+	if getBucketEncryptionOutput.ServerSideEncryptionConfiguration == nil {
+		return "", nil
+	}
+
+	for _, rule := range getBucketEncryptionOutput.ServerSideEncryptionConfiguration.Rules {
+		sse := rule.ApplyServerSideEncryptionByDefault
+		if sse != nil && aws.StringValue(sse.SSEAlgorithm) == s3.ServerSideEncryptionAwsKms {
+			return aws.StringValue(sse.KMSMasterKeyID), nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,319 @@
+package s3_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/alphagov/paas-service-broker-base/provider"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/brokerapi/v10/domain"
+)
+
+var _ = Describe("KMS encryption", func() {
+	var (
+		s3API          *fakeClient.FakeS3API
+		iamAPI         *fakeClient.FakeIAMAPI
+		kmsAPI         *fakeClient.FakeKMSAPI
+		s3Client       *s3.S3Client
+		s3ClientConfig *s3.Config
+	)
+
+	const (
+		instanceKeyARN = "arn:aws:kms:eu-west-2:123456789012:key/instance-key-id"
+		operatorKeyARN = "arn:aws:kms:eu-west-2:123456789012:key/operator-key-id"
+	)
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		storeBucketPolicies(s3API)
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
+		s3ClientConfig = &s3.Config{
+			AWSRegion:      "eu-west-2",
+			ResourcePrefix: "test-bucket-prefix-",
+			KMSPlanIDs:     []string{"kms-plan-id"},
+		}
+		kmsAPI.CreateKeyWithContextReturns(&kms.CreateKeyOutput{
+			KeyMetadata: &kms.KeyMetadata{
+				Arn:   aws.String(instanceKeyARN),
+				KeyId: aws.String("instance-key-id"),
+			},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			s3ClientConfig,
+			s3API,
+			iamAPI,
+			kmsAPI,
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	provisionWith := func(planID, params string) error {
+		return s3Client.CreateBucket(context.Background(), provider.ProvisionData{
+			InstanceID: "test-instance-id",
+			Plan:       domain.ServicePlan{ID: planID},
+			Details: domain.ProvisionDetails{
+				RawParameters: json.RawMessage(params),
+			},
+		})
+	}
+
+	encryptionRule := func() *awsS3.ServerSideEncryptionByDefault {
+		Expect(s3API.PutBucketEncryptionWithContextCallCount()).To(Equal(1))
+		rules := inputOf(s3API.PutBucketEncryptionWithContextArgsForCall(0)).ServerSideEncryptionConfiguration.Rules
+		Expect(rules).To(HaveLen(1))
+		return rules[0].ApplyServerSideEncryptionByDefault
+	}
+
+	Describe("provisioning", func() {
+		It("uses AES256 by default", func() {
+			Expect(provisionWith("plan-id", `{}`)).To(Succeed())
+
+			Expect(encryptionRule().SSEAlgorithm).To(HaveValue(Equal("AES256")))
+			Expect(kmsAPI.Invocations()).To(BeEmpty())
+			rules := inputOf(s3API.PutBucketEncryptionWithContextArgsForCall(0)).ServerSideEncryptionConfiguration.Rules
+			Expect(rules[0].BucketKeyEnabled).To(BeNil())
+		})
+
+		It("creates a key for the bucket when asked for kms", func() {
+			Expect(provisionWith("plan-id", `{"encryption": "kms"}`)).To(Succeed())
+
+			By("creating a tagged key")
+			Expect(kmsAPI.CreateKeyWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(kmsAPI.CreateKeyWithContextArgsForCall(0)).Tags).To(ContainElement(&kms.Tag{
+				TagKey:   aws.String("service_instance_guid"),
+				TagValue: aws.String("test-instance-id"),
+			}))
+
+			By("aliasing the key after the bucket")
+			Expect(kmsAPI.CreateAliasWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(kmsAPI.CreateAliasWithContextArgsForCall(0)).AliasName).To(HaveValue(Equal("alias/test-bucket-prefix-test-instance-id")))
+			Expect(inputOf(kmsAPI.CreateAliasWithContextArgsForCall(0)).TargetKeyId).To(HaveValue(Equal("instance-key-id")))
+
+			By("encrypting the bucket with the key")
+			Expect(encryptionRule().SSEAlgorithm).To(HaveValue(Equal("aws:kms")))
+			Expect(encryptionRule().KMSMasterKeyID).To(HaveValue(Equal(instanceKeyARN)))
+
+			By("using an S3 Bucket Key, so that S3 does not call KMS for every object")
+			rules := inputOf(s3API.PutBucketEncryptionWithContextArgsForCall(0)).ServerSideEncryptionConfiguration.Rules
+			Expect(rules[0].BucketKeyEnabled).To(HaveValue(BeTrue()))
+		})
+
+		It("always uses kms for plans that require it", func() {
+			Expect(provisionWith("kms-plan-id", `{}`)).To(Succeed())
+
+			Expect(kmsAPI.CreateKeyWithContextCallCount()).To(Equal(1))
+			Expect(encryptionRule().SSEAlgorithm).To(HaveValue(Equal("aws:kms")))
+		})
+
+		It("refuses aes256 for plans that require kms", func() {
+			err := provisionWith("kms-plan-id", `{"encryption": "aes256"}`)
+			Expect(err).To(MatchError(ContainSubstring(`this plan requires "kms" encryption`)))
+			Expect(s3API.CreateBucketWithContextCallCount()).To(Equal(0))
+		})
+
+		It("refuses unknown encryption types", func() {
+			err := provisionWith("plan-id", `{"encryption": "rot13"}`)
+			Expect(err).To(MatchError(ContainSubstring(`unknown encryption "rot13"`)))
+			Expect(s3API.CreateBucketWithContextCallCount()).To(Equal(0))
+		})
+
+		It("schedules the key for deletion if it cannot be aliased", func() {
+			kmsAPI.CreateAliasWithContextReturns(nil, errors.New("alias failed"))
+
+			Expect(provisionWith("plan-id", `{"encryption": "kms"}`)).To(MatchError("alias failed"))
+			Expect(kmsAPI.ScheduleKeyDeletionWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(kmsAPI.ScheduleKeyDeletionWithContextArgsForCall(0)).KeyId).To(HaveValue(Equal("instance-key-id")))
+			Expect(s3API.PutBucketEncryptionWithContextCallCount()).To(Equal(0))
+		})
+
+		Context("when the operator has configured a key", func() {
+			BeforeEach(func() {
+				s3ClientConfig.KMSKeyARN = operatorKeyARN
+			})
+
+			It("uses the operator's key rather than creating one", func() {
+				Expect(provisionWith("plan-id", `{"encryption": "kms"}`)).To(Succeed())
+
+				Expect(kmsAPI.Invocations()).To(BeEmpty())
+				Expect(encryptionRule().KMSMasterKeyID).To(HaveValue(Equal(operatorKeyARN)))
+			})
+		})
+	})
+
+	Describe("deprovisioning", func() {
+		encryptedWith := func(keyARN string) {
+			s3API.GetBucketEncryptionWithContextReturns(&awsS3.GetBucketEncryptionOutput{
+				ServerSideEncryptionConfiguration: &awsS3.ServerSideEncryptionConfiguration{
+					Rules: []*awsS3.ServerSideEncryptionRule{{
+						ApplyServerSideEncryptionByDefault: &awsS3.ServerSideEncryptionByDefault{
+							SSEAlgorithm:   aws.String("aws:kms"),
+							KMSMasterKeyID: aws.String(keyARN),
+						},
+					}},
+				},
+			}, nil)
+		}
+
+		It("schedules the bucket's own key for deletion", func() {
+			encryptedWith(instanceKeyARN)
+			kmsAPI.DescribeKeyWithContextReturns(&kms.DescribeKeyOutput{
+				KeyMetadata: &kms.KeyMetadata{KeyId: aws.String("instance-key-id")},
+			}, nil)
+
+			Expect(s3Client.DeleteBucket(context.Background(), "test-instance-id")).To(Succeed())
+
+			Expect(inputOf(kmsAPI.DescribeKeyWithContextArgsForCall(0)).KeyId).To(HaveValue(Equal("alias/test-bucket-prefix-test-instance-id")))
+			Expect(kmsAPI.DeleteAliasWithContextCallCount()).To(Equal(1))
+			Expect(kmsAPI.ScheduleKeyDeletionWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(kmsAPI.ScheduleKeyDeletionWithContextArgsForCall(0)).KeyId).To(HaveValue(Equal("instance-key-id")))
+			Expect(inputOf(kmsAPI.ScheduleKeyDeletionWithContextArgsForCall(0)).PendingWindowInDays).To(HaveValue(BeEquivalentTo(30)))
+		})
+
+		It("does nothing to keys when the bucket has no key of its own", func() {
+			encryptedWith(instanceKeyARN)
+			kmsAPI.DescribeKeyWithContextReturns(nil, awserr.New(kms.ErrCodeNotFoundException, "not found", nil))
+
+			Expect(s3Client.DeleteBucket(context.Background(), "test-instance-id")).To(Succeed())
+
+			Expect(kmsAPI.DeleteAliasWithContextCallCount()).To(Equal(0))
+			Expect(kmsAPI.ScheduleKeyDeletionWithContextCallCount()).To(Equal(0))
+		})
+
+		It("does not call KMS at all for AES256 buckets", func() {
+			s3API.GetBucketEncryptionWithContextReturns(&awsS3.GetBucketEncryptionOutput{
+				ServerSideEncryptionConfiguration: &awsS3.ServerSideEncryptionConfiguration{
+					Rules: []*awsS3.ServerSideEncryptionRule{{
+						ApplyServerSideEncryptionByDefault: &awsS3.ServerSideEncryptionByDefault{
+							SSEAlgorithm: aws.String("AES256"),
+						},
+					}},
+				},
+			}, nil)
+
+			Expect(s3Client.DeleteBucket(context.Background(), "test-instance-id")).To(Succeed())
+			Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(1))
+			Expect(kmsAPI.Invocations()).To(BeEmpty())
+		})
+
+		Context("when the operator has configured a key", func() {
+			BeforeEach(func() {
+				s3ClientConfig.KMSKeyARN = operatorKeyARN
+			})
+
+			It("does not call KMS for buckets using it", func() {
+				encryptedWith(operatorKeyARN)
+
+				Expect(s3Client.DeleteBucket(context.Background(), "test-instance-id")).To(Succeed())
+				Expect(kmsAPI.Invocations()).To(BeEmpty())
+			})
+		})
+
+		It("reports a bucket which has gone already as missing", func() {
+			s3API.GetBucketEncryptionWithContextReturns(nil, awserr.New("NoSuchBucket", "The specified bucket does not exist", nil))
+			s3API.DeleteBucketWithContextReturns(nil, awserr.New("NoSuchBucket", "The specified bucket does not exist", nil))
+
+			Expect(s3Client.DeleteBucket(context.Background(), "test-instance-id")).To(MatchError(s3.ErrNoSuchResources))
+			Expect(kmsAPI.Invocations()).To(BeEmpty())
+		})
+	})
+
+	Describe("binding", func() {
+		BeforeEach(func() {
+			iamAPI.CreateUserWithContextReturns(&iam.CreateUserOutput{
+				User: &iam.User{Arn: aws.String("arn")},
+			}, nil)
+			iamAPI.CreateAccessKeyWithContextReturns(&iam.CreateAccessKeyOutput{
+				AccessKey: &iam.AccessKey{
+					AccessKeyId:     aws.String("access-key-id"),
+					SecretAccessKey: aws.String("secret-access-key"),
+				},
+			}, nil)
+			s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
+				Policy: aws.String(`{"Version": "2012-10-17", "Statement":[]}`),
+			}, nil)
+		})
+
+		bindWith := func(params string) error {
+			bindData := provider.BindData{
+				InstanceID: "test-instance-id",
+				BindingID:  "test-binding-id",
+			}
+			if params != "" {
+				bindData.Details.RawParameters = json.RawMessage(params)
+			}
+			_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			return err
+		}
+
+		It("does not grant any key access for buckets without kms", func() {
+			s3API.GetBucketEncryptionWithContextReturns(nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "not found", nil))
+
+			Expect(bindWith("")).To(Succeed())
+			Expect(iamAPI.PutUserPolicyWithContextCallCount()).To(Equal(0))
+		})
+
+		Context("when the bucket is encrypted with kms", func() {
+			BeforeEach(func() {
+				s3API.GetBucketEncryptionWithContextReturns(&awsS3.GetBucketEncryptionOutput{
+					ServerSideEncryptionConfiguration: &awsS3.ServerSideEncryptionConfiguration{
+						Rules: []*awsS3.ServerSideEncryptionRule{{
+							ApplyServerSideEncryptionByDefault: &awsS3.ServerSideEncryptionByDefault{
+								SSEAlgorithm:   aws.String("aws:kms"),
+								KMSMasterKeyID: aws.String(instanceKeyARN),
+							},
+						}},
+					},
+				}, nil)
+			})
+
+			userPolicy := func() policy.IdentityPolicyDocument {
+				Expect(iamAPI.PutUserPolicyWithContextCallCount()).To(Equal(1))
+				input := inputOf(iamAPI.PutUserPolicyWithContextArgsForCall(0))
+				Expect(input.UserName).To(HaveValue(Equal("test-bucket-prefix-test-binding-id")))
+				Expect(input.PolicyName).To(HaveValue(Equal("kms-key-access")))
+
+				var doc policy.IdentityPolicyDocument
+				Expect(json.Unmarshal([]byte(aws.StringValue(input.PolicyDocument)), &doc)).To(Succeed())
+				Expect(doc.Statement).To(HaveLen(1))
+				Expect(doc.Statement[0].Resource).To(ConsistOf(instanceKeyARN))
+				return doc
+			}
+
+			It("lets read-write users encrypt and decrypt with the bucket's key", func() {
+				Expect(bindWith("")).To(Succeed())
+				Expect(userPolicy().Statement[0].Action).To(ConsistOf("kms:Decrypt", "kms:GenerateDataKey"))
+			})
+
+			It("only lets read-only users decrypt", func() {
+				Expect(bindWith(`{"permissions": "read-only"}`)).To(Succeed())
+				Expect(userPolicy().Statement[0].Action).To(ConsistOf("kms:Decrypt"))
+			})
+
+			It("deletes the user if the key policy cannot be attached", func() {
+				iamAPI.PutUserPolicyWithContextReturns(nil, errors.New("put failed"))
+				iamAPI.ListAccessKeysWithContextReturns(&iam.ListAccessKeysOutput{}, nil)
+				iamAPI.ListAttachedUserPoliciesWithContextReturns(&iam.ListAttachedUserPoliciesOutput{}, nil)
+				iamAPI.ListUserPoliciesWithContextReturns(&iam.ListUserPoliciesOutput{}, nil)
+
+				Expect(bindWith("")).To(MatchError("put failed"))
+				Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+				Expect(iamAPI.CreateAccessKeyWithContextCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,146 @@
+package s3
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	maxLifecycleRules  = 50
+	maxLifecycleRuleID = 255
+	minStandardIADays  = 30
+)
+
+// LifecycleRule is the tenant-facing shape of the `lifecycle_rules`
+// parameter. It deliberately exposes only a subset of what S3 supports.
+type LifecycleRule struct {
+	ID                                 string                `json:"id"`
+	Prefix                             string                `json:"prefix"`
+	ExpirationDays                     int64                 `json:"expiration_days"`
+	Transitions                        []LifecycleTransition `json:"transitions"`
+	AbortIncompleteMultipartUploadDays int64                 `json:"abort_incomplete_multipart_upload_days"`
+	NoncurrentVersionExpirationDays    int64                 `json:"noncurrent_version_expiration_days"`
+}
+
+type LifecycleTransition struct {
+	Days         int64  `json:"days"`
+	StorageClass string `json:"storage_class"`
+}
+
+var transitionStorageClasses = []string{
+	s3.TransitionStorageClassStandardIa,
+	s3.TransitionStorageClassOnezoneIa,
+	s3.TransitionStorageClassIntelligentTiering,
+	s3.TransitionStorageClassGlacier,
+	s3.TransitionStorageClassDeepArchive,
+}
+
+// validateLifecycleRules checks the tenant supplied rules, only permitting
+// transitions to the storage classes the operator has allowed.
+func validateLifecycleRules(rules []LifecycleRule, allowedStorageClasses []string) error {
+	if len(rules) > maxLifecycleRules {
+		return fmt.Errorf("at most %d lifecycle rules may be specified", maxLifecycleRules)
+	}
+
+	ids := map[string]bool{}
+	for i, rule := range rules {
+		id := lifecycleRuleID(rule, i)
+		if len(id) > maxLifecycleRuleID {
+			return fmt.Errorf("lifecycle rule id %q is longer than %d characters", id, maxLifecycleRuleID)
+		}
+		if ids[id] {
+			return fmt.Errorf("lifecycle rule id %q is used more than once", id)
+		}
+		ids[id] = true
+
+		if strings.HasPrefix(rule.Prefix, "/") {
+			return fmt.Errorf("lifecycle rule %q: prefix must not start with '/'", id)
+		}
+
+		if rule.ExpirationDays == 0 &&
+			len(rule.Transitions) == 0 &&
+			rule.AbortIncompleteMultipartUploadDays == 0 &&
+			rule.NoncurrentVersionExpirationDays == 0 {
+			return fmt.Errorf("lifecycle rule %q does not specify any action", id)
+		}
+		if rule.ExpirationDays < 0 || rule.AbortIncompleteMultipartUploadDays < 0 || rule.NoncurrentVersionExpirationDays < 0 {
+			return fmt.Errorf("lifecycle rule %q: days must be positive", id)
+		}
+
+		for _, transition := range rule.Transitions {
+			if transition.Days <= 0 {
+				return fmt.Errorf("lifecycle rule %q: transition days must be positive", id)
+			}
+			if !containsString(transitionStorageClasses, transition.StorageClass) {
+				return fmt.Errorf("lifecycle rule %q: unknown storage class %q", id, transition.StorageClass)
+			}
+			if !containsString(allowedStorageClasses, transition.StorageClass) {
+				return fmt.Errorf("lifecycle rule %q: transitions to storage class %q are not permitted", id, transition.StorageClass)
+			}
+			if (transition.StorageClass == s3.TransitionStorageClassStandardIa ||
+				transition.StorageClass == s3.TransitionStorageClassOnezoneIa) &&
+				transition.Days < minStandardIADays {
+				return fmt.Errorf("lifecycle rule %q: objects must be stored for at least %d days before transitioning to %s", id, minStandardIADays, transition.StorageClass)
+			}
+			if rule.ExpirationDays != 0 && transition.Days >= rule.ExpirationDays {
+				return fmt.Errorf("lifecycle rule %q: objects must transition before they expire", id)
+			}
+		}
+	}
+	return nil
+}
+
+func buildLifecycleConfiguration(rules []LifecycleRule) *s3.BucketLifecycleConfiguration {
+	config := &s3.BucketLifecycleConfiguration{}
+	for i, rule := range rules {
+		s3Rule := &s3.LifecycleRule{
+			ID:     aws.String(lifecycleRuleID(rule, i)),
+			Status: aws.String(s3.ExpirationStatusEnabled),
+			Filter: &s3.LifecycleRuleFilter{
+				Prefix: aws.String(rule.Prefix),
+			},
+		}
+		if rule.ExpirationDays > 0 {
+			s3Rule.Expiration = &s3.LifecycleExpiration{
+				Days: aws.Int64(rule.ExpirationDays),
+			}
+		}
+		for _, transition := range rule.Transitions {
+			s3Rule.Transitions = append(s3Rule.Transitions, &s3.Transition{
+				Days:         aws.Int64(transition.Days),
+				StorageClass: aws.String(transition.StorageClass),
+			})
+		}
+		if rule.AbortIncompleteMultipartUploadDays > 0 {
+			s3Rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(rule.AbortIncompleteMultipartUploadDays),
+			}
+		}
+		if rule.NoncurrentVersionExpirationDays > 0 {
+			s3Rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(rule.NoncurrentVersionExpirationDays),
+			}
+		}
+		config.Rules = append(config.Rules, s3Rule)
+	}
+	return config
+}
+
+func lifecycleRuleID(rule LifecycleRule, index int) string {
+	if rule.ID != "" {
+		return rule.ID
+	}
+	return fmt.Sprintf("rule-%d", index+1)
+}
+
+func containsString(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
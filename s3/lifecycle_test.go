@@ -0,0 +1,165 @@
+package s3_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-service-broker-base/provider"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/brokerapi/v10/domain"
+)
+
+var _ = Describe("Lifecycle rules", func() {
+	var (
+		s3API          *fakeClient.FakeS3API
+		iamAPI         *fakeClient.FakeIAMAPI
+		kmsAPI         *fakeClient.FakeKMSAPI
+		s3Client       *s3.S3Client
+		s3ClientConfig *s3.Config
+	)
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
+		s3ClientConfig = &s3.Config{
+			AWSRegion:             "eu-west-2",
+			ResourcePrefix:        "test-bucket-prefix-",
+			AllowedStorageClasses: []string{"STANDARD_IA"},
+		}
+		s3API.GetBucketTaggingWithContextReturns(&awsS3.GetBucketTaggingOutput{}, nil)
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			s3ClientConfig,
+			s3API,
+			iamAPI,
+			kmsAPI,
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	provisionWith := func(params string) error {
+		return s3Client.CreateBucket(context.Background(), provider.ProvisionData{
+			InstanceID: "test-instance-id",
+			Details: domain.ProvisionDetails{
+				RawParameters: json.RawMessage(params),
+			},
+		})
+	}
+
+	It("does not configure lifecycle rules by default", func() {
+		Expect(provisionWith(`{}`)).To(Succeed())
+		Expect(s3API.PutBucketLifecycleConfigurationWithContextCallCount()).To(Equal(0))
+	})
+
+	It("applies the requested rules when provisioning", func() {
+		Expect(provisionWith(`{"lifecycle_rules": [
+			{
+				"prefix": "logs/",
+				"expiration_days": 90,
+				"transitions": [{"days": 30, "storage_class": "STANDARD_IA"}]
+			},
+			{
+				"id": "tidy-up",
+				"abort_incomplete_multipart_upload_days": 7,
+				"noncurrent_version_expiration_days": 14
+			}
+		]}`)).To(Succeed())
+
+		Expect(s3API.PutBucketLifecycleConfigurationWithContextCallCount()).To(Equal(1))
+		input := inputOf(s3API.PutBucketLifecycleConfigurationWithContextArgsForCall(0))
+		Expect(input.Bucket).To(HaveValue(Equal("test-bucket-prefix-test-instance-id")))
+
+		rules := input.LifecycleConfiguration.Rules
+		Expect(rules).To(HaveLen(2))
+
+		Expect(rules[0].ID).To(HaveValue(Equal("rule-1")))
+		Expect(rules[0].Status).To(HaveValue(Equal("Enabled")))
+		Expect(rules[0].Filter.Prefix).To(HaveValue(Equal("logs/")))
+		Expect(rules[0].Expiration.Days).To(HaveValue(BeEquivalentTo(90)))
+		Expect(rules[0].Transitions).To(HaveLen(1))
+		Expect(rules[0].Transitions[0].Days).To(HaveValue(BeEquivalentTo(30)))
+		Expect(rules[0].Transitions[0].StorageClass).To(HaveValue(Equal("STANDARD_IA")))
+		Expect(rules[0].AbortIncompleteMultipartUpload).To(BeNil())
+
+		Expect(rules[1].ID).To(HaveValue(Equal("tidy-up")))
+		Expect(rules[1].Filter.Prefix).To(HaveValue(Equal("")))
+		Expect(rules[1].Expiration).To(BeNil())
+		Expect(rules[1].AbortIncompleteMultipartUpload.DaysAfterInitiation).To(HaveValue(BeEquivalentTo(7)))
+		Expect(rules[1].NoncurrentVersionExpiration.NoncurrentDays).To(HaveValue(BeEquivalentTo(14)))
+	})
+
+	DescribeTable("rejects invalid rules before creating the bucket",
+		func(params string, expectedError string) {
+			err := provisionWith(params)
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+			Expect(s3API.CreateBucketWithContextCallCount()).To(Equal(0))
+		},
+		Entry("storage class not permitted by the operator",
+			`{"lifecycle_rules": [{"transitions": [{"days": 30, "storage_class": "GLACIER"}]}]}`,
+			`storage class "GLACIER" are not permitted`),
+		Entry("unknown storage class",
+			`{"lifecycle_rules": [{"transitions": [{"days": 30, "storage_class": "FLOPPY_DISK"}]}]}`,
+			`unknown storage class "FLOPPY_DISK"`),
+		Entry("transition to infrequent access too soon",
+			`{"lifecycle_rules": [{"transitions": [{"days": 5, "storage_class": "STANDARD_IA"}]}]}`,
+			"at least 30 days"),
+		Entry("transition after expiry",
+			`{"lifecycle_rules": [{"expiration_days": 30, "transitions": [{"days": 60, "storage_class": "STANDARD_IA"}]}]}`,
+			"must transition before they expire"),
+		Entry("rule without any action",
+			`{"lifecycle_rules": [{"prefix": "logs/"}]}`,
+			"does not specify any action"),
+		Entry("negative days",
+			`{"lifecycle_rules": [{"expiration_days": -1}]}`,
+			"days must be positive"),
+		Entry("duplicate ids",
+			`{"lifecycle_rules": [{"id": "a", "expiration_days": 1}, {"id": "a", "expiration_days": 2}]}`,
+			`id "a" is used more than once`),
+		Entry("prefix with a leading slash",
+			`{"lifecycle_rules": [{"prefix": "/logs", "expiration_days": 1}]}`,
+			"must not start with '/'"),
+	)
+
+	Describe("updating", func() {
+		updateWith := func(params string) error {
+			return s3Client.UpdateBucket(context.Background(), provider.UpdateData{
+				InstanceID: "test-instance-id",
+				Details: domain.UpdateDetails{
+					RawParameters: json.RawMessage(params),
+				},
+			})
+		}
+
+		It("leaves lifecycle rules alone when the parameter is omitted", func() {
+			Expect(updateWith(`{}`)).To(Succeed())
+			Expect(s3API.PutBucketLifecycleConfigurationWithContextCallCount()).To(Equal(0))
+			Expect(s3API.DeleteBucketLifecycleWithContextCallCount()).To(Equal(0))
+		})
+
+		It("replaces the lifecycle rules", func() {
+			Expect(updateWith(`{"lifecycle_rules": [{"expiration_days": 1}]}`)).To(Succeed())
+			Expect(s3API.PutBucketLifecycleConfigurationWithContextCallCount()).To(Equal(1))
+			Expect(s3API.DeleteBucketLifecycleWithContextCallCount()).To(Equal(0))
+		})
+
+		It("removes the lifecycle rules when given an empty list", func() {
+			Expect(updateWith(`{"lifecycle_rules": []}`)).To(Succeed())
+			Expect(s3API.PutBucketLifecycleConfigurationWithContextCallCount()).To(Equal(0))
+			Expect(s3API.DeleteBucketLifecycleWithContextCallCount()).To(Equal(1))
+		})
+
+		It("rejects invalid rules before changing anything", func() {
+			err := updateWith(`{"public_bucket": true, "lifecycle_rules": [{"prefix": "logs/"}]}`)
+			Expect(err).To(HaveOccurred())
+			Expect(s3API.Invocations()).To(BeEmpty())
+		})
+	})
+})
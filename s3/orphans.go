@@ -0,0 +1,146 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// OrphanKind is a kind of AWS resource which the broker created for a
+// service instance or binding that Cloud Foundry no longer has.
+type OrphanKind string
+
+const (
+	OrphanBucket    OrphanKind = "bucket"
+	OrphanPrincipal OrphanKind = "principal"
+	OrphanStatement OrphanKind = "statement"
+)
+
+// orphanMinAge stops a bucket, user or role being reported as orphaned when
+// Cloud Foundry was asked about it before it had finished being created.
+const orphanMinAge = time.Hour
+
+// Orphan describes one resource found by FindOrphans. Bucket is the orphaned
+// bucket, or the bucket an orphaned principal or statement belongs to.
+type Orphan struct {
+	Kind          OrphanKind `json:"kind"`
+	Bucket        string     `json:"bucket,omitempty"`
+	Principal     string     `json:"principal,omitempty"`
+	PrincipalType string     `json:"principal_type,omitempty"`
+	Detail        string     `json:"detail"`
+}
+
+// FindOrphans lists the buckets whose service instance, and the users and
+// roles whose binding or service key, is not one of those Cloud Foundry
+// knows about, along with bucket policy statements naming deleted users and
+// roles. Buckets which are pending deletion are left to the reaper, and
+// restored buckets to the operator who restored them. It only reads from
+// AWS.
+func (s *S3Client) FindOrphans(ctx context.Context, liveInstanceIDs, liveBindingIDs map[string]bool) ([]Orphan, error) {
+	logger := s.logger.Session("find-orphans")
+
+	buckets, err := s.listBrokerBuckets(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+	principals, err := s.listBrokerPrincipals(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	orphans := []Orphan{}
+	for _, bucket := range buckets {
+		if bucketTagValue(bucket.tags, pendingDeletionTagKey) != "" || bucketTagValue(bucket.tags, restoredAtTagKey) != "" {
+			continue
+		}
+
+		instanceID := strings.TrimPrefix(bucket.name, s.bucketPrefix)
+		if !liveInstanceIDs[instanceID] {
+			if now.Sub(bucket.creationDate) >= orphanMinAge {
+				orphans = append(orphans, Orphan{
+					Kind:   OrphanBucket,
+					Bucket: bucket.name,
+					Detail: fmt.Sprintf("service instance %s does not exist in Cloud Foundry", instanceID),
+				})
+			}
+			continue
+		}
+
+		bucketPolicy, err := s.getBucketPolicy(ctx, logger, bucket.name)
+		if err != nil {
+			return nil, err
+		}
+		// Statements naming principals which exist but whose binding has
+		// gone are removed along with the principal, so only those which
+		// IAM has replaced with a unique ID are orphans in their own right.
+		staleStatements, err := s.findStaleStatements(bucketPolicy, nil)
+		if err != nil {
+			logger.Error("find-stale-statements", err, lager.Data{"bucket": bucket.name})
+			return nil, err
+		}
+		for _, principal := range staleStatements {
+			if strings.HasPrefix(principal, "arn:") {
+				continue
+			}
+			orphans = append(orphans, Orphan{
+				Kind:      OrphanStatement,
+				Bucket:    bucket.name,
+				Principal: principal,
+				Detail:    "statement names the unique ID of a deleted user or role",
+			})
+		}
+	}
+
+	for _, principal := range principals {
+		bindingID := strings.TrimPrefix(principal.name, s.bucketPrefix)
+		if liveBindingIDs[bindingID] || now.Sub(principal.createDate) < orphanMinAge {
+			continue
+		}
+		orphan := Orphan{
+			Kind:          OrphanPrincipal,
+			Principal:     principal.name,
+			PrincipalType: principal.principalType,
+			Detail:        fmt.Sprintf("service binding or key %s does not exist in Cloud Foundry", bindingID),
+		}
+		instanceID := tagValue(principal.tags, "service_instance_guid")
+		if instanceID != "" {
+			orphan.Bucket = s.buildBucketName(instanceID)
+		}
+		orphans = append(orphans, orphan)
+	}
+
+	return orphans, nil
+}
+
+// DeleteOrphan deletes one resource found by FindOrphans. An orphaned bucket
+// is soft deleted if soft_delete_retention_days is set, so that the reaper
+// deletes it later, and otherwise emptied and deleted straight away.
+func (s *S3Client) DeleteOrphan(ctx context.Context, orphan Orphan, progress func(objectsDeleted int)) error {
+	logger := s.logger.Session("delete-orphan", lager.Data{"kind": orphan.Kind, "bucket": orphan.Bucket, "principal": orphan.Principal})
+
+	switch orphan.Kind {
+	case OrphanBucket:
+		instanceID := strings.TrimPrefix(orphan.Bucket, s.bucketPrefix)
+		if s.SoftDeleteEnabled() {
+			return s.SoftDeleteBucket(ctx, instanceID)
+		}
+		err := s.EmptyBucket(ctx, instanceID, progress)
+		if err != nil {
+			return err
+		}
+		return s.DeleteBucket(ctx, instanceID)
+
+	case OrphanPrincipal:
+		return s.deletePrincipal(ctx, logger, orphan.Bucket, orphan.Principal, orphan.PrincipalType)
+
+	case OrphanStatement:
+		_, err := s.revokeBucketAccess(ctx, logger, orphan.Bucket, orphan.Principal)
+		return err
+	}
+
+	return fmt.Errorf("unknown orphan kind %q", orphan.Kind)
+}
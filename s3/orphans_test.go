@@ -0,0 +1,263 @@
+package s3_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Finding orphaned resources", func() {
+	const (
+		bucketName = "test-bucket-prefix-test-instance-id"
+		username   = "test-bucket-prefix-test-binding-id"
+		userARN    = "arn:aws:iam::123456789012:user/test-iam-path/" + username
+	)
+
+	var (
+		s3API          *fakeClient.FakeS3API
+		iamAPI         *fakeClient.FakeIAMAPI
+		kmsAPI         *fakeClient.FakeKMSAPI
+		s3ClientConfig *s3.Config
+		s3Client       *s3.S3Client
+
+		bucketTags      []*awsS3.Tag
+		liveInstanceIDs map[string]bool
+		liveBindingIDs  map[string]bool
+	)
+
+	setBucketPolicy := func(principals ...string) {
+		statements := []policy.Statement{}
+		for _, principal := range principals {
+			statements = append(statements, policy.BuildStatement("aws", bucketName, iam.User{Arn: aws.String(principal)}, policy.ReadWritePermissions{}))
+		}
+		policyJSON, err := json.Marshal(policy.PolicyDocument{Version: "2012-10-17", Statement: statements})
+		Expect(err).NotTo(HaveOccurred())
+		s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{Policy: aws.String(string(policyJSON))}, nil)
+	}
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
+		s3ClientConfig = &s3.Config{
+			AWSRegion:      "eu-west-2",
+			ResourcePrefix: "test-bucket-prefix-",
+			IAMUserPath:    "/test-iam-path/",
+		}
+
+		bucketTags = []*awsS3.Tag{
+			{Key: aws.String("service_instance_guid"), Value: aws.String("test-instance-id")},
+			{Key: aws.String("created_by"), Value: aws.String("paas-s3-broker")},
+		}
+		liveInstanceIDs = map[string]bool{"test-instance-id": true}
+		liveBindingIDs = map[string]bool{"test-binding-id": true}
+
+		s3API.ListBucketsWithContextReturns(&awsS3.ListBucketsOutput{
+			Buckets: []*awsS3.Bucket{{
+				Name:         aws.String(bucketName),
+				CreationDate: aws.Time(time.Now().Add(-2 * time.Hour)),
+			}},
+		}, nil)
+		s3API.GetBucketTaggingWithContextStub = func(context.Context, *awsS3.GetBucketTaggingInput, ...request.Option) (*awsS3.GetBucketTaggingOutput, error) {
+			return &awsS3.GetBucketTaggingOutput{TagSet: bucketTags}, nil
+		}
+		setBucketPolicy(userARN)
+
+		iamAPI.ListUsersPagesWithContextStub = func(_ context.Context, _ *iam.ListUsersInput, fn func(*iam.ListUsersOutput, bool) bool, _ ...request.Option) error {
+			fn(&iam.ListUsersOutput{Users: []*iam.User{{
+				UserName:   aws.String(username),
+				Arn:        aws.String(userARN),
+				CreateDate: aws.Time(time.Now().Add(-2 * time.Hour)),
+			}}}, true)
+			return nil
+		}
+		iamAPI.ListUserTagsWithContextReturns(&iam.ListUserTagsOutput{
+			Tags: []*iam.Tag{{Key: aws.String("service_instance_guid"), Value: aws.String("test-instance-id")}},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			s3ClientConfig,
+			s3API,
+			iamAPI,
+			kmsAPI,
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	Describe("FindOrphans", func() {
+		It("finds nothing when Cloud Foundry knows about everything", func() {
+			orphans, err := s3Client.FindOrphans(context.Background(), liveInstanceIDs, liveBindingIDs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(orphans).To(BeEmpty())
+		})
+
+		It("finds buckets whose service instance has gone", func() {
+			delete(liveInstanceIDs, "test-instance-id")
+
+			orphans, err := s3Client.FindOrphans(context.Background(), liveInstanceIDs, liveBindingIDs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(orphans).To(ContainElement(s3.Orphan{
+				Kind:   s3.OrphanBucket,
+				Bucket: bucketName,
+				Detail: "service instance test-instance-id does not exist in Cloud Foundry",
+			}))
+		})
+
+		It("does not report buckets which are pending deletion", func() {
+			delete(liveInstanceIDs, "test-instance-id")
+			bucketTags = append(bucketTags, &awsS3.Tag{Key: aws.String("pending_deletion"), Value: aws.String("2024-01-02T03:04:05Z")})
+
+			orphans, err := s3Client.FindOrphans(context.Background(), liveInstanceIDs, liveBindingIDs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(orphans).To(BeEmpty())
+		})
+
+		Context("when a soft deleted bucket has been restored", func() {
+			BeforeEach(func() {
+				s3ClientConfig.SoftDeleteRetentionDays = 7
+				delete(liveInstanceIDs, "test-instance-id")
+				bucketTags = append(bucketTags, &awsS3.Tag{Key: aws.String("pending_deletion"), Value: aws.String(time.Now().UTC().Format(time.RFC3339))})
+				s3API.PutBucketTaggingWithContextStub = func(_ context.Context, input *awsS3.PutBucketTaggingInput, _ ...request.Option) (*awsS3.PutBucketTaggingOutput, error) {
+					bucketTags = input.Tagging.TagSet
+					return &awsS3.PutBucketTaggingOutput{}, nil
+				}
+				storeBucketPolicies(s3API)
+			})
+
+			It("does not report it", func() {
+				Expect(s3Client.RestoreBucket(context.Background(), "test-instance-id")).To(Succeed())
+
+				orphans, err := s3Client.FindOrphans(context.Background(), liveInstanceIDs, liveBindingIDs)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(orphans).NotTo(ContainElement(HaveField("Kind", s3.OrphanBucket)))
+			})
+		})
+
+		It("does not report buckets which might still be being provisioned", func() {
+			delete(liveInstanceIDs, "test-instance-id")
+			s3API.ListBucketsWithContextReturns(&awsS3.ListBucketsOutput{
+				Buckets: []*awsS3.Bucket{{
+					Name:         aws.String(bucketName),
+					CreationDate: aws.Time(time.Now()),
+				}},
+			}, nil)
+
+			orphans, err := s3Client.FindOrphans(context.Background(), liveInstanceIDs, liveBindingIDs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(orphans).To(BeEmpty())
+		})
+
+		It("finds users whose binding has gone", func() {
+			delete(liveBindingIDs, "test-binding-id")
+
+			orphans, err := s3Client.FindOrphans(context.Background(), liveInstanceIDs, liveBindingIDs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(orphans).To(ConsistOf(s3.Orphan{
+				Kind:          s3.OrphanPrincipal,
+				Bucket:        bucketName,
+				Principal:     username,
+				PrincipalType: s3.PrincipalTypeUser,
+				Detail:        "service binding or key test-binding-id does not exist in Cloud Foundry",
+			}))
+		})
+
+		It("finds statements naming deleted users", func() {
+			setBucketPolicy(userARN, "AIDAEXAMPLEUNIQUEID", "AROAEXAMPLEUNIQUEID")
+
+			orphans, err := s3Client.FindOrphans(context.Background(), liveInstanceIDs, liveBindingIDs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(orphans).To(ConsistOf(
+				HaveField("Principal", "AIDAEXAMPLEUNIQUEID"),
+				HaveField("Principal", "AROAEXAMPLEUNIQUEID"),
+			))
+			Expect(orphans[0].Kind).To(Equal(s3.OrphanStatement))
+			Expect(orphans[0].Bucket).To(Equal(bucketName))
+		})
+
+		It("returns errors from AWS", func() {
+			iamAPI.ListUsersPagesWithContextReturns(errors.New("list-users-failed"))
+			iamAPI.ListUsersPagesWithContextStub = nil
+
+			_, err := s3Client.FindOrphans(context.Background(), liveInstanceIDs, liveBindingIDs)
+			Expect(err).To(MatchError("list-users-failed"))
+		})
+	})
+
+	Describe("DeleteOrphan", func() {
+		BeforeEach(func() {
+			storeBucketPolicies(s3API)
+		})
+
+		Context("when soft delete is enabled", func() {
+			BeforeEach(func() {
+				s3ClientConfig.SoftDeleteRetentionDays = 30
+			})
+
+			It("soft deletes orphaned buckets", func() {
+				err := s3Client.DeleteOrphan(context.Background(), s3.Orphan{Kind: s3.OrphanBucket, Bucket: bucketName}, func(int) {})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(0))
+				input := inputOf(s3API.PutBucketTaggingWithContextArgsForCall(0))
+				Expect(input.Bucket).To(Equal(aws.String(bucketName)))
+				Expect(input.Tagging.TagSet).To(ContainElement(HaveField("Key", aws.String("pending_deletion"))))
+			})
+		})
+
+		It("empties and deletes orphaned buckets otherwise", func() {
+			s3API.GetBucketEncryptionWithContextReturns(nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found", nil))
+			s3API.ListMultipartUploadsWithContextReturns(&awsS3.ListMultipartUploadsOutput{}, nil)
+			s3API.ListObjectVersionsWithContextReturns(&awsS3.ListObjectVersionsOutput{}, nil)
+
+			err := s3Client.DeleteOrphan(context.Background(), s3.Orphan{Kind: s3.OrphanBucket, Bucket: bucketName}, func(int) {})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s3API.ListObjectVersionsWithContextCallCount()).To(Equal(1))
+			Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(s3API.DeleteBucketWithContextArgsForCall(0)).Bucket).To(Equal(aws.String(bucketName)))
+		})
+
+		It("removes orphaned users from their bucket and deletes them", func() {
+			iamAPI.ListAccessKeysWithContextReturns(&iam.ListAccessKeysOutput{}, nil)
+			iamAPI.ListAttachedUserPoliciesWithContextReturns(&iam.ListAttachedUserPoliciesOutput{}, nil)
+			iamAPI.ListUserPoliciesWithContextReturns(&iam.ListUserPoliciesOutput{}, nil)
+
+			err := s3Client.DeleteOrphan(context.Background(), s3.Orphan{
+				Kind:          s3.OrphanPrincipal,
+				Bucket:        bucketName,
+				Principal:     username,
+				PrincipalType: s3.PrincipalTypeUser,
+			}, func(int) {})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).To(Equal(aws.String(username)))
+		})
+
+		It("removes orphaned statements", func() {
+			setBucketPolicy(userARN, "AIDAEXAMPLEUNIQUEID")
+
+			err := s3Client.DeleteOrphan(context.Background(), s3.Orphan{
+				Kind:      s3.OrphanStatement,
+				Bucket:    bucketName,
+				Principal: "AIDAEXAMPLEUNIQUEID",
+			}, func(int) {})
+			Expect(err).NotTo(HaveOccurred())
+			updatedPolicy := aws.StringValue(inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0)).Policy)
+			Expect(updatedPolicy).To(ContainSubstring(userARN))
+			Expect(updatedPolicy).NotTo(ContainSubstring("AIDAEXAMPLEUNIQUEID"))
+		})
+	})
+})
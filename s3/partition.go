@@ -0,0 +1,42 @@
+package s3
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// partitions are the AWS partitions the broker can run in.
+var partitions = []string{
+	endpoints.AwsPartitionID,
+	endpoints.AwsCnPartitionID,
+	endpoints.AwsUsGovPartitionID,
+	endpoints.AwsIsoPartitionID,
+	endpoints.AwsIsoBPartitionID,
+}
+
+// partitionRegionPrefixes are the prefixes of the regions outside the
+// standard partition. us-isob- must come before us-iso-, which it starts
+// with.
+var partitionRegionPrefixes = []struct{ prefix, partition string }{
+	{"cn-", endpoints.AwsCnPartitionID},
+	{"us-gov-", endpoints.AwsUsGovPartitionID},
+	{"us-isob-", endpoints.AwsIsoBPartitionID},
+	{"us-iso-", endpoints.AwsIsoPartitionID},
+}
+
+// Partition returns aws_partition if it is set, or otherwise the partition
+// aws_region is in, such as "aws-us-gov" for us-gov-west-1. It goes by the
+// region's name rather than the SDK's list of regions, so that regions newer
+// than the SDK are still put in the right partition.
+func (c *Config) Partition() string {
+	if c.AWSPartition != "" {
+		return c.AWSPartition
+	}
+	for _, p := range partitionRegionPrefixes {
+		if strings.HasPrefix(c.AWSRegion, p.prefix) {
+			return p.partition
+		}
+	}
+	return endpoints.AwsPartitionID
+}
@@ -0,0 +1,28 @@
+package s3_test
+
+import (
+	"github.com/alphagov/paas-s3-broker/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config.Partition", func() {
+	DescribeTable("derives the partition from the region",
+		func(region, partition string) {
+			config := &s3.Config{AWSRegion: region}
+			Expect(config.Partition()).To(Equal(partition))
+		},
+		Entry("London", "eu-west-2", "aws"),
+		Entry("a region newer than the SDK", "mx-central-1", "aws"),
+		Entry("GovCloud", "us-gov-west-1", "aws-us-gov"),
+		Entry("Beijing", "cn-north-1", "aws-cn"),
+		Entry("Ningxia", "cn-northwest-1", "aws-cn"),
+		Entry("ISO", "us-iso-east-1", "aws-iso"),
+		Entry("ISOB", "us-isob-east-1", "aws-iso-b"),
+	)
+
+	It("prefers an explicit partition", func() {
+		config := &s3.Config{AWSRegion: "us-east-1", AWSPartition: "aws-us-gov"}
+		Expect(config.Partition()).To(Equal("aws-us-gov"))
+	})
+})
@@ -0,0 +1,50 @@
+package policy
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// BuildAssumeRolePolicy builds the trust policy for a binding's role, which
+// lets principalARN assume the role only when it passes externalID.
+func BuildAssumeRolePolicy(principalARN, externalID string) (string, error) {
+	doc := PolicyDocument{
+		Version: "2012-10-17",
+		Statement: []Statement{
+			{
+				Effect:    "Allow",
+				Principal: Principal{AWS: principalARN},
+				Action:    []string{"sts:AssumeRole"},
+				Condition: Condition{
+					"StringEquals": {"sts:ExternalId": {externalID}},
+				},
+			},
+		},
+	}
+	policyJSON, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(policyJSON), nil
+}
+
+// ExternalIDFromAssumeRolePolicy returns the external ID which a trust policy
+// built by BuildAssumeRolePolicy requires, or an empty string if there is
+// none. IAM returns trust policies URL-encoded, which is undone first.
+func ExternalIDFromAssumeRolePolicy(assumeRolePolicy string) (string, error) {
+	decoded, err := url.PathUnescape(assumeRolePolicy)
+	if err != nil {
+		return "", err
+	}
+	doc := PolicyDocument{}
+	err = json.Unmarshal([]byte(decoded), &doc)
+	if err != nil {
+		return "", err
+	}
+	for _, stmt := range doc.Statement {
+		for _, value := range stmt.Condition["StringEquals"]["sts:ExternalId"] {
+			return value, nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,36 @@
+package policy_test
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Assume role policy", func() {
+	It("only trusts the principal when it passes the external ID", func() {
+		policyJSON, err := policy.BuildAssumeRolePolicy("arn:aws:iam::123456789012:user/assumer", "some-external-id")
+		Expect(err).NotTo(HaveOccurred())
+
+		doc := policy.PolicyDocument{}
+		Expect(json.Unmarshal([]byte(policyJSON), &doc)).To(Succeed())
+		Expect(doc.Statement).To(HaveLen(1))
+		Expect(doc.Statement[0].Effect).To(Equal("Allow"))
+		Expect(doc.Statement[0].Principal.AWS).To(Equal("arn:aws:iam::123456789012:user/assumer"))
+		Expect(doc.Statement[0].Action).To(ConsistOf("sts:AssumeRole"))
+		Expect(doc.Statement[0].Condition).To(Equal(policy.Condition{
+			"StringEquals": {"sts:ExternalId": {"some-external-id"}},
+		}))
+		Expect(policyJSON).NotTo(ContainSubstring("Resource"))
+	})
+
+	It("finds the external ID again, even when IAM has URL-encoded the policy", func() {
+		policyJSON, err := policy.BuildAssumeRolePolicy("arn:aws:iam::123456789012:user/assumer", "some-external-id")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(policy.ExternalIDFromAssumeRolePolicy(policyJSON)).To(Equal("some-external-id"))
+		Expect(policy.ExternalIDFromAssumeRolePolicy(url.PathEscape(policyJSON))).To(Equal("some-external-id"))
+	})
+})
@@ -0,0 +1,50 @@
+package policy
+
+import (
+	"encoding/json"
+)
+
+// IdentityPolicyDocument is an IAM policy attached to a user rather than a
+// bucket, so its statements have no Principal.
+type IdentityPolicyDocument struct {
+	Version   string              `json:"Version"`
+	Statement []IdentityStatement `json:"Statement"`
+}
+
+type IdentityStatement struct {
+	Effect   string   `json:"Effect"`
+	Action   Actions  `json:"Action"`
+	Resource []string `json:"Resource"`
+}
+
+// KMSActions returns the KMS actions a binding needs on the bucket's key to
+// use objects encrypted with SSE-KMS with the given bucket permissions.
+func KMSActions(permissions Permissions) []string {
+	actions := []string{"kms:Decrypt"}
+	for _, action := range permissions.Actions() {
+		if action == "s3:PutObject" {
+			return append(actions, "kms:GenerateDataKey")
+		}
+	}
+	return actions
+}
+
+// BuildKMSKeyUserPolicy builds an inline user policy granting access to a
+// single KMS key.
+func BuildKMSKeyUserPolicy(keyARN string, permissions Permissions) (string, error) {
+	doc := IdentityPolicyDocument{
+		Version: "2012-10-17",
+		Statement: []IdentityStatement{
+			{
+				Effect:   "Allow",
+				Action:   KMSActions(permissions),
+				Resource: []string{keyARN},
+			},
+		},
+	}
+	policyJSON, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(policyJSON), nil
+}
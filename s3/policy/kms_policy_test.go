@@ -0,0 +1,36 @@
+package policy_test
+
+import (
+	"encoding/json"
+
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("KMS policy", func() {
+	It("only allows decryption for read only bindings", func() {
+		Expect(policy.KMSActions(policy.ReadOnlyPermissions{})).To(ConsistOf("kms:Decrypt"))
+	})
+
+	It("allows generating data keys for read write bindings", func() {
+		Expect(policy.KMSActions(policy.ReadWritePermissions{})).To(ConsistOf("kms:Decrypt", "kms:GenerateDataKey"))
+	})
+
+	It("builds a user policy scoped to a single key", func() {
+		policyJSON, err := policy.BuildKMSKeyUserPolicy(
+			"arn:aws:kms:eu-west-2:123456789012:key/some-key-id",
+			policy.ReadWritePermissions{},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		doc := policy.IdentityPolicyDocument{}
+		Expect(json.Unmarshal([]byte(policyJSON), &doc)).To(Succeed())
+		Expect(doc.Version).To(Equal("2012-10-17"))
+		Expect(doc.Statement).To(HaveLen(1))
+		Expect(doc.Statement[0].Effect).To(Equal("Allow"))
+		Expect(doc.Statement[0].Resource).To(ConsistOf("arn:aws:kms:eu-west-2:123456789012:key/some-key-id"))
+		Expect(doc.Statement[0].Action).To(ConsistOf("kms:Decrypt", "kms:GenerateDataKey"))
+		Expect(policyJSON).NotTo(ContainSubstring("Principal"))
+	})
+})
@@ -0,0 +1,99 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// PendingDeletionSid identifies the statement which blocks access to a
+// bucket while it is waiting to be reaped.
+const PendingDeletionSid = "PendingDeletion"
+
+// pendingDeletionAllowedActions are left out of the deny, so that the broker
+// can still read and remove the block to restore or reap the bucket.
+var pendingDeletionAllowedActions = []string{
+	"s3:GetBucketPolicy",
+	"s3:PutBucketPolicy",
+	"s3:DeleteBucketPolicy",
+	"s3:GetBucketTagging",
+	"s3:PutBucketTagging",
+}
+
+func BuildPendingDeletionStatement(partition, bucketName string) Statement {
+	return Statement{
+		Sid:       PendingDeletionSid,
+		Effect:    "Deny",
+		Principal: Principal{AWS: "*"},
+		Resource: []string{
+			BucketARN(partition, bucketName),
+			BucketARN(partition, bucketName) + "/*",
+		},
+		NotAction: pendingDeletionAllowedActions,
+	}
+}
+
+// AddPendingDeletionToPolicy appends the pending deletion statement to the
+// policy, unless it is already there.
+func AddPendingDeletionToPolicy(maybeExistingPolicy string, partition, bucketName string) (PolicyDocument, error) {
+	policyDoc, err := BuildPolicy(maybeExistingPolicy, BuildPendingDeletionStatement(partition, bucketName))
+	if err != nil {
+		return PolicyDocument{}, err
+	}
+
+	// BuildPolicy has just appended the statement, so only look at the
+	// ones which were already there.
+	for _, stmt := range policyDoc.Statement[:len(policyDoc.Statement)-1] {
+		if stmt.Sid == PendingDeletionSid {
+			policyDoc.Statement = policyDoc.Statement[:len(policyDoc.Statement)-1]
+			break
+		}
+	}
+	return policyDoc, nil
+}
+
+// HasPendingDeletion reports whether the policy has the pending deletion
+// statement.
+func HasPendingDeletion(existingPolicy string) (bool, error) {
+	if existingPolicy == "" {
+		return false, nil
+	}
+
+	policyDoc := PolicyDocument{}
+	err := json.Unmarshal([]byte(existingPolicy), &policyDoc)
+	if err != nil {
+		return false, err
+	}
+
+	for _, stmt := range policyDoc.Statement {
+		if stmt.Sid == PendingDeletionSid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RemovePendingDeletionFromPolicy returns the policy as it was before
+// AddPendingDeletionToPolicy. It is not an error if there was nothing to
+// remove.
+func RemovePendingDeletionFromPolicy(existingPolicy string) (PolicyDocument, error) {
+	policyDoc := PolicyDocument{}
+	err := json.Unmarshal([]byte(existingPolicy), &policyDoc)
+	if err != nil {
+		return PolicyDocument{}, err
+	}
+	if reflect.DeepEqual(policyDoc, PolicyDocument{}) {
+		return PolicyDocument{}, fmt.Errorf(
+			"provided json was well-formed, but did not unmarshal into a Policy. Provided JSON: %s",
+			existingPolicy)
+	}
+
+	var maintainedStatements []Statement
+	for _, stmt := range policyDoc.Statement {
+		if stmt.Sid != PendingDeletionSid {
+			maintainedStatements = append(maintainedStatements, stmt)
+		}
+	}
+	policyDoc.Statement = maintainedStatements
+	return policyDoc, nil
+}
@@ -0,0 +1,87 @@
+package policy_test
+
+import (
+	"encoding/json"
+
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pending deletion policy", func() {
+	publicPolicy := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Effect": "Allow",
+				"Principal": {"AWS": "*"},
+				"Action": ["s3:GetObject"],
+				"Resource": ["arn:aws:s3:::some-bucket/*"]
+			}
+		]
+	}`
+
+	It("denies everything except managing the policy and tags", func() {
+		stmt := policy.BuildPendingDeletionStatement("aws", "some-bucket")
+		Expect(stmt.Effect).To(Equal("Deny"))
+		Expect(stmt.Principal.AWS).To(Equal("*"))
+		Expect(stmt.Action).To(BeEmpty())
+		Expect(stmt.NotAction).To(ContainElements("s3:PutBucketPolicy", "s3:GetBucketTagging"))
+		Expect(stmt.Resource).To(ConsistOf("arn:aws:s3:::some-bucket", "arn:aws:s3:::some-bucket/*"))
+
+		data, err := json.Marshal(stmt)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).NotTo(ContainSubstring(`"Action"`))
+		Expect(string(data)).To(ContainSubstring(`"Sid":"PendingDeletion"`))
+	})
+
+	It("is not mistaken for a public statement", func() {
+		Expect(policy.IsPublicStatement(policy.BuildPendingDeletionStatement("aws", "some-bucket"))).To(BeFalse())
+	})
+
+	It("is added to an empty policy", func() {
+		document, err := policy.AddPendingDeletionToPolicy("", "aws", "some-bucket")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(document.Statement).To(ConsistOf(policy.BuildPendingDeletionStatement("aws", "some-bucket")))
+	})
+
+	It("is added alongside existing statements only once", func() {
+		document, err := policy.AddPendingDeletionToPolicy(publicPolicy, "aws", "some-bucket")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(document.Statement).To(HaveLen(2))
+
+		data, err := json.Marshal(document)
+		Expect(err).NotTo(HaveOccurred())
+		document, err = policy.AddPendingDeletionToPolicy(string(data), "aws", "some-bucket")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(document.Statement).To(HaveLen(2))
+	})
+
+	It("can be removed, leaving the other statements as they were", func() {
+		document, err := policy.AddPendingDeletionToPolicy(publicPolicy, "aws", "some-bucket")
+		Expect(err).NotTo(HaveOccurred())
+		data, err := json.Marshal(document)
+		Expect(err).NotTo(HaveOccurred())
+
+		document, err = policy.RemovePendingDeletionFromPolicy(string(data))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(document.Statement).To(HaveLen(1))
+		Expect(policy.IsPublicStatement(document.Statement[0])).To(BeTrue())
+	})
+
+	It("reports whether a policy has the statement", func() {
+		document, err := policy.AddPendingDeletionToPolicy(publicPolicy, "aws", "some-bucket")
+		Expect(err).NotTo(HaveOccurred())
+		data, err := json.Marshal(document)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(policy.HasPendingDeletion(string(data))).To(BeTrue())
+		Expect(policy.HasPendingDeletion(publicPolicy)).To(BeFalse())
+		Expect(policy.HasPendingDeletion("")).To(BeFalse())
+	})
+
+	It("should return an error if passed incorrect JSON", func() {
+		_, err := policy.RemovePendingDeletionFromPolicy(`{"crap": "json"}`)
+		Expect(err).To(HaveOccurred())
+	})
+})
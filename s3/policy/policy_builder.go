@@ -2,21 +2,25 @@ package policy
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 )
 
+var ErrNoPublicStatement = errors.New("could not find a public policy statement")
+
 type PolicyDocument struct {
 	Version   string      `json:"Version"`
 	Statement []Statement `json:"Statement"`
 }
 
-func BuildPolicy(maybeExistingPolicy string, statement Statement) (PolicyDocument, error) {
+func BuildPolicy(maybeExistingPolicy string, statements ...Statement) (PolicyDocument, error) {
 	if maybeExistingPolicy == "" {
 		return PolicyDocument{
 			Version:   "2012-10-17",
-			Statement: []Statement{statement},
+			Statement: statements,
 		}, nil
 	}
 
@@ -31,7 +35,7 @@ func BuildPolicy(maybeExistingPolicy string, statement Statement) (PolicyDocumen
 			maybeExistingPolicy)
 	}
 
-	existingPolicy.Statement = append(existingPolicy.Statement, statement)
+	existingPolicy.Statement = append(existingPolicy.Statement, statements...)
 	return existingPolicy, nil
 }
 
@@ -62,3 +66,143 @@ func RemoveUserFromPolicy(existingPolicy string, userArnSuffix string) (PolicyDo
 
 	return policyDoc, nil
 }
+
+// HasStatementsForUser reports whether the policy has any statements which
+// RemoveUserFromPolicy would remove.
+func HasStatementsForUser(existingPolicy string, userArnSuffix string) (bool, error) {
+	if existingPolicy == "" {
+		return false, nil
+	}
+
+	policyDoc := PolicyDocument{}
+	err := json.Unmarshal([]byte(existingPolicy), &policyDoc)
+	if err != nil {
+		return false, err
+	}
+
+	for _, stmt := range policyDoc.Statement {
+		if strings.HasSuffix(stmt.Principal.AWS, userArnSuffix) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// PrefixForUser returns the key prefix which BuildPrefixStatements limited
+// the user's statements to, or an empty string if the user may use the
+// whole bucket or has no statements.
+func PrefixForUser(existingPolicy string, userArnSuffix string) (string, error) {
+	if existingPolicy == "" {
+		return "", nil
+	}
+
+	policyDoc := PolicyDocument{}
+	err := json.Unmarshal([]byte(existingPolicy), &policyDoc)
+	if err != nil {
+		return "", err
+	}
+
+	for _, stmt := range policyDoc.Statement {
+		if !strings.HasSuffix(stmt.Principal.AWS, userArnSuffix) {
+			continue
+		}
+		for _, value := range stmt.Condition["StringLike"]["s3:prefix"] {
+			return strings.TrimSuffix(value, "*"), nil
+		}
+	}
+	return "", nil
+}
+
+// ContainsStatements reports whether every one of the statements is in the
+// policy. The order of actions and resources does not matter, as S3 does not
+// promise to keep it.
+func ContainsStatements(existingPolicy string, statements ...Statement) (bool, error) {
+	if existingPolicy == "" {
+		return len(statements) == 0, nil
+	}
+
+	policyDoc := PolicyDocument{}
+	err := json.Unmarshal([]byte(existingPolicy), &policyDoc)
+	if err != nil {
+		return false, err
+	}
+
+	for _, wanted := range statements {
+		found := false
+		for _, stmt := range policyDoc.Statement {
+			if reflect.DeepEqual(sortedStatement(stmt), sortedStatement(wanted)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func sortedStatement(stmt Statement) Statement {
+	sorted := func(values []string) []string {
+		if len(values) == 0 {
+			return nil
+		}
+		sortedValues := append([]string{}, values...)
+		sort.Strings(sortedValues)
+		return sortedValues
+	}
+	stmt.Action = sorted(stmt.Action)
+	stmt.NotAction = sorted(stmt.NotAction)
+	stmt.Resource = sorted(stmt.Resource)
+	if len(stmt.Condition) == 0 {
+		stmt.Condition = nil
+	}
+	return stmt
+}
+
+func RemovePublicAccessFromPolicy(existingPolicy string) (PolicyDocument, error) {
+	policyDoc := PolicyDocument{}
+	err := json.Unmarshal([]byte(existingPolicy), &policyDoc)
+	if err != nil {
+		return PolicyDocument{}, err
+	}
+	if reflect.DeepEqual(policyDoc, PolicyDocument{}) {
+		return PolicyDocument{}, fmt.Errorf(
+			"provided json was well-formed, but did not unmarshal into a Policy. Provided JSON: %s",
+			existingPolicy)
+	}
+
+	var maintainedStatements []Statement
+	for _, stmt := range policyDoc.Statement {
+		if !IsPublicStatement(stmt) {
+			maintainedStatements = append(maintainedStatements, stmt)
+		}
+	}
+
+	statementsCount := len(policyDoc.Statement)
+	policyDoc.Statement = maintainedStatements
+	if len(maintainedStatements) == statementsCount {
+		return policyDoc, ErrNoPublicStatement
+	}
+
+	return policyDoc, nil
+}
+
+func HasPublicStatement(existingPolicy string) (bool, error) {
+	if existingPolicy == "" {
+		return false, nil
+	}
+
+	policyDoc := PolicyDocument{}
+	err := json.Unmarshal([]byte(existingPolicy), &policyDoc)
+	if err != nil {
+		return false, err
+	}
+
+	for _, stmt := range policyDoc.Statement {
+		if IsPublicStatement(stmt) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
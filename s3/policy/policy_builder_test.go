@@ -150,6 +150,41 @@ var _ = Describe("PolicyBuilder", func() {
 				Expect(document.Statement).To(HaveLen(1))
 				Expect(document.Statement[0].Principal.AWS).To(Equal("arn:aws:sts::some-other-arn"))
 			})
+
+			It("removes all of a prefix scoped user's statements, and keeps other users' conditions", func() {
+				document, err := policy.RemoveUserFromPolicy(`{
+					"Version":"2012-10-17",
+					"Statement":[
+						{
+							"Effect": "Allow",
+							"Principal": {"AWS": "arn:aws:sts::some-arn"},
+							"Action": "s3:ListBucket",
+							"Resource": ["arn:aws:s3:::some-instance-id"],
+							"Condition": {"StringLike": {"s3:prefix": "a/*"}}
+						},
+						{
+							"Effect": "Allow",
+							"Principal": {"AWS": "arn:aws:sts::some-arn"},
+							"Action": ["s3:GetObject"],
+							"Resource": ["arn:aws:s3:::some-instance-id/a/*"]
+						},
+						{
+							"Effect": "Allow",
+							"Principal": {"AWS": "arn:aws:sts::some-other-arn"},
+							"Action": "s3:ListBucket",
+							"Resource": ["arn:aws:s3:::some-instance-id"],
+							"Condition": {"StringLike": {"s3:prefix": "b/*"}}
+						}
+					]
+				}`, "arn:aws:sts::some-arn")
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(document.Statement).To(HaveLen(1))
+				Expect(document.Statement[0].Principal.AWS).To(Equal("arn:aws:sts::some-other-arn"))
+				Expect(document.Statement[0].Condition).To(Equal(policy.Condition{
+					"StringLike": {"s3:prefix": {"b/*"}},
+				}))
+			})
 		})
 
 		Context("when an existing policy is empty", func() {
@@ -160,4 +195,123 @@ var _ = Describe("PolicyBuilder", func() {
 		})
 
 	})
+
+	Context("checking the statements in a policy", func() {
+		existingPolicy := `{
+			"Version":"2012-10-17",
+			"Statement":[
+				{
+					"Effect": "Allow",
+					"Principal": {"AWS": "arn:aws:iam::123456789012:user/some-user"},
+					"Action": ["s3:GetObject", "s3:ListBucket"],
+					"Resource": ["arn:aws:s3:::some-bucket/*", "arn:aws:s3:::some-bucket"]
+				},
+				{
+					"Effect": "Allow",
+					"Principal": {"AWS": "arn:aws:iam::123456789012:user/prefix-user"},
+					"Action": "s3:ListBucket",
+					"Resource": "arn:aws:s3:::some-bucket",
+					"Condition": {"StringLike": {"s3:prefix": "app/*"}}
+				}
+			]
+		}`
+
+		It("finds statements whatever the order of their actions and resources", func() {
+			Expect(policy.ContainsStatements(existingPolicy, policy.Statement{
+				Effect:    "Allow",
+				Principal: policy.Principal{AWS: "arn:aws:iam::123456789012:user/some-user"},
+				Action:    []string{"s3:ListBucket", "s3:GetObject"},
+				Resource:  []string{"arn:aws:s3:::some-bucket", "arn:aws:s3:::some-bucket/*"},
+			})).To(BeTrue())
+		})
+
+		It("finds statements with conditions and single values", func() {
+			Expect(policy.ContainsStatements(existingPolicy, policy.Statement{
+				Effect:    "Allow",
+				Principal: policy.Principal{AWS: "arn:aws:iam::123456789012:user/prefix-user"},
+				Action:    []string{"s3:ListBucket"},
+				Resource:  []string{"arn:aws:s3:::some-bucket"},
+				Condition: policy.Condition{"StringLike": {"s3:prefix": {"app/*"}}},
+			})).To(BeTrue())
+		})
+
+		It("does not find statements which differ", func() {
+			Expect(policy.ContainsStatements(existingPolicy, policy.Statement{
+				Effect:    "Allow",
+				Principal: policy.Principal{AWS: "arn:aws:iam::123456789012:user/some-user"},
+				Action:    []string{"s3:GetObject"},
+				Resource:  []string{"arn:aws:s3:::some-bucket", "arn:aws:s3:::some-bucket/*"},
+			})).To(BeFalse())
+			Expect(policy.ContainsStatements("", policy.Statement{})).To(BeFalse())
+		})
+
+		It("reports whether a user has any statements", func() {
+			Expect(policy.HasStatementsForUser(existingPolicy, "prefix-user")).To(BeTrue())
+			Expect(policy.HasStatementsForUser(existingPolicy, "other-user")).To(BeFalse())
+			Expect(policy.HasStatementsForUser("", "prefix-user")).To(BeFalse())
+		})
+
+		It("finds the prefix a user is limited to", func() {
+			Expect(policy.PrefixForUser(existingPolicy, "/prefix-user")).To(Equal("app/"))
+			Expect(policy.PrefixForUser(existingPolicy, "/some-user")).To(BeEmpty())
+			Expect(policy.PrefixForUser("", "/prefix-user")).To(BeEmpty())
+		})
+	})
+
+	Context("removing public access from a policy", func() {
+		publicPolicy := `{
+			"Version":"2012-10-17",
+			"Statement":[
+				{
+					"Effect": "Allow",
+					"Principal": {
+						"AWS": "*"
+					},
+					"Action": "s3:GetObject",
+					"Resource": [
+						"arn:aws:s3:::some-instance-id",
+						"arn:aws:s3:::some-instance-id/*"
+					]
+				},
+				{
+					"Effect": "Allow",
+					"Principal": {
+						"AWS": "arn:aws:sts::some-arn"
+					},
+					"Action": [
+						"s3:ListBucket",
+						"s3:GetObject"
+					],
+					"Resource": [
+						"arn:aws:s3:::some-instance-id",
+						"arn:aws:s3:::some-instance-id/*"
+					]
+				}
+			]
+		}`
+
+		It("returns the document without the public statement", func() {
+			document, err := policy.RemovePublicAccessFromPolicy(publicPolicy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(document.Statement).To(HaveLen(1))
+			Expect(document.Statement[0].Principal.AWS).To(Equal("arn:aws:sts::some-arn"))
+		})
+
+		It("returns ErrNoPublicStatement when the policy is not public", func() {
+			_, err := policy.RemovePublicAccessFromPolicy(`{"Version": "2012-10-17", "Statement":[]}`)
+			Expect(err).To(MatchError(policy.ErrNoPublicStatement))
+		})
+
+		It("should return an error if passed incorrect JSON", func() {
+			_, err := policy.RemovePublicAccessFromPolicy(`{"crap": "json"}`)
+			Expect(err).To(HaveOccurred())
+			Expect(err).ToNot(MatchError(policy.ErrNoPublicStatement))
+		})
+
+		It("reports whether a policy has a public statement", func() {
+			Expect(policy.HasPublicStatement(publicPolicy)).To(BeTrue())
+			Expect(policy.HasPublicStatement("")).To(BeFalse())
+			Expect(policy.HasPublicStatement(`{"Version": "2012-10-17", "Statement":[]}`)).To(BeFalse())
+		})
+	})
 })
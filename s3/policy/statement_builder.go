@@ -2,17 +2,23 @@ package policy
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 )
 
 type Statement struct {
+	Sid       string    `json:"Sid,omitempty"`
 	Effect    string    `json:"Effect"`
-	Action    Actions   `json:"Action"`
-	Resource  []string  `json:"Resource"`
+	Action    Actions   `json:"Action,omitempty"`
+	NotAction Actions   `json:"NotAction,omitempty"`
+	Resource  Resources `json:"Resource,omitempty"`
 	Principal Principal `json:"Principal"`
+	Condition Condition `json:"Condition,omitempty"`
 }
 
 // We alias Actions as []string here and
@@ -40,6 +46,52 @@ func (a *Actions) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Resources are unmarshaled like Actions, as S3 also returns a single
+// resource as a string.
+type Resources []string
+
+func (r *Resources) UnmarshalJSON(b []byte) error {
+	var actions Actions
+	err := actions.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	*r = Resources(actions)
+	return nil
+}
+
+// Condition maps a condition operator, such as StringLike, to the condition
+// keys it tests and the values they are tested against.
+type Condition map[string]map[string]ConditionValues
+
+// ConditionValues are unmarshaled like Actions, except that AWS also allows
+// single booleans and numbers, which we keep as strings.
+type ConditionValues []string
+
+func (c *ConditionValues) UnmarshalJSON(b []byte) error {
+	var values []interface{}
+	err := json.Unmarshal(b, &values)
+	if err != nil {
+		var singleValue interface{}
+		newerr := json.Unmarshal(b, &singleValue)
+		if newerr != nil {
+			return newerr
+		}
+		values = []interface{}{singleValue}
+	}
+
+	*c = ConditionValues{}
+	for _, value := range values {
+		switch value.(type) {
+		case string, bool, float64:
+			*c = append(*c, fmt.Sprint(value))
+		default:
+			return fmt.Errorf("unsupported condition value %v", value)
+		}
+	}
+	return nil
+}
+
 type Principal struct {
 	AWS string `json:"AWS"`
 }
@@ -91,6 +143,12 @@ func (ReadWritePermissions) Actions() []string {
 	}
 }
 
+// IsObjectAction reports whether the action applies to objects, rather than
+// the bucket itself.
+func IsObjectAction(action string) bool {
+	return strings.HasPrefix(action, "s3:") && strings.Contains(action, "Object")
+}
+
 func ValidatePermissions(permissionName string) (Permissions, error) {
 	if permissionName == ReadOnlyPermissionsName {
 		return ReadOnlyPermissions{}, nil
@@ -101,14 +159,76 @@ func ValidatePermissions(permissionName string) (Permissions, error) {
 	}
 }
 
-func BuildStatement(bucketName string, iamUser iam.User, permissions Permissions) Statement {
+// IsPublicStatement reports whether the statement grants access to anonymous
+// principals, as added to buckets provisioned with `public_bucket`.
+func IsPublicStatement(stmt Statement) bool {
+	return stmt.Effect == "Allow" && stmt.Principal.AWS == "*"
+}
+
+// BucketARN returns the ARN of a bucket in the given AWS partition, such as
+// "aws".
+func BucketARN(partition, bucketName string) string {
+	return fmt.Sprintf("arn:%s:s3:::%s", partition, bucketName)
+}
+
+func BuildStatement(partition, bucketName string, iamUser iam.User, permissions Permissions) Statement {
 	return Statement{
 		Effect:    "Allow",
 		Principal: Principal{AWS: aws.StringValue(iamUser.Arn)},
 		Resource: []string{
-			fmt.Sprintf("arn:aws:s3:::%s", bucketName),
-			fmt.Sprintf("arn:aws:s3:::%s/*", bucketName),
+			BucketARN(partition, bucketName),
+			BucketARN(partition, bucketName) + "/*",
 		},
 		Action: permissions.Actions(),
 	}
 }
+
+var (
+	ErrInvalidPrefix = errors.New("prefix may only contain letters, digits, and the characters !_.'()/- and must not start with / or contain //")
+
+	validPrefix = regexp.MustCompile(`^[A-Za-z0-9!_.'()-][A-Za-z0-9!_.'()/-]*$`)
+)
+
+// NormalisePrefix validates a binding's key prefix and makes sure it ends
+// with a /, so that a binding for `app` cannot see `app-other/`.
+func NormalisePrefix(prefix string) (string, error) {
+	if !validPrefix.MatchString(prefix) || strings.Contains(prefix, "//") {
+		return "", ErrInvalidPrefix
+	}
+	return strings.TrimSuffix(prefix, "/") + "/", nil
+}
+
+// BuildPrefixStatements is BuildStatement for a binding which may only use
+// keys under prefix, which must have been normalised with NormalisePrefix.
+// Bucket level actions other than listing the prefix are not granted, as
+// they would let the binding affect the whole bucket.
+func BuildPrefixStatements(partition, bucketName string, iamUser iam.User, permissions Permissions, prefix string) []Statement {
+	objectActions := []string{}
+	for _, action := range permissions.Actions() {
+		if IsObjectAction(action) {
+			objectActions = append(objectActions, action)
+		}
+	}
+
+	return []Statement{
+		{
+			Effect:    "Allow",
+			Principal: Principal{AWS: aws.StringValue(iamUser.Arn)},
+			Resource: []string{
+				BucketARN(partition, bucketName),
+			},
+			Action: []string{"s3:ListBucket"},
+			Condition: Condition{
+				"StringLike": {"s3:prefix": {prefix + "*"}},
+			},
+		},
+		{
+			Effect:    "Allow",
+			Principal: Principal{AWS: aws.StringValue(iamUser.Arn)},
+			Resource: []string{
+				BucketARN(partition, bucketName) + "/" + prefix + "*",
+			},
+			Action: objectActions,
+		},
+	}
+}
@@ -13,6 +13,7 @@ import (
 var _ = Describe("StatementBuilder", func() {
 	It("should build a statement that gives read only permissions", func() {
 		actualStatement := policy.BuildStatement(
+			"aws",
 			"some-instance-id",
 			iam.User{Arn: aws.String("some-arn")},
 			policy.ReadOnlyPermissions{})
@@ -33,6 +34,7 @@ var _ = Describe("StatementBuilder", func() {
 
 	It("should build a statement that gives read and write permissions", func() {
 		actualStatement := policy.BuildStatement(
+			"aws",
 			"some-instance-id",
 			iam.User{Arn: aws.String("some-arn")},
 			policy.ReadWritePermissions{})
@@ -53,6 +55,19 @@ var _ = Describe("StatementBuilder", func() {
 			"s3:GetObjectTagging",
 		))
 	})
+
+	It("should build bucket ARNs in the given partition", func() {
+		actualStatement := policy.BuildStatement(
+			"aws-us-gov",
+			"some-instance-id",
+			iam.User{Arn: aws.String("some-arn")},
+			policy.ReadOnlyPermissions{})
+
+		Expect(actualStatement.Resource).To(ConsistOf(
+			"arn:aws-us-gov:s3:::some-instance-id",
+			"arn:aws-us-gov:s3:::some-instance-id/*",
+		))
+	})
 })
 
 var _ = Describe("Statement JSON unmarshaling", func() {
@@ -77,4 +92,97 @@ var _ = Describe("Statement JSON unmarshaling", func() {
 		Expect(statement.Action).To(HaveLen(2))
 		Expect(statement.Action).To(ConsistOf("foo", "bar"))
 	})
+
+	It("unmarshals a single resource, as S3 returns it", func() {
+		bytes := []byte(`{"effect": "allow", "resource": "arn:aws:s3:::some-bucket", "action": "s3:ListBucket"}`)
+		statement := policy.Statement{}
+
+		err := json.Unmarshal(bytes, &statement)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statement.Resource).To(ConsistOf("arn:aws:s3:::some-bucket"))
+	})
+})
+
+var _ = Describe("Prefix scoped statements", func() {
+	It("normalises prefixes to end with a slash", func() {
+		Expect(policy.NormalisePrefix("app")).To(Equal("app/"))
+		Expect(policy.NormalisePrefix("app/")).To(Equal("app/"))
+		Expect(policy.NormalisePrefix("team/app")).To(Equal("team/app/"))
+	})
+
+	DescribeTable("rejects prefixes which could reach outside themselves",
+		func(prefix string) {
+			_, err := policy.NormalisePrefix(prefix)
+			Expect(err).To(MatchError(policy.ErrInvalidPrefix))
+		},
+		Entry("empty", ""),
+		Entry("leading slash", "/app"),
+		Entry("wildcard", "app*"),
+		Entry("single character wildcard", "app?"),
+		Entry("policy variable", "${aws:username}"),
+		Entry("double slash", "app//other"),
+	)
+
+	It("limits listing and object access to the prefix", func() {
+		statements := policy.BuildPrefixStatements(
+			"aws",
+			"some-bucket",
+			iam.User{Arn: aws.String("some-arn")},
+			policy.ReadWritePermissions{},
+			"app/",
+		)
+
+		Expect(statements).To(HaveLen(2))
+		for _, statement := range statements {
+			Expect(statement.Effect).To(Equal("Allow"))
+			Expect(statement.Principal.AWS).To(Equal("some-arn"))
+		}
+
+		Expect(statements[0].Resource).To(ConsistOf("arn:aws:s3:::some-bucket"))
+		Expect(statements[0].Action).To(ConsistOf("s3:ListBucket"))
+		Expect(statements[0].Condition).To(Equal(policy.Condition{
+			"StringLike": {"s3:prefix": {"app/*"}},
+		}))
+
+		Expect(statements[1].Resource).To(ConsistOf("arn:aws:s3:::some-bucket/app/*"))
+		Expect(statements[1].Action).To(ConsistOf(
+			"s3:GetObject",
+			"s3:PutObject",
+			"s3:DeleteObject",
+			"s3:GetObjectTagging",
+		))
+		Expect(statements[1].Condition).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Condition JSON unmarshaling", func() {
+	It("accepts single values, arrays and non-string values", func() {
+		bytes := []byte(`{
+			"Effect": "Allow",
+			"Action": "s3:ListBucket",
+			"Resource": [],
+			"Condition": {
+				"StringLike": {"s3:prefix": ["a/*", "b/*"]},
+				"StringEquals": {"s3:delimiter": "/"},
+				"Bool": {"aws:SecureTransport": false},
+				"NumericLessThanEquals": {"s3:max-keys": 10}
+			}
+		}`)
+		statement := policy.Statement{}
+
+		err := json.Unmarshal(bytes, &statement)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statement.Condition).To(Equal(policy.Condition{
+			"StringLike":            {"s3:prefix": {"a/*", "b/*"}},
+			"StringEquals":          {"s3:delimiter": {"/"}},
+			"Bool":                  {"aws:SecureTransport": {"false"}},
+			"NumericLessThanEquals": {"s3:max-keys": {"10"}},
+		}))
+	})
+
+	It("leaves the condition out when there is none", func() {
+		data, err := json.Marshal(policy.Statement{Effect: "Allow"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).ToNot(ContainSubstring("Condition"))
+	})
 })
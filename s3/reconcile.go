@@ -0,0 +1,655 @@
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// DriftKind is a way in which a bucket, user or role differs from what the
+// broker would have set up.
+type DriftKind string
+
+const (
+	DriftPublicAccessBlock DriftKind = "public-access-block"
+	DriftEncryption        DriftKind = "encryption"
+	DriftTags              DriftKind = "tags"
+	DriftStaleStatement    DriftKind = "stale-statement"
+	DriftUnusedPrincipal   DriftKind = "unused-principal"
+	DriftNeedsCleanup      DriftKind = "needs-cleanup"
+)
+
+const (
+	PrincipalTypeUser = "user"
+	PrincipalTypeRole = "role"
+
+	// unusedPrincipalMinAge stops a user or role being reported as unused
+	// while the bind which created it is still adding it to the bucket.
+	unusedPrincipalMinAge = time.Hour
+)
+
+var ErrDriftNotRepairable = errors.New("this drift cannot be repaired automatically")
+
+// Drift describes one difference found by FindDrift. Bucket is set for
+// drift in a bucket's configuration; Principal for drift in a user or role,
+// or, for a stale statement, the principal the statement names.
+type Drift struct {
+	Kind          DriftKind `json:"kind"`
+	Bucket        string    `json:"bucket,omitempty"`
+	Principal     string    `json:"principal,omitempty"`
+	PrincipalType string    `json:"principal_type,omitempty"`
+	Detail        string    `json:"detail"`
+	Repairable    bool      `json:"repairable"`
+}
+
+type brokerBucket struct {
+	name         string
+	creationDate time.Time
+	tags         []*s3.Tag
+}
+
+type brokerPrincipal struct {
+	name          string
+	principalType string
+	createDate    time.Time
+	tags          []*iam.Tag
+}
+
+// FindDrift compares every bucket tagged `created_by=paas-s3-broker`, other
+// than those soft deleted, and every user and role under the IAM user path
+// with what the broker would have set up. It only reads from AWS.
+func (s *S3Client) FindDrift(ctx context.Context) ([]Drift, error) {
+	logger := s.logger.Session("find-drift")
+
+	allBuckets, err := s.listBrokerBuckets(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+	// Soft deleted buckets are locked down until they are reaped, so they
+	// are bound to look different from a live one.
+	buckets := []brokerBucket{}
+	for _, bucket := range allBuckets {
+		if bucketTagValue(bucket.tags, pendingDeletionTagKey) == "" {
+			buckets = append(buckets, bucket)
+		}
+	}
+	bucketPolicies := map[string]string{}
+	for _, bucket := range buckets {
+		bucketPolicies[bucket.name], err = s.getBucketPolicy(ctx, logger, bucket.name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Users and roles are listed after the policies are read, so that a
+	// binding made in between cannot look like a stale statement.
+	principals, err := s.listBrokerPrincipals(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+	principalNames := map[string]bool{}
+	for _, principal := range principals {
+		principalNames[principal.name] = true
+	}
+
+	drifts := []Drift{}
+	for _, bucket := range buckets {
+		bucketDrifts, err := s.findBucketDrift(ctx, logger, bucket.name, bucket.tags, bucketPolicies[bucket.name], principalNames)
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, bucketDrifts...)
+	}
+
+	now := time.Now()
+	for _, principal := range principals {
+		cleanupTime := tagValue(principal.tags, NeedsCleanupTagKey)
+		if cleanupTime != "" {
+			drift := Drift{
+				Kind:          DriftNeedsCleanup,
+				Principal:     principal.name,
+				PrincipalType: principal.principalType,
+				Detail:        fmt.Sprintf("rolling back a failed bind left this %s behind at %s", principal.principalType, cleanupTime),
+				Repairable:    true,
+			}
+			instanceID := tagValue(principal.tags, "service_instance_guid")
+			if instanceID != "" {
+				drift.Bucket = s.buildBucketName(instanceID)
+			}
+			drifts = append(drifts, drift)
+			continue
+		}
+
+		if now.Sub(principal.createDate) < unusedPrincipalMinAge {
+			continue
+		}
+		used := false
+		for _, bucketPolicy := range bucketPolicies {
+			used, err = policy.HasStatementsForUser(bucketPolicy, "/"+principal.name)
+			if err != nil {
+				logger.Error("check-policy-statements", err, lager.Data{"principal": principal.name})
+				return nil, err
+			}
+			if used {
+				break
+			}
+		}
+		if !used {
+			drifts = append(drifts, Drift{
+				Kind:          DriftUnusedPrincipal,
+				Principal:     principal.name,
+				PrincipalType: principal.principalType,
+				Detail:        fmt.Sprintf("no bucket policy grants this %s access", principal.principalType),
+				Repairable:    true,
+			})
+		}
+	}
+
+	return drifts, nil
+}
+
+func (s *S3Client) findBucketDrift(
+	ctx context.Context,
+	logger lager.Logger,
+	bucketName string,
+	tags []*s3.Tag,
+	bucketPolicy string,
+	principalNames map[string]bool,
+) ([]Drift, error) {
+	drifts := []Drift{}
+	instanceID := strings.TrimPrefix(bucketName, s.bucketPrefix)
+
+	cleanupTime := bucketTagValue(tags, NeedsCleanupTagKey)
+	if cleanupTime != "" {
+		drifts = append(drifts, Drift{
+			Kind:   DriftNeedsCleanup,
+			Bucket: bucketName,
+			Detail: fmt.Sprintf("rolling back a failed provision left this bucket behind at %s", cleanupTime),
+		})
+	}
+
+	isPublic, err := policy.HasPublicStatement(bucketPolicy)
+	if err != nil {
+		logger.Error("check-public-statement", err, lager.Data{"bucket": bucketName})
+		return nil, err
+	}
+	if !isPublic {
+		blocked, err := s.publicAccessBlocked(ctx, logger, bucketName)
+		if err != nil {
+			return nil, err
+		}
+		if !blocked {
+			drifts = append(drifts, Drift{
+				Kind:       DriftPublicAccessBlock,
+				Bucket:     bucketName,
+				Detail:     "public access is not fully blocked, but the bucket is not public",
+				Repairable: true,
+			})
+		}
+	}
+
+	encrypted, err := s.encryptedByDefault(ctx, logger, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	if !encrypted {
+		useKMS, _, known, err := s.encryptionToRestore(ctx, logger, bucketName, tags)
+		if err != nil {
+			return nil, err
+		}
+		drift := Drift{
+			Kind:       DriftEncryption,
+			Bucket:     bucketName,
+			Detail:     "default encryption is not configured",
+			Repairable: known,
+		}
+		if useKMS {
+			drift.Detail = "default encryption is not configured, and the bucket uses SSE-KMS"
+		} else if !known {
+			drift.Detail = "default encryption is not configured, and the bucket may have used the operator's KMS key"
+		}
+		drifts = append(drifts, drift)
+	}
+
+	tagProblems, repairable := s.checkBucketTags(instanceID, tags)
+	if len(tagProblems) > 0 {
+		drifts = append(drifts, Drift{
+			Kind:       DriftTags,
+			Bucket:     bucketName,
+			Detail:     strings.Join(tagProblems, "; "),
+			Repairable: repairable,
+		})
+	}
+
+	staleStatements, err := s.findStaleStatements(bucketPolicy, principalNames)
+	if err != nil {
+		logger.Error("find-stale-statements", err, lager.Data{"bucket": bucketName})
+		return nil, err
+	}
+	for _, principal := range staleStatements {
+		detail := "statement names a user or role which no longer exists"
+		if !strings.HasPrefix(principal, "arn:") {
+			detail = "statement names the unique ID of a deleted user or role"
+		}
+		drifts = append(drifts, Drift{
+			Kind:       DriftStaleStatement,
+			Bucket:     bucketName,
+			Principal:  principal,
+			Detail:     detail,
+			Repairable: true,
+		})
+	}
+
+	return drifts, nil
+}
+
+// RepairDrift puts right one difference found by FindDrift. Anything it
+// would change is read again first, so it is safe to use on drift found a
+// while ago.
+func (s *S3Client) RepairDrift(ctx context.Context, drift Drift) error {
+	logger := s.logger.Session("repair-drift", lager.Data{"kind": drift.Kind, "bucket": drift.Bucket, "principal": drift.Principal})
+	if !drift.Repairable {
+		return ErrDriftNotRepairable
+	}
+
+	switch drift.Kind {
+	case DriftPublicAccessBlock:
+		bucketPolicy, err := s.getBucketPolicy(ctx, logger, drift.Bucket)
+		if err != nil {
+			return err
+		}
+		isPublic, err := policy.HasPublicStatement(bucketPolicy)
+		if err != nil {
+			return err
+		}
+		if isPublic {
+			return fmt.Errorf("bucket %s has been made public since the drift was found", drift.Bucket)
+		}
+		logger.Info("put-public-access-block")
+		return s.putPublicAccessBlock(ctx, drift.Bucket)
+
+	case DriftEncryption:
+		tags, err := s.getBucketTags(ctx, logger, drift.Bucket)
+		if err != nil {
+			return err
+		}
+		useKMS, kmsKeyARN, known, err := s.encryptionToRestore(ctx, logger, drift.Bucket, tags)
+		if err != nil {
+			return err
+		}
+		if !known {
+			return ErrDriftNotRepairable
+		}
+		if useKMS && kmsKeyARN == "" {
+			kmsKeyARN, err = s.getOrCreateKMSKey(ctx, logger, drift.Bucket, tags)
+			if err != nil {
+				return err
+			}
+		}
+		return s.putBucketEncryption(ctx, logger, drift.Bucket, kmsKeyARN)
+
+	case DriftTags:
+		instanceID := strings.TrimPrefix(drift.Bucket, s.bucketPrefix)
+		tags, err := s.getBucketTags(ctx, logger, drift.Bucket)
+		if err != nil {
+			return err
+		}
+		logger.Info("tag-bucket")
+		_, err = s.tagBucket(ctx, instanceID, mergeTags(tags, s.knownBucketTags(instanceID)))
+		return err
+
+	case DriftStaleStatement:
+		exists, err := s.principalExists(ctx, logger, drift.Principal)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("%s has been created since the drift was found", drift.Principal)
+		}
+		_, err = s.revokeBucketAccess(ctx, logger, drift.Bucket, drift.Principal)
+		return err
+
+	case DriftUnusedPrincipal, DriftNeedsCleanup:
+		if drift.Principal == "" {
+			return ErrDriftNotRepairable
+		}
+		return s.deletePrincipal(ctx, logger, drift.Bucket, drift.Principal, drift.PrincipalType)
+	}
+
+	return ErrDriftNotRepairable
+}
+
+// deletePrincipal removes a binding's user or role from the bucket policy,
+// if the bucket is known and still exists, and deletes it.
+func (s *S3Client) deletePrincipal(ctx context.Context, logger lager.Logger, bucketName, name, principalType string) error {
+	if bucketName != "" {
+		_, err := s.revokeBucketAccess(ctx, logger, bucketName, "/"+name)
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucket" {
+			err = nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if principalType == PrincipalTypeRole {
+		logger.Info("delete-role", lager.Data{"role": name})
+		return ignoreNoSuchResources(s.deleteRole(ctx, name))
+	}
+	logger.Info("delete-user", lager.Data{"user": name})
+	return ignoreNoSuchResources(s.deleteUser(ctx, name))
+}
+
+// principalExists reports whether the user or role named by a bucket policy
+// statement's principal exists. A principal which is not an ARN is the
+// unique ID of one which has been deleted.
+func (s *S3Client) principalExists(ctx context.Context, logger lager.Logger, principal string) (bool, error) {
+	if !strings.HasPrefix(principal, "arn:") {
+		return false, nil
+	}
+	name := principal[strings.LastIndex(principal, "/")+1:]
+
+	if strings.Contains(principal, ":role/") {
+		logger.Info("get-role", lager.Data{"role": name})
+		_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.GetRoleOutput, error) {
+			return s.iamClient.GetRoleWithContext(ctx, &iam.GetRoleInput{
+				RoleName: aws.String(name),
+			})
+		})
+		if err != nil {
+			if !isIAMUserNotFound(err) {
+				return false, nil
+			}
+			logger.Error("get-role", err)
+			return false, err
+		}
+		return true, nil
+	}
+
+	_, err := s.listUserTags(ctx, logger, name)
+	if err != nil {
+		if err == ErrNoSuchResources {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// listBrokerBuckets returns every bucket with the broker's prefix which the
+// broker has finished creating.
+func (s *S3Client) listBrokerBuckets(ctx context.Context, logger lager.Logger) ([]brokerBucket, error) {
+	logger.Info("list-buckets")
+	listBucketsOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.ListBucketsOutput, error) {
+		return s.s3Client.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
+	})
+	if err != nil {
+		logger.Error("list-buckets", err)
+		return nil, err
+	}
+
+	buckets := []brokerBucket{}
+	for _, bucket := range listBucketsOutput.Buckets {
+		bucketName := aws.StringValue(bucket.Name)
+		if !strings.HasPrefix(bucketName, s.bucketPrefix) {
+			continue
+		}
+		tags, err := s.getBucketTags(ctx, logger, bucketName)
+		if err != nil {
+			if err == ErrNoSuchResources {
+				continue
+			}
+			return nil, err
+		}
+		if bucketTagValue(tags, "created_by") != "paas-s3-broker" {
+			continue
+		}
+		buckets = append(buckets, brokerBucket{
+			name:         bucketName,
+			creationDate: aws.TimeValue(bucket.CreationDate),
+			tags:         tags,
+		})
+	}
+	return buckets, nil
+}
+
+// listBrokerPrincipals returns the users, and roles if assume_role
+// credentials are enabled, under the IAM user path.
+func (s *S3Client) listBrokerPrincipals(ctx context.Context, logger lager.Logger) ([]brokerPrincipal, error) {
+	principals := []brokerPrincipal{}
+
+	logger.Info("list-users", lager.Data{"path": s.iamUserPath})
+	var users []*iam.User
+	err := s.retryAWSError(ctx, logger, func(ctx context.Context) error {
+		users = nil
+		return s.iamClient.ListUsersPagesWithContext(ctx, &iam.ListUsersInput{
+			PathPrefix: aws.String(s.iamUserPath),
+		}, func(page *iam.ListUsersOutput, lastPage bool) bool {
+			users = append(users, page.Users...)
+			return true
+		})
+	})
+	if err != nil {
+		logger.Error("list-users", err)
+		return nil, err
+	}
+	for _, user := range users {
+		username := aws.StringValue(user.UserName)
+		tags, err := s.listUserTags(ctx, logger, username)
+		if err != nil {
+			if err == ErrNoSuchResources {
+				continue
+			}
+			return nil, err
+		}
+		principals = append(principals, brokerPrincipal{
+			name:          username,
+			principalType: PrincipalTypeUser,
+			createDate:    aws.TimeValue(user.CreateDate),
+			tags:          tags,
+		})
+	}
+
+	if !s.assumeRoleEnabled() {
+		return principals, nil
+	}
+
+	logger.Info("list-roles", lager.Data{"path": s.iamUserPath})
+	var roles []*iam.Role
+	err = s.retryAWSError(ctx, logger, func(ctx context.Context) error {
+		roles = nil
+		return s.iamClient.ListRolesPagesWithContext(ctx, &iam.ListRolesInput{
+			PathPrefix: aws.String(s.iamUserPath),
+		}, func(page *iam.ListRolesOutput, lastPage bool) bool {
+			roles = append(roles, page.Roles...)
+			return true
+		})
+	})
+	if err != nil {
+		logger.Error("list-roles", err)
+		return nil, err
+	}
+	for _, role := range roles {
+		roleName := aws.StringValue(role.RoleName)
+		logger.Info("list-role-tags", lager.Data{"role": roleName})
+		listRoleTagsOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.ListRoleTagsOutput, error) {
+			return s.iamClient.ListRoleTagsWithContext(ctx, &iam.ListRoleTagsInput{
+				RoleName: aws.String(roleName),
+			})
+		})
+		if err != nil {
+			if !isIAMUserNotFound(err) {
+				continue
+			}
+			logger.Error("list-role-tags", err)
+			return nil, err
+		}
+		principals = append(principals, brokerPrincipal{
+			name:          roleName,
+			principalType: PrincipalTypeRole,
+			createDate:    aws.TimeValue(role.CreateDate),
+			tags:          listRoleTagsOutput.Tags,
+		})
+	}
+	return principals, nil
+}
+
+// publicAccessBlocked reports whether all four public access block settings
+// are on, as CreateBucket leaves them.
+func (s *S3Client) publicAccessBlocked(ctx context.Context, logger lager.Logger, bucketName string) (bool, error) {
+	logger.Info("get-public-access-block", lager.Data{"bucket": bucketName})
+	output, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.GetPublicAccessBlockOutput, error) {
+		return s.s3Client.GetPublicAccessBlockWithContext(ctx, &s3.GetPublicAccessBlockInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchPublicAccessBlockConfiguration" {
+			return false, nil
+		}
+		logger.Error("get-public-access-block", err)
+		return false, err
+	}
+
+	config := output.PublicAccessBlockConfiguration
+	return config != nil &&
+		aws.BoolValue(config.BlockPublicAcls) &&
+		aws.BoolValue(config.BlockPublicPolicy) &&
+		aws.BoolValue(config.IgnorePublicAcls) &&
+		aws.BoolValue(config.RestrictPublicBuckets), nil
+}
+
+func (s *S3Client) encryptedByDefault(ctx context.Context, logger lager.Logger, bucketName string) (bool, error) {
+	logger.Info("get-bucket-encryption", lager.Data{"bucket": bucketName})
+	output, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.GetBucketEncryptionOutput, error) {
+		return s.s3Client.GetBucketEncryptionWithContext(ctx, &s3.GetBucketEncryptionInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ServerSideEncryptionConfigurationNotFoundError" {
+			return false, nil
+		}
+		logger.Error("get-bucket-encryption", err)
+		return false, err
+	}
+	if output.ServerSideEncryptionConfiguration == nil {
+		return false, nil
+	}
+
+	for _, rule := range output.ServerSideEncryptionConfiguration.Rules {
+		sse := rule.ApplyServerSideEncryptionByDefault
+		if sse != nil && aws.StringValue(sse.SSEAlgorithm) != "" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// encryptionToRestore works out how a bucket which has lost its default
+// encryption was encrypted. It used SSE-KMS if the broker created a key for
+// it, whose ARN is returned, or if its plan requires SSE-KMS. Otherwise it
+// used SSE-S3, unless the operator has a key of their own: a tenant who
+// asked for SSE-KMS on another plan would have been given that key, which
+// leaves nothing behind to tell, so the encryption is not known.
+func (s *S3Client) encryptionToRestore(ctx context.Context, logger lager.Logger, bucketName string, tags []*s3.Tag) (useKMS bool, kmsKeyARN string, known bool, err error) {
+	keyMetadata, err := s.describeBucketKMSKey(ctx, logger, bucketName)
+	if err != nil {
+		return false, "", false, err
+	}
+	if keyMetadata != nil {
+		return true, aws.StringValue(keyMetadata.Arn), true, nil
+	}
+	if containsString(s.kmsPlanIDs, bucketTagValue(tags, "plan_guid")) {
+		return true, "", true, nil
+	}
+	return false, "", s.kmsKeyARN == "", nil
+}
+
+// knownBucketTags are the tags from buildBucketTags whose values can be
+// worked out from the bucket name alone.
+func (s *S3Client) knownBucketTags(instanceID string) []*s3.Tag {
+	return []*s3.Tag{
+		{Key: aws.String("service_instance_guid"), Value: aws.String(instanceID)},
+		{Key: aws.String("created_by"), Value: aws.String("paas-s3-broker")},
+		{Key: aws.String("deploy_env"), Value: aws.String(s.deployEnvironment)},
+		{Key: aws.String("chargeable_entity"), Value: aws.String(instanceID)},
+	}
+}
+
+// checkBucketTags lists the ways the bucket's tags differ from
+// buildBucketTags. They can be repaired if only known tags are wrong.
+func (s *S3Client) checkBucketTags(instanceID string, tags []*s3.Tag) (problems []string, repairable bool) {
+	repairable = true
+	known := map[string]bool{}
+	for _, tag := range s.knownBucketTags(instanceID) {
+		key := aws.StringValue(tag.Key)
+		known[key] = true
+		value := bucketTagValue(tags, key)
+		if value != aws.StringValue(tag.Value) {
+			problems = append(problems, fmt.Sprintf("%s is %q, expected %q", key, value, aws.StringValue(tag.Value)))
+		}
+	}
+	for _, tag := range s.buildBucketTags(instanceID, "", "", "") {
+		key := aws.StringValue(tag.Key)
+		if !known[key] && bucketTagValue(tags, key) == "" {
+			problems = append(problems, fmt.Sprintf("%s is missing", key))
+			repairable = false
+		}
+	}
+	return problems, repairable
+}
+
+// findStaleStatements returns the principals of statements in the policy
+// which name a user or role under the IAM user path which no longer exists.
+// IAM replaces the ARN of a deleted principal with its unique ID, so
+// statements naming anything other than an ARN or `*` are stale too.
+func (s *S3Client) findStaleStatements(bucketPolicy string, principalNames map[string]bool) ([]string, error) {
+	if bucketPolicy == "" {
+		return nil, nil
+	}
+	policyDoc := policy.PolicyDocument{}
+	err := json.Unmarshal([]byte(bucketPolicy), &policyDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	stale := []string{}
+	seen := map[string]bool{}
+	for _, stmt := range policyDoc.Statement {
+		principal := stmt.Principal.AWS
+		if principal == "" || principal == "*" || seen[principal] {
+			continue
+		}
+		if strings.HasPrefix(principal, "arn:") {
+			if !strings.Contains(principal, ":user"+s.iamUserPath) && !strings.Contains(principal, ":role"+s.iamUserPath) {
+				continue
+			}
+			name := principal[strings.LastIndex(principal, "/")+1:]
+			if principalNames[name] {
+				continue
+			}
+		}
+		seen[principal] = true
+		stale = append(stale, principal)
+	}
+	return stale, nil
+}
+
+func bucketTagValue(tags []*s3.Tag, key string) string {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == key {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,544 @@
+package s3_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reconciling", func() {
+	const (
+		bucketName = "test-bucket-prefix-test-instance-id"
+		username   = "test-bucket-prefix-test-binding-id"
+		userARN    = "arn:aws:iam::123456789012:user/test-iam-path/" + username
+	)
+
+	var (
+		s3API          *fakeClient.FakeS3API
+		iamAPI         *fakeClient.FakeIAMAPI
+		kmsAPI         *fakeClient.FakeKMSAPI
+		s3ClientConfig *s3.Config
+		s3Client       *s3.S3Client
+
+		bucketTags []*awsS3.Tag
+		users      []*iam.User
+	)
+
+	tag := func(key, value string) *awsS3.Tag {
+		return &awsS3.Tag{Key: aws.String(key), Value: aws.String(value)}
+	}
+
+	setBucketPolicy := func(principals ...string) {
+		statements := []policy.Statement{}
+		for _, principal := range principals {
+			statements = append(statements, policy.BuildStatement("aws", bucketName, iam.User{Arn: aws.String(principal)}, policy.ReadWritePermissions{}))
+		}
+		policyJSON, err := json.Marshal(policy.PolicyDocument{Version: "2012-10-17", Statement: statements})
+		Expect(err).NotTo(HaveOccurred())
+		s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{Policy: aws.String(string(policyJSON))}, nil)
+	}
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
+		s3ClientConfig = &s3.Config{
+			AWSRegion:         "eu-west-2",
+			ResourcePrefix:    "test-bucket-prefix-",
+			IAMUserPath:       "/test-iam-path/",
+			DeployEnvironment: "test-env",
+			KMSPlanIDs:        []string{"kms-plan-id"},
+		}
+
+		bucketTags = []*awsS3.Tag{
+			tag("service_instance_guid", "test-instance-id"),
+			tag("org_guid", "test-org-guid"),
+			tag("space_guid", "test-space-guid"),
+			tag("created_by", "paas-s3-broker"),
+			tag("plan_guid", "test-plan-id"),
+			tag("deploy_env", "test-env"),
+			tag("tenant", "test-org-guid"),
+			tag("chargeable_entity", "test-instance-id"),
+		}
+		users = []*iam.User{{
+			UserName:   aws.String(username),
+			Arn:        aws.String(userARN),
+			CreateDate: aws.Time(time.Now().Add(-2 * time.Hour)),
+		}}
+
+		s3API.ListBucketsWithContextReturns(&awsS3.ListBucketsOutput{
+			Buckets: []*awsS3.Bucket{{Name: aws.String(bucketName)}},
+		}, nil)
+		s3API.GetBucketTaggingWithContextStub = func(context.Context, *awsS3.GetBucketTaggingInput, ...request.Option) (*awsS3.GetBucketTaggingOutput, error) {
+			return &awsS3.GetBucketTaggingOutput{TagSet: bucketTags}, nil
+		}
+		s3API.GetPublicAccessBlockWithContextReturns(&awsS3.GetPublicAccessBlockOutput{
+			PublicAccessBlockConfiguration: &awsS3.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(true),
+				BlockPublicPolicy:     aws.Bool(true),
+				IgnorePublicAcls:      aws.Bool(true),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+		}, nil)
+		s3API.GetBucketEncryptionWithContextReturns(&awsS3.GetBucketEncryptionOutput{
+			ServerSideEncryptionConfiguration: &awsS3.ServerSideEncryptionConfiguration{
+				Rules: []*awsS3.ServerSideEncryptionRule{{
+					ApplyServerSideEncryptionByDefault: &awsS3.ServerSideEncryptionByDefault{
+						SSEAlgorithm: aws.String(awsS3.ServerSideEncryptionAes256),
+					},
+				}},
+			},
+		}, nil)
+		setBucketPolicy(userARN)
+
+		iamAPI.ListUsersPagesWithContextStub = func(_ context.Context, _ *iam.ListUsersInput, fn func(*iam.ListUsersOutput, bool) bool, _ ...request.Option) error {
+			fn(&iam.ListUsersOutput{Users: users}, true)
+			return nil
+		}
+		iamAPI.ListUserTagsWithContextReturns(&iam.ListUserTagsOutput{}, nil)
+		kmsAPI.DescribeKeyWithContextReturns(nil, awserr.New(kms.ErrCodeNotFoundException, "Alias not found", nil))
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			s3ClientConfig,
+			s3API,
+			iamAPI,
+			kmsAPI,
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	Describe("FindDrift", func() {
+		It("finds no drift when everything is as the broker set it up", func() {
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(BeEmpty())
+
+			_, listUsersInput, _, _ := iamAPI.ListUsersPagesWithContextArgsForCall(0)
+			Expect(listUsersInput.PathPrefix).To(Equal(aws.String("/test-iam-path/")))
+			Expect(iamAPI.ListRolesPagesWithContextCallCount()).To(Equal(0))
+		})
+
+		It("ignores buckets which do not have the prefix or the created_by tag", func() {
+			s3API.ListBucketsWithContextReturns(&awsS3.ListBucketsOutput{
+				Buckets: []*awsS3.Bucket{
+					{Name: aws.String("someone-elses-bucket")},
+					{Name: aws.String(bucketName)},
+				},
+			}, nil)
+			bucketTags = []*awsS3.Tag{tag("created_by", "someone-else")}
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s3API.GetBucketTaggingWithContextCallCount()).To(Equal(1))
+			Expect(s3API.GetPublicAccessBlockWithContextCallCount()).To(Equal(0))
+			// The user's statement was not looked at, so it seems unused.
+			Expect(drifts).To(ConsistOf(HaveField("Kind", s3.DriftUnusedPrincipal)))
+		})
+
+		It("ignores soft deleted buckets, which are locked down until they are reaped", func() {
+			bucketTags = append(bucketTags, tag("pending_deletion", time.Now().UTC().Format(time.RFC3339)))
+			s3API.GetPublicAccessBlockWithContextReturns(nil, awserr.New("AccessDenied", "Access Denied", nil))
+			s3API.GetBucketEncryptionWithContextReturns(nil, awserr.New("AccessDenied", "Access Denied", nil))
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).NotTo(ContainElement(HaveField("Bucket", bucketName)))
+			Expect(s3API.GetBucketPolicyWithContextCallCount()).To(Equal(0))
+			Expect(s3API.GetPublicAccessBlockWithContextCallCount()).To(Equal(0))
+			Expect(s3API.GetBucketEncryptionWithContextCallCount()).To(Equal(0))
+		})
+
+		It("reports public access which is not blocked", func() {
+			s3API.GetPublicAccessBlockWithContextReturns(nil, awserr.New("NoSuchPublicAccessBlockConfiguration", "The public access block configuration was not found", nil))
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(ConsistOf(s3.Drift{
+				Kind:       s3.DriftPublicAccessBlock,
+				Bucket:     bucketName,
+				Detail:     "public access is not fully blocked, but the bucket is not public",
+				Repairable: true,
+			}))
+		})
+
+		It("does not expect public buckets to block public access", func() {
+			setBucketPolicy(userARN, "*")
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(BeEmpty())
+			Expect(s3API.GetPublicAccessBlockWithContextCallCount()).To(Equal(0))
+		})
+
+		It("reports buckets without default encryption", func() {
+			s3API.GetBucketEncryptionWithContextReturns(nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found", nil))
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(ConsistOf(And(
+				HaveField("Kind", s3.DriftEncryption),
+				HaveField("Repairable", true),
+			)))
+		})
+
+		It("reports buckets whose plan requires SSE-KMS as needing it again", func() {
+			s3API.GetBucketEncryptionWithContextReturns(nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found", nil))
+			bucketTags[4] = tag("plan_guid", "kms-plan-id")
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(ConsistOf(And(
+				HaveField("Kind", s3.DriftEncryption),
+				HaveField("Detail", ContainSubstring("SSE-KMS")),
+				HaveField("Repairable", true),
+			)))
+		})
+
+		It("reports buckets with a key of their own as needing SSE-KMS again", func() {
+			s3API.GetBucketEncryptionWithContextReturns(nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found", nil))
+			kmsAPI.DescribeKeyWithContextReturns(&kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{
+				Arn:   aws.String("arn:aws:kms:eu-west-2:123456789012:key/bucket-key"),
+				KeyId: aws.String("bucket-key"),
+			}}, nil)
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(ConsistOf(And(
+				HaveField("Kind", s3.DriftEncryption),
+				HaveField("Detail", ContainSubstring("SSE-KMS")),
+				HaveField("Repairable", true),
+			)))
+			Expect(inputOf(kmsAPI.DescribeKeyWithContextArgsForCall(0)).KeyId).To(HaveValue(Equal("alias/" + bucketName)))
+		})
+
+		Context("when the operator has a KMS key", func() {
+			BeforeEach(func() {
+				s3ClientConfig.KMSKeyARN = "arn:aws:kms:eu-west-2:123456789012:key/operator-key"
+			})
+
+			It("does not offer to repair buckets which may have used it", func() {
+				s3API.GetBucketEncryptionWithContextReturns(nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found", nil))
+
+				drifts, err := s3Client.FindDrift(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(drifts).To(ConsistOf(And(
+					HaveField("Kind", s3.DriftEncryption),
+					HaveField("Repairable", false),
+				)))
+			})
+		})
+
+		It("reports wrong tags, which can be repaired if their values are known", func() {
+			bucketTags[5] = tag("deploy_env", "other-env")
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(ConsistOf(s3.Drift{
+				Kind:       s3.DriftTags,
+				Bucket:     bucketName,
+				Detail:     `deploy_env is "other-env", expected "test-env"`,
+				Repairable: true,
+			}))
+		})
+
+		It("reports missing tags which cannot be worked out", func() {
+			bucketTags = append(bucketTags[:1], bucketTags[2:]...)
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(ConsistOf(s3.Drift{
+				Kind:       s3.DriftTags,
+				Bucket:     bucketName,
+				Detail:     "org_guid is missing",
+				Repairable: false,
+			}))
+		})
+
+		It("reports statements for users which no longer exist", func() {
+			setBucketPolicy(
+				userARN,
+				"arn:aws:iam::123456789012:user/test-iam-path/test-bucket-prefix-deleted-binding-id",
+				"AIDAEXAMPLEUNIQUEID",
+				"arn:aws:iam::123456789012:user/someone-else",
+			)
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(ConsistOf(
+				s3.Drift{
+					Kind:       s3.DriftStaleStatement,
+					Bucket:     bucketName,
+					Principal:  "arn:aws:iam::123456789012:user/test-iam-path/test-bucket-prefix-deleted-binding-id",
+					Detail:     "statement names a user or role which no longer exists",
+					Repairable: true,
+				},
+				s3.Drift{
+					Kind:       s3.DriftStaleStatement,
+					Bucket:     bucketName,
+					Principal:  "AIDAEXAMPLEUNIQUEID",
+					Detail:     "statement names the unique ID of a deleted user or role",
+					Repairable: true,
+				},
+			))
+		})
+
+		It("reports users which no bucket policy mentions", func() {
+			s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(ConsistOf(s3.Drift{
+				Kind:          s3.DriftUnusedPrincipal,
+				Principal:     username,
+				PrincipalType: s3.PrincipalTypeUser,
+				Detail:        "no bucket policy grants this user access",
+				Repairable:    true,
+			}))
+		})
+
+		It("does not report users which might still be being bound", func() {
+			s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+			users[0].CreateDate = aws.Time(time.Now().Add(-time.Minute))
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(BeEmpty())
+		})
+
+		It("reports users which a failed bind left behind", func() {
+			iamAPI.ListUserTagsWithContextReturns(&iam.ListUserTagsOutput{
+				Tags: []*iam.Tag{
+					{Key: aws.String("service_instance_guid"), Value: aws.String("test-instance-id")},
+					{Key: aws.String(s3.NeedsCleanupTagKey), Value: aws.String("2024-01-02T03:04:05Z")},
+				},
+			}, nil)
+
+			drifts, err := s3Client.FindDrift(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(ConsistOf(s3.Drift{
+				Kind:          s3.DriftNeedsCleanup,
+				Bucket:        bucketName,
+				Principal:     username,
+				PrincipalType: s3.PrincipalTypeUser,
+				Detail:        "rolling back a failed bind left this user behind at 2024-01-02T03:04:05Z",
+				Repairable:    true,
+			}))
+		})
+
+		Context("when assume_role credentials are enabled", func() {
+			BeforeEach(func() {
+				s3ClientConfig.AssumeRolePrincipalARN = "arn:aws:iam::123456789012:user/shared-principal"
+			})
+
+			It("lists roles too", func() {
+				iamAPI.ListRolesPagesWithContextStub = func(_ context.Context, _ *iam.ListRolesInput, fn func(*iam.ListRolesOutput, bool) bool, _ ...request.Option) error {
+					fn(&iam.ListRolesOutput{Roles: []*iam.Role{{
+						RoleName:   aws.String("test-bucket-prefix-role-binding-id"),
+						CreateDate: aws.Time(time.Now().Add(-2 * time.Hour)),
+					}}}, true)
+					return nil
+				}
+				iamAPI.ListRoleTagsWithContextReturns(&iam.ListRoleTagsOutput{}, nil)
+
+				drifts, err := s3Client.FindDrift(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(drifts).To(ConsistOf(And(
+					HaveField("Kind", s3.DriftUnusedPrincipal),
+					HaveField("Principal", "test-bucket-prefix-role-binding-id"),
+					HaveField("PrincipalType", s3.PrincipalTypeRole),
+				)))
+			})
+		})
+
+		It("returns errors from AWS", func() {
+			s3API.ListBucketsWithContextReturns(nil, errors.New("list-buckets-failed"))
+
+			_, err := s3Client.FindDrift(context.Background())
+			Expect(err).To(MatchError("list-buckets-failed"))
+		})
+	})
+
+	Describe("RepairDrift", func() {
+		BeforeEach(func() {
+			storeBucketPolicies(s3API)
+		})
+
+		It("blocks public access", func() {
+			err := s3Client.RepairDrift(context.Background(), s3.Drift{
+				Kind:       s3.DriftPublicAccessBlock,
+				Bucket:     bucketName,
+				Repairable: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s3API.PutPublicAccessBlockWithContextCallCount()).To(Equal(1))
+			input := inputOf(s3API.PutPublicAccessBlockWithContextArgsForCall(0))
+			Expect(input.Bucket).To(Equal(aws.String(bucketName)))
+			Expect(input.PublicAccessBlockConfiguration.BlockPublicPolicy).To(Equal(aws.Bool(true)))
+		})
+
+		It("does not block public access to a bucket which has been made public since", func() {
+			setBucketPolicy("*")
+
+			err := s3Client.RepairDrift(context.Background(), s3.Drift{
+				Kind:       s3.DriftPublicAccessBlock,
+				Bucket:     bucketName,
+				Repairable: true,
+			})
+			Expect(err).To(MatchError(ContainSubstring("has been made public")))
+			Expect(s3API.PutPublicAccessBlockWithContextCallCount()).To(Equal(0))
+		})
+
+		It("turns on SSE-S3 default encryption", func() {
+			err := s3Client.RepairDrift(context.Background(), s3.Drift{
+				Kind:       s3.DriftEncryption,
+				Bucket:     bucketName,
+				Repairable: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			input := inputOf(s3API.PutBucketEncryptionWithContextArgsForCall(0))
+			Expect(input.ServerSideEncryptionConfiguration.Rules[0].ApplyServerSideEncryptionByDefault.SSEAlgorithm).To(Equal(aws.String("AES256")))
+			Expect(kmsAPI.CreateKeyWithContextCallCount()).To(Equal(0))
+		})
+
+		It("turns SSE-KMS back on with the bucket's own key", func() {
+			kmsAPI.DescribeKeyWithContextReturns(&kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{
+				Arn:   aws.String("arn:aws:kms:eu-west-2:123456789012:key/bucket-key"),
+				KeyId: aws.String("bucket-key"),
+			}}, nil)
+
+			err := s3Client.RepairDrift(context.Background(), s3.Drift{
+				Kind:       s3.DriftEncryption,
+				Bucket:     bucketName,
+				Repairable: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			rule := inputOf(s3API.PutBucketEncryptionWithContextArgsForCall(0)).ServerSideEncryptionConfiguration.Rules[0]
+			Expect(rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm).To(HaveValue(Equal("aws:kms")))
+			Expect(rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID).To(HaveValue(Equal("arn:aws:kms:eu-west-2:123456789012:key/bucket-key")))
+			Expect(rule.BucketKeyEnabled).To(HaveValue(BeTrue()))
+			Expect(kmsAPI.CreateKeyWithContextCallCount()).To(Equal(0))
+		})
+
+		It("creates a key for a bucket whose plan requires SSE-KMS if it has lost its own", func() {
+			bucketTags[4] = tag("plan_guid", "kms-plan-id")
+			kmsAPI.CreateKeyWithContextReturns(&kms.CreateKeyOutput{KeyMetadata: &kms.KeyMetadata{
+				Arn:   aws.String("arn:aws:kms:eu-west-2:123456789012:key/new-key"),
+				KeyId: aws.String("new-key"),
+			}}, nil)
+
+			err := s3Client.RepairDrift(context.Background(), s3.Drift{
+				Kind:       s3.DriftEncryption,
+				Bucket:     bucketName,
+				Repairable: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputOf(kmsAPI.CreateAliasWithContextArgsForCall(0)).AliasName).To(HaveValue(Equal("alias/" + bucketName)))
+			rule := inputOf(s3API.PutBucketEncryptionWithContextArgsForCall(0)).ServerSideEncryptionConfiguration.Rules[0]
+			Expect(rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID).To(HaveValue(Equal("arn:aws:kms:eu-west-2:123456789012:key/new-key")))
+		})
+
+		Context("when the operator has a KMS key", func() {
+			BeforeEach(func() {
+				s3ClientConfig.KMSKeyARN = "arn:aws:kms:eu-west-2:123456789012:key/operator-key"
+			})
+
+			It("does not guess whether a bucket used it", func() {
+				err := s3Client.RepairDrift(context.Background(), s3.Drift{
+					Kind:       s3.DriftEncryption,
+					Bucket:     bucketName,
+					Repairable: true,
+				})
+				Expect(err).To(Equal(s3.ErrDriftNotRepairable))
+				Expect(s3API.PutBucketEncryptionWithContextCallCount()).To(Equal(0))
+			})
+		})
+
+		It("corrects the tags it knows the values of, keeping the others", func() {
+			bucketTags[5] = tag("deploy_env", "other-env")
+
+			err := s3Client.RepairDrift(context.Background(), s3.Drift{
+				Kind:       s3.DriftTags,
+				Bucket:     bucketName,
+				Repairable: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			input := inputOf(s3API.PutBucketTaggingWithContextArgsForCall(0))
+			Expect(input.Bucket).To(Equal(aws.String(bucketName)))
+			Expect(hasTag(input.Tagging.TagSet, "deploy_env", "test-env")).To(BeTrue())
+			Expect(hasTag(input.Tagging.TagSet, "org_guid", "test-org-guid")).To(BeTrue())
+		})
+
+		It("removes stale statements", func() {
+			stalePrincipal := "arn:aws:iam::123456789012:user/test-iam-path/test-bucket-prefix-deleted-binding-id"
+			setBucketPolicy(userARN, stalePrincipal)
+			iamAPI.ListUserTagsWithContextReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "The user does not exist", nil))
+
+			err := s3Client.RepairDrift(context.Background(), s3.Drift{
+				Kind:       s3.DriftStaleStatement,
+				Bucket:     bucketName,
+				Principal:  stalePrincipal,
+				Repairable: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputOf(iamAPI.ListUserTagsWithContextArgsForCall(0)).UserName).To(Equal(aws.String("test-bucket-prefix-deleted-binding-id")))
+
+			updatedPolicy := aws.StringValue(inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0)).Policy)
+			Expect(updatedPolicy).To(ContainSubstring(userARN))
+			Expect(updatedPolicy).NotTo(ContainSubstring(stalePrincipal))
+		})
+
+		It("does not remove the statement if the user has been created since", func() {
+			err := s3Client.RepairDrift(context.Background(), s3.Drift{
+				Kind:       s3.DriftStaleStatement,
+				Bucket:     bucketName,
+				Principal:  userARN,
+				Repairable: true,
+			})
+			Expect(err).To(MatchError(ContainSubstring("has been created since")))
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(0))
+		})
+
+		It("removes a user left behind by a failed bind from the bucket and deletes it", func() {
+			iamAPI.ListAccessKeysWithContextReturns(&iam.ListAccessKeysOutput{}, nil)
+			iamAPI.ListAttachedUserPoliciesWithContextReturns(&iam.ListAttachedUserPoliciesOutput{}, nil)
+			iamAPI.ListUserPoliciesWithContextReturns(&iam.ListUserPoliciesOutput{}, nil)
+
+			err := s3Client.RepairDrift(context.Background(), s3.Drift{
+				Kind:          s3.DriftNeedsCleanup,
+				Bucket:        bucketName,
+				Principal:     username,
+				PrincipalType: s3.PrincipalTypeUser,
+				Repairable:    true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(1))
+			Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(iamAPI.DeleteUserWithContextArgsForCall(0)).UserName).To(Equal(aws.String(username)))
+		})
+
+		It("refuses to repair drift which is not repairable", func() {
+			err := s3Client.RepairDrift(context.Background(), s3.Drift{
+				Kind:   s3.DriftNeedsCleanup,
+				Bucket: bucketName,
+			})
+			Expect(err).To(Equal(s3.ErrDriftNotRepairable))
+			Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/metrics"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	retryBaseDelay = 200 * time.Millisecond
+	retryMaxDelay  = 5 * time.Second
+)
+
+// retryAWS makes an AWS API call, retrying errors which are likely to go
+// away by themselves, with jittered exponential backoff. It stops retrying
+// once the client's timeout has passed or ctx is cancelled, and returns the
+// last error. call is given ctx with the timeout applied, and must pass it on
+// to the SDK, so that a request which is in flight is also stopped.
+func retryAWS[T any](ctx context.Context, s *S3Client, logger lager.Logger, call func(ctx context.Context) (T, error)) (T, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	for attempt := 0; ; attempt++ {
+		output, err := call(ctx)
+		if err == nil || !isRetryableAWSError(err) {
+			return output, err
+		}
+
+		delay := backoffDelay(attempt)
+		logger.Info("retry-aws-call", lager.Data{"attempt": attempt + 1, "delay": delay.String(), "error": err.Error()})
+		select {
+		case <-ctx.Done():
+			return output, err
+		case <-time.After(delay):
+		}
+		metrics.AWSRetries.WithLabelValues(metrics.ErrorCode(err)).Inc()
+	}
+}
+
+// retryAWSError is retryAWS for calls which only return an error, such as
+// the paginated listings.
+func (s *S3Client) retryAWSError(ctx context.Context, logger lager.Logger, call func(ctx context.Context) error) error {
+	_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, call(ctx)
+	})
+	return err
+}
+
+// backoffDelay picks a delay at random up to an exponentially growing
+// ceiling, so that brokers retrying the same throttled API do not keep
+// retrying in step.
+func backoffDelay(attempt int) time.Duration {
+	ceiling := retryMaxDelay
+	if attempt < 16 {
+		ceiling = min(retryBaseDelay<<attempt, retryMaxDelay)
+	}
+	return time.Duration(rand.Int63n(int64(ceiling))) + 1
+}
+
+// isRetryableAWSError reports whether an error is worth retrying: throttling,
+// server errors, and IAM's eventual consistency, which makes S3 reject a
+// bucket policy naming a user or role which was created moments ago.
+func isRetryableAWSError(err error) bool {
+	if request.IsErrorThrottle(err) {
+		return true
+	}
+
+	var requestFailure awserr.RequestFailure
+	if errors.As(err, &requestFailure) && requestFailure.StatusCode() >= 500 {
+		return true
+	}
+
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		switch awsErr.Code() {
+		case "SlowDown":
+			// S3's throttling code, which this SDK version does not know.
+			return true
+		case "InternalError", "ServiceUnavailable", "ServiceFailure":
+			return true
+		case "MalformedPolicy":
+			return strings.Contains(awsErr.Message(), "Invalid principal")
+		}
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+package s3_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-service-broker-base/provider"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/brokerapi/v10/domain"
+)
+
+var _ = Describe("Retrying AWS calls", func() {
+	var (
+		s3API          *fakeClient.FakeS3API
+		iamAPI         *fakeClient.FakeIAMAPI
+		kmsAPI         *fakeClient.FakeKMSAPI
+		s3Client       *s3.S3Client
+		s3ClientConfig *s3.Config
+		ctx            context.Context
+	)
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
+		s3ClientConfig = &s3.Config{
+			AWSRegion:      "eu-west-2",
+			ResourcePrefix: "test-bucket-prefix-",
+			IAMUserPath:    "/test-iam-path/",
+			Timeout:        2 * time.Second,
+		}
+		ctx = context.Background()
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			s3ClientConfig,
+			s3API,
+			iamAPI,
+			kmsAPI,
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	It("retries throttled calls", func() {
+		s3API.GetBucketTaggingWithContextReturnsOnCall(0, nil, awserr.New("SlowDown", "Please reduce your request rate.", nil))
+		s3API.GetBucketTaggingWithContextReturnsOnCall(1, nil, awserr.New("Throttling", "Rate exceeded", nil))
+		s3API.GetBucketTaggingWithContextReturnsOnCall(2, &awsS3.GetBucketTaggingOutput{}, nil)
+
+		state, err := s3Client.GetBucketState(ctx, "test-instance-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state).To(Equal(s3.BucketStateConfiguring))
+		Expect(s3API.GetBucketTaggingWithContextCallCount()).To(Equal(3))
+	})
+
+	It("retries server errors", func() {
+		s3API.GetBucketTaggingWithContextReturnsOnCall(0, nil, awserr.NewRequestFailure(awserr.New("InternalError", "We encountered an internal error.", nil), 500, "request-id"))
+		s3API.GetBucketTaggingWithContextReturnsOnCall(1, &awsS3.GetBucketTaggingOutput{}, nil)
+
+		_, err := s3Client.GetBucketState(ctx, "test-instance-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s3API.GetBucketTaggingWithContextCallCount()).To(Equal(2))
+	})
+
+	It("does not retry errors which will not go away", func() {
+		s3API.GetBucketTaggingWithContextReturns(nil, awserr.NewRequestFailure(awserr.New("AccessDenied", "Access Denied", nil), 403, "request-id"))
+
+		_, err := s3Client.GetBucketState(ctx, "test-instance-id")
+		Expect(err).To(MatchError(ContainSubstring("AccessDenied")))
+		Expect(s3API.GetBucketTaggingWithContextCallCount()).To(Equal(1))
+	})
+
+	It("retries putting a bucket policy until IAM knows about the new user", func() {
+		iamAPI.CreateUserWithContextReturns(&iam.CreateUserOutput{User: &iam.User{Arn: aws.String("arn")}}, nil)
+		iamAPI.CreateAccessKeyWithContextReturns(&iam.CreateAccessKeyOutput{AccessKey: &iam.AccessKey{
+			AccessKeyId:     aws.String("access-key-id"),
+			SecretAccessKey: aws.String("secret-access-key"),
+		}}, nil)
+		s3API.GetBucketEncryptionWithContextReturns(&awsS3.GetBucketEncryptionOutput{}, nil)
+		kmsAPI.DescribeKeyWithContextReturns(nil, awserr.New(kms.ErrCodeNotFoundException, "Alias not found", nil))
+		s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+		storeBucketPolicies(s3API)
+		putBucketPolicy := s3API.PutBucketPolicyWithContextStub
+		s3API.PutBucketPolicyWithContextStub = func(ctx context.Context, input *awsS3.PutBucketPolicyInput, opts ...request.Option) (*awsS3.PutBucketPolicyOutput, error) {
+			if s3API.PutBucketPolicyWithContextCallCount() == 1 {
+				return nil, awserr.New("MalformedPolicy", "Invalid principal in policy", nil)
+			}
+			return putBucketPolicy(ctx, input, opts...)
+		}
+
+		_, err := s3Client.AddUserToBucket(ctx, provider.BindData{
+			InstanceID: "test-instance-id",
+			BindingID:  "test-binding-id",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(2))
+	})
+
+	It("does not retry other malformed policies", func() {
+		s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+		s3API.PutPublicAccessBlockWithContextReturns(nil, awserr.New("MalformedPolicy", "Policy has invalid resource", nil))
+
+		err := s3Client.UpdateBucket(ctx, provider.UpdateData{
+			InstanceID: "test-instance-id",
+			Details: domain.UpdateDetails{
+				RawParameters: json.RawMessage(`{"public_bucket": false}`),
+			},
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(s3API.PutPublicAccessBlockWithContextCallCount()).To(Equal(1))
+	})
+
+	Context("when the timeout passes", func() {
+		BeforeEach(func() {
+			s3ClientConfig.Timeout = 300 * time.Millisecond
+			s3API.GetBucketTaggingWithContextReturns(nil, awserr.New("SlowDown", "Please reduce your request rate.", nil))
+		})
+
+		It("gives up and returns the last error", func() {
+			start := time.Now()
+			_, err := s3Client.GetBucketState(ctx, "test-instance-id")
+			Expect(err).To(MatchError(ContainSubstring("SlowDown")))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+			Expect(s3API.GetBucketTaggingWithContextCallCount()).To(BeNumerically(">", 1))
+		})
+
+		It("passes the deadline on to the call, so that it stops a request in flight", func() {
+			start := time.Now()
+			_, _ = s3Client.GetBucketState(ctx, "test-instance-id")
+
+			callCtx, _, _ := s3API.GetBucketTaggingWithContextArgsForCall(0)
+			deadline, ok := callCtx.Deadline()
+			Expect(ok).To(BeTrue())
+			Expect(deadline).To(BeTemporally("~", start.Add(300*time.Millisecond), 100*time.Millisecond))
+		})
+	})
+
+	Context("when the context is cancelled", func() {
+		BeforeEach(func() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(context.Background())
+			cancel()
+			s3API.GetBucketTaggingWithContextReturns(nil, awserr.New("SlowDown", "Please reduce your request rate.", nil))
+		})
+
+		It("stops retrying", func() {
+			_, err := s3Client.GetBucketState(ctx, "test-instance-id")
+			Expect(err).To(MatchError(ContainSubstring("SlowDown")))
+			Expect(s3API.GetBucketTaggingWithContextCallCount()).To(Equal(1))
+		})
+	})
+})
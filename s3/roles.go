@@ -0,0 +1,293 @@
+package s3
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/alphagov/paas-service-broker-base/provider"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+const (
+	CredentialTypeIAMUser    = "iam_user"
+	CredentialTypeAssumeRole = "assume_role"
+
+	externalIDBytes = 32
+)
+
+var ErrAssumeRoleNotEnabled = errors.New("credential_type assume_role is not enabled on this broker")
+
+func (s *S3Client) validateCredentialType(credentialType string) error {
+	switch credentialType {
+	case "", CredentialTypeIAMUser:
+		return nil
+	case CredentialTypeAssumeRole:
+		if !s.assumeRoleEnabled() {
+			return ErrAssumeRoleNotEnabled
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown credential_type %q: must be one of %q or %q", credentialType, CredentialTypeIAMUser, CredentialTypeAssumeRole)
+	}
+}
+
+// assumeRoleEnabled reports whether the operator has configured a principal
+// for apps to assume binding roles with.
+func (s *S3Client) assumeRoleEnabled() bool {
+	return s.assumeRolePrincipalARN != ""
+}
+
+// addRoleToBucket is AddUserToBucket for bindings which use short-lived
+// credentials. Instead of a user with its own access key, the binding gets a
+// role which the operator's principal may assume, but only with the
+// binding's external ID. Apps call sts:AssumeRole with an identity of their
+// own, which the principal allows to, to get credentials for the role.
+func (s *S3Client) addRoleToBucket(ctx context.Context, logger lager.Logger, bindData provider.BindData, bindParams BindParams, permissions policy.Permissions) (BucketCredentials, error) {
+	fullBucketName := s.buildBucketName(bindData.InstanceID)
+	roleName := s.buildBindingUsername(bindData.BindingID)
+
+	externalID, err := generateExternalID()
+	if err != nil {
+		logger.Error("generate-external-id", err)
+		return BucketCredentials{}, err
+	}
+	assumeRolePolicy, err := policy.BuildAssumeRolePolicy(s.assumeRolePrincipalARN, externalID)
+	if err != nil {
+		logger.Error("build-assume-role-policy", err)
+		return BucketCredentials{}, err
+	}
+
+	cleanupCtx := context.WithoutCancel(ctx)
+
+	var role *iam.Role
+	steps := []step{
+		{
+			name: "create-role",
+			do: func() (err error) {
+				role, err = s.createOrUpdateRole(ctx, logger, bindData, roleName, assumeRolePolicy)
+				return err
+			},
+			undo: func() error {
+				return ignoreNoSuchResources(s.deleteRole(cleanupCtx, roleName))
+			},
+			markForCleanup: func() error {
+				return s.markRoleForCleanup(cleanupCtx, logger, roleName)
+			},
+		},
+		{
+			name: "wait-for-role-exist",
+			do: func() error {
+				return s.iamClient.WaitUntilRoleExistsWithContext(
+					ctx,
+					&iam.GetRoleInput{RoleName: aws.String(roleName)},
+
+					request.WithWaiterDelay(request.ConstantWaiterDelay(awsWaitDelay)),
+					request.WithWaiterMaxAttempts(awsMaxWaitAttempts),
+				)
+			},
+		},
+	}
+
+	policyARNs := []string{}
+	if s.commonUserPolicyArn != "" {
+		policyARNs = append(policyARNs, s.commonUserPolicyArn)
+	}
+	if !bindParams.AllowExternalAccess {
+		policyARNs = append(policyARNs, s.ipRestrictionPolicyArn)
+	}
+	for _, policyARN := range policyARNs {
+		steps = append(steps, step{
+			name: "attach-role-policy",
+			do: func() error {
+				logger.Info("attach-role-policy", lager.Data{"role": roleName, "policy": policyARN})
+				_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.AttachRolePolicyOutput, error) {
+					return s.iamClient.AttachRolePolicyWithContext(ctx, &iam.AttachRolePolicyInput{
+						PolicyArn: aws.String(policyARN),
+						RoleName:  aws.String(roleName),
+					})
+				})
+				return err
+			},
+		})
+	}
+
+	steps = append(steps,
+		step{
+			name: "put-kms-key-role-policy",
+			do: func() error {
+				kmsKeyARN, err := s.getBucketKMSKey(ctx, logger, fullBucketName)
+				if err != nil || kmsKeyARN == "" {
+					return err
+				}
+				logger.Info("put-kms-key-role-policy", lager.Data{"role": roleName, "key": kmsKeyARN})
+				kmsPolicy, err := policy.BuildKMSKeyUserPolicy(kmsKeyARN, permissions)
+				if err != nil {
+					return err
+				}
+				_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.PutRolePolicyOutput, error) {
+					return s.iamClient.PutRolePolicyWithContext(ctx, &iam.PutRolePolicyInput{
+						PolicyDocument: aws.String(kmsPolicy),
+						PolicyName:     aws.String(kmsKeyUserPolicyName),
+						RoleName:       aws.String(roleName),
+					})
+				})
+				return err
+			},
+		},
+		s.grantBucketAccessStep(ctx, cleanupCtx, logger, bindData.InstanceID, func() iam.User {
+			return iam.User{Arn: role.Arn}
+		}, roleName, permissions, bindParams.Prefix),
+	)
+
+	err = runSteps(logger, steps)
+	if err != nil {
+		return BucketCredentials{}, err
+	}
+
+	credentials := s.bucketCredentials(fullBucketName)
+	credentials.Prefix = bindParams.Prefix
+	credentials.RoleARN = aws.StringValue(role.Arn)
+	credentials.ExternalID = externalID
+	return credentials, nil
+}
+
+// createOrUpdateRole creates the binding's role. If the role is already
+// there, for instance because Cloud Controller retried the bind, it is reused
+// with the new trust policy so that only the latest external ID works.
+func (s *S3Client) createOrUpdateRole(ctx context.Context, logger lager.Logger, bindData provider.BindData, roleName, assumeRolePolicy string) (*iam.Role, error) {
+	role := &iam.CreateRoleInput{
+		Path:                     aws.String(s.iamUserPath),
+		RoleName:                 aws.String(roleName),
+		AssumeRolePolicyDocument: aws.String(assumeRolePolicy),
+		Tags: []*iam.Tag{
+			{
+				Key:   aws.String("service_instance_guid"),
+				Value: aws.String(bindData.InstanceID),
+			},
+			{
+				Key:   aws.String("created_by"),
+				Value: aws.String("paas-s3-broker"),
+			},
+			{
+				Key:   aws.String("deploy_env"),
+				Value: aws.String(s.deployEnvironment),
+			},
+		},
+	}
+	if s.permissionsBoundaryArn != "" {
+		role.PermissionsBoundary = aws.String(s.permissionsBoundaryArn)
+	}
+
+	logger.Info("create-role", lager.Data{"role": roleName})
+	createRoleOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.CreateRoleOutput, error) {
+		return s.iamClient.CreateRoleWithContext(ctx, role)
+	})
+	if err == nil {
+		return createRoleOutput.Role, nil
+	}
+	if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != iam.ErrCodeEntityAlreadyExistsException {
+		logger.Error("create-role", err)
+		return nil, err
+	}
+
+	logger.Info("update-assume-role-policy", lager.Data{"role": roleName})
+	_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.UpdateAssumeRolePolicyOutput, error) {
+		return s.iamClient.UpdateAssumeRolePolicyWithContext(ctx, &iam.UpdateAssumeRolePolicyInput{
+			RoleName:       aws.String(roleName),
+			PolicyDocument: aws.String(assumeRolePolicy),
+		})
+	})
+	if err != nil {
+		logger.Error("update-assume-role-policy", err)
+		return nil, err
+	}
+	getRoleOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.GetRoleOutput, error) {
+		return s.iamClient.GetRoleWithContext(ctx, &iam.GetRoleInput{
+			RoleName: aws.String(roleName),
+		})
+	})
+	if err != nil {
+		logger.Error("get-role", err)
+		return nil, err
+	}
+	return getRoleOutput.Role, nil
+}
+
+// deleteRole deletes a binding's role and its policies, returning
+// ErrNoSuchResources if there was no such role.
+func (s *S3Client) deleteRole(ctx context.Context, roleName string) error {
+	attachedPoliciesOutput, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*iam.ListAttachedRolePoliciesOutput, error) {
+		return s.iamClient.ListAttachedRolePoliciesWithContext(ctx, &iam.ListAttachedRolePoliciesInput{
+			RoleName: aws.String(roleName),
+		})
+	})
+	if err != nil {
+		if !isIAMUserNotFound(err) {
+			return ErrNoSuchResources
+		}
+		s.logger.Error("list-attached-role-policies", err)
+		return err
+	}
+	for _, p := range attachedPoliciesOutput.AttachedPolicies {
+		_, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*iam.DetachRolePolicyOutput, error) {
+			return s.iamClient.DetachRolePolicyWithContext(ctx, &iam.DetachRolePolicyInput{
+				RoleName:  aws.String(roleName),
+				PolicyArn: p.PolicyArn,
+			})
+		})
+		if err != nil {
+			s.logger.Error("detach-role-policy", err)
+			return err
+		}
+	}
+
+	inlinePoliciesOutput, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*iam.ListRolePoliciesOutput, error) {
+		return s.iamClient.ListRolePoliciesWithContext(ctx, &iam.ListRolePoliciesInput{
+			RoleName: aws.String(roleName),
+		})
+	})
+	if err != nil {
+		s.logger.Error("list-role-policies", err)
+		return err
+	}
+	for _, p := range inlinePoliciesOutput.PolicyNames {
+		_, err := retryAWS(ctx, s, s.logger, func(ctx context.Context) (*iam.DeleteRolePolicyOutput, error) {
+			return s.iamClient.DeleteRolePolicyWithContext(ctx, &iam.DeleteRolePolicyInput{
+				RoleName:   aws.String(roleName),
+				PolicyName: p,
+			})
+		})
+		if err != nil {
+			s.logger.Error("delete-role-policy", err)
+			return err
+		}
+	}
+
+	_, err = retryAWS(ctx, s, s.logger, func(ctx context.Context) (*iam.DeleteRoleOutput, error) {
+		return s.iamClient.DeleteRoleWithContext(ctx, &iam.DeleteRoleInput{
+			RoleName: aws.String(roleName),
+		})
+	})
+	if err != nil {
+		s.logger.Error("delete-role", err)
+		return err
+	}
+	return nil
+}
+
+func generateExternalID() (string, error) {
+	b := make([]byte, externalIDBytes)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
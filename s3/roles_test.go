@@ -0,0 +1,202 @@
+package s3_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/alphagov/paas-service-broker-base/provider"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/brokerapi/v10/domain"
+)
+
+var _ = Describe("Role bindings", func() {
+	const roleARN = "arn:aws:iam::123456789012:role/test-iam-path/test-bucket-prefix-test-binding-id"
+
+	var (
+		s3API          *fakeClient.FakeS3API
+		iamAPI         *fakeClient.FakeIAMAPI
+		kmsAPI         *fakeClient.FakeKMSAPI
+		s3Client       *s3.S3Client
+		s3ClientConfig *s3.Config
+		bindData       provider.BindData
+	)
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		storeBucketPolicies(s3API)
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
+		s3ClientConfig = &s3.Config{
+			AWSRegion:              "eu-west-2",
+			ResourcePrefix:         "test-bucket-prefix-",
+			IAMUserPath:            "/test-iam-path/",
+			IpRestrictionPolicyARN: "test-ip-restriction-policy-arn",
+			AssumeRolePrincipalARN: "arn:aws:iam::123456789012:user/assumer",
+		}
+		bindData = provider.BindData{
+			InstanceID: "test-instance-id",
+			BindingID:  "test-binding-id",
+			Details: domain.BindDetails{
+				RawParameters: json.RawMessage(`{"credential_type": "assume_role"}`),
+			},
+		}
+
+		iamAPI.CreateRoleWithContextReturns(&iam.CreateRoleOutput{
+			Role: &iam.Role{Arn: aws.String(roleARN)},
+		}, nil)
+		s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
+			Policy: aws.String(`{"Version": "2012-10-17", "Statement":[]}`),
+		}, nil)
+		s3API.GetBucketEncryptionWithContextReturns(&awsS3.GetBucketEncryptionOutput{}, nil)
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			s3ClientConfig,
+			s3API,
+			iamAPI,
+			kmsAPI,
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	Describe("AddUserToBucket", func() {
+		It("creates a role which can only be assumed with the external ID", func() {
+			bucketCredentials, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("not creating a user")
+			Expect(iamAPI.CreateUserWithContextCallCount()).To(Equal(0))
+			Expect(iamAPI.CreateAccessKeyWithContextCallCount()).To(Equal(0))
+
+			By("creating a role trusting the configured principal")
+			Expect(iamAPI.CreateRoleWithContextCallCount()).To(Equal(1))
+			createRoleInput := inputOf(iamAPI.CreateRoleWithContextArgsForCall(0))
+			Expect(createRoleInput.RoleName).To(HaveValue(Equal("test-bucket-prefix-test-binding-id")))
+			Expect(createRoleInput.Path).To(HaveValue(Equal("/test-iam-path/")))
+			trustPolicy := policy.PolicyDocument{}
+			Expect(json.Unmarshal([]byte(aws.StringValue(createRoleInput.AssumeRolePolicyDocument)), &trustPolicy)).To(Succeed())
+			Expect(trustPolicy.Statement[0].Principal.AWS).To(Equal("arn:aws:iam::123456789012:user/assumer"))
+			Expect(trustPolicy.Statement[0].Condition["StringEquals"]["sts:ExternalId"]).To(ConsistOf(bucketCredentials.ExternalID))
+
+			By("restricting the role to the platform's IP addresses")
+			Expect(iamAPI.AttachRolePolicyWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(iamAPI.AttachRolePolicyWithContextArgsForCall(0)).PolicyArn).To(HaveValue(Equal("test-ip-restriction-policy-arn")))
+
+			By("granting the role access in the bucket policy")
+			updatedPolicy := policy.PolicyDocument{}
+			Expect(json.Unmarshal([]byte(*inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0)).Policy), &updatedPolicy)).To(Succeed())
+			Expect(updatedPolicy.Statement).To(HaveLen(1))
+			Expect(updatedPolicy.Statement[0].Principal.AWS).To(Equal(roleARN))
+
+			By("returning only the role to assume, and no key")
+			Expect(bucketCredentials.ExternalID).To(HaveLen(64))
+			Expect(bucketCredentials).To(Equal(s3.BucketCredentials{
+				BucketName:   "test-bucket-prefix-test-instance-id",
+				AWSRegion:    "eu-west-2",
+				AWSPartition: "aws",
+				RoleARN:      roleARN,
+				ExternalID:   bucketCredentials.ExternalID,
+			}))
+		})
+
+		It("reuses an existing role with a new external ID", func() {
+			iamAPI.CreateRoleWithContextReturns(nil, awserr.New(iam.ErrCodeEntityAlreadyExistsException, "Role already exists", nil))
+			iamAPI.GetRoleWithContextReturns(&iam.GetRoleOutput{Role: &iam.Role{Arn: aws.String(roleARN)}}, nil)
+
+			bucketCredentials, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bucketCredentials.RoleARN).To(Equal(roleARN))
+
+			Expect(iamAPI.UpdateAssumeRolePolicyWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(iamAPI.UpdateAssumeRolePolicyWithContextArgsForCall(0)).PolicyDocument).To(HaveValue(ContainSubstring(bucketCredentials.ExternalID)))
+		})
+
+		It("deletes the role if it cannot be set up", func() {
+			iamAPI.AttachRolePolicyWithContextReturns(nil, awserr.New("AccessDenied", "Access Denied", nil))
+			iamAPI.ListAttachedRolePoliciesWithContextReturns(&iam.ListAttachedRolePoliciesOutput{}, nil)
+			iamAPI.ListRolePoliciesWithContextReturns(&iam.ListRolePoliciesOutput{}, nil)
+
+			_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			Expect(err).To(HaveOccurred())
+			Expect(iamAPI.DeleteRoleWithContextCallCount()).To(Equal(1))
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(0))
+		})
+
+		It("rejects unknown credential types", func() {
+			bindData.Details.RawParameters = json.RawMessage(`{"credential_type": "magic"}`)
+
+			_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			Expect(err).To(MatchError(ContainSubstring("unknown credential_type")))
+			Expect(iamAPI.Invocations()).To(BeEmpty())
+		})
+
+		Context("when the operator has not configured a principal", func() {
+			BeforeEach(func() {
+				s3ClientConfig.AssumeRolePrincipalARN = ""
+			})
+
+			It("rejects the binding", func() {
+				_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+				Expect(err).To(MatchError(s3.ErrAssumeRoleNotEnabled))
+				Expect(iamAPI.Invocations()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("RemoveUserFromBucketAndDeleteUser", func() {
+		BeforeEach(func() {
+			s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{
+				Policy: aws.String(`{
+					"Version": "2012-10-17",
+					"Statement": [{
+						"Effect": "Allow",
+						"Principal": {"AWS": "` + roleARN + `"},
+						"Action": ["s3:GetObject"],
+						"Resource": ["arn:aws:s3:::test-bucket-prefix-test-instance-id/*"]
+					}]
+				}`),
+			}, nil)
+			notFound := awserr.New(iam.ErrCodeNoSuchEntityException, "The user cannot be found", nil)
+			iamAPI.ListAccessKeysWithContextReturns(nil, notFound)
+			iamAPI.ListAttachedUserPoliciesWithContextReturns(nil, notFound)
+			iamAPI.ListUserPoliciesWithContextReturns(nil, notFound)
+			iamAPI.DeleteUserWithContextReturns(nil, notFound)
+			iamAPI.ListAttachedRolePoliciesWithContextReturns(&iam.ListAttachedRolePoliciesOutput{
+				AttachedPolicies: []*iam.AttachedPolicy{{PolicyArn: aws.String("test-ip-restriction-policy-arn")}},
+			}, nil)
+			iamAPI.ListRolePoliciesWithContextReturns(&iam.ListRolePoliciesOutput{
+				PolicyNames: []*string{aws.String("kms-key-access")},
+			}, nil)
+		})
+
+		It("deletes the role when there is no user", func() {
+			err := s3Client.RemoveUserFromBucketAndDeleteUser(context.Background(), "test-binding-id", "test-instance-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(1))
+			Expect(iamAPI.DetachRolePolicyWithContextCallCount()).To(Equal(1))
+			Expect(iamAPI.DeleteRolePolicyWithContextCallCount()).To(Equal(1))
+			Expect(iamAPI.DeleteRoleWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(iamAPI.DeleteRoleWithContextArgsForCall(0)).RoleName).To(HaveValue(Equal("test-bucket-prefix-test-binding-id")))
+		})
+
+		It("returns ErrNoSuchResources if there is no role either", func() {
+			s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+			iamAPI.ListAttachedRolePoliciesWithContextReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "The role cannot be found", nil))
+
+			err := s3Client.RemoveUserFromBucketAndDeleteUser(context.Background(), "test-binding-id", "test-instance-id")
+			Expect(err).To(MatchError(s3.ErrNoSuchResources))
+			Expect(iamAPI.DeleteRoleWithContextCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,302 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+const (
+	previousAccessKeyTagKey        = "previous_access_key_id"
+	previousAccessKeyExpiresTagKey = "previous_access_key_expires_at"
+
+	// maxAccessKeysPerUser is the IAM limit on access keys for one user,
+	// so we cannot rotate again until the previous key has been deleted.
+	maxAccessKeysPerUser = 2
+
+	defaultAccessKeyRotationOverlap = 24 * time.Hour
+)
+
+var (
+	ErrKeyRotationInProgress = errors.New("the previous access key has not been deleted yet")
+	ErrBindingNotForInstance = errors.New("binding belongs to a different service instance")
+)
+
+// AccessKeyRotationOverlap returns how long a binding's previous access key
+// keeps working after it is rotated.
+func (c *Config) AccessKeyRotationOverlap() time.Duration {
+	if c.AccessKeyRotationOverlapHours == 0 {
+		return defaultAccessKeyRotationOverlap
+	}
+	return time.Duration(c.AccessKeyRotationOverlapHours) * time.Hour
+}
+
+// RotateAccessKey creates a new access key for a binding's user. The old key
+// keeps working for the rotation overlap, so that apps can be restarted with
+// the new one, and is deleted by the next call to RotateAccessKey or
+// ExpireRotatedAccessKey after that.
+func (s *S3Client) RotateAccessKey(ctx context.Context, bindingID string) (BucketCredentials, error) {
+	logger := s.logger.Session("rotate-access-key")
+	username := s.buildBindingUsername(bindingID)
+
+	tags, err := s.listUserTags(ctx, logger, username)
+	if err != nil {
+		return BucketCredentials{}, err
+	}
+	return s.rotateBindingAccessKey(ctx, logger, username, tagValue(tags, "service_instance_guid"), tags)
+}
+
+// rotateBindingAccessKeyForBind handles a bind request with
+// `rotate_access_key` for a binding whose user already exists.
+func (s *S3Client) rotateBindingAccessKeyForBind(ctx context.Context, logger lager.Logger, instanceID, bindingID string) (BucketCredentials, error) {
+	username := s.buildBindingUsername(bindingID)
+
+	tags, err := s.listUserTags(ctx, logger, username)
+	if err != nil {
+		return BucketCredentials{}, err
+	}
+	if tagValue(tags, "service_instance_guid") != instanceID {
+		logger.Error("rotate-access-key", ErrBindingNotForInstance, lager.Data{"user": username})
+		return BucketCredentials{}, ErrBindingNotForInstance
+	}
+	return s.rotateBindingAccessKey(ctx, logger, username, instanceID, tags)
+}
+
+// rotateBindingAccessKey gives the binding's user a new access key, and
+// returns the binding's credentials with it.
+func (s *S3Client) rotateBindingAccessKey(ctx context.Context, logger lager.Logger, username, instanceID string, tags []*iam.Tag) (BucketCredentials, error) {
+	// The binding keeps the prefix it was made with, which is only
+	// recorded in the bucket policy. It is looked up first so that nothing
+	// can fail once the new key exists, as its secret cannot be fetched
+	// again.
+	bucketName := s.buildBucketName(instanceID)
+	bucketPolicy, err := s.getBucketPolicy(ctx, logger, bucketName)
+	if err != nil {
+		return BucketCredentials{}, err
+	}
+	prefix, err := policy.PrefixForUser(bucketPolicy, "/"+username)
+	if err != nil {
+		logger.Error("find-prefix", err)
+		return BucketCredentials{}, err
+	}
+
+	accessKey, err := s.rotateAccessKey(ctx, logger, username, tags)
+	if err != nil {
+		return BucketCredentials{}, err
+	}
+	credentials := s.bucketCredentials(bucketName)
+	credentials.AWSAccessKeyID = aws.StringValue(accessKey.AccessKeyId)
+	credentials.AWSSecretAccessKey = aws.StringValue(accessKey.SecretAccessKey)
+	credentials.Prefix = prefix
+	return credentials, nil
+}
+
+// UndoAccessKeyRotation deletes the access key a rotation created, for when
+// it could not be handed on, and makes the previous key permanent again.
+func (s *S3Client) UndoAccessKeyRotation(ctx context.Context, bindingID, accessKeyID string) error {
+	logger := s.logger.Session("undo-access-key-rotation")
+	username := s.buildBindingUsername(bindingID)
+
+	logger.Info("delete-access-key", lager.Data{"user": username, "access-key-id": accessKeyID})
+	_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.DeleteAccessKeyOutput, error) {
+		return s.iamClient.DeleteAccessKeyWithContext(ctx, &iam.DeleteAccessKeyInput{
+			UserName:    aws.String(username),
+			AccessKeyId: aws.String(accessKeyID),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != iam.ErrCodeNoSuchEntityException {
+			logger.Error("delete-access-key", err)
+			return err
+		}
+	}
+
+	logger.Info("untag-previous-access-key", lager.Data{"user": username})
+	_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.UntagUserOutput, error) {
+		return s.iamClient.UntagUserWithContext(ctx, &iam.UntagUserInput{
+			UserName: aws.String(username),
+			TagKeys:  []*string{aws.String(previousAccessKeyTagKey), aws.String(previousAccessKeyExpiresTagKey)},
+		})
+	})
+	if err != nil {
+		logger.Error("untag-previous-access-key", err)
+		return err
+	}
+	return nil
+}
+
+// ExpireRotatedAccessKey deletes a binding's previous access key if the
+// rotation overlap is over.
+func (s *S3Client) ExpireRotatedAccessKey(ctx context.Context, bindingID string) error {
+	logger := s.logger.Session("expire-rotated-access-key")
+	username := s.buildBindingUsername(bindingID)
+
+	tags, err := s.listUserTags(ctx, logger, username)
+	if err != nil {
+		return err
+	}
+	_, err = s.expireRotatedAccessKey(ctx, logger, username, tags)
+	return err
+}
+
+func (s *S3Client) rotateAccessKey(ctx context.Context, logger lager.Logger, username string, tags []*iam.Tag) (*iam.AccessKey, error) {
+	tags, err := s.expireRotatedAccessKey(ctx, logger, username, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("list-access-keys", lager.Data{"user": username})
+	listAccessKeysOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.ListAccessKeysOutput, error) {
+		return s.iamClient.ListAccessKeysWithContext(ctx, &iam.ListAccessKeysInput{
+			UserName: aws.String(username),
+		})
+	})
+	if err != nil {
+		logger.Error("list-access-keys", err)
+		return nil, err
+	}
+	keys := listAccessKeysOutput.AccessKeyMetadata
+	if len(keys) >= maxAccessKeysPerUser {
+		expiresAt := tagValue(tags, previousAccessKeyExpiresTagKey)
+		logger.Error("too-many-access-keys", ErrKeyRotationInProgress, lager.Data{"user": username, "expires-at": expiresAt})
+		return nil, fmt.Errorf("%w: try again after %s", ErrKeyRotationInProgress, expiresAt)
+	}
+
+	logger.Info("create-access-key", lager.Data{"user": username})
+	createAccessKeyOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.CreateAccessKeyOutput, error) {
+		return s.iamClient.CreateAccessKeyWithContext(ctx, &iam.CreateAccessKeyInput{
+			UserName: aws.String(username),
+		})
+	})
+	if err != nil {
+		logger.Error("create-access-key", err)
+		return nil, err
+	}
+	newKey := createAccessKeyOutput.AccessKey
+	logger.Info("created-access-key", lager.Data{"user": username, "access-key-id": aws.StringValue(newKey.AccessKeyId)})
+
+	if len(keys) == 0 {
+		return newKey, nil
+	}
+
+	previousKeyID := aws.StringValue(keys[0].AccessKeyId)
+	expiresAt := time.Now().Add(s.accessKeyRotationOverlap).UTC().Format(time.RFC3339)
+	logger.Info("tag-previous-access-key", lager.Data{"user": username, "access-key-id": previousKeyID, "expires-at": expiresAt})
+	_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.TagUserOutput, error) {
+		return s.iamClient.TagUserWithContext(ctx, &iam.TagUserInput{
+			UserName: aws.String(username),
+			Tags: []*iam.Tag{
+				{Key: aws.String(previousAccessKeyTagKey), Value: aws.String(previousKeyID)},
+				{Key: aws.String(previousAccessKeyExpiresTagKey), Value: aws.String(expiresAt)},
+			},
+		})
+	})
+	if err != nil {
+		// Without the tags nothing would ever delete the previous key, so
+		// back out rather than leave the user with two keys for good.
+		logger.Error("tag-previous-access-key", err)
+		s.deleteAccessKeyWithoutError(ctx, logger, username, aws.StringValue(newKey.AccessKeyId))
+		return nil, err
+	}
+	return newKey, nil
+}
+
+// expireRotatedAccessKey deletes the previous access key recorded in the
+// user's tags if its overlap is over, and returns the tags left afterwards.
+func (s *S3Client) expireRotatedAccessKey(ctx context.Context, logger lager.Logger, username string, tags []*iam.Tag) ([]*iam.Tag, error) {
+	previousKeyID := tagValue(tags, previousAccessKeyTagKey)
+	if previousKeyID == "" {
+		return tags, nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, tagValue(tags, previousAccessKeyExpiresTagKey))
+	if err != nil {
+		// Treat a mangled expiry as expired, rather than never
+		// deleting the key.
+		logger.Error("parse-previous-access-key-expiry", err, lager.Data{"user": username})
+	} else if time.Now().Before(expiresAt) {
+		logger.Info("previous-access-key-not-expired", lager.Data{"user": username, "access-key-id": previousKeyID, "expires-at": expiresAt})
+		return tags, nil
+	}
+
+	logger.Info("delete-previous-access-key", lager.Data{"user": username, "access-key-id": previousKeyID})
+	_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.DeleteAccessKeyOutput, error) {
+		return s.iamClient.DeleteAccessKeyWithContext(ctx, &iam.DeleteAccessKeyInput{
+			UserName:    aws.String(username),
+			AccessKeyId: aws.String(previousKeyID),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != iam.ErrCodeNoSuchEntityException {
+			logger.Error("delete-previous-access-key", err)
+			return nil, err
+		}
+	}
+
+	logger.Info("untag-previous-access-key", lager.Data{"user": username})
+	_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.UntagUserOutput, error) {
+		return s.iamClient.UntagUserWithContext(ctx, &iam.UntagUserInput{
+			UserName: aws.String(username),
+			TagKeys:  []*string{aws.String(previousAccessKeyTagKey), aws.String(previousAccessKeyExpiresTagKey)},
+		})
+	})
+	if err != nil {
+		logger.Error("untag-previous-access-key", err)
+		return nil, err
+	}
+
+	remainingTags := []*iam.Tag{}
+	for _, tag := range tags {
+		key := aws.StringValue(tag.Key)
+		if key != previousAccessKeyTagKey && key != previousAccessKeyExpiresTagKey {
+			remainingTags = append(remainingTags, tag)
+		}
+	}
+	return remainingTags, nil
+}
+
+func (s *S3Client) listUserTags(ctx context.Context, logger lager.Logger, username string) ([]*iam.Tag, error) {
+	logger.Info("list-user-tags", lager.Data{"user": username})
+	listUserTagsOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.ListUserTagsOutput, error) {
+		return s.iamClient.ListUserTagsWithContext(ctx, &iam.ListUserTagsInput{
+			UserName: aws.String(username),
+		})
+	})
+	if err != nil {
+		logger.Error("list-user-tags", err)
+		if !isIAMUserNotFound(err) {
+			return nil, ErrNoSuchResources
+		}
+		return nil, err
+	}
+	return listUserTagsOutput.Tags, nil
+}
+
+func (s *S3Client) deleteAccessKeyWithoutError(ctx context.Context, logger lager.Logger, username, accessKeyID string) {
+	ctx = context.WithoutCancel(ctx)
+	_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.DeleteAccessKeyOutput, error) {
+		return s.iamClient.DeleteAccessKeyWithContext(ctx, &iam.DeleteAccessKeyInput{
+			UserName:    aws.String(username),
+			AccessKeyId: aws.String(accessKeyID),
+		})
+	})
+	if err != nil {
+		logger.Error("delete-access-key-suppressed", err, lager.Data{"user": username, "access-key-id": accessKeyID})
+	}
+}
+
+func tagValue(tags []*iam.Tag, key string) string {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == key {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,283 @@
+package s3_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/alphagov/paas-service-broker-base/provider"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/brokerapi/v10/domain"
+)
+
+var _ = Describe("Access key rotation", func() {
+	var (
+		policyJSON     []byte
+		s3API          *fakeClient.FakeS3API
+		iamAPI         *fakeClient.FakeIAMAPI
+		kmsAPI         *fakeClient.FakeKMSAPI
+		s3Client       *s3.S3Client
+		s3ClientConfig *s3.Config
+		userTags       []*iam.Tag
+	)
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
+		s3ClientConfig = &s3.Config{
+			AWSRegion:                     "eu-west-2",
+			ResourcePrefix:                "test-bucket-prefix-",
+			IAMUserPath:                   "/test-iam-path/",
+			AccessKeyRotationOverlapHours: 2,
+		}
+		userTags = []*iam.Tag{
+			{Key: aws.String("service_instance_guid"), Value: aws.String("test-instance-id")},
+		}
+
+		iamAPI.ListUserTagsWithContextStub = func(context.Context, *iam.ListUserTagsInput, ...request.Option) (*iam.ListUserTagsOutput, error) {
+			return &iam.ListUserTagsOutput{Tags: userTags}, nil
+		}
+		iamAPI.ListAccessKeysWithContextReturns(&iam.ListAccessKeysOutput{
+			AccessKeyMetadata: []*iam.AccessKeyMetadata{{AccessKeyId: aws.String("old-access-key-id")}},
+		}, nil)
+		iamAPI.CreateAccessKeyWithContextReturns(&iam.CreateAccessKeyOutput{
+			AccessKey: &iam.AccessKey{
+				AccessKeyId:     aws.String("new-access-key-id"),
+				SecretAccessKey: aws.String("new-secret-access-key"),
+			},
+		}, nil)
+		s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+
+		policyDoc, err := policy.BuildPolicy("", policy.BuildPrefixStatements(
+			"aws",
+			"test-bucket-prefix-test-instance-id",
+			iam.User{Arn: aws.String("arn:aws:iam::123456789012:user/test-iam-path/test-bucket-prefix-test-binding-id")},
+			policy.ReadWritePermissions{},
+			"app-1/",
+		)...)
+		Expect(err).NotTo(HaveOccurred())
+		policyJSON, err = json.Marshal(policyDoc)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			s3ClientConfig,
+			s3API,
+			iamAPI,
+			kmsAPI,
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	Describe("RotateAccessKey", func() {
+		It("creates a new access key and tags the old one to be deleted after the overlap", func() {
+			bucketCredentials, err := s3Client.RotateAccessKey(context.Background(), "test-binding-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bucketCredentials).To(Equal(s3.BucketCredentials{
+				BucketName:         "test-bucket-prefix-test-instance-id",
+				AWSAccessKeyID:     "new-access-key-id",
+				AWSSecretAccessKey: "new-secret-access-key",
+				AWSRegion:          "eu-west-2",
+				AWSPartition:       "aws",
+			}))
+
+			Expect(inputOf(iamAPI.ListUserTagsWithContextArgsForCall(0)).UserName).To(HaveValue(Equal("test-bucket-prefix-test-binding-id")))
+			Expect(iamAPI.CreateAccessKeyWithContextCallCount()).To(Equal(1))
+			Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(0))
+
+			Expect(iamAPI.TagUserWithContextCallCount()).To(Equal(1))
+			tags := inputOf(iamAPI.TagUserWithContextArgsForCall(0)).Tags
+			Expect(tags).To(HaveLen(2))
+			Expect(tags[0].Key).To(HaveValue(Equal("previous_access_key_id")))
+			Expect(tags[0].Value).To(HaveValue(Equal("old-access-key-id")))
+			Expect(tags[1].Key).To(HaveValue(Equal("previous_access_key_expires_at")))
+			expiresAt, err := time.Parse(time.RFC3339, aws.StringValue(tags[1].Value))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expiresAt).To(BeTemporally("~", time.Now().Add(2*time.Hour), time.Minute))
+		})
+
+		It("returns the prefix the binding was made with", func() {
+			s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{Policy: aws.String(string(policyJSON))}, nil)
+
+			bucketCredentials, err := s3Client.RotateAccessKey(context.Background(), "test-binding-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bucketCredentials.Prefix).To(Equal("app-1/"))
+			Expect(inputOf(s3API.GetBucketPolicyWithContextArgsForCall(0)).Bucket).To(HaveValue(Equal("test-bucket-prefix-test-instance-id")))
+		})
+
+		It("refuses to create a third access key while the previous one is still in use", func() {
+			userTags = append(userTags,
+				&iam.Tag{Key: aws.String("previous_access_key_id"), Value: aws.String("older-access-key-id")},
+				&iam.Tag{Key: aws.String("previous_access_key_expires_at"), Value: aws.String(time.Now().Add(time.Hour).UTC().Format(time.RFC3339))},
+			)
+			iamAPI.ListAccessKeysWithContextReturns(&iam.ListAccessKeysOutput{
+				AccessKeyMetadata: []*iam.AccessKeyMetadata{
+					{AccessKeyId: aws.String("older-access-key-id")},
+					{AccessKeyId: aws.String("old-access-key-id")},
+				},
+			}, nil)
+
+			_, err := s3Client.RotateAccessKey(context.Background(), "test-binding-id")
+			Expect(err).To(MatchError(s3.ErrKeyRotationInProgress))
+			Expect(iamAPI.CreateAccessKeyWithContextCallCount()).To(Equal(0))
+			Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(0))
+		})
+
+		It("deletes the previous access key first if its overlap is over", func() {
+			userTags = append(userTags,
+				&iam.Tag{Key: aws.String("previous_access_key_id"), Value: aws.String("older-access-key-id")},
+				&iam.Tag{Key: aws.String("previous_access_key_expires_at"), Value: aws.String(time.Now().Add(-time.Hour).UTC().Format(time.RFC3339))},
+			)
+
+			_, err := s3Client.RotateAccessKey(context.Background(), "test-binding-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(iamAPI.DeleteAccessKeyWithContextArgsForCall(0)).AccessKeyId).To(HaveValue(Equal("older-access-key-id")))
+			Expect(iamAPI.UntagUserWithContextCallCount()).To(Equal(1))
+			Expect(iamAPI.CreateAccessKeyWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(iamAPI.TagUserWithContextArgsForCall(0)).Tags[0].Value).To(HaveValue(Equal("old-access-key-id")))
+		})
+
+		It("creates no access key if the binding's prefix cannot be found", func() {
+			s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("AccessDenied", "Access Denied", nil))
+
+			_, err := s3Client.RotateAccessKey(context.Background(), "test-binding-id")
+			Expect(err).To(HaveOccurred())
+			Expect(iamAPI.CreateAccessKeyWithContextCallCount()).To(Equal(0))
+			Expect(iamAPI.TagUserWithContextCallCount()).To(Equal(0))
+		})
+
+		It("deletes the new access key if the old one cannot be tagged", func() {
+			iamAPI.TagUserWithContextReturns(nil, awserr.New("AccessDenied", "Access Denied", nil))
+
+			_, err := s3Client.RotateAccessKey(context.Background(), "test-binding-id")
+			Expect(err).To(HaveOccurred())
+			Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(iamAPI.DeleteAccessKeyWithContextArgsForCall(0)).AccessKeyId).To(HaveValue(Equal("new-access-key-id")))
+		})
+
+		It("returns ErrNoSuchResources if the binding has no user", func() {
+			iamAPI.ListUserTagsWithContextStub = nil
+			iamAPI.ListUserTagsWithContextReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "The user cannot be found", nil))
+
+			_, err := s3Client.RotateAccessKey(context.Background(), "test-binding-id")
+			Expect(err).To(MatchError(s3.ErrNoSuchResources))
+			Expect(iamAPI.CreateAccessKeyWithContextCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("UndoAccessKeyRotation", func() {
+		It("deletes the new access key and keeps the previous one", func() {
+			Expect(s3Client.UndoAccessKeyRotation(context.Background(), "test-binding-id", "new-access-key-id")).To(Succeed())
+
+			Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(1))
+			deleteInput := inputOf(iamAPI.DeleteAccessKeyWithContextArgsForCall(0))
+			Expect(deleteInput.UserName).To(HaveValue(Equal("test-bucket-prefix-test-binding-id")))
+			Expect(deleteInput.AccessKeyId).To(HaveValue(Equal("new-access-key-id")))
+			Expect(iamAPI.UntagUserWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(iamAPI.UntagUserWithContextArgsForCall(0)).TagKeys).To(HaveLen(2))
+		})
+	})
+
+	Describe("AccessKeyRotationOverlap", func() {
+		It("defaults to a day", func() {
+			Expect((&s3.Config{}).AccessKeyRotationOverlap()).To(Equal(24 * time.Hour))
+			Expect(s3ClientConfig.AccessKeyRotationOverlap()).To(Equal(2 * time.Hour))
+		})
+	})
+
+	Describe("ExpireRotatedAccessKey", func() {
+		It("does nothing if there is no previous access key", func() {
+			Expect(s3Client.ExpireRotatedAccessKey(context.Background(), "test-binding-id")).To(Succeed())
+			Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(0))
+			Expect(iamAPI.UntagUserWithContextCallCount()).To(Equal(0))
+		})
+
+		It("keeps the previous access key until its overlap is over", func() {
+			userTags = append(userTags,
+				&iam.Tag{Key: aws.String("previous_access_key_id"), Value: aws.String("old-access-key-id")},
+				&iam.Tag{Key: aws.String("previous_access_key_expires_at"), Value: aws.String(time.Now().Add(time.Hour).UTC().Format(time.RFC3339))},
+			)
+
+			Expect(s3Client.ExpireRotatedAccessKey(context.Background(), "test-binding-id")).To(Succeed())
+			Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(0))
+		})
+
+		It("untags the user if the previous access key was already deleted", func() {
+			userTags = append(userTags,
+				&iam.Tag{Key: aws.String("previous_access_key_id"), Value: aws.String("old-access-key-id")},
+				&iam.Tag{Key: aws.String("previous_access_key_expires_at"), Value: aws.String(time.Now().Add(-time.Hour).UTC().Format(time.RFC3339))},
+			)
+			iamAPI.DeleteAccessKeyWithContextReturns(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "The access key cannot be found", nil))
+
+			Expect(s3Client.ExpireRotatedAccessKey(context.Background(), "test-binding-id")).To(Succeed())
+			Expect(iamAPI.UntagUserWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(iamAPI.UntagUserWithContextArgsForCall(0)).TagKeys).To(HaveLen(2))
+		})
+	})
+
+	Describe("AddUserToBucket with rotate_access_key", func() {
+		var bindData provider.BindData
+
+		BeforeEach(func() {
+			bindData = provider.BindData{
+				InstanceID: "test-instance-id",
+				BindingID:  "test-binding-id",
+				Details: domain.BindDetails{
+					RawParameters: json.RawMessage(`{"rotate_access_key": true, "prefix": "app-2"}`),
+				},
+			}
+			s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{Policy: aws.String(string(policyJSON))}, nil)
+		})
+
+		It("rotates the existing user's access key instead of creating a user", func() {
+			bucketCredentials, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bucketCredentials.AWSAccessKeyID).To(Equal("new-access-key-id"))
+
+			Expect(iamAPI.CreateUserWithContextCallCount()).To(Equal(0))
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(0))
+			Expect(iamAPI.TagUserWithContextCallCount()).To(Equal(1))
+		})
+
+		It("keeps the prefix from the bucket policy rather than the request", func() {
+			bucketCredentials, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bucketCredentials.Prefix).To(Equal("app-1/"))
+		})
+
+		It("refuses to rotate a binding of another service instance", func() {
+			bindData.InstanceID = "other-instance-id"
+
+			_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			Expect(err).To(MatchError(s3.ErrBindingNotForInstance))
+			Expect(iamAPI.CreateAccessKeyWithContextCallCount()).To(Equal(0))
+		})
+
+		Context("for role bindings", func() {
+			BeforeEach(func() {
+				s3ClientConfig.AssumeRolePrincipalARN = "arn:aws:iam::123456789012:user/assumer"
+				bindData.Details.RawParameters = json.RawMessage(`{"rotate_access_key": true, "credential_type": "assume_role"}`)
+			})
+
+			It("rejects the rotation", func() {
+				_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+				Expect(err).To(MatchError(ContainSubstring("rotate_access_key cannot be used")))
+				Expect(iamAPI.Invocations()).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -1,8 +1,13 @@
 package s3_test
 
 import (
+	"context"
 	"testing"
 
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -11,3 +16,22 @@ func TestS3(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "S3 Suite")
 }
+
+// storeBucketPolicies makes the fake return the last bucket policy put, as S3
+// would, so that the client's checks of its policy changes pass.
+func storeBucketPolicies(s3API *fakeClient.FakeS3API) {
+	s3API.PutBucketPolicyWithContextStub = func(_ context.Context, input *awsS3.PutBucketPolicyInput, _ ...request.Option) (*awsS3.PutBucketPolicyOutput, error) {
+		s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{Policy: input.Policy}, nil)
+		return &awsS3.PutBucketPolicyOutput{}, nil
+	}
+	s3API.DeleteBucketPolicyWithContextStub = func(context.Context, *awsS3.DeleteBucketPolicyInput, ...request.Option) (*awsS3.DeleteBucketPolicyOutput, error) {
+		s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+		return &awsS3.DeleteBucketPolicyOutput{}, nil
+	}
+}
+
+// inputOf picks the input out of the arguments recorded by a fake AWS
+// client's ...WithContextArgsForCall.
+func inputOf[T any](_ context.Context, input T, _ []request.Option) T {
+	return input
+}
@@ -0,0 +1,41 @@
+package s3
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// NewSession returns an AWS session for the configured region. If
+// aws_ca_cert is set, it is trusted instead of the system's CAs, for S3
+// compatible backends with their own CA.
+func NewSession(config *Config) (*session.Session, error) {
+	options := session.Options{
+		Config: aws.Config{Region: aws.String(config.AWSRegion)},
+	}
+	if config.AWSCACert != "" {
+		options.CustomCABundle = strings.NewReader(config.AWSCACert)
+	}
+	return session.NewSessionWithOptions(options)
+}
+
+// S3ServiceConfig is the configuration for the S3 client, on top of the
+// session's, which points it at s3_endpoint if that is set.
+func (c *Config) S3ServiceConfig() *aws.Config {
+	serviceConfig := &aws.Config{S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle)}
+	if c.S3Endpoint != "" {
+		serviceConfig.Endpoint = aws.String(c.S3Endpoint)
+	}
+	return serviceConfig
+}
+
+// IAMServiceConfig is the configuration for the IAM client, on top of the
+// session's, which points it at iam_endpoint if that is set.
+func (c *Config) IAMServiceConfig() *aws.Config {
+	serviceConfig := &aws.Config{}
+	if c.IAMEndpoint != "" {
+		serviceConfig.Endpoint = aws.String(c.IAMEndpoint)
+	}
+	return serviceConfig
+}
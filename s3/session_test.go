@@ -0,0 +1,44 @@
+package s3_test
+
+import (
+	"github.com/alphagov/paas-s3-broker/s3"
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AWS client configuration", func() {
+	var config *s3.Config
+
+	BeforeEach(func() {
+		config = &s3.Config{AWSRegion: "eu-west-2"}
+	})
+
+	It("uses AWS's endpoints by default", func() {
+		Expect(config.S3ServiceConfig().Endpoint).To(BeNil())
+		Expect(config.S3ServiceConfig().S3ForcePathStyle).To(HaveValue(BeFalse()))
+		Expect(config.IAMServiceConfig().Endpoint).To(BeNil())
+	})
+
+	It("uses custom endpoints", func() {
+		config.S3Endpoint = "https://minio.internal:9000"
+		config.IAMEndpoint = "http://localhost:4566"
+		config.S3ForcePathStyle = true
+
+		Expect(config.S3ServiceConfig().Endpoint).To(Equal(aws.String("https://minio.internal:9000")))
+		Expect(config.S3ServiceConfig().S3ForcePathStyle).To(HaveValue(BeTrue()))
+		Expect(config.IAMServiceConfig().Endpoint).To(Equal(aws.String("http://localhost:4566")))
+	})
+
+	It("creates a session in the configured region", func() {
+		sess, err := s3.NewSession(config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sess.Config.Region).To(HaveValue(Equal("eu-west-2")))
+	})
+
+	It("errors if the CA certificate cannot be loaded", func() {
+		config.AWSCACert = "not a certificate"
+		_, err := s3.NewSession(config)
+		Expect(err).To(HaveOccurred())
+	})
+})
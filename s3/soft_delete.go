@@ -0,0 +1,306 @@
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	pendingDeletionTagKey = "pending_deletion"
+	restoredAtTagKey      = "restored_at"
+)
+
+var (
+	ErrNotPendingDeletion        = errors.New("bucket is not pending deletion")
+	ErrRetentionPeriodExpired    = errors.New("bucket is past its retention period")
+	ErrRetentionPeriodNotExpired = errors.New("bucket is still within its retention period")
+)
+
+// SoftDeletedBucket is a bucket whose service instance has been deleted, but
+// which is being kept for the retention period in case it was a mistake.
+type SoftDeletedBucket struct {
+	InstanceID string
+	BucketName string
+	DeletedAt  time.Time
+	ExpiresAt  time.Time
+}
+
+func (b SoftDeletedBucket) Expired(now time.Time) bool {
+	return !now.Before(b.ExpiresAt)
+}
+
+// SoftDeleteEnabled reports whether deprovisioned buckets should be kept
+// for a retention period rather than being deleted straight away.
+func (s *S3Client) SoftDeleteEnabled() bool {
+	return s.softDeleteRetention > 0
+}
+
+// SoftDeleteBucket blocks all access to the bucket and tags it with the time
+// it was deleted, so that the reaper can delete it for real once the
+// retention period is over. The block goes on first so that a tagged bucket
+// is always a blocked one.
+func (s *S3Client) SoftDeleteBucket(ctx context.Context, instanceID string) error {
+	logger := s.logger.Session("soft-delete-bucket")
+	bucketName := s.buildBucketName(instanceID)
+
+	tags, err := s.getBucketTags(ctx, logger, bucketName)
+	if err != nil {
+		return err
+	}
+
+	err = s.updateBucketPolicy(ctx, logger, bucketName,
+		func(currentBucketPolicy string) (bool, error) {
+			logger.Info("block-access", lager.Data{"bucket": bucketName})
+			updatedPolicy, err := policy.AddPendingDeletionToPolicy(currentBucketPolicy, s.partition, bucketName)
+			if err != nil {
+				logger.Error("block-access", err)
+				return false, err
+			}
+			err = s.putOrDeleteBucketPolicy(ctx, logger, bucketName, updatedPolicy)
+			if err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+		policy.HasPendingDeletion,
+	)
+	if err != nil {
+		return classifyAWSError(err)
+	}
+
+	// If Cloud Controller retries the deprovision, keep the original
+	// timestamp so that the retention period is not extended.
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == pendingDeletionTagKey {
+			return nil
+		}
+	}
+
+	deletedAt := time.Now().UTC().Format(time.RFC3339)
+	tags = append(tags, &s3.Tag{Key: aws.String(pendingDeletionTagKey), Value: aws.String(deletedAt)})
+	logger.Info("tag-bucket", lager.Data{"bucket": bucketName, "tags": tags})
+	_, err = s.tagBucket(ctx, instanceID, tags)
+	if err != nil {
+		logger.Error("tag-bucket", err)
+		return classifyAWSError(err)
+	}
+	return nil
+}
+
+// RestoreBucket lifts the block put on by SoftDeleteBucket. The service
+// instance has already gone from Cloud Foundry, so the bucket is no longer
+// managed by the broker once it has been restored. It is tagged with the
+// time it was restored, so that FindOrphans does not offer to delete it.
+func (s *S3Client) RestoreBucket(ctx context.Context, instanceID string) error {
+	logger := s.logger.Session("restore-bucket")
+	bucketName := s.buildBucketName(instanceID)
+
+	softDeletedBucket, tags, err := s.getSoftDeletedBucket(ctx, logger, instanceID)
+	if err != nil {
+		return err
+	}
+	if softDeletedBucket.Expired(time.Now()) {
+		return fmt.Errorf("%w: it was due to be deleted at %s", ErrRetentionPeriodExpired, softDeletedBucket.ExpiresAt)
+	}
+
+	err = s.updateBucketPolicy(ctx, logger, bucketName,
+		func(currentBucketPolicy string) (bool, error) {
+			if currentBucketPolicy == "" {
+				return false, nil
+			}
+			logger.Info("unblock-access", lager.Data{"bucket": bucketName})
+			updatedPolicy, err := policy.RemovePendingDeletionFromPolicy(currentBucketPolicy)
+			if err != nil {
+				logger.Error("unblock-access", err)
+				return false, err
+			}
+			err = s.putOrDeleteBucketPolicy(ctx, logger, bucketName, updatedPolicy)
+			if err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+		func(updatedBucketPolicy string) (bool, error) {
+			blocked, err := policy.HasPendingDeletion(updatedBucketPolicy)
+			return !blocked, err
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	remainingTags := []*s3.Tag{}
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) != pendingDeletionTagKey {
+			remainingTags = append(remainingTags, tag)
+		}
+	}
+	remainingTags = append(remainingTags, &s3.Tag{
+		Key:   aws.String(restoredAtTagKey),
+		Value: aws.String(time.Now().UTC().Format(time.RFC3339)),
+	})
+	logger.Info("tag-bucket", lager.Data{"bucket": bucketName, "tags": remainingTags})
+	_, err = s.tagBucket(ctx, instanceID, remainingTags)
+	if err != nil {
+		logger.Error("tag-bucket", err)
+		return err
+	}
+	return nil
+}
+
+// ListSoftDeletedBuckets finds every bucket belonging to this broker which is
+// waiting to be reaped, whether or not its retention period is over.
+func (s *S3Client) ListSoftDeletedBuckets(ctx context.Context) ([]SoftDeletedBucket, error) {
+	logger := s.logger.Session("list-soft-deleted-buckets")
+
+	logger.Info("list-buckets")
+	listBucketsOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.ListBucketsOutput, error) {
+		return s.s3Client.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
+	})
+	if err != nil {
+		logger.Error("list-buckets", err)
+		return nil, err
+	}
+
+	softDeletedBuckets := []SoftDeletedBucket{}
+	for _, bucket := range listBucketsOutput.Buckets {
+		bucketName := aws.StringValue(bucket.Name)
+		if !strings.HasPrefix(bucketName, s.bucketPrefix) {
+			continue
+		}
+		softDeletedBucket, _, err := s.getSoftDeletedBucket(ctx, logger, strings.TrimPrefix(bucketName, s.bucketPrefix))
+		if err != nil {
+			if err == ErrNotPendingDeletion || err == ErrNoSuchResources {
+				continue
+			}
+			return nil, err
+		}
+		softDeletedBuckets = append(softDeletedBuckets, softDeletedBucket)
+	}
+	return softDeletedBuckets, nil
+}
+
+// ReapBucket permanently deletes a soft deleted bucket and everything in it.
+// It refuses to touch buckets which are still within their retention period.
+func (s *S3Client) ReapBucket(ctx context.Context, instanceID string, progress func(objectsDeleted int)) error {
+	logger := s.logger.Session("reap-bucket")
+	bucketName := s.buildBucketName(instanceID)
+
+	softDeletedBucket, _, err := s.getSoftDeletedBucket(ctx, logger, instanceID)
+	if err != nil {
+		return err
+	}
+	if !softDeletedBucket.Expired(time.Now()) {
+		return fmt.Errorf("%w: it is due to be deleted at %s", ErrRetentionPeriodNotExpired, softDeletedBucket.ExpiresAt)
+	}
+
+	// Nobody should be using the bucket any more, so rather than lifting
+	// just the block, which could briefly make a public bucket public
+	// again, remove the whole policy.
+	logger.Info("delete-policy", lager.Data{"bucket": bucketName})
+	_, err = retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.DeleteBucketPolicyOutput, error) {
+		return s.s3Client.DeleteBucketPolicyWithContext(ctx, &s3.DeleteBucketPolicyInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		logger.Error("delete-policy", err)
+		return err
+	}
+
+	err = s.EmptyBucket(ctx, instanceID, progress)
+	if err != nil {
+		return err
+	}
+	return s.DeleteBucket(ctx, instanceID)
+}
+
+func (s *S3Client) getSoftDeletedBucket(ctx context.Context, logger lager.Logger, instanceID string) (SoftDeletedBucket, []*s3.Tag, error) {
+	bucketName := s.buildBucketName(instanceID)
+
+	tags, err := s.getBucketTags(ctx, logger, bucketName)
+	if err != nil {
+		return SoftDeletedBucket{}, nil, err
+	}
+
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) != pendingDeletionTagKey {
+			continue
+		}
+		deletedAt, err := time.Parse(time.RFC3339, aws.StringValue(tag.Value))
+		if err != nil {
+			logger.Error("parse-pending-deletion-tag", err, lager.Data{"bucket": bucketName})
+			return SoftDeletedBucket{}, nil, fmt.Errorf("bucket %s has an invalid %s tag: %v", bucketName, pendingDeletionTagKey, err)
+		}
+		return SoftDeletedBucket{
+			InstanceID: instanceID,
+			BucketName: bucketName,
+			DeletedAt:  deletedAt,
+			ExpiresAt:  deletedAt.Add(s.softDeleteRetention),
+		}, tags, nil
+	}
+	return SoftDeletedBucket{}, nil, ErrNotPendingDeletion
+}
+
+func (s *S3Client) getBucketTags(ctx context.Context, logger lager.Logger, bucketName string) ([]*s3.Tag, error) {
+	logger.Info("get-bucket-tagging", lager.Data{"bucket": bucketName})
+	getBucketTaggingOutput, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.GetBucketTaggingOutput, error) {
+		return s.s3Client.GetBucketTaggingWithContext(ctx, &s3.GetBucketTaggingInput{
+			Bucket: aws.String(bucketName),
+		})
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			switch awsErr.Code() {
+			case "NoSuchBucket":
+				return nil, ErrNoSuchResources
+			case "NoSuchTagSet":
+				return []*s3.Tag{}, nil
+			}
+		}
+		logger.Error("get-bucket-tagging", err)
+		return nil, classifyAWSError(err)
+	}
+	return getBucketTaggingOutput.TagSet, nil
+}
+
+// putOrDeleteBucketPolicy saves the policy, or deletes it if there are no
+// statements left, which S3 would reject.
+func (s *S3Client) putOrDeleteBucketPolicy(ctx context.Context, logger lager.Logger, bucketName string, policyDoc policy.PolicyDocument) error {
+	if len(policyDoc.Statement) == 0 {
+		logger.Info("delete-policy", lager.Data{"bucket": bucketName})
+		_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*s3.DeleteBucketPolicyOutput, error) {
+			return s.s3Client.DeleteBucketPolicyWithContext(ctx, &s3.DeleteBucketPolicyInput{
+				Bucket: aws.String(bucketName),
+			})
+		})
+		if err != nil {
+			logger.Error("delete-policy", err)
+			return err
+		}
+		return nil
+	}
+
+	logger.Info("update-policy", lager.Data{"bucket": bucketName})
+	policyJSON, err := json.Marshal(policyDoc)
+	if err != nil {
+		logger.Error("update-policy", err)
+		return err
+	}
+	err = s.putBucketPolicy(ctx, logger, bucketName, string(policyJSON))
+	if err != nil {
+		logger.Error("put-bucket-policy", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package s3_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-s3-broker/s3/policy"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Soft delete", func() {
+	const bucketName = "test-bucket-prefix-test-instance-id"
+
+	var (
+		s3API          *fakeClient.FakeS3API
+		iamAPI         *fakeClient.FakeIAMAPI
+		kmsAPI         *fakeClient.FakeKMSAPI
+		s3Client       *s3.S3Client
+		s3ClientConfig *s3.Config
+	)
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		storeBucketPolicies(s3API)
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
+		s3ClientConfig = &s3.Config{
+			AWSRegion:               "eu-west-2",
+			ResourcePrefix:          "test-bucket-prefix-",
+			SoftDeleteRetentionDays: 7,
+		}
+		s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+		s3API.GetBucketEncryptionWithContextReturns(nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found", nil))
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			s3ClientConfig,
+			s3API,
+			iamAPI,
+			kmsAPI,
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	tagged := func(deletedAt time.Time) *awsS3.GetBucketTaggingOutput {
+		return &awsS3.GetBucketTaggingOutput{
+			TagSet: []*awsS3.Tag{
+				{Key: aws.String("service_instance_guid"), Value: aws.String("test-instance-id")},
+				{Key: aws.String("pending_deletion"), Value: aws.String(deletedAt.UTC().Format(time.RFC3339))},
+			},
+		}
+	}
+
+	pendingDeletionTag := func(tags []*awsS3.Tag) *awsS3.Tag {
+		for _, tag := range tags {
+			if aws.StringValue(tag.Key) == "pending_deletion" {
+				return tag
+			}
+		}
+		return nil
+	}
+
+	It("is enabled by setting a retention period", func() {
+		Expect(s3Client.SoftDeleteEnabled()).To(BeTrue())
+
+		s3ClientConfig.SoftDeleteRetentionDays = 0
+		Expect(s3.NewS3Client(s3ClientConfig, s3API, iamAPI, kmsAPI, lager.NewLogger("test")).SoftDeleteEnabled()).To(BeFalse())
+	})
+
+	Describe("SoftDeleteBucket", func() {
+		BeforeEach(func() {
+			s3API.GetBucketTaggingWithContextReturns(&awsS3.GetBucketTaggingOutput{
+				TagSet: []*awsS3.Tag{{Key: aws.String("service_instance_guid"), Value: aws.String("test-instance-id")}},
+			}, nil)
+		})
+
+		It("blocks access to the bucket, then tags it with the deletion time", func() {
+			err := s3Client.SoftDeleteBucket(context.Background(), "test-instance-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(1))
+			putPolicyInput := inputOf(s3API.PutBucketPolicyWithContextArgsForCall(0))
+			Expect(putPolicyInput.Bucket).To(HaveValue(Equal(bucketName)))
+			Expect(putPolicyInput.Policy).To(HaveValue(ContainSubstring(policy.PendingDeletionSid)))
+
+			Expect(s3API.PutBucketTaggingWithContextCallCount()).To(Equal(1))
+			tags := inputOf(s3API.PutBucketTaggingWithContextArgsForCall(0)).Tagging.TagSet
+			Expect(tags).To(HaveLen(2))
+			deletedAt, err := time.Parse(time.RFC3339, aws.StringValue(pendingDeletionTag(tags).Value))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deletedAt).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		It("does not restart the retention period if it is retried", func() {
+			s3API.GetBucketTaggingWithContextReturns(tagged(time.Now().Add(-time.Hour)), nil)
+
+			err := s3Client.SoftDeleteBucket(context.Background(), "test-instance-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s3API.PutBucketTaggingWithContextCallCount()).To(Equal(0))
+		})
+
+		It("returns ErrNoSuchResources if there is no bucket", func() {
+			s3API.GetBucketTaggingWithContextReturns(nil, awserr.New("NoSuchBucket", "The specified bucket does not exist", nil))
+
+			err := s3Client.SoftDeleteBucket(context.Background(), "test-instance-id")
+			Expect(err).To(MatchError(s3.ErrNoSuchResources))
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("RestoreBucket", func() {
+		BeforeEach(func() {
+			blockedPolicy, err := policy.AddPendingDeletionToPolicy("", "aws", bucketName)
+			Expect(err).NotTo(HaveOccurred())
+			blockedPolicyJSON, err := json.Marshal(blockedPolicy)
+			Expect(err).NotTo(HaveOccurred())
+			s3API.GetBucketPolicyWithContextReturns(&awsS3.GetBucketPolicyOutput{Policy: aws.String(string(blockedPolicyJSON))}, nil)
+			s3API.GetBucketTaggingWithContextReturns(tagged(time.Now().Add(-24*time.Hour)), nil)
+		})
+
+		It("lifts the block and replaces the tag with the time it was restored", func() {
+			err := s3Client.RestoreBucket(context.Background(), "test-instance-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(1))
+			Expect(s3API.PutBucketTaggingWithContextCallCount()).To(Equal(1))
+			tags := inputOf(s3API.PutBucketTaggingWithContextArgsForCall(0)).Tagging.TagSet
+			Expect(tags).To(HaveLen(2))
+			Expect(pendingDeletionTag(tags)).To(BeNil())
+			Expect(tags[1].Key).To(HaveValue(Equal("restored_at")))
+			restoredAt, err := time.Parse(time.RFC3339, aws.StringValue(tags[1].Value))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restoredAt).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		It("refuses once the retention period is over", func() {
+			s3API.GetBucketTaggingWithContextReturns(tagged(time.Now().Add(-8*24*time.Hour)), nil)
+
+			err := s3Client.RestoreBucket(context.Background(), "test-instance-id")
+			Expect(err).To(MatchError(s3.ErrRetentionPeriodExpired))
+			Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(0))
+		})
+
+		It("refuses if the bucket was never soft deleted", func() {
+			s3API.GetBucketTaggingWithContextReturns(&awsS3.GetBucketTaggingOutput{}, nil)
+
+			err := s3Client.RestoreBucket(context.Background(), "test-instance-id")
+			Expect(err).To(MatchError(s3.ErrNotPendingDeletion))
+		})
+	})
+
+	Describe("ListSoftDeletedBuckets", func() {
+		It("only returns this broker's buckets which are pending deletion", func() {
+			deletedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+			s3API.ListBucketsWithContextReturns(&awsS3.ListBucketsOutput{
+				Buckets: []*awsS3.Bucket{
+					{Name: aws.String("someone-elses-bucket")},
+					{Name: aws.String("test-bucket-prefix-live")},
+					{Name: aws.String("test-bucket-prefix-deleted")},
+				},
+			}, nil)
+			s3API.GetBucketTaggingWithContextStub = func(_ context.Context, input *awsS3.GetBucketTaggingInput, _ ...request.Option) (*awsS3.GetBucketTaggingOutput, error) {
+				if aws.StringValue(input.Bucket) == "test-bucket-prefix-deleted" {
+					return tagged(deletedAt), nil
+				}
+				return &awsS3.GetBucketTaggingOutput{}, nil
+			}
+
+			buckets, err := s3Client.ListSoftDeletedBuckets(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(buckets).To(Equal([]s3.SoftDeletedBucket{{
+				InstanceID: "deleted",
+				BucketName: "test-bucket-prefix-deleted",
+				DeletedAt:  deletedAt,
+				ExpiresAt:  deletedAt.Add(7 * 24 * time.Hour),
+			}}))
+			Expect(s3API.GetBucketTaggingWithContextCallCount()).To(Equal(2))
+		})
+	})
+
+	Describe("ReapBucket", func() {
+		It("deletes the policy, everything in the bucket and then the bucket", func() {
+			s3API.GetBucketTaggingWithContextReturns(tagged(time.Now().Add(-8*24*time.Hour)), nil)
+			s3API.ListMultipartUploadsWithContextReturns(&awsS3.ListMultipartUploadsOutput{}, nil)
+			s3API.ListObjectVersionsWithContextReturns(&awsS3.ListObjectVersionsOutput{}, nil)
+
+			err := s3Client.ReapBucket(context.Background(), "test-instance-id", func(int) {})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(1))
+			Expect(s3API.ListObjectVersionsWithContextCallCount()).To(Equal(1))
+			Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(s3API.DeleteBucketWithContextArgsForCall(0)).Bucket).To(HaveValue(Equal(bucketName)))
+		})
+
+		It("refuses while the bucket is within its retention period", func() {
+			s3API.GetBucketTaggingWithContextReturns(tagged(time.Now().Add(-24*time.Hour)), nil)
+
+			err := s3Client.ReapBucket(context.Background(), "test-instance-id", func(int) {})
+			Expect(err).To(MatchError(s3.ErrRetentionPeriodNotExpired))
+			Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(0))
+			Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(0))
+		})
+	})
+})
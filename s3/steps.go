@@ -0,0 +1,169 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// NeedsCleanupTagKey marks a bucket, user, role or KMS key which was left
+// behind when a failed bind or provision could not be rolled back. Its
+// value is the time the rollback failed.
+const NeedsCleanupTagKey = "needs_cleanup"
+
+// step is one change made by an operation, such as binding, which makes
+// several changes in AWS. If a later step fails, undo is run to reverse it.
+type step struct {
+	name string
+	do   func() error
+
+	// undo reverses do. It can be nil if there is nothing to reverse, or if
+	// undoing an earlier step reverses this one too, as deleting a user
+	// deletes its access keys.
+	undo func() error
+
+	// partial means that do can fail after it has taken effect, so undo is
+	// run even if do fails, and must cope with do having done nothing.
+	partial bool
+
+	// markForCleanup tags whatever undo failed to remove with
+	// NeedsCleanupTagKey, so that it can be found and removed later.
+	markForCleanup func() error
+}
+
+// runSteps runs the steps in order. If one fails, the steps which were
+// completed are undone in reverse order and the step's error is returned.
+// Undoing carries on past steps which cannot be undone, marking what they
+// left behind for cleanup, and their errors are added to the one returned.
+func runSteps(logger lager.Logger, steps []step) error {
+	for i, st := range steps {
+		err := st.do()
+		if err == nil {
+			continue
+		}
+
+		completed := steps[:i]
+		if st.partial {
+			completed = steps[:i+1]
+		}
+		completedNames := []string{}
+		for _, c := range completed {
+			completedNames = append(completedNames, c.name)
+		}
+		logger.Error("step-failed", err, lager.Data{"step": st.name, "completed-steps": completedNames})
+
+		undoErr := undoSteps(logger, completed)
+		if undoErr != nil {
+			return fmt.Errorf("%w (rolling back also failed, leaving resources tagged %s: %v)", err, NeedsCleanupTagKey, undoErr)
+		}
+		logger.Info("rolled-back", lager.Data{"steps": completedNames})
+		return err
+	}
+	return nil
+}
+
+func undoSteps(logger lager.Logger, steps []step) error {
+	var errs []error
+	for i := len(steps) - 1; i >= 0; i-- {
+		st := steps[i]
+		if st.undo == nil {
+			continue
+		}
+
+		logger.Info("undo-step", lager.Data{"step": st.name})
+		err := st.undo()
+		if err == nil {
+			continue
+		}
+		logger.Error("undo-step", err, lager.Data{"step": st.name})
+		errs = append(errs, fmt.Errorf("undoing %s: %w", st.name, err))
+
+		if st.markForCleanup != nil {
+			markErr := st.markForCleanup()
+			if markErr != nil {
+				logger.Error("mark-for-cleanup", markErr, lager.Data{"step": st.name})
+				errs = append(errs, fmt.Errorf("marking %s for cleanup: %w", st.name, markErr))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func needsCleanupValue() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// markBucketForCleanup adds the needs cleanup tag to the bucket, keeping its
+// other tags.
+func (s *S3Client) markBucketForCleanup(ctx context.Context, logger lager.Logger, instanceID string) error {
+	bucketName := s.buildBucketName(instanceID)
+	tags, err := s.getBucketTags(ctx, logger, bucketName)
+	if err != nil {
+		return err
+	}
+	tags = mergeTags(tags, []*s3.Tag{{
+		Key:   aws.String(NeedsCleanupTagKey),
+		Value: aws.String(needsCleanupValue()),
+	}})
+
+	logger.Info("mark-bucket-for-cleanup", lager.Data{"bucket": bucketName})
+	_, err = s.tagBucket(ctx, instanceID, tags)
+	return err
+}
+
+func (s *S3Client) markUserForCleanup(ctx context.Context, logger lager.Logger, username string) error {
+	logger.Info("mark-user-for-cleanup", lager.Data{"user": username})
+	_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.TagUserOutput, error) {
+		return s.iamClient.TagUserWithContext(ctx, &iam.TagUserInput{
+			UserName: aws.String(username),
+			Tags: []*iam.Tag{{
+				Key:   aws.String(NeedsCleanupTagKey),
+				Value: aws.String(needsCleanupValue()),
+			}},
+		})
+	})
+	return err
+}
+
+func (s *S3Client) markRoleForCleanup(ctx context.Context, logger lager.Logger, roleName string) error {
+	logger.Info("mark-role-for-cleanup", lager.Data{"role": roleName})
+	_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*iam.TagRoleOutput, error) {
+		return s.iamClient.TagRoleWithContext(ctx, &iam.TagRoleInput{
+			RoleName: aws.String(roleName),
+			Tags: []*iam.Tag{{
+				Key:   aws.String(NeedsCleanupTagKey),
+				Value: aws.String(needsCleanupValue()),
+			}},
+		})
+	})
+	return err
+}
+
+func (s *S3Client) markKMSKeyForCleanup(ctx context.Context, logger lager.Logger, keyARN string) error {
+	logger.Info("mark-kms-key-for-cleanup", lager.Data{"key": keyARN})
+	_, err := retryAWS(ctx, s, logger, func(ctx context.Context) (*kms.TagResourceOutput, error) {
+		return s.kmsClient.TagResourceWithContext(ctx, &kms.TagResourceInput{
+			KeyId: aws.String(keyARN),
+			Tags: []*kms.Tag{{
+				TagKey:   aws.String(NeedsCleanupTagKey),
+				TagValue: aws.String(needsCleanupValue()),
+			}},
+		})
+	})
+	return err
+}
+
+// ignoreNoSuchResources treats there being nothing to delete as success.
+func ignoreNoSuchResources(err error) error {
+	if err == ErrNoSuchResources {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,242 @@
+package s3_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/alphagov/paas-s3-broker/s3"
+	fakeClient "github.com/alphagov/paas-s3-broker/s3/fakes"
+	"github.com/alphagov/paas-service-broker-base/provider"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/brokerapi/v10/domain"
+)
+
+var _ = Describe("Rolling back failed operations", func() {
+	var (
+		s3API          *fakeClient.FakeS3API
+		iamAPI         *fakeClient.FakeIAMAPI
+		kmsAPI         *fakeClient.FakeKMSAPI
+		s3ClientConfig *s3.Config
+		s3Client       *s3.S3Client
+	)
+
+	BeforeEach(func() {
+		s3API = &fakeClient.FakeS3API{}
+		iamAPI = &fakeClient.FakeIAMAPI{}
+		kmsAPI = &fakeClient.FakeKMSAPI{}
+		s3ClientConfig = &s3.Config{
+			AWSRegion:      "eu-west-2",
+			ResourcePrefix: "test-bucket-prefix-",
+			IAMUserPath:    "/test-iam-path/",
+		}
+	})
+
+	JustBeforeEach(func() {
+		s3Client = s3.NewS3Client(
+			s3ClientConfig,
+			s3API,
+			iamAPI,
+			kmsAPI,
+			lager.NewLogger("s3-service-broker-test"),
+		)
+	})
+
+	Describe("binding", func() {
+		bindData := provider.BindData{
+			InstanceID: "test-instance-id",
+			BindingID:  "test-binding-id",
+		}
+
+		BeforeEach(func() {
+			storeBucketPolicies(s3API)
+			s3API.GetBucketPolicyWithContextReturns(nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil))
+			s3API.GetBucketEncryptionWithContextReturns(&awsS3.GetBucketEncryptionOutput{}, nil)
+			kmsAPI.DescribeKeyWithContextReturns(nil, awserr.New(kms.ErrCodeNotFoundException, "Alias not found", nil))
+			iamAPI.CreateUserWithContextReturns(&iam.CreateUserOutput{
+				User: &iam.User{Arn: aws.String("arn:aws:iam::123456789012:user/test-bucket-prefix-test-binding-id")},
+			}, nil)
+			iamAPI.CreateAccessKeyWithContextReturns(&iam.CreateAccessKeyOutput{
+				AccessKey: &iam.AccessKey{
+					AccessKeyId:     aws.String("access-key-id"),
+					SecretAccessKey: aws.String("secret-access-key"),
+				},
+			}, nil)
+			iamAPI.ListAccessKeysWithContextReturns(&iam.ListAccessKeysOutput{
+				AccessKeyMetadata: []*iam.AccessKeyMetadata{{AccessKeyId: aws.String("access-key-id")}},
+			}, nil)
+			iamAPI.ListAttachedUserPoliciesWithContextReturns(&iam.ListAttachedUserPoliciesOutput{}, nil)
+			iamAPI.ListUserPoliciesWithContextReturns(&iam.ListUserPoliciesOutput{}, nil)
+		})
+
+		It("does not delete anything if creating the user fails", func() {
+			iamAPI.CreateUserWithContextReturns(nil, errors.New("create-user-failed"))
+
+			_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			Expect(err).To(MatchError("create-user-failed"))
+			Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(0))
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(0))
+		})
+
+		It("deletes the user and its access key if a later step fails", func() {
+			iamAPI.AttachUserPolicyWithContextReturns(nil, errors.New("attach-failed"))
+
+			_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			Expect(err).To(MatchError("attach-failed"))
+			Expect(iamAPI.DeleteAccessKeyWithContextCallCount()).To(Equal(1))
+			Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+			Expect(iamAPI.TagUserWithContextCallCount()).To(Equal(0))
+		})
+
+		It("removes the binding's statement if granting access fails after the policy was changed", func() {
+			s3API.GetBucketPolicyWithContextReturnsOnCall(1, nil, errors.New("read-back-failed"))
+
+			_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+			Expect(err).To(MatchError("read-back-failed"))
+
+			Expect(s3API.PutBucketPolicyWithContextCallCount()).To(Equal(1))
+			Expect(s3API.DeleteBucketPolicyWithContextCallCount()).To(Equal(1))
+			Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+		})
+
+		It("undoes the steps even if the request has been cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			iamAPI.AttachUserPolicyWithContextStub = func(context.Context, *iam.AttachUserPolicyInput, ...request.Option) (*iam.AttachUserPolicyOutput, error) {
+				cancel()
+				return nil, errors.New("attach-failed")
+			}
+
+			var deleteCtxErr error
+			iamAPI.DeleteUserWithContextStub = func(ctx context.Context, _ *iam.DeleteUserInput, _ ...request.Option) (*iam.DeleteUserOutput, error) {
+				deleteCtxErr = ctx.Err()
+				return &iam.DeleteUserOutput{}, nil
+			}
+
+			_, err := s3Client.AddUserToBucket(ctx, bindData)
+			Expect(err).To(MatchError("attach-failed"))
+			Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+			Expect(deleteCtxErr).NotTo(HaveOccurred())
+		})
+
+		Context("when the user cannot be deleted", func() {
+			BeforeEach(func() {
+				iamAPI.AttachUserPolicyWithContextReturns(nil, errors.New("attach-failed"))
+				iamAPI.DeleteUserWithContextReturns(nil, awserr.New("DeleteConflict", "Cannot delete entity", nil))
+			})
+
+			It("tags the user as needing cleanup", func() {
+				_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+				Expect(err).To(MatchError(ContainSubstring("attach-failed")))
+				Expect(err).To(MatchError(ContainSubstring("rolling back also failed")))
+
+				Expect(iamAPI.TagUserWithContextCallCount()).To(Equal(1))
+				tagUserInput := inputOf(iamAPI.TagUserWithContextArgsForCall(0))
+				Expect(tagUserInput.UserName).To(HaveValue(Equal("test-bucket-prefix-test-binding-id")))
+				Expect(tagUserInput.Tags).To(HaveLen(1))
+				Expect(tagUserInput.Tags[0].Key).To(HaveValue(Equal(s3.NeedsCleanupTagKey)))
+			})
+		})
+
+		Context("when the binding's statement cannot be removed", func() {
+			BeforeEach(func() {
+				s3API.GetBucketPolicyWithContextReturnsOnCall(1, nil, errors.New("read-back-failed"))
+				s3API.DeleteBucketPolicyWithContextReturns(nil, errors.New("delete-policy-failed"))
+				s3API.GetBucketTaggingWithContextReturns(&awsS3.GetBucketTaggingOutput{
+					TagSet: []*awsS3.Tag{{Key: aws.String("service_instance_guid"), Value: aws.String("test-instance-id")}},
+				}, nil)
+			})
+
+			It("tags the bucket as needing cleanup, keeping its other tags", func() {
+				_, err := s3Client.AddUserToBucket(context.Background(), bindData)
+				Expect(err).To(MatchError(ContainSubstring("rolling back also failed")))
+
+				Expect(s3API.PutBucketTaggingWithContextCallCount()).To(Equal(1))
+				tagSet := inputOf(s3API.PutBucketTaggingWithContextArgsForCall(0)).Tagging.TagSet
+				Expect(hasTag(tagSet, "service_instance_guid", "test-instance-id")).To(BeTrue())
+				Expect(tagSet).To(ContainElement(HaveField("Key", HaveValue(Equal(s3.NeedsCleanupTagKey)))))
+
+				By("still deleting the user")
+				Expect(iamAPI.DeleteUserWithContextCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("provisioning", func() {
+		provisionData := provider.ProvisionData{
+			InstanceID: "test-instance-id",
+			Plan:       domain.ServicePlan{ID: "test-plan-id"},
+		}
+
+		It("deletes the bucket if a step after creating it fails", func() {
+			s3API.PutBucketEncryptionWithContextReturns(nil, errors.New("encryption-failed"))
+
+			err := s3Client.CreateBucket(context.Background(), provisionData)
+			Expect(err).To(MatchError("encryption-failed"))
+			Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(1))
+			Expect(inputOf(s3API.DeleteBucketWithContextArgsForCall(0)).Bucket).To(HaveValue(Equal("test-bucket-prefix-test-instance-id")))
+			Expect(s3API.PutBucketTaggingWithContextCallCount()).To(Equal(0))
+		})
+
+		It("does not delete anything if creating the bucket fails", func() {
+			s3API.CreateBucketWithContextReturns(nil, awserr.New("BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded", nil))
+
+			err := s3Client.CreateBucket(context.Background(), provisionData)
+			Expect(err).To(HaveOccurred())
+			Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(0))
+		})
+
+		Context("with a KMS key", func() {
+			BeforeEach(func() {
+				s3ClientConfig.KMSPlanIDs = []string{"test-plan-id"}
+				kmsAPI.CreateKeyWithContextReturns(&kms.CreateKeyOutput{
+					KeyMetadata: &kms.KeyMetadata{
+						Arn:   aws.String("arn:aws:kms:eu-west-2:123456789012:key/test-key-id"),
+						KeyId: aws.String("test-key-id"),
+					},
+				}, nil)
+				kmsAPI.DescribeKeyWithContextReturns(&kms.DescribeKeyOutput{
+					KeyMetadata: &kms.KeyMetadata{KeyId: aws.String("test-key-id")},
+				}, nil)
+				s3API.PutBucketEncryptionWithContextReturns(nil, errors.New("encryption-failed"))
+			})
+
+			It("schedules the key's deletion as well as deleting the bucket", func() {
+				err := s3Client.CreateBucket(context.Background(), provisionData)
+				Expect(err).To(MatchError("encryption-failed"))
+				Expect(kmsAPI.ScheduleKeyDeletionWithContextCallCount()).To(Equal(1))
+				Expect(s3API.DeleteBucketWithContextCallCount()).To(Equal(1))
+			})
+
+			It("tags the key as needing cleanup if it cannot be deleted", func() {
+				kmsAPI.ScheduleKeyDeletionWithContextReturns(nil, errors.New("schedule-failed"))
+
+				err := s3Client.CreateBucket(context.Background(), provisionData)
+				Expect(err).To(MatchError(ContainSubstring("rolling back also failed")))
+				Expect(kmsAPI.TagResourceWithContextCallCount()).To(Equal(1))
+				Expect(inputOf(kmsAPI.TagResourceWithContextArgsForCall(0)).KeyId).To(HaveValue(Equal("arn:aws:kms:eu-west-2:123456789012:key/test-key-id")))
+			})
+		})
+
+		It("tags the bucket as needing cleanup if it cannot be deleted", func() {
+			s3API.PutBucketEncryptionWithContextReturns(nil, errors.New("encryption-failed"))
+			s3API.DeleteBucketWithContextReturns(nil, errors.New("delete-failed"))
+			s3API.GetBucketTaggingWithContextReturns(nil, awserr.New("NoSuchTagSet", "The TagSet does not exist", nil))
+
+			err := s3Client.CreateBucket(context.Background(), provisionData)
+			Expect(err).To(MatchError(ContainSubstring("encryption-failed")))
+			Expect(err).To(MatchError(ContainSubstring("delete-failed")))
+
+			Expect(s3API.PutBucketTaggingWithContextCallCount()).To(Equal(1))
+			tagSet := inputOf(s3API.PutBucketTaggingWithContextArgsForCall(0)).Tagging.TagSet
+			Expect(tagSet).To(HaveLen(1))
+			Expect(tagSet[0].Key).To(HaveValue(Equal(s3.NeedsCleanupTagKey)))
+		})
+	})
+})
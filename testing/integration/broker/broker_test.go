@@ -1,10 +1,10 @@
 package broker_test
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"path"
 	"sync"
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	aws_s3 "github.com/aws/aws-sdk-go/service/s3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -37,6 +38,10 @@ type BindingResponse struct {
 	Credentials map[string]interface{} `json:"credentials"`
 }
 
+type InstanceResponse struct {
+	Parameters s3.BucketDetails `json:"parameters"`
+}
+
 var _ = Describe("Broker", func() {
 
 	var (
@@ -69,7 +74,8 @@ var _ = Describe("Broker", func() {
 			ServiceID: serviceID,
 			PlanID:    planID,
 		}, ASYNC_ALLOWED)
-		Expect(res.Code).To(Equal(http.StatusCreated))
+		Expect(res.Code).To(Equal(http.StatusAccepted))
+		helpers.WaitForProvision(brokerTester, res, instanceID, serviceID, planID)
 
 		defer helpers.DeprovisionService(brokerTester, instanceID, serviceID, planID)
 
@@ -109,6 +115,25 @@ var _ = Describe("Broker", func() {
 		readOnlyBindingCreds := extractCredentials(res)
 		helpers.AssertBucketReadOnlyAccess(readOnlyBindingCreds, s3ClientConfig.ResourcePrefix, instanceID, s3ClientConfig.AWSRegion)
 
+		By("Fetching the instance")
+		res = brokerTester.Get(fmt.Sprintf("/v2/service_instances/%s", instanceID), url.Values{})
+		Expect(res.Code).To(Equal(http.StatusOK))
+		instance := InstanceResponse{}
+		Expect(json.NewDecoder(res.Body).Decode(&instance)).To(Succeed())
+		Expect(instance.Parameters.AWSRegion).To(Equal(s3ClientConfig.AWSRegion))
+		Expect(instance.Parameters.PublicBucket).To(BeFalse())
+		Expect(instance.Parameters.Encryption).To(Equal(s3.EncryptionAES256))
+		Expect(instance.Parameters.Tags).To(HaveKeyWithValue("service_instance_guid", instanceID))
+		Expect(instance.Parameters.Bindings).To(Equal(2))
+
+		By("Fetching the read-only binding")
+		res = brokerTester.GetBinding(instanceID, binding2ID, serviceID, planID)
+		Expect(res.Code).To(Equal(http.StatusOK))
+		parsedBinding := BindingResponse{}
+		Expect(json.NewDecoder(res.Body).Decode(&parsedBinding)).To(Succeed())
+		Expect(parsedBinding.Credentials).To(HaveKeyWithValue("bucket_name", readOnlyBindingCreds.BucketName))
+		Expect(parsedBinding.Credentials).To(HaveKeyWithValue("aws_access_key_id", readOnlyBindingCreds.AWSAccessKeyID))
+
 		By("Asserting the first user's credentials still work for reading and writing")
 		helpers.AssertBucketReadWriteAccess(readWriteBindingCreds, s3ClientConfig.ResourcePrefix, instanceID, s3ClientConfig.AWSRegion)
 
@@ -142,7 +167,8 @@ var _ = Describe("Broker", func() {
 			PlanID:     planID,
 			Parameters: &brokertesting.ConfigurationValues{"public_bucket": true},
 		}, ASYNC_ALLOWED)
-		Expect(res.Code).To(Equal(http.StatusCreated))
+		Expect(res.Code).To(Equal(http.StatusAccepted))
+		helpers.WaitForProvision(brokerTester, res, instanceID, serviceID, planID)
 
 		defer helpers.DeprovisionService(brokerTester, instanceID, serviceID, planID)
 
@@ -187,7 +213,8 @@ var _ = Describe("Broker", func() {
 				"public_bucket": false,
 			},
 		}, ASYNC_ALLOWED)
-		Expect(res.Code).To(Equal(http.StatusCreated))
+		Expect(res.Code).To(Equal(http.StatusAccepted))
+		helpers.WaitForProvision(brokerTester, res, instanceID, serviceID, planID)
 
 		defer helpers.DeprovisionService(brokerTester, instanceID, serviceID, planID)
 
@@ -233,7 +260,8 @@ var _ = Describe("Broker", func() {
 					"public_bucket": false,
 				},
 			}, ASYNC_ALLOWED)
-			Expect(res.Code).To(Equal(http.StatusCreated))
+			Expect(res.Code).To(Equal(http.StatusAccepted))
+			helpers.WaitForProvision(brokerTester, res, instanceID, serviceID, planID)
 
 			defer helpers.DeprovisionService(brokerTester, instanceID, serviceID, planID)
 
@@ -287,7 +315,8 @@ var _ = Describe("Broker", func() {
 					"public_bucket": false,
 				},
 			}, ASYNC_ALLOWED)
-			Expect(res.Code).To(Equal(http.StatusCreated))
+			Expect(res.Code).To(Equal(http.StatusAccepted))
+			helpers.WaitForProvision(brokerTester, res, instanceID, serviceID, planID)
 
 			defer helpers.DeprovisionService(brokerTester, instanceID, serviceID, planID)
 
@@ -347,7 +376,8 @@ var _ = Describe("Broker", func() {
 						"public_bucket": false,
 					},
 				}, ASYNC_ALLOWED)
-				Expect(res.Code).To(Equal(http.StatusCreated))
+				Expect(res.Code).To(Equal(http.StatusAccepted))
+				helpers.WaitForProvision(brokerTester, res, instanceID, serviceID, planID)
 
 				DeferCleanup(func() {
 					helpers.DeprovisionService(brokerTester, instanceID, serviceID, planID)
@@ -407,7 +437,8 @@ var _ = Describe("Broker", func() {
 						"public_bucket": false,
 					},
 				}, ASYNC_ALLOWED)
-				Expect(res.Code).To(Equal(http.StatusCreated))
+				Expect(res.Code).To(Equal(http.StatusAccepted))
+				helpers.WaitForProvision(brokerTester, res, instanceID, serviceID, planID)
 
 				DeferCleanup(func() {
 					helpers.DeprovisionService(brokerTester, instanceID, serviceID, planID)
@@ -458,7 +489,8 @@ var _ = Describe("Broker", func() {
 				PlanID:     planID,
 				Parameters: &brokertesting.ConfigurationValues{"public_bucket": true},
 			}, ASYNC_ALLOWED)
-			Expect(res.Code).To(Equal(http.StatusCreated))
+			Expect(res.Code).To(Equal(http.StatusAccepted))
+			helpers.WaitForProvision(brokerTester, res, instanceID, serviceID, planID)
 			defer helpers.DeprovisionService(brokerTester, instanceID, serviceID, planID)
 
 			By("binding in parallel")
@@ -564,7 +596,7 @@ func initialise(
 	logger.RegisterSink(lager.NewWriterSink(GinkgoWriter, config.API.LagerLogLevel))
 
 	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(s3ClientConfig.AWSRegion)}))
-	s3Client := s3.NewS3Client(s3ClientConfig, aws_s3.New(sess), iam.New(sess), logger, context.Background())
+	s3Client := s3.NewS3Client(s3ClientConfig, aws_s3.New(sess), iam.New(sess), kms.New(sess), logger)
 
 	s3Provider := provider.NewS3Provider(s3Client)
 
@@ -576,12 +608,12 @@ func initialise(
 	_, binderimplemented := serviceBroker.BinderImplemented()
 	_, provisionerimplemented := serviceBroker.ProvisionerImplemented()
 	_, updaterimplemented := serviceBroker.UpdaterImplemented()
-	Expect(asyncbinderimplemented).To(BeFalse())
+	Expect(asyncbinderimplemented).To(BeTrue())
 	Expect(asyncprovisionerimplemented).To(BeTrue())
 	Expect(binderimplemented).To(BeTrue())
 	Expect(provisionerimplemented).To(BeTrue())
 	Expect(updaterimplemented).To(BeTrue())
-	brokerAPI := broker.NewAPI(serviceBroker, logger, config)
+	brokerAPI := broker.NewAPI(provider.NewBroker(serviceBroker, s3Provider, config, logger), logger, config)
 
 	return s3ClientConfig, brokertesting.New(brokerapi.BrokerCredentials{
 		Username: "username",
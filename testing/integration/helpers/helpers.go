@@ -1,10 +1,12 @@
 package helpers
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"time"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/codecommit"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/brokerapi/v10/domain"
 )
 
 const (
@@ -126,6 +129,26 @@ func DeleteTempFile(creds s3.BucketCredentials, bucketPrefix, bucketName, region
 	}, 10*time.Second).ShouldNot(HaveOccurred())
 }
 
+func WaitForProvision(brokerTester brokertesting.BrokerTester, provisionRes *httptest.ResponseRecorder, instanceID, serviceID, planID string) {
+	By("Waiting for the bucket to be provisioned")
+	provisionResponse := struct {
+		Operation string `json:"operation"`
+	}{}
+	err := json.NewDecoder(provisionRes.Body).Decode(&provisionResponse)
+	Expect(err).ToNot(HaveOccurred())
+
+	Eventually(func() domain.LastOperationState {
+		res := brokerTester.LastOperation(instanceID, serviceID, planID, provisionResponse.Operation)
+		Expect(res.Code).To(Equal(http.StatusOK))
+
+		lastOperation := domain.LastOperation{}
+		err := json.NewDecoder(res.Body).Decode(&lastOperation)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lastOperation.State).ToNot(Equal(domain.Failed), lastOperation.Description)
+		return lastOperation.State
+	}, 2*time.Minute, 2*time.Second).Should(Equal(domain.Succeeded))
+}
+
 func DeprovisionService(brokerTester brokertesting.BrokerTester, instanceID, serviceID, planID string) {
 	By("Deprovisioning")
 	res := brokerTester.Deprovision(instanceID, serviceID, planID, true)